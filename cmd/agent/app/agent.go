@@ -48,6 +48,7 @@ import (
 	"github.com/karmada-io/karmada/pkg/controllers/mcs"
 	"github.com/karmada-io/karmada/pkg/controllers/multiclusterservice"
 	"github.com/karmada-io/karmada/pkg/controllers/status"
+	"github.com/karmada-io/karmada/pkg/executor"
 	"github.com/karmada-io/karmada/pkg/features"
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
 	"github.com/karmada-io/karmada/pkg/karmadactl/util/apiclient"
@@ -57,11 +58,13 @@ import (
 	"github.com/karmada-io/karmada/pkg/sharedcli/klogflag"
 	"github.com/karmada-io/karmada/pkg/sharedcli/profileflag"
 	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/bandwidth"
 	"github.com/karmada-io/karmada/pkg/util/fedinformer"
 	"github.com/karmada-io/karmada/pkg/util/fedinformer/genericmanager"
 	"github.com/karmada-io/karmada/pkg/util/fedinformer/typedmanager"
 	"github.com/karmada-io/karmada/pkg/util/gclient"
 	"github.com/karmada-io/karmada/pkg/util/helper"
+	"github.com/karmada-io/karmada/pkg/util/lifted"
 	"github.com/karmada-io/karmada/pkg/util/names"
 	"github.com/karmada-io/karmada/pkg/util/objectwatcher"
 	"github.com/karmada-io/karmada/pkg/util/restmapper"
@@ -150,6 +153,25 @@ func run(ctx context.Context, opts *options.Options) error {
 	controlPlaneKubeClient := kubeclientset.NewForConfigOrDie(controlPlaneRestConfig)
 	karmadaClient := karmadaclientset.NewForConfigOrDie(controlPlaneRestConfig)
 
+	clusterTaints, taintsToRemove, err := lifted.ParseTaints(opts.ClusterTaints)
+	if err != nil {
+		return fmt.Errorf("failed to parse cluster taints: %w", err)
+	}
+	if len(taintsToRemove) > 0 {
+		return fmt.Errorf("--cluster-taints does not support removing taints")
+	}
+
+	// Derive region and zones from the node topology labels unless specified explicitly.
+	if opts.ClusterRegion == "" && len(opts.ClusterZones) == 0 {
+		region, zones, err := util.DiscoverRegionAndZones(clusterKubeClient)
+		if err != nil {
+			klog.Warningf("Failed to discover region and zones of the member cluster: %v", err)
+		} else {
+			opts.ClusterRegion = region
+			opts.ClusterZones = zones
+		}
+	}
+
 	registerOption := util.ClusterRegisterOption{
 		ClusterNamespace:   opts.ClusterNamespace,
 		ClusterName:        opts.ClusterName,
@@ -159,6 +181,8 @@ func run(ctx context.Context, opts *options.Options) error {
 		ClusterProvider:    opts.ClusterProvider,
 		ClusterRegion:      opts.ClusterRegion,
 		ClusterZones:       opts.ClusterZones,
+		ClusterLabels:      opts.ClusterLabels,
+		ClusterTaints:      clusterTaints,
 		DryRun:             false,
 		ControlPlaneConfig: controlPlaneRestConfig,
 		ClusterConfig:      clusterConfig,
@@ -229,6 +253,7 @@ func run(ctx context.Context, opts *options.Options) error {
 	}
 
 	ctrlmetrics.Registry.MustRegister(metrics.ClusterCollectors()...)
+	ctrlmetrics.Registry.MustRegister(bandwidth.Collectors()...)
 	ctrlmetrics.Registry.MustRegister(metrics.ResourceCollectorsForAgent()...)
 	ctrlmetrics.Registry.MustRegister(metrics.PoolCollectors()...)
 	ctrlmetrics.Registry.MustRegister(metrics.NewBuildInfoCollector())
@@ -339,6 +364,7 @@ func startExecutionController(ctx controllerscontext.Context) (bool, error) {
 		PredicateFunc:      helper.NewExecutionPredicateOnAgent(),
 		InformerManager:    genericmanager.GetInstance(),
 		RateLimiterOptions: ctx.Opts.RateLimiterOptions,
+		ExecutorRegistry:   executor.DefaultRegistry,
 	}
 	if err := executionController.SetupWithManager(ctx.Mgr); err != nil {
 		return false, err
@@ -437,6 +463,10 @@ func startCertRotationController(ctx controllerscontext.Context) (bool, error) {
 func generateClusterInControllerPlane(opts util.ClusterRegisterOption) (*clusterv1alpha1.Cluster, error) {
 	clusterObj := &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: opts.ClusterName}}
 	mutateFunc := func(cluster *clusterv1alpha1.Cluster) {
+		if cluster.Annotations == nil {
+			cluster.Annotations = map[string]string{}
+		}
+		cluster.Annotations[clusterv1alpha1.AgentVersionAnnotationKey] = version.Get().GitVersion
 		cluster.Spec.SyncMode = clusterv1alpha1.Pull
 		cluster.Spec.APIEndpoint = opts.ClusterAPIEndpoint
 		cluster.Spec.ProxyURL = opts.ProxyServerAddress
@@ -453,6 +483,17 @@ func generateClusterInControllerPlane(opts util.ClusterRegisterOption) (*cluster
 			cluster.Spec.Region = opts.ClusterRegion
 		}
 
+		for key, value := range opts.ClusterLabels {
+			if cluster.Labels == nil {
+				cluster.Labels = map[string]string{}
+			}
+			cluster.Labels[key] = value
+		}
+
+		if len(opts.ClusterTaints) > 0 {
+			cluster.Spec.Taints = opts.ClusterTaints
+		}
+
 		cluster.Spec.InsecureSkipTLSVerification = opts.ClusterConfig.TLSClientConfig.Insecure
 
 		if opts.ClusterConfig.Proxy != nil {
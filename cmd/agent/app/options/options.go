@@ -127,6 +127,13 @@ type Options struct {
 	// ClusterZones represents the zones of the cluster locate in.
 	ClusterZones []string
 
+	// ClusterLabels is the labels to be written to the Cluster object.
+	ClusterLabels map[string]string
+
+	// ClusterTaints is the taints to be written to the Cluster object, in the
+	// form 'key=value:Effect'.
+	ClusterTaints []string
+
 	// EnableClusterResourceModeling indicates if enable cluster resource modeling.
 	// The resource modeling might be used by the scheduler to make scheduling decisions
 	// in scenario of dynamic replica assignment based on cluster free resources.
@@ -205,6 +212,8 @@ func (o *Options) AddFlags(fs *pflag.FlagSet, allControllers []string) {
 	fs.StringVar(&o.ClusterProvider, "cluster-provider", "", "Provider of the joining cluster. The Karmada scheduler can use this information to spread workloads across providers for higher availability.")
 	fs.StringVar(&o.ClusterRegion, "cluster-region", "", "The region of the joining cluster. The Karmada scheduler can use this information to spread workloads across regions for higher availability.")
 	fs.StringSliceVar(&o.ClusterZones, "cluster-zones", []string{}, "The zones of the joining cluster. The Karmada scheduler can use this information to spread workloads across zones for higher availability.")
+	fs.StringToStringVar(&o.ClusterLabels, "cluster-labels", nil, "Labels to be written to the Cluster object, e.g. 'env=prod,tier=frontend'.")
+	fs.StringSliceVar(&o.ClusterTaints, "cluster-taints", nil, "Taints to be written to the Cluster object, in the form 'key=value:Effect', e.g. 'dedicated=db:NoSchedule'.")
 	fs.BoolVar(&o.EnableClusterResourceModeling, "enable-cluster-resource-modeling", true, "Enable means controller would build resource modeling for each cluster by syncing Nodes and Pods resources.\n"+
 		"The resource modeling might be used by the scheduler to make scheduling decisions in scenario of dynamic replica assignment based on cluster free resources.\n"+
 		"Disable if it does not fit your cases for better performance.")
@@ -38,6 +38,7 @@ import (
 	"k8s.io/metrics/pkg/client/external_metrics"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
@@ -49,13 +50,18 @@ import (
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/chaos"
 	"github.com/karmada-io/karmada/pkg/clusterdiscovery/clusterapi"
 	"github.com/karmada-io/karmada/pkg/controllers/applicationfailover"
 	"github.com/karmada-io/karmada/pkg/controllers/binding"
 	"github.com/karmada-io/karmada/pkg/controllers/certificate/approver"
 	"github.com/karmada-io/karmada/pkg/controllers/cluster"
+	"github.com/karmada-io/karmada/pkg/controllers/clusterinventory"
+	"github.com/karmada-io/karmada/pkg/controllers/compliance"
 	controllerscontext "github.com/karmada-io/karmada/pkg/controllers/context"
+	"github.com/karmada-io/karmada/pkg/controllers/crdlifecycle"
 	"github.com/karmada-io/karmada/pkg/controllers/cronfederatedhpa"
+	"github.com/karmada-io/karmada/pkg/controllers/datamigration"
 	"github.com/karmada-io/karmada/pkg/controllers/deploymentreplicassyncer"
 	"github.com/karmada-io/karmada/pkg/controllers/execution"
 	"github.com/karmada-io/karmada/pkg/controllers/federatedhpa"
@@ -66,8 +72,11 @@ import (
 	"github.com/karmada-io/karmada/pkg/controllers/mcs"
 	"github.com/karmada-io/karmada/pkg/controllers/multiclusterservice"
 	"github.com/karmada-io/karmada/pkg/controllers/namespace"
+	"github.com/karmada-io/karmada/pkg/controllers/notification"
 	"github.com/karmada-io/karmada/pkg/controllers/remediation"
+	"github.com/karmada-io/karmada/pkg/controllers/schedulingreport"
 	"github.com/karmada-io/karmada/pkg/controllers/status"
+	"github.com/karmada-io/karmada/pkg/controllers/uncordon"
 	"github.com/karmada-io/karmada/pkg/controllers/unifiedauth"
 	"github.com/karmada-io/karmada/pkg/controllers/workloadrebalancer"
 	"github.com/karmada-io/karmada/pkg/dependenciesdistributor"
@@ -79,7 +88,9 @@ import (
 	"github.com/karmada-io/karmada/pkg/sharedcli"
 	"github.com/karmada-io/karmada/pkg/sharedcli/klogflag"
 	"github.com/karmada-io/karmada/pkg/sharedcli/profileflag"
+	"github.com/karmada-io/karmada/pkg/trafficsignal"
 	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/bandwidth"
 	"github.com/karmada-io/karmada/pkg/util/fedinformer"
 	"github.com/karmada-io/karmada/pkg/util/fedinformer/genericmanager"
 	"github.com/karmada-io/karmada/pkg/util/fedinformer/typedmanager"
@@ -90,6 +101,7 @@ import (
 	"github.com/karmada-io/karmada/pkg/util/objectwatcher"
 	"github.com/karmada-io/karmada/pkg/util/overridemanager"
 	"github.com/karmada-io/karmada/pkg/util/restmapper"
+	"github.com/karmada-io/karmada/pkg/util/shadow"
 	"github.com/karmada-io/karmada/pkg/version"
 	"github.com/karmada-io/karmada/pkg/version/sharedcommand"
 )
@@ -147,6 +159,15 @@ func Run(ctx context.Context, opts *options.Options) error {
 		panic(err)
 	}
 	controlPlaneRestConfig.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(opts.KubeAPIQPS, opts.KubeAPIBurst)
+	if opts.ShadowReconcile {
+		klog.Infof("Running in shadow mode: reconcile decisions are recorded but every write is executed as a server-side dry run")
+		if opts.LeaderElection.LeaderElect {
+			// A shadow instance must not compete with the active
+			// controller-manager for leadership, elect on a dedicated resource
+			// instead.
+			opts.LeaderElection.ResourceName += "-shadow"
+		}
+	}
 	controllerManager, err := controllerruntime.NewManager(controlPlaneRestConfig, controllerruntime.Options{
 		Logger:                     klog.Background(),
 		Scheme:                     gclient.NewSchema(),
@@ -179,6 +200,16 @@ func Run(ctx context.Context, opts *options.Options) error {
 			opts.DefaultTransform = fedinformer.StripUnusedFields
 			return cache.New(config, opts)
 		},
+		NewClient: func(config *rest.Config, options client.Options) (client.Client, error) {
+			c, err := client.New(config, options)
+			if err != nil {
+				return nil, err
+			}
+			if opts.ShadowReconcile {
+				c = shadow.NewClient(c)
+			}
+			return c, nil
+		},
 	})
 	if err != nil {
 		klog.Errorf("Failed to build controller manager: %v", err)
@@ -190,7 +221,24 @@ func Run(ctx context.Context, opts *options.Options) error {
 		return err
 	}
 
+	// Expose the per-cluster readiness fractions of exported Services on the
+	// metrics endpoint, so external GSLB or traffic managers can shift
+	// weights as Karmada detects degraded clusters.
+	if err := controllerManager.AddMetricsServerExtraHandler(trafficsignal.EndpointPath, trafficsignal.NewHandler(controllerManager.GetClient())); err != nil {
+		klog.Errorf("Failed to add traffic signal endpoint: %v", err)
+		return err
+	}
+
+	if chaos.Enabled() {
+		klog.Warning("This binary was built with the chaos build tag, fault injection is active, never use it in production")
+		if err := chaos.Setup(ctx, controlPlaneRestConfig); err != nil {
+			klog.Errorf("Failed to set up chaos fault injection: %v", err)
+			return err
+		}
+	}
+
 	ctrlmetrics.Registry.MustRegister(metrics.ClusterCollectors()...)
+	ctrlmetrics.Registry.MustRegister(bandwidth.Collectors()...)
 	ctrlmetrics.Registry.MustRegister(metrics.ResourceCollectors()...)
 	ctrlmetrics.Registry.MustRegister(metrics.PoolCollectors()...)
 	ctrlmetrics.Registry.MustRegister(metrics.NewBuildInfoCollector())
@@ -217,7 +265,7 @@ func Run(ctx context.Context, opts *options.Options) error {
 var controllers = make(controllerscontext.Initializers)
 
 // controllersDisabledByDefault is the set of controllers which is disabled by default
-var controllersDisabledByDefault = sets.New("hpaScaleTargetMarker", "deploymentReplicasSyncer")
+var controllersDisabledByDefault = sets.New("hpaScaleTargetMarker", "deploymentReplicasSyncer", "dataMigration", "notification", "crdLifecycle", "clusterInventory")
 
 func init() {
 	controllers["cluster"] = startClusterController
@@ -235,6 +283,10 @@ func init() {
 	controllers["federatedResourceQuotaStatus"] = startFederatedResourceQuotaStatusController
 	controllers["gracefulEviction"] = startGracefulEvictionController
 	controllers["applicationFailover"] = startApplicationFailoverController
+	controllers["dataMigration"] = startDataMigrationController
+	controllers["notification"] = startNotificationController
+	controllers["scheduledUncordon"] = startScheduledUncordonController
+	controllers["crdLifecycle"] = startCRDLifecycleController
 	controllers["federatedHorizontalPodAutoscaler"] = startFederatedHorizontalPodAutoscalerController
 	controllers["cronFederatedHorizontalPodAutoscaler"] = startCronFederatedHorizontalPodAutoscalerController
 	controllers["hpaScaleTargetMarker"] = startHPAScaleTargetMarkerController
@@ -243,8 +295,11 @@ func init() {
 	controllers["endpointsliceCollect"] = startEndpointSliceCollectController
 	controllers["endpointsliceDispatch"] = startEndpointSliceDispatchController
 	controllers["remedy"] = startRemedyController
+	controllers["schedulingReport"] = startSchedulingReportController
+	controllers["complianceReport"] = startComplianceReportController
 	controllers["workloadRebalancer"] = startWorkloadRebalancerController
 	controllers["agentcsrapproving"] = startAgentCSRApprovingController
+	controllers["clusterInventory"] = startClusterInventoryController
 }
 
 func startClusterController(ctx controllerscontext.Context) (enabled bool, err error) {
@@ -272,11 +327,13 @@ func startClusterController(ctx controllerscontext.Context) (enabled bool, err e
 			return false, err
 		}
 		taintManager := &cluster.NoExecuteTaintManager{
-			Client:                             mgr.GetClient(),
-			EventRecorder:                      mgr.GetEventRecorderFor(cluster.TaintManagerName),
-			ClusterTaintEvictionRetryFrequency: 10 * time.Second,
-			ConcurrentReconciles:               3,
-			RateLimiterOptions:                 ctx.Opts.RateLimiterOptions,
+			Client:                              mgr.GetClient(),
+			EventRecorder:                       mgr.GetEventRecorderFor(cluster.TaintManagerName),
+			ClusterTaintEvictionRetryFrequency:  10 * time.Second,
+			ConcurrentReconciles:                3,
+			DefaultNotReadyTolerationSeconds:    ctx.Opts.DefaultNotReadyTolerationSeconds,
+			DefaultUnreachableTolerationSeconds: ctx.Opts.DefaultUnreachableTolerationSeconds,
+			RateLimiterOptions:                  ctx.Opts.RateLimiterOptions,
 		}
 		if err := taintManager.SetupWithManager(mgr); err != nil {
 			return false, err
@@ -635,6 +692,52 @@ func startApplicationFailoverController(ctx controllerscontext.Context) (enabled
 	return true, nil
 }
 
+func startDataMigrationController(ctx controllerscontext.Context) (enabled bool, err error) {
+	dataMigrationController := &datamigration.Controller{
+		Client:             ctx.Mgr.GetClient(),
+		EventRecorder:      ctx.Mgr.GetEventRecorderFor(datamigration.ControllerName),
+		RateLimiterOptions: ctx.Opts.RateLimiterOptions,
+		MigratorRegistry:   datamigration.DefaultMigratorRegistry,
+	}
+	if err = dataMigrationController.SetupWithManager(ctx.Mgr); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func startNotificationController(ctx controllerscontext.Context) (enabled bool, err error) {
+	notificationController := &notification.Controller{
+		Client:             ctx.Mgr.GetClient(),
+		RateLimiterOptions: ctx.Opts.RateLimiterOptions,
+	}
+	if err = notificationController.SetupWithManager(ctx.Mgr); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func startScheduledUncordonController(ctx controllerscontext.Context) (enabled bool, err error) {
+	uncordonController := &uncordon.Controller{
+		Client:             ctx.Mgr.GetClient(),
+		RateLimiterOptions: ctx.Opts.RateLimiterOptions,
+	}
+	if err = uncordonController.SetupWithManager(ctx.Mgr); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func startCRDLifecycleController(ctx controllerscontext.Context) (enabled bool, err error) {
+	crdLifecycleController := &crdlifecycle.Controller{
+		Client:             ctx.Mgr.GetClient(),
+		RateLimiterOptions: ctx.Opts.RateLimiterOptions,
+	}
+	if err = crdLifecycleController.SetupWithManager(ctx.Mgr); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func startFederatedHorizontalPodAutoscalerController(ctx controllerscontext.Context) (enabled bool, err error) {
 	apiVersionsGetter := custom_metrics.NewAvailableAPIsGetter(ctx.KubeClientSet.Discovery())
 	go custom_metrics.PeriodicallyInvalidate(
@@ -733,6 +836,30 @@ func startRemedyController(ctx controllerscontext.Context) (enabled bool, err er
 	return true, nil
 }
 
+func startSchedulingReportController(ctx controllerscontext.Context) (enabled bool, err error) {
+	c := &schedulingreport.SchedulingReportController{
+		Client:             ctx.Mgr.GetClient(),
+		RateLimiterOptions: ctx.Opts.RateLimiterOptions,
+	}
+	if err = c.SetupWithManager(ctx.Mgr); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func startComplianceReportController(ctx controllerscontext.Context) (enabled bool, err error) {
+	c := &compliance.ComplianceReportController{
+		Client:                      ctx.Mgr.GetClient(),
+		ClusterDynamicClientSetFunc: util.NewClusterDynamicClientSet,
+		ClusterClientOption:         ctx.ClusterClientOption,
+		RateLimiterOptions:          ctx.Opts.RateLimiterOptions,
+	}
+	if err = c.SetupWithManager(ctx.Mgr); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func startWorkloadRebalancerController(ctx controllerscontext.Context) (enabled bool, err error) {
 	workloadRebalancer := workloadrebalancer.RebalancerController{
 		Client:             ctx.Mgr.GetClient(),
@@ -758,6 +885,26 @@ func startAgentCSRApprovingController(ctx controllerscontext.Context) (enabled b
 	return true, nil
 }
 
+func startClusterInventoryController(ctx controllerscontext.Context) (enabled bool, err error) {
+	if ctx.Opts.ClusterInventorySource == "" {
+		klog.Infof("Skipping %s, no cluster inventory source configured.", clusterinventory.ControllerName)
+		return false, nil
+	}
+	backend, err := clusterinventory.NewBackendFromSource(ctx.Opts.ClusterInventorySource, ctx.Mgr.GetClient())
+	if err != nil {
+		return false, err
+	}
+	inventoryController := &clusterinventory.ClusterInventoryController{
+		Client:             ctx.Mgr.GetClient(),
+		Backend:            backend,
+		RateLimiterOptions: ctx.Opts.RateLimiterOptions,
+	}
+	if err = inventoryController.SetupWithManager(ctx.Mgr); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // setupControllers initialize controllers and setup one by one.
 func setupControllers(ctx context.Context, mgr controllerruntime.Manager, opts *options.Options) {
 	restConfig := mgr.GetConfig()
@@ -818,6 +965,7 @@ func setupControllers(ctx context.Context, mgr controllerruntime.Manager, opts *
 			EventRecorder:                    mgr.GetEventRecorderFor("dependencies-distributor"),
 			RateLimiterOptions:               opts.RateLimiterOpts,
 			ConcurrentDependentResourceSyncs: opts.ConcurrentDependentResourceSyncs,
+			DependencyGCGracePeriod:          opts.DependencyGCGracePeriod.Duration,
 		}
 		if err := dependenciesDistributor.SetupWithManager(mgr); err != nil {
 			klog.Fatalf("Failed to setup dependencies distributor: %v", err)
@@ -828,24 +976,27 @@ func setupControllers(ctx context.Context, mgr controllerruntime.Manager, opts *
 		Mgr:           mgr,
 		ObjectWatcher: objectWatcher,
 		Opts: controllerscontext.Options{
-			Controllers:                       opts.Controllers,
-			ClusterMonitorPeriod:              opts.ClusterMonitorPeriod,
-			ClusterMonitorGracePeriod:         opts.ClusterMonitorGracePeriod,
-			ClusterStartupGracePeriod:         opts.ClusterStartupGracePeriod,
-			ClusterStatusUpdateFrequency:      opts.ClusterStatusUpdateFrequency,
-			FailoverEvictionTimeout:           opts.FailoverEvictionTimeout,
-			ClusterLeaseDuration:              opts.ClusterLeaseDuration,
-			ClusterLeaseRenewIntervalFraction: opts.ClusterLeaseRenewIntervalFraction,
-			ClusterSuccessThreshold:           opts.ClusterSuccessThreshold,
-			ClusterFailureThreshold:           opts.ClusterFailureThreshold,
-			ClusterCacheSyncTimeout:           opts.ClusterCacheSyncTimeout,
-			SkippedPropagatingNamespaces:      opts.SkippedNamespacesRegexps(),
-			ConcurrentWorkSyncs:               opts.ConcurrentWorkSyncs,
-			EnableTaintManager:                opts.EnableTaintManager,
-			RateLimiterOptions:                opts.RateLimiterOpts,
-			GracefulEvictionTimeout:           opts.GracefulEvictionTimeout,
-			EnableClusterResourceModeling:     opts.EnableClusterResourceModeling,
-			HPAControllerConfiguration:        opts.HPAControllerConfiguration,
+			Controllers:                         opts.Controllers,
+			ClusterMonitorPeriod:                opts.ClusterMonitorPeriod,
+			ClusterMonitorGracePeriod:           opts.ClusterMonitorGracePeriod,
+			ClusterStartupGracePeriod:           opts.ClusterStartupGracePeriod,
+			ClusterStatusUpdateFrequency:        opts.ClusterStatusUpdateFrequency,
+			FailoverEvictionTimeout:             opts.FailoverEvictionTimeout,
+			ClusterLeaseDuration:                opts.ClusterLeaseDuration,
+			ClusterLeaseRenewIntervalFraction:   opts.ClusterLeaseRenewIntervalFraction,
+			ClusterSuccessThreshold:             opts.ClusterSuccessThreshold,
+			ClusterFailureThreshold:             opts.ClusterFailureThreshold,
+			ClusterCacheSyncTimeout:             opts.ClusterCacheSyncTimeout,
+			SkippedPropagatingNamespaces:        opts.SkippedNamespacesRegexps(),
+			ConcurrentWorkSyncs:                 opts.ConcurrentWorkSyncs,
+			EnableTaintManager:                  opts.EnableTaintManager,
+			DefaultNotReadyTolerationSeconds:    opts.DefaultNotReadyTolerationSeconds,
+			DefaultUnreachableTolerationSeconds: opts.DefaultUnreachableTolerationSeconds,
+			RateLimiterOptions:                  opts.RateLimiterOpts,
+			GracefulEvictionTimeout:             opts.GracefulEvictionTimeout,
+			EnableClusterResourceModeling:       opts.EnableClusterResourceModeling,
+			HPAControllerConfiguration:          opts.HPAControllerConfiguration,
+			ClusterInventorySource:              opts.ClusterInventorySource,
 		},
 		Context:                     ctx,
 		DynamicClientSet:            dynamicClientSet,
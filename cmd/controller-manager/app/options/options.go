@@ -131,13 +131,34 @@ type Options struct {
 	ConcurrentResourceTemplateSyncs int
 	// ConcurrentDependentResourceSyncs is the number of dependent resource that are allowed to sync concurrently.
 	ConcurrentDependentResourceSyncs int
+	// DependencyGCGracePeriod is how long a dependency resource stays unreferenced before
+	// it is removed from member clusters. Zero means remove immediately.
+	DependencyGCGracePeriod metav1.Duration
 	// If set to true enables NoExecute Taints and will evict all not-tolerating
 	// objects propagating on Clusters tainted with this kind of Taints.
 	EnableTaintManager bool
+	// DefaultNotReadyTolerationSeconds is the default tolerationSeconds the taint manager
+	// applies against the notReady:NoExecute taint for bindings whose placement does not
+	// declare its own toleration.
+	DefaultNotReadyTolerationSeconds int64
+	// DefaultUnreachableTolerationSeconds is the default tolerationSeconds the taint manager
+	// applies against the unreachable:NoExecute taint for bindings whose placement does not
+	// declare its own toleration.
+	DefaultUnreachableTolerationSeconds int64
 	// GracefulEvictionTimeout is the timeout period waiting for the grace-eviction-controller performs the final
 	// removal since the workload(resource) has been moved to the graceful eviction tasks.
 	GracefulEvictionTimeout metav1.Duration
 
+	// ClusterInventorySource is the source of the declarative cluster inventory,
+	// in the form 'file:<path>' or 'configmap:<namespace>/<name>'. Empty means the
+	// cluster inventory controller stays idle.
+	ClusterInventorySource string
+
+	// ShadowReconcile indicates the controller-manager runs as a canary
+	// alongside the active one: controllers compute their decisions and record
+	// them, but every write is executed as a server-side dry run.
+	ShadowReconcile bool
+
 	RateLimiterOpts            ratelimiterflag.Options
 	ProfileOpts                profileflag.Options
 	HPAControllerConfiguration config.HPAControllerConfiguration
@@ -223,12 +244,17 @@ func (o *Options) AddFlags(flags *pflag.FlagSet, allControllers, disabledByDefau
 	flags.IntVar(&o.ConcurrentClusterPropagationPolicySyncs, "concurrent-cluster-propagation-policy-syncs", 1, "The number of ClusterPropagationPolicy that are allowed to sync concurrently.")
 	flags.IntVar(&o.ConcurrentResourceTemplateSyncs, "concurrent-resource-template-syncs", 5, "The number of resource templates that are allowed to sync concurrently.")
 	flags.IntVar(&o.ConcurrentDependentResourceSyncs, "concurrent-dependent-resource-syncs", 2, "The number of dependent resource that are allowed to sync concurrently.")
+	flags.DurationVar(&o.DependencyGCGracePeriod.Duration, "dependency-gc-grace-period", 0, "Specifies how long a dependency resource propagated by PropagateDeps stays unreferenced before it is removed from member clusters, protecting dependencies from transient reference changes. 0 means remove immediately.")
 	flags.BoolVar(&o.EnableTaintManager, "enable-taint-manager", true, "If set to true enables NoExecute Taints and will evict all not-tolerating objects propagating on Clusters tainted with this kind of Taints.")
+	flags.Int64Var(&o.DefaultNotReadyTolerationSeconds, "default-not-ready-toleration-seconds", 300, "Indicates the tolerationSeconds of the toleration for notReady:NoExecute that the taint manager applies to every binding whose placement does not already have such a toleration.")
+	flags.Int64Var(&o.DefaultUnreachableTolerationSeconds, "default-unreachable-toleration-seconds", 300, "Indicates the tolerationSeconds of the toleration for unreachable:NoExecute that the taint manager applies to every binding whose placement does not already have such a toleration.")
 	flags.DurationVar(&o.GracefulEvictionTimeout.Duration, "graceful-eviction-timeout", 10*time.Minute, "Specifies the timeout period waiting for the graceful-eviction-controller performs the final removal since the workload(resource) has been moved to the graceful eviction tasks.")
 	flags.BoolVar(&o.EnableClusterResourceModeling, "enable-cluster-resource-modeling", true, "Enable means controller would build resource modeling for each cluster by syncing Nodes and Pods resources.\n"+
 		"The resource modeling might be used by the scheduler to make scheduling decisions in scenario of dynamic replica assignment based on cluster free resources.\n"+
 		"Disable if it does not fit your cases for better performance.")
 
+	flags.StringVar(&o.ClusterInventorySource, "cluster-inventory-source", "", "The source of the declarative cluster inventory, in the form 'file:<path>' or 'configmap:<namespace>/<name>'. Requires the clusterInventory controller to be enabled.")
+	flags.BoolVar(&o.ShadowReconcile, "shadow-reconcile", false, "Run the controller-manager in shadow mode: compute reconcile decisions and record them, but execute every write as a server-side dry run. Intended for canarying a new controller-manager version alongside the active one.")
 	o.RateLimiterOpts.AddFlags(flags)
 	o.ProfileOpts.AddFlags(flags)
 	o.HPAControllerConfiguration.AddFlags(flags)
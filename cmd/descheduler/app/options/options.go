@@ -32,6 +32,10 @@ const (
 	defaultEstimatorPort          = 10352
 	defaultDeschedulingInterval   = 2 * time.Minute
 	defaultUnschedulableThreshold = 5 * time.Minute
+
+	defaultClusterPressureThreshold       = 80
+	defaultClusterPressurePodThreshold    = 90
+	defaultClusterPressureRequiredSamples = 3
 )
 
 var (
@@ -70,7 +74,23 @@ type Options struct {
 	DeschedulingInterval metav1.Duration
 	// UnschedulableThreshold specifies the period of pod unschedulable condition.
 	UnschedulableThreshold metav1.Duration
-	ProfileOpts            profileflag.Options
+	// EnableClusterPressureDescheduling enables proactively triggering binding
+	// rescheduling when a member cluster stays above the pressure thresholds,
+	// rather than only reacting to unschedulable pods.
+	EnableClusterPressureDescheduling bool
+	// ClusterPressureCPUThreshold is the allocated CPU percentage of the allocatable
+	// CPU above which a cluster is considered under pressure. 0 disables the dimension.
+	ClusterPressureCPUThreshold int
+	// ClusterPressureMemoryThreshold is the allocated memory percentage of the allocatable
+	// memory above which a cluster is considered under pressure. 0 disables the dimension.
+	ClusterPressureMemoryThreshold int
+	// ClusterPressurePodThreshold is the allocated pod count percentage of the allocatable
+	// pod count above which a cluster is considered under pressure. 0 disables the dimension.
+	ClusterPressurePodThreshold int
+	// ClusterPressureRequiredSamples is the number of consecutive descheduling intervals a
+	// cluster must stay above the thresholds before its bindings are rescheduled.
+	ClusterPressureRequiredSamples int
+	ProfileOpts                    profileflag.Options
 	// MetricsBindAddress is the TCP address that the server should bind to
 	// for serving prometheus metrics.
 	// It can be set to "0" to disable the metrics serving.
@@ -132,6 +152,11 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.SchedulerEstimatorServicePrefix, "scheduler-estimator-service-prefix", names.KarmadaSchedulerEstimatorComponentName, "The prefix of scheduler estimator service name")
 	fs.DurationVar(&o.DeschedulingInterval.Duration, "descheduling-interval", defaultDeschedulingInterval, "Time interval between two consecutive descheduler executions. Setting this value instructs the descheduler to run in a continuous loop at the interval specified.")
 	fs.DurationVar(&o.UnschedulableThreshold.Duration, "unschedulable-threshold", defaultUnschedulableThreshold, "The period of pod unschedulable condition. This value is considered as a classification standard of unschedulable replicas.")
+	fs.BoolVar(&o.EnableClusterPressureDescheduling, "enable-cluster-pressure-descheduling", false, "Enable triggering binding rescheduling when a member cluster stays above the cluster pressure thresholds for the required number of consecutive descheduling intervals.")
+	fs.IntVar(&o.ClusterPressureCPUThreshold, "cluster-pressure-cpu-threshold", defaultClusterPressureThreshold, "The allocated CPU percentage of the allocatable CPU above which a cluster is considered under pressure. Set to 0 to ignore CPU utilization.")
+	fs.IntVar(&o.ClusterPressureMemoryThreshold, "cluster-pressure-memory-threshold", defaultClusterPressureThreshold, "The allocated memory percentage of the allocatable memory above which a cluster is considered under pressure. Set to 0 to ignore memory utilization.")
+	fs.IntVar(&o.ClusterPressurePodThreshold, "cluster-pressure-pod-threshold", defaultClusterPressurePodThreshold, "The allocated pod count percentage of the allocatable pod count above which a cluster is considered under pressure. Set to 0 to ignore the pod count.")
+	fs.IntVar(&o.ClusterPressureRequiredSamples, "cluster-pressure-required-samples", defaultClusterPressureRequiredSamples, "The number of consecutive descheduling intervals a cluster must stay above the pressure thresholds before its bindings are rescheduled.")
 	fs.StringVar(&o.MetricsBindAddress, "metrics-bind-address", ":8080", "The TCP address that the server should bind to for serving prometheus metrics(e.g. 127.0.0.1:8080, :8080). It can be set to \"0\" to disable the metrics serving. Defaults to 0.0.0.0:8080.")
 	fs.StringVar(&o.HealthProbeBindAddress, "health-probe-bind-address", ":10358", "The TCP address that the server should bind to for serving health probes(e.g. 127.0.0.1:10358, :10358). It can be set to \"0\" to disable serving the health probe. Defaults to 0.0.0.0:10358.")
 	o.ProfileOpts.AddFlags(fs)
@@ -41,5 +41,21 @@ func (o *Options) Validate() field.ErrorList {
 		errs = append(errs, field.Invalid(newPath.Child("UnschedulableThreshold"), o.UnschedulableThreshold, "must be greater than or equal to 0"))
 	}
 
+	if o.ClusterPressureCPUThreshold < 0 || o.ClusterPressureCPUThreshold > 100 {
+		errs = append(errs, field.Invalid(newPath.Child("ClusterPressureCPUThreshold"), o.ClusterPressureCPUThreshold, "must be a percentage between 0 and 100 inclusive"))
+	}
+
+	if o.ClusterPressureMemoryThreshold < 0 || o.ClusterPressureMemoryThreshold > 100 {
+		errs = append(errs, field.Invalid(newPath.Child("ClusterPressureMemoryThreshold"), o.ClusterPressureMemoryThreshold, "must be a percentage between 0 and 100 inclusive"))
+	}
+
+	if o.ClusterPressurePodThreshold < 0 || o.ClusterPressurePodThreshold > 100 {
+		errs = append(errs, field.Invalid(newPath.Child("ClusterPressurePodThreshold"), o.ClusterPressurePodThreshold, "must be a percentage between 0 and 100 inclusive"))
+	}
+
+	if o.EnableClusterPressureDescheduling && o.ClusterPressureRequiredSamples < 1 {
+		errs = append(errs, field.Invalid(newPath.Child("ClusterPressureRequiredSamples"), o.ClusterPressureRequiredSamples, "must be greater than or equal to 1"))
+	}
+
 	return errs
 }
@@ -17,16 +17,59 @@ limitations under the License.
 package main
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc" //  Register AuthProviderPlugin, using OIDC mode to connect to Kubernetes
 	"k8s.io/component-base/cli"
 	"k8s.io/kubectl/pkg/cmd/util"
 
 	"github.com/karmada-io/karmada/pkg/karmadactl"
+	"github.com/karmada-io/karmada/pkg/karmadactl/get"
+	"github.com/karmada-io/karmada/pkg/karmadactl/plugin"
 )
 
 func main() {
 	cmd := karmadactl.NewKarmadaCtlCommand("karmadactl", "karmadactl")
+	// Dispatch to a karmadactl-<name> plugin on PATH, kubectl style, when the
+	// given subcommand is not built in. A plugin that executes replaces the
+	// current process; otherwise fall through and let cobra report the
+	// unknown command.
+	if len(os.Args) > 1 {
+		cmdPathPieces := os.Args[1:]
+		if _, _, err := cmd.Find(cmdPathPieces); err != nil {
+			var cmdName string // first "non-flag" argument
+			for _, arg := range cmdPathPieces {
+				if !strings.HasPrefix(arg, "-") {
+					cmdName = arg
+					break
+				}
+			}
+			switch cmdName {
+			case "help", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+				// these commands are added by cobra at execution time only,
+				// never resolve them to plugins
+			default:
+				if err := plugin.HandleCommand(plugin.NewDefaultHandler(), cmdPathPieces, 1); err != nil {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+					os.Exit(1)
+				}
+			}
+		}
+	}
 	if err := cli.RunNoErrOutput(cmd); err != nil {
+		// commands may attach a differentiated exit code(e.g. partial
+		// failures in `karmadactl get`) that scripts rely on
+		var codeErr *get.ExitCodeError
+		if errors.As(err, &codeErr) {
+			if msg := codeErr.Error(); len(msg) != 0 {
+				fmt.Fprintf(os.Stderr, "error: %s\n", msg)
+			}
+			os.Exit(codeErr.Code)
+		}
 		util.CheckErr(err)
 	}
 }
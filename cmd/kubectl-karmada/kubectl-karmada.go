@@ -17,15 +17,29 @@ limitations under the License.
 package main
 
 import (
+	"errors"
+	"fmt"
+	"os"
+
 	"k8s.io/component-base/cli"
 	"k8s.io/kubectl/pkg/cmd/util"
 
 	"github.com/karmada-io/karmada/pkg/karmadactl"
+	"github.com/karmada-io/karmada/pkg/karmadactl/get"
 )
 
 func main() {
 	cmd := karmadactl.NewKarmadaCtlCommand("kubectl-karmada", "kubectl karmada")
 	if err := cli.RunNoErrOutput(cmd); err != nil {
+		// commands may attach a differentiated exit code(e.g. partial
+		// failures in `karmadactl get`) that scripts rely on
+		var codeErr *get.ExitCodeError
+		if errors.As(err, &codeErr) {
+			if msg := codeErr.Error(); len(msg) != 0 {
+				fmt.Fprintf(os.Stderr, "error: %s\n", msg)
+			}
+			os.Exit(codeErr.Code)
+		}
 		util.CheckErr(err)
 	}
 }
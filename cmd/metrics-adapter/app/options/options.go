@@ -40,6 +40,7 @@ import (
 	generatedopenapi "github.com/karmada-io/karmada/pkg/generated/openapi"
 	versionmetrics "github.com/karmada-io/karmada/pkg/metrics"
 	"github.com/karmada-io/karmada/pkg/metricsadapter"
+	"github.com/karmada-io/karmada/pkg/metricsadapter/provider"
 	"github.com/karmada-io/karmada/pkg/sharedcli/profileflag"
 	"github.com/karmada-io/karmada/pkg/util"
 	"github.com/karmada-io/karmada/pkg/util/names"
@@ -95,12 +96,26 @@ type Options struct {
 	// KubeAPIBurst is the burst to allow while talking with karmada-apiserver.
 	KubeAPIBurst int
 	ProfileOpts  profileflag.Options
+
+	// CustomMetricsDefaultAggregation is the aggregation strategy applied to
+	// custom metrics without an explicit rule.
+	CustomMetricsDefaultAggregation string
+	// CustomMetricsAggregationRules maps custom metric names to aggregation
+	// strategies, each entry in the form METRIC=STRATEGY.
+	CustomMetricsAggregationRules []string
+	// CustomMetricsMissingClusterPolicy decides how custom metric queries
+	// behave when a member cluster fails to report a metric.
+	CustomMetricsMissingClusterPolicy string
+
+	aggregationOptions *provider.AggregationOptions
 }
 
 // NewOptions builds a default metrics-adapter options.
 func NewOptions() *Options {
 	o := &Options{
 		CustomMetricsAdapterServerOptions: options.NewCustomMetricsAdapterServerOptions(),
+		CustomMetricsDefaultAggregation:   string(provider.AggregationSum),
+		CustomMetricsMissingClusterPolicy: string(provider.MissingClusterIgnore),
 	}
 
 	return o
@@ -108,7 +123,16 @@ func NewOptions() *Options {
 
 // Complete fills in fields required to have valid data.
 func (o *Options) Complete() error {
-	return nil
+	rules, err := provider.ParseAggregationRules(o.CustomMetricsAggregationRules)
+	if err != nil {
+		return err
+	}
+	o.aggregationOptions = &provider.AggregationOptions{
+		DefaultStrategy:      provider.AggregationStrategy(o.CustomMetricsDefaultAggregation),
+		Rules:                rules,
+		MissingClusterPolicy: provider.MissingClusterPolicy(o.CustomMetricsMissingClusterPolicy),
+	}
+	return o.aggregationOptions.Validate()
 }
 
 // AddFlags adds flags to the specified FlagSet.
@@ -121,6 +145,9 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.Float32Var(&o.KubeAPIQPS, "kube-api-qps", 40.0, "QPS to use while talking with karmada-apiserver.")
 	fs.IntVar(&o.KubeAPIBurst, "kube-api-burst", 60, "Burst to use while talking with karmada-apiserver.")
 	fs.StringVar(&o.KubeConfig, "kubeconfig", o.KubeConfig, "Path to karmada control plane kubeconfig file.")
+	fs.StringVar(&o.CustomMetricsDefaultAggregation, "custom-metrics-default-aggregation", o.CustomMetricsDefaultAggregation, "Aggregation strategy applied to custom metrics collected from multiple member clusters when no per-metric rule matches. One of: sum, avg, max.")
+	fs.StringSliceVar(&o.CustomMetricsAggregationRules, "custom-metrics-aggregation-rules", o.CustomMetricsAggregationRules, "Per-metric aggregation strategies for custom metrics, each entry in the form METRIC=STRATEGY, e.g. http_requests=avg,queue_depth=max.")
+	fs.StringVar(&o.CustomMetricsMissingClusterPolicy, "custom-metrics-missing-cluster-policy", o.CustomMetricsMissingClusterPolicy, "Behavior of custom metric queries when a member cluster fails to report a metric. One of: ignore (aggregate over the reporting clusters), fail (reject the query).")
 }
 
 // Config returns config for the metrics-adapter server given Options
@@ -138,7 +165,7 @@ func (o *Options) Config(ctx context.Context) (*metricsadapter.MetricsServer, er
 	kubeFactory := informers.NewSharedInformerFactory(kubeClient, 0)
 	limiterGetter := util.GetClusterRateLimiterGetter().SetDefaultLimits(o.ClusterAPIQPS, o.ClusterAPIBurst)
 	metricsController := metricsadapter.NewMetricsController(ctx, restConfig, factory, kubeFactory, &util.ClientOption{RateLimiterGetter: limiterGetter.GetRateLimiter})
-	metricsAdapter := metricsadapter.NewMetricsAdapter(metricsController, o.CustomMetricsAdapterServerOptions)
+	metricsAdapter := metricsadapter.NewMetricsAdapter(metricsController, o.CustomMetricsAdapterServerOptions, o.aggregationOptions)
 	metricsAdapter.OpenAPIConfig = genericapiserver.DefaultOpenAPIConfig(generatedopenapi.GetOpenAPIDefinitions, openapinamer.NewDefinitionNamer(api.Scheme))
 	metricsAdapter.OpenAPIV3Config = genericapiserver.DefaultOpenAPIV3Config(generatedopenapi.GetOpenAPIDefinitions, openapinamer.NewDefinitionNamer(api.Scheme))
 	metricsAdapter.OpenAPIConfig.Info.Title = names.KarmadaMetricsAdapterComponentName
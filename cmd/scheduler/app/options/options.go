@@ -90,7 +90,10 @@ type Options struct {
 
 	// EnableEmptyWorkloadPropagation represents whether workload with 0 replicas could be propagated to member clusters.
 	EnableEmptyWorkloadPropagation bool
-	ProfileOpts                    profileflag.Options
+	// ShadowMode represents whether the scheduler runs as a canary alongside the active one:
+	// it computes schedule decisions and records divergences from the live results, but never acts.
+	ShadowMode  bool
+	ProfileOpts profileflag.Options
 
 	// Plugins is the list of plugins to enable or disable
 	// '*' means "all enabled by default plugins"
@@ -98,10 +101,26 @@ type Options struct {
 	// '*,-foo' means "disable 'foo'"
 	Plugins []string
 
+	// SchedulerConfigFile is the path to a versioned SchedulerConfiguration
+	// file declaring scheduling profiles with per-plugin arguments and score
+	// weights. Bindings selecting one of the profiles by schedulerName are
+	// scheduled with that profile, others keep the flag-based behavior.
+	SchedulerConfigFile string
+
 	// SchedulerName represents the name of the scheduler.
 	// default is "default-scheduler".
 	SchedulerName string
 
+	// ClusterLatencyProbeInterval specifies the interval between two cluster
+	// latency probe rounds. 0 disables the probes.
+	ClusterLatencyProbeInterval metav1.Duration
+	// ClusterLatencyConfigMap references the ConfigMap(namespace/name) to
+	// ingest latency measurements from. Empty disables the ingestion.
+	ClusterLatencyConfigMap string
+	// CarbonIntensityConfigMap references the ConfigMap(namespace/name) to
+	// ingest carbon intensity signals from. Empty disables the ingestion.
+	CarbonIntensityConfigMap string
+
 	// RateLimiterOpts contains the options for rate limiter.
 	RateLimiterOpts ratelimiterflag.Options
 }
@@ -160,9 +179,14 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.SchedulerEstimatorCaFile, "scheduler-estimator-ca-file", "", "SSL Certificate Authority file used to secure scheduler estimator communication.")
 	fs.BoolVar(&o.InsecureSkipEstimatorVerify, "insecure-skip-estimator-verify", false, "Controls whether verifies the scheduler estimator's certificate chain and host name.")
 	fs.BoolVar(&o.EnableEmptyWorkloadPropagation, "enable-empty-workload-propagation", false, "Enable workload with replicas 0 to be propagated to member clusters.")
+	fs.BoolVar(&o.ShadowMode, "shadow-mode", false, "Run the scheduler in shadow mode: compute schedule decisions and record divergences from the live results, but never write them back. Intended for canarying a new scheduler version alongside the active one.")
 	fs.StringSliceVar(&o.Plugins, "plugins", []string{"*"},
 		fmt.Sprintf("A list of plugins to enable. '*' enables all build-in and customized plugins, 'foo' enables the plugin named 'foo', '*,-foo' disables the plugin named 'foo'.\nAll build-in plugins: %s.", strings.Join(frameworkplugins.NewInTreeRegistry().FactoryNames(), ",")))
 	fs.StringVar(&o.SchedulerName, "scheduler-name", scheduler.DefaultScheduler, "SchedulerName represents the name of the scheduler. default is 'default-scheduler'.")
+	fs.DurationVar(&o.ClusterLatencyProbeInterval.Duration, "cluster-latency-probe-interval", 0, "The interval between two cluster latency probe rounds, which measure the network latency from the control plane to each member cluster API endpoint for the LatencyAware plugin. Set to 0 to disable the probes.")
+	fs.StringVar(&o.ClusterLatencyConfigMap, "cluster-latency-configmap", "", "The ConfigMap(namespace/name) to ingest cluster latency measurements from, for environments where the built-in probes can not reach the member clusters or better measurements are available from an external monitoring system.")
+	fs.StringVar(&o.CarbonIntensityConfigMap, "carbon-intensity-configmap", "", "The ConfigMap(namespace/name) to ingest per-cluster carbon intensity signals from, typically kept up to date by an adapter polling a grid data service, for the CarbonAware plugin.")
+	fs.StringVar(&o.SchedulerConfigFile, "config", "", "The path to a SchedulerConfiguration file declaring scheduling profiles, each with its own schedulerName, plugin enablement, per-plugin arguments and score weights. Profiles take precedence over the --plugins and --scheduler-name flags for the bindings that select them.")
 	features.FeatureGate.AddFlag(fs)
 	o.ProfileOpts.AddFlags(fs)
 	o.RateLimiterOpts.AddFlags(fs)
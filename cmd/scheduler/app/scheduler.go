@@ -42,6 +42,7 @@ import (
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
 	versionmetrics "github.com/karmada-io/karmada/pkg/metrics"
 	"github.com/karmada-io/karmada/pkg/scheduler"
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/scheduler/apis/config/v1alpha1"
 	"github.com/karmada-io/karmada/pkg/scheduler/framework/runtime"
 	"github.com/karmada-io/karmada/pkg/sharedcli"
 	"github.com/karmada-io/karmada/pkg/sharedcli/klogflag"
@@ -163,7 +164,7 @@ func run(ctx context.Context, opts *options.Options, registryOptions ...Option)
 		}
 	}
 
-	sched, err := scheduler.NewScheduler(dynamicClientSet, karmadaClient, kubeClientSet,
+	schedulerOpts := []scheduler.Option{
 		scheduler.WithOutOfTreeRegistry(outOfTreeRegistry),
 		scheduler.WithEnableSchedulerEstimator(opts.EnableSchedulerEstimator),
 		scheduler.WithDisableSchedulerEstimatorInPullMode(opts.DisableSchedulerEstimatorInPullMode),
@@ -172,10 +173,23 @@ func run(ctx context.Context, opts *options.Options, registryOptions ...Option)
 		scheduler.WithSchedulerEstimatorConnection(opts.SchedulerEstimatorPort, opts.SchedulerEstimatorCertFile, opts.SchedulerEstimatorKeyFile, opts.SchedulerEstimatorCaFile, opts.InsecureSkipEstimatorVerify),
 		scheduler.WithSchedulerEstimatorTimeout(opts.SchedulerEstimatorTimeout),
 		scheduler.WithEnableEmptyWorkloadPropagation(opts.EnableEmptyWorkloadPropagation),
+		scheduler.WithShadowMode(opts.ShadowMode),
 		scheduler.WithEnableSchedulerPlugin(opts.Plugins),
 		scheduler.WithSchedulerName(opts.SchedulerName),
 		scheduler.WithRateLimiterOptions(opts.RateLimiterOpts),
-	)
+		scheduler.WithLatencyProbeInterval(opts.ClusterLatencyProbeInterval.Duration),
+		scheduler.WithLatencyConfigMap(opts.ClusterLatencyConfigMap),
+		scheduler.WithCarbonIntensityConfigMap(opts.CarbonIntensityConfigMap),
+	}
+	if opts.SchedulerConfigFile != "" {
+		schedulerConfig, err := configv1alpha1.LoadSchedulerConfiguration(opts.SchedulerConfigFile)
+		if err != nil {
+			return fmt.Errorf("failed to load scheduler configuration: %w", err)
+		}
+		schedulerOpts = append(schedulerOpts, scheduler.WithSchedulerProfiles(schedulerConfig.Profiles))
+	}
+
+	sched, err := scheduler.NewScheduler(dynamicClientSet, karmadaClient, kubeClientSet, schedulerOpts...)
 	if err != nil {
 		return fmt.Errorf("couldn't create scheduler: %w", err)
 	}
@@ -185,6 +199,12 @@ func run(ctx context.Context, opts *options.Options, registryOptions ...Option)
 		return fmt.Errorf("scheduler exited")
 	}
 
+	if opts.ShadowMode {
+		// A shadow instance must not compete with the active scheduler for
+		// leadership, elect on a dedicated resource instead.
+		opts.LeaderElection.ResourceName += "-shadow"
+	}
+
 	leaderElectionClient, err := kubernetes.NewForConfig(rest.AddUserAgent(restConfig, "leader-election"))
 	if err != nil {
 		return err
@@ -18,8 +18,10 @@ package v1alpha1
 
 import (
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
@@ -44,6 +46,9 @@ var (
 	karmadaSearchImageRepository              = fmt.Sprintf("%s/%s", constants.KarmadaDefaultRepository, names.KarmadaSearchComponentName)
 )
 
+// etcdBackupVolumePath is the default host directory where etcd snapshots are stored.
+const etcdBackupVolumePath = "/var/lib/karmada/etcd-backup"
+
 func init() {
 	releaseVer, err := version.ParseGitVersion(version.Get().GitVersion)
 	if err != nil {
@@ -74,6 +79,19 @@ func SetObjectDefaultsKarmada(in *Karmada) {
 func setDefaultsKarmada(obj *Karmada) {
 	setDefaultsHostCluster(obj)
 	setDefaultsKarmadaComponents(obj)
+	setDefaultsSizing(obj)
+}
+
+func setDefaultsSizing(obj *Karmada) {
+	if obj.Spec.Sizing == nil {
+		return
+	}
+	if len(obj.Spec.Sizing.Mode) == 0 {
+		obj.Spec.Sizing.Mode = SizingModeRecommend
+	}
+	if obj.Spec.Sizing.Interval == nil {
+		obj.Spec.Sizing.Interval = &metav1.Duration{Duration: 10 * time.Minute}
+	}
 }
 
 func setDefaultsKarmadaComponents(obj *Karmada) {
@@ -136,6 +154,9 @@ func setDefaultsEtcd(obj *KarmadaComponents) {
 		if obj.Etcd.Local.VolumeData.EmptyDir == nil && obj.Etcd.Local.VolumeData.HostPath == nil && obj.Etcd.Local.VolumeData.VolumeClaim == nil {
 			obj.Etcd.Local.VolumeData.EmptyDir = &corev1.EmptyDirVolumeSource{}
 		}
+		if obj.Etcd.Local.Maintenance != nil && len(obj.Etcd.Local.Maintenance.BackupVolumePath) == 0 {
+			obj.Etcd.Local.Maintenance.BackupVolumePath = etcdBackupVolumePath
+		}
 	}
 }
 
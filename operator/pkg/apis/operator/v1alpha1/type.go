@@ -120,6 +120,49 @@ type KarmadaSpec struct {
 	// Currently, it only supports customizing the CA certificate for limited components.
 	// +optional
 	CustomCertificate *CustomCertificate `json:"customCertificate,omitempty"`
+
+	// Sizing configures the component resource auto-sizing support. When set,
+	// the operator periodically observes the size of the governed fleet
+	// (clusters, bindings, works) and publishes resource request/limit
+	// recommendations for every control plane component in the status,
+	// optionally applying them within bounds.
+	// +optional
+	Sizing *Sizing `json:"sizing,omitempty"`
+}
+
+// SizingMode describes how the operator acts on sizing recommendations.
+type SizingMode string
+
+const (
+	// SizingModeRecommend only publishes the recommendations in the status,
+	// leaving the component resources untouched.
+	SizingModeRecommend SizingMode = "Recommend"
+
+	// SizingModeAuto additionally applies the recommendations, within the
+	// configured bounds, to the control plane components.
+	SizingModeAuto SizingMode = "Auto"
+)
+
+// Sizing configures the component resource auto-sizing support.
+type Sizing struct {
+	// Mode describes how the operator acts on the sizing recommendations.
+	// Valid values are "Recommend" and "Auto".
+	// Defaults to "Recommend".
+	// +kubebuilder:validation:Enum=Recommend;Auto
+	// +kubebuilder:default=Recommend
+	// +optional
+	Mode SizingMode `json:"mode,omitempty"`
+
+	// MaxResourcePerComponent caps the recommended requests and limits of
+	// every component, e.g. cpu and memory. Mandatory safety net for the
+	// Auto mode, recommendations exceeding the cap are clamped to it.
+	// +optional
+	MaxResourcePerComponent corev1.ResourceList `json:"maxResourcePerComponent,omitempty"`
+
+	// Interval is the time between two fleet observations.
+	// Defaults to 10m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
 }
 
 // CustomCertificate holds the configuration for generating the certificate.
@@ -233,6 +276,42 @@ type LocalEtcd struct {
 	// PeerCertSANs sets extra Subject Alternative Names for the etcd peer signing cert.
 	// +optional
 	PeerCertSANs []string `json:"peerCertSANs,omitempty"`
+
+	// Maintenance configures scheduled snapshot backup and defragmentation
+	// of the built-in etcd cluster.
+	// +optional
+	Maintenance *EtcdMaintenance `json:"maintenance,omitempty"`
+}
+
+// EtcdMaintenance holds backup and defragmentation settings of the built-in etcd cluster.
+// The operator materializes the schedules as CronJobs running in the same namespace as
+// the etcd StatefulSet, using the same image and client credentials.
+type EtcdMaintenance struct {
+	// BackupSchedule is a cron expression(e.g. "0 */6 * * *") defining when to take
+	// a snapshot of the etcd cluster with 'etcdctl snapshot save'.
+	// Snapshots are written to the backup volume, one file per run.
+	// An empty value means scheduled backup is disabled.
+	// +optional
+	BackupSchedule string `json:"backupSchedule,omitempty"`
+
+	// DefragSchedule is a cron expression(e.g. "0 2 * * 0") defining when to
+	// defragment all etcd members with 'etcdctl defrag'.
+	// An empty value means scheduled defragmentation is disabled.
+	// +optional
+	DefragSchedule string `json:"defragSchedule,omitempty"`
+
+	// BackupVolumePath is a directory on the host machine where snapshots are stored.
+	// It is mounted into backup and restore pods as a hostPath volume.
+	// Defaults to "/var/lib/karmada/etcd-backup".
+	// +optional
+	BackupVolumePath string `json:"backupVolumePath,omitempty"`
+
+	// RestoreFromSnapshot is the path of a snapshot file, relative to BackupVolumePath,
+	// to restore the etcd data from. When set, the operator creates a one-shot Job that
+	// runs 'etcdutl snapshot restore' and materializes the restored data directory under
+	// '<BackupVolumePath>/restore', from which a new etcd data volume can be populated.
+	// +optional
+	RestoreFromSnapshot string `json:"restoreFromSnapshot,omitempty"`
 }
 
 // VolumeData describes the settings of etcd data store.
@@ -738,6 +817,51 @@ type KarmadaStatus struct {
 	// expose the service outside the cluster by Ingress.
 	// +optional
 	APIServerService *APIServerService `json:"apiServerService,omitempty"`
+
+	// FleetSummary reports the fleet size observed by the last sizing run.
+	// +optional
+	FleetSummary *FleetSummary `json:"fleetSummary,omitempty"`
+
+	// ComponentResourceRecommendations are the most recent per component
+	// resource recommendations derived from the observed fleet size.
+	// +optional
+	ComponentResourceRecommendations []ComponentResourceRecommendation `json:"componentResourceRecommendations,omitempty"`
+}
+
+// FleetSummary is the size of the fleet governed by the Karmada instance.
+type FleetSummary struct {
+	// Clusters is the number of registered member clusters.
+	// +optional
+	Clusters int32 `json:"clusters,omitempty"`
+
+	// ResourceBindings is the number of ResourceBindings.
+	// +optional
+	ResourceBindings int32 `json:"resourceBindings,omitempty"`
+
+	// Works is the number of Works.
+	// +optional
+	Works int32 `json:"works,omitempty"`
+
+	// LastObservedTime is the time of the observation.
+	// +optional
+	LastObservedTime metav1.Time `json:"lastObservedTime,omitempty"`
+}
+
+// ComponentResourceRecommendation recommends the resources of one control
+// plane component for the observed fleet size.
+type ComponentResourceRecommendation struct {
+	// Component is the name of the control plane component, e.g. karmada-apiserver.
+	// +required
+	Component string `json:"component"`
+
+	// Resources are the recommended resource requests and limits.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Applied reports whether the recommendation has been applied to the
+	// component, which only happens in the Auto sizing mode.
+	// +optional
+	Applied bool `json:"applied,omitempty"`
 }
 
 // APIServerService tells the location of Karmada API server service.
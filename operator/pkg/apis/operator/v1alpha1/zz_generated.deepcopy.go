@@ -106,6 +106,23 @@ func (in *CommonSettings) DeepCopy() *CommonSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentResourceRecommendation) DeepCopyInto(out *ComponentResourceRecommendation) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentResourceRecommendation.
+func (in *ComponentResourceRecommendation) DeepCopy() *ComponentResourceRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentResourceRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomCertificate) DeepCopyInto(out *CustomCertificate) {
 	*out = *in
@@ -158,6 +175,22 @@ func (in *Etcd) DeepCopy() *Etcd {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdMaintenance) DeepCopyInto(out *EtcdMaintenance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdMaintenance.
+func (in *EtcdMaintenance) DeepCopy() *EtcdMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalEtcd) DeepCopyInto(out *ExternalEtcd) {
 	*out = *in
@@ -195,6 +228,23 @@ func (in *ExternalEtcd) DeepCopy() *ExternalEtcd {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetSummary) DeepCopyInto(out *FleetSummary) {
+	*out = *in
+	in.LastObservedTime.DeepCopyInto(&out.LastObservedTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetSummary.
+func (in *FleetSummary) DeepCopy() *FleetSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPSource) DeepCopyInto(out *HTTPSource) {
 	*out = *in
@@ -675,6 +725,11 @@ func (in *KarmadaSpec) DeepCopyInto(out *KarmadaSpec) {
 		*out = new(CustomCertificate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Sizing != nil {
+		in, out := &in.Sizing, &out.Sizing
+		*out = new(Sizing)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -708,6 +763,18 @@ func (in *KarmadaStatus) DeepCopyInto(out *KarmadaStatus) {
 		*out = new(APIServerService)
 		**out = **in
 	}
+	if in.FleetSummary != nil {
+		in, out := &in.FleetSummary, &out.FleetSummary
+		*out = new(FleetSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ComponentResourceRecommendations != nil {
+		in, out := &in.ComponentResourceRecommendations, &out.ComponentResourceRecommendations
+		*out = make([]ComponentResourceRecommendation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -800,6 +867,11 @@ func (in *LocalEtcd) DeepCopyInto(out *LocalEtcd) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(EtcdMaintenance)
+		**out = **in
+	}
 	return
 }
 
@@ -850,6 +922,34 @@ func (in *Networking) DeepCopy() *Networking {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Sizing) DeepCopyInto(out *Sizing) {
+	*out = *in
+	if in.MaxResourcePerComponent != nil {
+		in, out := &in.MaxResourcePerComponent, &out.MaxResourcePerComponent
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sizing.
+func (in *Sizing) DeepCopy() *Sizing {
+	if in == nil {
+		return nil
+	}
+	out := new(Sizing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeData) DeepCopyInto(out *VolumeData) {
 	*out = *in
@@ -101,7 +101,11 @@ func (ctrl *Controller) Reconcile(ctx context.Context, req controllerruntime.Req
 		return controllerruntime.Result{}, nil
 	}
 
-	return controllerruntime.Result{}, ctrl.syncKarmada(karmada)
+	if err := ctrl.syncKarmada(karmada); err != nil {
+		return controllerruntime.Result{}, err
+	}
+
+	return ctrl.reconcileSizing(ctx, karmada)
 }
 
 func (ctrl *Controller) syncKarmada(karmada *operatorv1alpha1.Karmada) error {
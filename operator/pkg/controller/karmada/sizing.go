@@ -0,0 +1,316 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package karmada
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/karmada-io/karmada/operator/pkg/apis/operator/v1alpha1"
+	"github.com/karmada-io/karmada/operator/pkg/constants"
+	"github.com/karmada-io/karmada/operator/pkg/util"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+const (
+	// sizingDefaultInterval is the default time between two fleet observations.
+	sizingDefaultInterval = 10 * time.Minute
+
+	// sizingCountChunkSize bounds the page size of a single count request, so
+	// counting a large fleet never asks the apiserver for a complete list.
+	sizingCountChunkSize = 1000
+)
+
+// fleetSize is the observed size of the fleet governed by a Karmada instance.
+type fleetSize struct {
+	clusters         int32
+	resourceBindings int32
+	works            int32
+}
+
+// loadUnits condenses the fleet size into a single scale driver. Works and
+// bindings dominate the sustained load of the control plane, every cluster
+// additionally maintains status reports and watch connections.
+func (f fleetSize) loadUnits() int64 {
+	return int64(f.works) + int64(f.resourceBindings) + 100*int64(f.clusters)
+}
+
+// componentSizing models how the resource needs of one component grow with
+// the fleet. The growth figures are applied per thousand load units.
+type componentSizing struct {
+	component string
+	// workloadName yields the deployment name of the component, nil for
+	// components the Auto mode must not touch.
+	workloadName util.Namefunc
+
+	baseCPUMilli     int64
+	cpuMilliPerKUnit int64
+	baseMemoryMi     int64
+	memoryMiPerKUnit int64
+}
+
+// sizingModel holds the per component baselines and growth rates. The figures
+// are deliberately conservative, the bounds of the Sizing spec are the user's
+// handle on runaway recommendations. Etcd has no workload name on purpose,
+// resizing a quorum-based store is not safely automatable, so its
+// recommendation stays advisory even in the Auto mode.
+var sizingModel = []componentSizing{
+	{component: constants.Etcd, baseCPUMilli: 200, cpuMilliPerKUnit: 100, baseMemoryMi: 512, memoryMiPerKUnit: 256},
+	{component: constants.KarmadaAPIServer, workloadName: util.KarmadaAPIServerName, baseCPUMilli: 250, cpuMilliPerKUnit: 250, baseMemoryMi: 512, memoryMiPerKUnit: 256},
+	{component: names.KarmadaAggregatedAPIServerComponentName, workloadName: util.KarmadaAggregatedAPIServerName, baseCPUMilli: 100, cpuMilliPerKUnit: 50, baseMemoryMi: 256, memoryMiPerKUnit: 128},
+	{component: constants.KubeControllerManager, workloadName: util.KubeControllerManagerName, baseCPUMilli: 100, cpuMilliPerKUnit: 50, baseMemoryMi: 256, memoryMiPerKUnit: 128},
+	{component: names.KarmadaControllerManagerComponentName, workloadName: util.KarmadaControllerManagerName, baseCPUMilli: 200, cpuMilliPerKUnit: 200, baseMemoryMi: 512, memoryMiPerKUnit: 256},
+	{component: names.KarmadaSchedulerComponentName, workloadName: util.KarmadaSchedulerName, baseCPUMilli: 100, cpuMilliPerKUnit: 100, baseMemoryMi: 256, memoryMiPerKUnit: 128},
+	{component: names.KarmadaWebhookComponentName, workloadName: util.KarmadaWebhookName, baseCPUMilli: 100, cpuMilliPerKUnit: 50, baseMemoryMi: 256, memoryMiPerKUnit: 64},
+}
+
+// reconcileSizing runs the component resource auto-sizing support: it
+// observes the size of the fleet, publishes per component resource
+// recommendations in the status and, in the Auto mode, applies them to the
+// component workloads within the configured bounds.
+func (ctrl *Controller) reconcileSizing(ctx context.Context, karmada *operatorv1alpha1.Karmada) (controllerruntime.Result, error) {
+	if karmada.Spec.Sizing == nil {
+		return controllerruntime.Result{}, nil
+	}
+	if karmada.Status.SecretRef == nil {
+		// the control plane is not up yet, the reconciliation completing the
+		// installation triggers the first sizing run
+		return controllerruntime.Result{}, nil
+	}
+
+	localClusterClient, err := clientset.NewForConfig(ctrl.Config)
+	if err != nil {
+		return controllerruntime.Result{}, err
+	}
+	controlPlaneConfig, err := util.BuildRestConfigFromSecretRef(localClusterClient, karmada.Status.SecretRef)
+	if err != nil {
+		return controllerruntime.Result{}, err
+	}
+	karmadaClient, err := karmadaclientset.NewForConfig(controlPlaneConfig)
+	if err != nil {
+		return controllerruntime.Result{}, err
+	}
+
+	fleet, err := observeFleet(ctx, karmadaClient)
+	if err != nil {
+		return controllerruntime.Result{}, err
+	}
+	klog.V(2).InfoS("Observed fleet size", "karmada", karmada.Name,
+		"clusters", fleet.clusters, "resourceBindings", fleet.resourceBindings, "works", fleet.works)
+
+	recommendations := recommendComponentResources(fleet)
+	clampRecommendations(recommendations, karmada.Spec.Sizing.MaxResourcePerComponent)
+
+	if karmada.Spec.Sizing.Mode == operatorv1alpha1.SizingModeAuto {
+		if err := ctrl.applyRecommendations(ctx, karmada, localClusterClient, recommendations); err != nil {
+			return controllerruntime.Result{}, err
+		}
+	}
+
+	if err := ctrl.updateSizingStatus(ctx, karmada, fleet, recommendations); err != nil {
+		return controllerruntime.Result{}, err
+	}
+	return controllerruntime.Result{RequeueAfter: sizingInterval(karmada.Spec.Sizing)}, nil
+}
+
+// sizingInterval returns the time between two fleet observations.
+func sizingInterval(sizing *operatorv1alpha1.Sizing) time.Duration {
+	if sizing.Interval != nil && sizing.Interval.Duration > 0 {
+		return sizing.Interval.Duration
+	}
+	return sizingDefaultInterval
+}
+
+// observeFleet counts the clusters, bindings and works of the control plane.
+func observeFleet(ctx context.Context, client karmadaclientset.Interface) (fleetSize, error) {
+	fleet := fleetSize{}
+
+	clusterList, err := client.ClusterV1alpha1().Clusters().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fleet, fmt.Errorf("failed to count member clusters: %v", err)
+	}
+	fleet.clusters = int32(len(clusterList.Items)) // #nosec G115: the apiserver bounds the list size
+
+	fleet.resourceBindings, err = countChunked(func(opts metav1.ListOptions) (int, string, error) {
+		list, err := client.WorkV1alpha2().ResourceBindings(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return 0, "", err
+		}
+		return len(list.Items), list.Continue, nil
+	})
+	if err != nil {
+		return fleet, fmt.Errorf("failed to count resource bindings: %v", err)
+	}
+
+	fleet.works, err = countChunked(func(opts metav1.ListOptions) (int, string, error) {
+		list, err := client.WorkV1alpha1().Works(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return 0, "", err
+		}
+		return len(list.Items), list.Continue, nil
+	})
+	if err != nil {
+		return fleet, fmt.Errorf("failed to count works: %v", err)
+	}
+
+	return fleet, nil
+}
+
+// countChunked counts the objects of a list call page by page.
+func countChunked(list func(metav1.ListOptions) (int, string, error)) (int32, error) {
+	opts := metav1.ListOptions{Limit: sizingCountChunkSize}
+	var count int32
+	for {
+		length, continueToken, err := list(opts)
+		if err != nil {
+			return 0, err
+		}
+		count += int32(length) // #nosec G115: the page size is bounded
+		if len(continueToken) == 0 {
+			return count, nil
+		}
+		opts.Continue = continueToken
+	}
+}
+
+// recommendComponentResources derives per component resource recommendations
+// from the observed fleet size. The limits leave the usual operational
+// headroom of twice the requests.
+func recommendComponentResources(fleet fleetSize) []operatorv1alpha1.ComponentResourceRecommendation {
+	units := fleet.loadUnits()
+	recommendations := make([]operatorv1alpha1.ComponentResourceRecommendation, 0, len(sizingModel))
+	for _, sizing := range sizingModel {
+		cpuMilli := sizing.baseCPUMilli + sizing.cpuMilliPerKUnit*units/1000
+		memoryMi := sizing.baseMemoryMi + sizing.memoryMiPerKUnit*units/1000
+		recommendations = append(recommendations, operatorv1alpha1.ComponentResourceRecommendation{
+			Component: sizing.component,
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    *resource.NewMilliQuantity(cpuMilli, resource.DecimalSI),
+					corev1.ResourceMemory: *resource.NewQuantity(memoryMi<<20, resource.BinarySI),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    *resource.NewMilliQuantity(2*cpuMilli, resource.DecimalSI),
+					corev1.ResourceMemory: *resource.NewQuantity(2*memoryMi<<20, resource.BinarySI),
+				},
+			},
+		})
+	}
+	return recommendations
+}
+
+// clampRecommendations caps the recommended requests and limits at the
+// configured per component bounds.
+func clampRecommendations(recommendations []operatorv1alpha1.ComponentResourceRecommendation, bounds corev1.ResourceList) {
+	if len(bounds) == 0 {
+		return
+	}
+	for i := range recommendations {
+		clampResourceList(recommendations[i].Resources.Requests, bounds)
+		clampResourceList(recommendations[i].Resources.Limits, bounds)
+	}
+}
+
+func clampResourceList(list, bounds corev1.ResourceList) {
+	for name, bound := range bounds {
+		if value, exist := list[name]; exist && value.Cmp(bound) > 0 {
+			list[name] = bound.DeepCopy()
+		}
+	}
+}
+
+// applyRecommendations updates the container resources of the component
+// deployments on the host cluster and marks the applied recommendations.
+// Components that are not installed are skipped.
+func (ctrl *Controller) applyRecommendations(ctx context.Context, karmada *operatorv1alpha1.Karmada, localClusterClient clientset.Interface, recommendations []operatorv1alpha1.ComponentResourceRecommendation) error {
+	var hostClient clientset.Interface = localClusterClient
+	if !util.IsInCluster(karmada.Spec.HostCluster) {
+		remoteClient, err := util.BuildClientFromSecretRef(localClusterClient, karmada.Spec.HostCluster.SecretRef)
+		if err != nil {
+			return fmt.Errorf("error when creating cluster client to install karmada: %v", err)
+		}
+		hostClient = remoteClient
+	}
+
+	workloadNames := map[string]util.Namefunc{}
+	for _, sizing := range sizingModel {
+		workloadNames[sizing.component] = sizing.workloadName
+	}
+
+	for i := range recommendations {
+		workloadName := workloadNames[recommendations[i].Component]
+		if workloadName == nil {
+			continue
+		}
+		name := workloadName(karmada.Name)
+		resources := recommendations[i].Resources
+
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			deployment, err := hostClient.AppsV1().Deployments(karmada.GetNamespace()).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if len(deployment.Spec.Template.Spec.Containers) == 0 ||
+				reflect.DeepEqual(deployment.Spec.Template.Spec.Containers[0].Resources, resources) {
+				return nil
+			}
+			deployment.Spec.Template.Spec.Containers[0].Resources = *resources.DeepCopy()
+			_, err = hostClient.AppsV1().Deployments(karmada.GetNamespace()).Update(ctx, deployment, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to apply resource recommendation to component %s: %v", recommendations[i].Component, err)
+		}
+		recommendations[i].Applied = true
+	}
+	return nil
+}
+
+// updateSizingStatus publishes the fleet summary and the recommendations in
+// the status of the Karmada object.
+func (ctrl *Controller) updateSizingStatus(ctx context.Context, karmada *operatorv1alpha1.Karmada, fleet fleetSize, recommendations []operatorv1alpha1.ComponentResourceRecommendation) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		newer := &operatorv1alpha1.Karmada{}
+		if err := ctrl.Get(ctx, client.ObjectKeyFromObject(karmada), newer); err != nil {
+			return err
+		}
+		newer.Status.FleetSummary = &operatorv1alpha1.FleetSummary{
+			Clusters:         fleet.clusters,
+			ResourceBindings: fleet.resourceBindings,
+			Works:            fleet.works,
+			LastObservedTime: metav1.Now(),
+		}
+		newer.Status.ComponentResourceRecommendations = recommendations
+		return ctrl.Status().Update(ctx, newer)
+	})
+}
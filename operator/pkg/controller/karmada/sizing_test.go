@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package karmada
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/karmada-io/karmada/operator/pkg/apis/operator/v1alpha1"
+	"github.com/karmada-io/karmada/operator/pkg/constants"
+)
+
+func recommendationFor(t *testing.T, recommendations []operatorv1alpha1.ComponentResourceRecommendation, component string) operatorv1alpha1.ComponentResourceRecommendation {
+	t.Helper()
+	for _, recommendation := range recommendations {
+		if recommendation.Component == component {
+			return recommendation
+		}
+	}
+	t.Fatalf("no recommendation for component %s", component)
+	return operatorv1alpha1.ComponentResourceRecommendation{}
+}
+
+func TestRecommendComponentResources(t *testing.T) {
+	empty := recommendComponentResources(fleetSize{})
+	if len(empty) != len(sizingModel) {
+		t.Fatalf("recommendComponentResources() returned %d recommendation(s), want %d", len(empty), len(sizingModel))
+	}
+	apiserver := recommendationFor(t, empty, constants.KarmadaAPIServer)
+	if got := apiserver.Resources.Requests.Cpu().MilliValue(); got != 250 {
+		t.Errorf("empty fleet apiserver cpu request = %dm, want the 250m baseline", got)
+	}
+	if got, want := apiserver.Resources.Limits.Cpu().MilliValue(), 2*apiserver.Resources.Requests.Cpu().MilliValue(); got != want {
+		t.Errorf("apiserver cpu limit = %dm, want twice the request %dm", got, want)
+	}
+
+	// 100 clusters, 20000 bindings, 60000 works -> 90000 load units
+	large := recommendComponentResources(fleetSize{clusters: 100, resourceBindings: 20000, works: 60000})
+	largeAPIServer := recommendationFor(t, large, constants.KarmadaAPIServer)
+	if got, want := largeAPIServer.Resources.Requests.Cpu().MilliValue(), int64(250+250*90); got != want {
+		t.Errorf("large fleet apiserver cpu request = %dm, want %dm", got, want)
+	}
+	for _, sizing := range sizingModel {
+		small := recommendationFor(t, empty, sizing.component).Resources.Requests
+		big := recommendationFor(t, large, sizing.component).Resources.Requests
+		if big.Cpu().Cmp(*small.Cpu()) <= 0 || big.Memory().Cmp(*small.Memory()) <= 0 {
+			t.Errorf("component %s recommendation does not grow with the fleet", sizing.component)
+		}
+	}
+}
+
+func TestClampRecommendations(t *testing.T) {
+	recommendations := recommendComponentResources(fleetSize{clusters: 1000, works: 500000})
+	bounds := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("2"),
+		corev1.ResourceMemory: resource.MustParse("4Gi"),
+	}
+	clampRecommendations(recommendations, bounds)
+	for _, recommendation := range recommendations {
+		for _, list := range []corev1.ResourceList{recommendation.Resources.Requests, recommendation.Resources.Limits} {
+			if list.Cpu().MilliValue() > 2000 {
+				t.Errorf("component %s cpu %s exceeds the bound", recommendation.Component, list.Cpu())
+			}
+			if list.Memory().Value() > 4<<30 {
+				t.Errorf("component %s memory %s exceeds the bound", recommendation.Component, list.Memory())
+			}
+		}
+	}
+}
+
+func TestCountChunked(t *testing.T) {
+	pages := map[string]int{"": 1000, "page2": 1000, "page3": 42}
+	next := map[string]string{"": "page2", "page2": "page3", "page3": ""}
+	count, err := countChunked(func(opts metav1.ListOptions) (int, string, error) {
+		return pages[opts.Continue], next[opts.Continue], nil
+	})
+	if err != nil {
+		t.Fatalf("countChunked() returned error: %v", err)
+	}
+	if count != 2042 {
+		t.Errorf("countChunked() = %d, want 2042", count)
+	}
+
+	wantErr := fmt.Errorf("boom")
+	if _, err := countChunked(func(metav1.ListOptions) (int, string, error) { return 0, "", wantErr }); err != wantErr {
+		t.Errorf("countChunked() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestSizingInterval(t *testing.T) {
+	if got := sizingInterval(&operatorv1alpha1.Sizing{}); got != sizingDefaultInterval {
+		t.Errorf("sizingInterval() = %s, want the default %s", got, sizingDefaultInterval)
+	}
+	if got := sizingInterval(&operatorv1alpha1.Sizing{Interval: &metav1.Duration{Duration: time.Minute}}); got != time.Minute {
+		t.Errorf("sizingInterval() = %s, want 1m", got)
+	}
+}
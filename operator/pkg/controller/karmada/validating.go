@@ -83,10 +83,26 @@ func validateETCD(etcd *operatorv1alpha1.Etcd, karmadaName string, fldPath *fiel
 	return errs
 }
 
+func validateSizing(sizing *operatorv1alpha1.Sizing, fldPath *field.Path) (errs field.ErrorList) {
+	if sizing == nil {
+		return nil
+	}
+
+	if sizing.Mode == operatorv1alpha1.SizingModeAuto && len(sizing.MaxResourcePerComponent) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("maxResourcePerComponent"), "the Auto sizing mode requires an upper bound on the component resources"))
+	}
+	if sizing.Interval != nil && sizing.Interval.Duration < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("interval"), sizing.Interval.Duration, "must not be negative"))
+	}
+
+	return errs
+}
+
 func validate(karmada *operatorv1alpha1.Karmada) error {
 	var errs field.ErrorList
 
 	errs = append(errs, validateCRDTarball(karmada.Spec.CRDTarball, field.NewPath("spec").Child("crdTarball"))...)
+	errs = append(errs, validateSizing(karmada.Spec.Sizing, field.NewPath("spec").Child("sizing"))...)
 
 	if karmada.Spec.Components != nil {
 		components, fldPath := karmada.Spec.Components, field.NewPath("spec").Child("components")
@@ -19,6 +19,8 @@ package karmada
 import (
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	operatorv1alpha1 "github.com/karmada-io/karmada/operator/pkg/apis/operator/v1alpha1"
@@ -198,6 +200,36 @@ func Test_validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Auto sizing mode without bounds",
+			karmada: &operatorv1alpha1.Karmada{
+				TypeMeta:   karmadaType,
+				ObjectMeta: testObj,
+				Spec: operatorv1alpha1.KarmadaSpec{
+					Sizing: &operatorv1alpha1.Sizing{
+						Mode: operatorv1alpha1.SizingModeAuto,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Auto sizing mode with bounds",
+			karmada: &operatorv1alpha1.Karmada{
+				TypeMeta:   karmadaType,
+				ObjectMeta: testObj,
+				Spec: operatorv1alpha1.KarmadaSpec{
+					Sizing: &operatorv1alpha1.Sizing{
+						Mode: operatorv1alpha1.SizingModeAuto,
+						MaxResourcePerComponent: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("4"),
+							corev1.ResourceMemory: resource.MustParse("8Gi"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
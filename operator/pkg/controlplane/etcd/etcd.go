@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	kuberuntime "k8s.io/apimachinery/pkg/runtime"
 	clientset "k8s.io/client-go/kubernetes"
@@ -34,12 +35,119 @@ import (
 	"github.com/karmada-io/karmada/operator/pkg/util/patcher"
 )
 
+// etcdBackupMountPath is the path at which the backup volume is mounted into
+// backup and restore pods.
+const etcdBackupMountPath = "/backup"
+
 // EnsureKarmadaEtcd creates etcd StatefulSet and service resource.
 func EnsureKarmadaEtcd(client clientset.Interface, cfg *operatorv1alpha1.LocalEtcd, name, namespace string) error {
 	if err := installKarmadaEtcd(client, name, namespace, cfg); err != nil {
 		return err
 	}
-	return createEtcdService(client, name, namespace)
+	if err := createEtcdService(client, name, namespace); err != nil {
+		return err
+	}
+	return ensureEtcdMaintenance(client, name, namespace, cfg)
+}
+
+// ensureEtcdMaintenance materializes the configured etcd backup and defragmentation
+// schedules as CronJobs, and the optional snapshot restore as a one-shot Job.
+func ensureEtcdMaintenance(client clientset.Interface, name, namespace string, cfg *operatorv1alpha1.LocalEtcd) error {
+	if cfg.Maintenance == nil {
+		return nil
+	}
+
+	if len(cfg.Maintenance.BackupSchedule) != 0 {
+		etcdBackupCronJobBytes, err := util.ParseTemplate(KarmadaEtcdBackupCronJob, struct {
+			KarmadaInstanceName, CronJobName, Namespace, Schedule string
+			Image, ImagePullPolicy, EtcdClientService             string
+			CertsSecretName, BackupMountPath, BackupVolumePath    string
+			EtcdListenClientPort                                  int32
+		}{
+			KarmadaInstanceName:  name,
+			CronJobName:          fmt.Sprintf("%s-backup", util.KarmadaEtcdName(name)),
+			Namespace:            namespace,
+			Schedule:             cfg.Maintenance.BackupSchedule,
+			Image:                cfg.Image.Name(),
+			ImagePullPolicy:      string(cfg.ImagePullPolicy),
+			EtcdClientService:    util.KarmadaEtcdClientName(name),
+			CertsSecretName:      util.EtcdCertSecretName(name),
+			BackupMountPath:      etcdBackupMountPath,
+			BackupVolumePath:     cfg.Maintenance.BackupVolumePath,
+			EtcdListenClientPort: constants.EtcdListenClientPort,
+		})
+		if err != nil {
+			return fmt.Errorf("error when parsing Etcd backup CronJob template: %w", err)
+		}
+
+		etcdBackupCronJob := &batchv1.CronJob{}
+		if err := kuberuntime.DecodeInto(clientsetscheme.Codecs.UniversalDecoder(), etcdBackupCronJobBytes, etcdBackupCronJob); err != nil {
+			return fmt.Errorf("error when decoding Etcd backup CronJob: %w", err)
+		}
+		if err := apiclient.CreateOrUpdateCronJob(client, etcdBackupCronJob); err != nil {
+			return fmt.Errorf("error when creating Etcd backup CronJob, err: %w", err)
+		}
+	}
+
+	if len(cfg.Maintenance.DefragSchedule) != 0 {
+		etcdDefragCronJobBytes, err := util.ParseTemplate(KarmadaEtcdDefragCronJob, struct {
+			KarmadaInstanceName, CronJobName, Namespace, Schedule string
+			Image, ImagePullPolicy, EtcdClientService             string
+			CertsSecretName                                       string
+			EtcdListenClientPort                                  int32
+		}{
+			KarmadaInstanceName:  name,
+			CronJobName:          fmt.Sprintf("%s-defrag", util.KarmadaEtcdName(name)),
+			Namespace:            namespace,
+			Schedule:             cfg.Maintenance.DefragSchedule,
+			Image:                cfg.Image.Name(),
+			ImagePullPolicy:      string(cfg.ImagePullPolicy),
+			EtcdClientService:    util.KarmadaEtcdClientName(name),
+			CertsSecretName:      util.EtcdCertSecretName(name),
+			EtcdListenClientPort: constants.EtcdListenClientPort,
+		})
+		if err != nil {
+			return fmt.Errorf("error when parsing Etcd defrag CronJob template: %w", err)
+		}
+
+		etcdDefragCronJob := &batchv1.CronJob{}
+		if err := kuberuntime.DecodeInto(clientsetscheme.Codecs.UniversalDecoder(), etcdDefragCronJobBytes, etcdDefragCronJob); err != nil {
+			return fmt.Errorf("error when decoding Etcd defrag CronJob: %w", err)
+		}
+		if err := apiclient.CreateOrUpdateCronJob(client, etcdDefragCronJob); err != nil {
+			return fmt.Errorf("error when creating Etcd defrag CronJob, err: %w", err)
+		}
+	}
+
+	if len(cfg.Maintenance.RestoreFromSnapshot) != 0 {
+		etcdRestoreJobBytes, err := util.ParseTemplate(KarmadaEtcdRestoreJob, struct {
+			KarmadaInstanceName, JobName, Namespace, Image string
+			ImagePullPolicy, SnapshotPath                  string
+			BackupMountPath, BackupVolumePath              string
+		}{
+			KarmadaInstanceName: name,
+			JobName:             fmt.Sprintf("%s-restore", util.KarmadaEtcdName(name)),
+			Namespace:           namespace,
+			Image:               cfg.Image.Name(),
+			ImagePullPolicy:     string(cfg.ImagePullPolicy),
+			SnapshotPath:        cfg.Maintenance.RestoreFromSnapshot,
+			BackupMountPath:     etcdBackupMountPath,
+			BackupVolumePath:    cfg.Maintenance.BackupVolumePath,
+		})
+		if err != nil {
+			return fmt.Errorf("error when parsing Etcd restore Job template: %w", err)
+		}
+
+		etcdRestoreJob := &batchv1.Job{}
+		if err := kuberuntime.DecodeInto(clientsetscheme.Codecs.UniversalDecoder(), etcdRestoreJobBytes, etcdRestoreJob); err != nil {
+			return fmt.Errorf("error when decoding Etcd restore Job: %w", err)
+		}
+		if err := apiclient.CreateJobIfNeed(client, etcdRestoreJob); err != nil {
+			return fmt.Errorf("error when creating Etcd restore Job, err: %w", err)
+		}
+	}
+
+	return nil
 }
 
 func installKarmadaEtcd(client clientset.Interface, name, namespace string, cfg *operatorv1alpha1.LocalEtcd) error {
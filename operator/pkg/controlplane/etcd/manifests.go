@@ -149,3 +149,120 @@ spec:
   type: ClusterIP
 `
 )
+
+const (
+	// KarmadaEtcdBackupCronJob is karmada etcd snapshot backup CronJob manifest
+	KarmadaEtcdBackupCronJob = `
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  labels:
+    app.kubernetes.io/name: etcd-backup
+    app.kubernetes.io/instance: {{ .KarmadaInstanceName }}
+    app.kubernetes.io/managed-by: karmada-operator
+  name: {{ .CronJobName }}
+  namespace: {{ .Namespace }}
+spec:
+  schedule: "{{ .Schedule }}"
+  concurrencyPolicy: Forbid
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          automountServiceAccountToken: false
+          restartPolicy: OnFailure
+          containers:
+          - name: etcd-backup
+            image: {{ .Image }}
+            imagePullPolicy: {{ .ImagePullPolicy }}
+            command:
+            - /bin/sh
+            - -ec
+            - etcdctl snapshot save {{ .BackupMountPath }}/snapshot-$(date +%Y%m%d%H%M%S).db --endpoints https://{{ .EtcdClientService }}.{{ .Namespace }}.svc.cluster.local:{{ .EtcdListenClientPort }} --cacert=/etc/karmada/pki/etcd/etcd-ca.crt --cert=/etc/karmada/pki/etcd/etcd-server.crt --key=/etc/karmada/pki/etcd/etcd-server.key
+            volumeMounts:
+            - mountPath: {{ .BackupMountPath }}
+              name: etcd-backup
+            - mountPath: /etc/karmada/pki/etcd
+              name: etcd-cert
+          volumes:
+          - name: etcd-backup
+            hostPath:
+              path: {{ .BackupVolumePath }}
+              type: DirectoryOrCreate
+          - name: etcd-cert
+            secret:
+              secretName: {{ .CertsSecretName }}
+`
+
+	// KarmadaEtcdDefragCronJob is karmada etcd defragmentation CronJob manifest
+	KarmadaEtcdDefragCronJob = `
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  labels:
+    app.kubernetes.io/name: etcd-defrag
+    app.kubernetes.io/instance: {{ .KarmadaInstanceName }}
+    app.kubernetes.io/managed-by: karmada-operator
+  name: {{ .CronJobName }}
+  namespace: {{ .Namespace }}
+spec:
+  schedule: "{{ .Schedule }}"
+  concurrencyPolicy: Forbid
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          automountServiceAccountToken: false
+          restartPolicy: OnFailure
+          containers:
+          - name: etcd-defrag
+            image: {{ .Image }}
+            imagePullPolicy: {{ .ImagePullPolicy }}
+            command:
+            - /bin/sh
+            - -ec
+            - etcdctl defrag --cluster --endpoints https://{{ .EtcdClientService }}.{{ .Namespace }}.svc.cluster.local:{{ .EtcdListenClientPort }} --cacert=/etc/karmada/pki/etcd/etcd-ca.crt --cert=/etc/karmada/pki/etcd/etcd-server.crt --key=/etc/karmada/pki/etcd/etcd-server.key
+            volumeMounts:
+            - mountPath: /etc/karmada/pki/etcd
+              name: etcd-cert
+          volumes:
+          - name: etcd-cert
+            secret:
+              secretName: {{ .CertsSecretName }}
+`
+
+	// KarmadaEtcdRestoreJob is karmada etcd snapshot restore Job manifest
+	KarmadaEtcdRestoreJob = `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  labels:
+    app.kubernetes.io/name: etcd-restore
+    app.kubernetes.io/instance: {{ .KarmadaInstanceName }}
+    app.kubernetes.io/managed-by: karmada-operator
+  name: {{ .JobName }}
+  namespace: {{ .Namespace }}
+spec:
+  backoffLimit: 2
+  template:
+    spec:
+      automountServiceAccountToken: false
+      restartPolicy: Never
+      containers:
+      - name: etcd-restore
+        image: {{ .Image }}
+        imagePullPolicy: {{ .ImagePullPolicy }}
+        command:
+        - /bin/sh
+        - -ec
+        - rm -rf {{ .BackupMountPath }}/restore && etcdutl snapshot restore {{ .BackupMountPath }}/{{ .SnapshotPath }} --data-dir {{ .BackupMountPath }}/restore
+        volumeMounts:
+        - mountPath: {{ .BackupMountPath }}
+          name: etcd-backup
+      volumes:
+      - name: etcd-backup
+        hostPath:
+          path: {{ .BackupVolumePath }}
+          type: Directory
+`
+)
@@ -24,6 +24,7 @@ import (
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -136,6 +137,40 @@ func CreateOrUpdateDeployment(client clientset.Interface, deployment *appsv1.Dep
 	return nil
 }
 
+// CreateOrUpdateCronJob creates a CronJob if the target resource doesn't exist. If the resource exists already, this function will update the resource instead.
+func CreateOrUpdateCronJob(client clientset.Interface, cronJob *batchv1.CronJob) error {
+	_, err := client.BatchV1().CronJobs(cronJob.GetNamespace()).Create(context.TODO(), cronJob, metav1.CreateOptions{})
+	if err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+
+		_, err := client.BatchV1().CronJobs(cronJob.GetNamespace()).Update(context.TODO(), cronJob, metav1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+	}
+
+	klog.V(5).InfoS("Successfully created or updated cronjob", "cronjob", cronJob.GetName())
+	return nil
+}
+
+// CreateJobIfNeed creates a Job if the target resource doesn't exist. Since the pod template
+// of a Job is immutable, an already existing Job is left as is.
+func CreateJobIfNeed(client clientset.Interface, job *batchv1.Job) error {
+	_, err := client.BatchV1().Jobs(job.GetNamespace()).Create(context.TODO(), job, metav1.CreateOptions{})
+	if err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		klog.V(5).InfoS("Job already exists, skip creating", "job", job.GetName())
+		return nil
+	}
+
+	klog.V(5).InfoS("Successfully created job", "job", job.GetName())
+	return nil
+}
+
 // CreateOrUpdateMutatingWebhookConfiguration creates a MutatingWebhookConfiguration if the target resource doesn't exist. If the resource exists already, this function will update the resource instead.
 func CreateOrUpdateMutatingWebhookConfiguration(client clientset.Interface, mwc *admissionregistrationv1.MutatingWebhookConfiguration) error {
 	_, err := client.AdmissionregistrationV1().MutatingWebhookConfigurations().Create(context.TODO(), mwc, metav1.CreateOptions{})
@@ -22,6 +22,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
@@ -86,6 +87,27 @@ func BuildClientFromSecretRef(client clientset.Interface, ref *operatorv1alpha1.
 	return newClientSetForConfig(kubeconfigBytes)
 }
 
+// BuildRestConfigFromSecretRef builds a rest config from the kubeconfig held
+// by the secret reference.
+func BuildRestConfigFromSecretRef(client clientset.Interface, ref *operatorv1alpha1.LocalSecretReference) (*rest.Config, error) {
+	secret, err := client.CoreV1().Secrets(ref.Namespace).Get(context.TODO(), ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	kubeconfigBytes, ok := secret.Data["kubeconfig"]
+	if !ok {
+		return nil, fmt.Errorf("the kubeconfig or data key 'kubeconfig' is not found, please check the secret %s/%s", secret.Namespace, secret.Name)
+	}
+
+	clientConfig, err := clientcmd.NewClientConfigFromBytes(kubeconfigBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientConfig.ClientConfig()
+}
+
 func newClientSetForConfig(kubeconfig []byte) (clientset.Interface, error) {
 	clientConfig, err := clientcmd.NewClientConfigFromBytes(kubeconfig)
 	if err != nil {
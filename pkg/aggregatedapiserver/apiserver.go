@@ -96,6 +96,7 @@ func (c completedConfig) New(restConfig *restclient.Config, secretLister listcor
 	v1alpha1cluster["clusters"] = clusterStorage.Cluster
 	v1alpha1cluster["clusters/status"] = clusterStorage.Status
 	v1alpha1cluster["clusters/proxy"] = clusterStorage.Proxy
+	v1alpha1cluster["whoamis"] = clusterStorage.WhoAmI
 	apiGroupInfo.VersionedResourcesStorageMap["v1alpha1"] = v1alpha1cluster
 
 	if err = apiGroupInstaller(server, &apiGroupInfo); err != nil {
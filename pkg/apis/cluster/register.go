@@ -50,6 +50,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&Cluster{},
 		&ClusterList{},
 		&ClusterProxyOptions{},
+		&WhoAmI{},
 	)
 	return nil
 }
@@ -388,4 +388,54 @@ type ClusterProxyOptions struct {
 	Path string
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WhoAmI is a virtual, create-only resource reporting the identity of the
+// caller as seen by the karmada control plane, together with the member
+// clusters the caller may proxy to and the identity impersonated there.
+type WhoAmI struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Status is filled in by the server with the resolved identity.
+	Status WhoAmIStatus
+}
+
+// WhoAmIStatus holds the resolved identity information of the caller.
+type WhoAmIStatus struct {
+	// Username is the name of the authenticated user.
+	Username string
+
+	// UID is the unique identifier of the authenticated user.
+	UID string
+
+	// Groups are the groups the authenticated user belongs to.
+	Groups []string
+
+	// Clusters describes, per member cluster, whether the caller can reach it
+	// through the cluster proxy and with which impersonated identity.
+	Clusters []ClusterAccess
+}
+
+// ClusterAccess describes the caller's proxy access to one member cluster.
+type ClusterAccess struct {
+	// Name is the member cluster name.
+	Name string
+
+	// ProxyAvailable indicates whether the cluster proxy can impersonate the
+	// caller in this cluster.
+	ProxyAvailable bool
+
+	// Reason explains why the proxy is unavailable.
+	Reason string
+
+	// ImpersonatedUser is the username the proxy impersonates in the member
+	// cluster.
+	ImpersonatedUser string
+
+	// ImpersonatedGroups are the groups the proxy impersonates in the member
+	// cluster.
+	ImpersonatedGroups []string
+}
+
 //revive:enable:exported
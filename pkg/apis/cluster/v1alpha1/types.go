@@ -402,3 +402,67 @@ type ClusterProxyOptions struct {
 	// +optional
 	Path string `json:"path,omitempty" protobuf:"bytes,1,opt,name=path"`
 }
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WhoAmI is a virtual, create-only resource reporting the identity of the
+// caller as seen by the karmada control plane, together with the member
+// clusters the caller may proxy to and the identity impersonated there.
+type WhoAmI struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Status is filled in by the server with the resolved identity.
+	// +optional
+	Status WhoAmIStatus `json:"status,omitempty"`
+}
+
+// WhoAmIStatus holds the resolved identity information of the caller.
+type WhoAmIStatus struct {
+	// Username is the name of the authenticated user.
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// UID is the unique identifier of the authenticated user.
+	// +optional
+	UID string `json:"uid,omitempty"`
+
+	// Groups are the groups the authenticated user belongs to.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+
+	// Clusters describes, per member cluster, whether the caller can reach it
+	// through the cluster proxy and with which impersonated identity.
+	// +optional
+	Clusters []ClusterAccess `json:"clusters,omitempty"`
+}
+
+// ClusterAccess describes the caller's proxy access to one member cluster.
+type ClusterAccess struct {
+	// Name is the member cluster name.
+	// +required
+	Name string `json:"name"`
+
+	// ProxyAvailable indicates whether the cluster proxy can impersonate the
+	// caller in this cluster.
+	// +optional
+	ProxyAvailable bool `json:"proxyAvailable,omitempty"`
+
+	// Reason explains why the proxy is unavailable.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// ImpersonatedUser is the username the proxy impersonates in the member
+	// cluster.
+	// +optional
+	ImpersonatedUser string `json:"impersonatedUser,omitempty"`
+
+	// ImpersonatedGroups are the groups the proxy impersonates in the member
+	// cluster.
+	// +optional
+	ImpersonatedGroups []string `json:"impersonatedGroups,omitempty"`
+}
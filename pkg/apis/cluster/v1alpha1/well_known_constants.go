@@ -33,4 +33,28 @@ const (
 	// CacheSourceAnnotationKey is the annotation that added to a resource to
 	// represent which cluster it cached from.
 	CacheSourceAnnotationKey = "resource.karmada.io/cached-from-cluster"
+
+	// UncordonAfterAnnotationKey is the annotation that added to a cluster by
+	// `karmadactl cordon --until` to record, in RFC3339 format, when the
+	// cluster should automatically be marked schedulable again.
+	UncordonAfterAnnotationKey = "cluster.karmada.io/uncordon-after"
+
+	// CordonReasonAnnotationKey is the annotation that added to a cluster by
+	// `karmadactl cordon --reason` to record why the cluster was marked
+	// unschedulable, e.g. a maintenance ticket reference.
+	CordonReasonAnnotationKey = "cluster.karmada.io/cordon-reason"
+
+	// BandwidthBudgetAnnotationKey is the annotation that can be added to a
+	// cluster to declare a budget, in bytes per second, for the control plane
+	// traffic towards the cluster (Work applies, status reports, proxy
+	// requests). The value is a quantity, e.g. "10Mi". Traffic beyond the
+	// budget is throttled, which matters on metered WAN links at edge sites.
+	// When absent, the traffic is accounted but not throttled.
+	BandwidthBudgetAnnotationKey = "cluster.karmada.io/bandwidth-budget"
+
+	// AgentVersionAnnotationKey is the annotation that added to a cluster by
+	// the karmada-agent in Pull mode, recording the version of the agent
+	// managing the cluster. It is refreshed every time the agent registers
+	// itself, so it follows agent upgrades.
+	AgentVersionAnnotationKey = "cluster.karmada.io/agent-version"
 )
@@ -169,6 +169,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WhoAmI)(nil), (*cluster.WhoAmI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WhoAmI_To_cluster_WhoAmI(a.(*WhoAmI), b.(*cluster.WhoAmI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*cluster.WhoAmI)(nil), (*WhoAmI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_cluster_WhoAmI_To_v1alpha1_WhoAmI(a.(*cluster.WhoAmI), b.(*WhoAmI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WhoAmIStatus)(nil), (*cluster.WhoAmIStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WhoAmIStatus_To_cluster_WhoAmIStatus(a.(*WhoAmIStatus), b.(*cluster.WhoAmIStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*cluster.WhoAmIStatus)(nil), (*WhoAmIStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_cluster_WhoAmIStatus_To_v1alpha1_WhoAmIStatus(a.(*cluster.WhoAmIStatus), b.(*WhoAmIStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAccess)(nil), (*cluster.ClusterAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterAccess_To_cluster_ClusterAccess(a.(*ClusterAccess), b.(*cluster.ClusterAccess), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*cluster.ClusterAccess)(nil), (*ClusterAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_cluster_ClusterAccess_To_v1alpha1_ClusterAccess(a.(*cluster.ClusterAccess), b.(*ClusterAccess), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*ClusterProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_url_Values_To_v1alpha1_ClusterProxyOptions(a.(*url.Values), b.(*ClusterProxyOptions), scope)
 	}); err != nil {
@@ -526,3 +556,83 @@ func autoConvert_cluster_ResourceSummary_To_v1alpha1_ResourceSummary(in *cluster
 func Convert_cluster_ResourceSummary_To_v1alpha1_ResourceSummary(in *cluster.ResourceSummary, out *ResourceSummary, s conversion.Scope) error {
 	return autoConvert_cluster_ResourceSummary_To_v1alpha1_ResourceSummary(in, out, s)
 }
+
+func autoConvert_v1alpha1_ClusterAccess_To_cluster_ClusterAccess(in *ClusterAccess, out *cluster.ClusterAccess, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ProxyAvailable = in.ProxyAvailable
+	out.Reason = in.Reason
+	out.ImpersonatedUser = in.ImpersonatedUser
+	out.ImpersonatedGroups = *(*[]string)(unsafe.Pointer(&in.ImpersonatedGroups))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterAccess_To_cluster_ClusterAccess is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterAccess_To_cluster_ClusterAccess(in *ClusterAccess, out *cluster.ClusterAccess, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterAccess_To_cluster_ClusterAccess(in, out, s)
+}
+
+func autoConvert_cluster_ClusterAccess_To_v1alpha1_ClusterAccess(in *cluster.ClusterAccess, out *ClusterAccess, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ProxyAvailable = in.ProxyAvailable
+	out.Reason = in.Reason
+	out.ImpersonatedUser = in.ImpersonatedUser
+	out.ImpersonatedGroups = *(*[]string)(unsafe.Pointer(&in.ImpersonatedGroups))
+	return nil
+}
+
+// Convert_cluster_ClusterAccess_To_v1alpha1_ClusterAccess is an autogenerated conversion function.
+func Convert_cluster_ClusterAccess_To_v1alpha1_ClusterAccess(in *cluster.ClusterAccess, out *ClusterAccess, s conversion.Scope) error {
+	return autoConvert_cluster_ClusterAccess_To_v1alpha1_ClusterAccess(in, out, s)
+}
+
+func autoConvert_v1alpha1_WhoAmI_To_cluster_WhoAmI(in *WhoAmI, out *cluster.WhoAmI, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_WhoAmIStatus_To_cluster_WhoAmIStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_WhoAmI_To_cluster_WhoAmI is an autogenerated conversion function.
+func Convert_v1alpha1_WhoAmI_To_cluster_WhoAmI(in *WhoAmI, out *cluster.WhoAmI, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WhoAmI_To_cluster_WhoAmI(in, out, s)
+}
+
+func autoConvert_cluster_WhoAmI_To_v1alpha1_WhoAmI(in *cluster.WhoAmI, out *WhoAmI, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_cluster_WhoAmIStatus_To_v1alpha1_WhoAmIStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_cluster_WhoAmI_To_v1alpha1_WhoAmI is an autogenerated conversion function.
+func Convert_cluster_WhoAmI_To_v1alpha1_WhoAmI(in *cluster.WhoAmI, out *WhoAmI, s conversion.Scope) error {
+	return autoConvert_cluster_WhoAmI_To_v1alpha1_WhoAmI(in, out, s)
+}
+
+func autoConvert_v1alpha1_WhoAmIStatus_To_cluster_WhoAmIStatus(in *WhoAmIStatus, out *cluster.WhoAmIStatus, s conversion.Scope) error {
+	out.Username = in.Username
+	out.UID = in.UID
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Clusters = *(*[]cluster.ClusterAccess)(unsafe.Pointer(&in.Clusters))
+	return nil
+}
+
+// Convert_v1alpha1_WhoAmIStatus_To_cluster_WhoAmIStatus is an autogenerated conversion function.
+func Convert_v1alpha1_WhoAmIStatus_To_cluster_WhoAmIStatus(in *WhoAmIStatus, out *cluster.WhoAmIStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WhoAmIStatus_To_cluster_WhoAmIStatus(in, out, s)
+}
+
+func autoConvert_cluster_WhoAmIStatus_To_v1alpha1_WhoAmIStatus(in *cluster.WhoAmIStatus, out *WhoAmIStatus, s conversion.Scope) error {
+	out.Username = in.Username
+	out.UID = in.UID
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Clusters = *(*[]ClusterAccess)(unsafe.Pointer(&in.Clusters))
+	return nil
+}
+
+// Convert_cluster_WhoAmIStatus_To_v1alpha1_WhoAmIStatus is an autogenerated conversion function.
+func Convert_cluster_WhoAmIStatus_To_v1alpha1_WhoAmIStatus(in *cluster.WhoAmIStatus, out *WhoAmIStatus, s conversion.Scope) error {
+	return autoConvert_cluster_WhoAmIStatus_To_v1alpha1_WhoAmIStatus(in, out, s)
+}
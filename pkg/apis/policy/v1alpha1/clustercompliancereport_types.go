@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ResourceKindClusterComplianceReport is kind name of ClusterComplianceReport.
+	ResourceKindClusterComplianceReport = "ClusterComplianceReport"
+	// ResourceSingularClusterComplianceReport is singular name of ClusterComplianceReport.
+	ResourceSingularClusterComplianceReport = "clustercompliancereport"
+	// ResourcePluralClusterComplianceReport is plural name of ClusterComplianceReport.
+	ResourcePluralClusterComplianceReport = "clustercompliancereports"
+	// ResourceNamespaceScopedClusterComplianceReport indicates if ClusterComplianceReport is NamespaceScoped.
+	ResourceNamespaceScopedClusterComplianceReport = false
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=clustercompliancereports,scope=Cluster,categories={karmada-io}
+// +kubebuilder:printcolumn:JSONPath=`.summary.pass`,name="PASS",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.summary.fail`,name="FAIL",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.summary.warn`,name="WARN",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.lastUpdateTime`,name="LAST-UPDATE",type=date
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="AGE",type=date
+
+// ClusterComplianceReport aggregates the policy-engine results of one member
+// cluster on the control plane. It is named after the member cluster and
+// summarizes the PolicyReport and ClusterPolicyReport objects (wgpolicyk8s.io)
+// that engines such as Kyverno or Gatekeeper maintain in that cluster, so
+// that compliance teams get one federated view instead of having to query
+// every member cluster.
+type ClusterComplianceReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Summary aggregates the results of all policy reports of the cluster,
+	// both namespaced and cluster-scoped ones.
+	// +optional
+	Summary ComplianceSummary `json:"summary,omitempty"`
+
+	// ClusterScoped aggregates the results of the cluster-scoped
+	// ClusterPolicyReports of the cluster.
+	// +optional
+	ClusterScoped ComplianceSummary `json:"clusterScoped,omitempty"`
+
+	// Namespaces holds the per-namespace rollups built from the namespaced
+	// PolicyReports of the cluster, sorted by namespace.
+	// +optional
+	Namespaces []NamespaceComplianceSummary `json:"namespaces,omitempty"`
+
+	// LastUpdateTime is the last time the report content changed.
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// ComplianceSummary counts policy results by outcome, following the result
+// categories of the wgpolicyk8s.io report summary.
+type ComplianceSummary struct {
+	// Pass is the number of policies whose requirements were met.
+	// +optional
+	Pass int32 `json:"pass,omitempty"`
+
+	// Fail is the number of policies whose requirements were not met.
+	// +optional
+	Fail int32 `json:"fail,omitempty"`
+
+	// Warn is the number of non-blocking policies whose requirements were not met.
+	// +optional
+	Warn int32 `json:"warn,omitempty"`
+
+	// Error is the number of policies that could not be evaluated.
+	// +optional
+	Error int32 `json:"error,omitempty"`
+
+	// Skip is the number of policies that were not selected for evaluation.
+	// +optional
+	Skip int32 `json:"skip,omitempty"`
+}
+
+// NamespaceComplianceSummary is the rollup of the policy results of one
+// namespace of the member cluster.
+type NamespaceComplianceSummary struct {
+	// Namespace is the name of the namespace the results belong to.
+	// +required
+	Namespace string `json:"namespace"`
+
+	// Summary aggregates the results of the PolicyReports of the namespace.
+	// +optional
+	Summary ComplianceSummary `json:"summary,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterComplianceReportList contains a list of ClusterComplianceReport.
+type ClusterComplianceReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items holds a list of ClusterComplianceReport.
+	Items []ClusterComplianceReport `json:"items"`
+}
@@ -211,9 +211,9 @@ type PropagationSpec struct {
 	//
 	// Note: This feature is currently in the alpha stage. The priority-based scheduling functionality is
 	// controlled by the PriorityBasedScheduling feature gate, and preemption is controlled by the
-	// PriorityBasedPreemptiveScheduling feature gate. Currently, only priority-based scheduling is
-	// supported. Preemption functionality is not yet available and will be introduced in future
-	// releases as the feature matures.
+	// PriorityBasedPreemptiveScheduling feature gate. With preemption enabled, the scheduler triggers
+	// the rescheduling of lower priority bindings when a high-priority binding can not be scheduled
+	// due to insufficient cluster capacity.
 	//
 	// +optional
 	SchedulePriority *SchedulePriority `json:"schedulePriority,omitempty"`
@@ -457,10 +457,126 @@ type Placement struct {
 	// +optional
 	SpreadConstraints []SpreadConstraint `json:"spreadConstraints,omitempty"`
 
+	// ClusterTopologySpreadConstraints describes how replicas of the
+	// referencing resources should be spread across cluster topology
+	// domains(region, zone or provider), analogous to pod topology spread
+	// constraints. Unlike SpreadConstraints which restricts how many groups
+	// get selected, these constraints bound the replica imbalance between
+	// the selected domains by MaxSkew.
+	// Only takes effect when the replica scheduling type is "Divided".
+	// +optional
+	ClusterTopologySpreadConstraints []ClusterTopologySpreadConstraint `json:"clusterTopologySpreadConstraints,omitempty"`
+
+	// ServiceAffinity represents a list of services the referencing resources
+	// depend on. The scheduler prefers the clusters holding healthy endpoints
+	// of the referenced MultiClusterServices, so that chatty consumer/producer
+	// pairs stay co-located without hand-maintaining cluster lists. A required
+	// term restricts the scheduling candidates to such clusters instead.
+	// +optional
+	ServiceAffinity []ServiceAffinityTerm `json:"serviceAffinity,omitempty"`
+
+	// Singleton indicates the referencing resources must exist in exactly one
+	// cluster at a time, e.g. a cluster-singleton operator or a CronJob that
+	// must not fire twice. The scheduler elects a single holder cluster and
+	// sticks to it as long as it stays feasible. When the holder cluster
+	// fails, the resource is moved to another cluster only after the replica
+	// in the previous holder is confirmed absent, so a fail-over never yields
+	// two live copies. The current holder is exposed by the SingletonHolder
+	// condition of the ResourceBinding.
+	// +optional
+	Singleton bool `json:"singleton,omitempty"`
+
 	// ReplicaScheduling represents the scheduling policy on dealing with the number of replicas
 	// when propagating resources that have replicas in spec (e.g. deployments, statefulsets) to member clusters.
 	// +optional
 	ReplicaScheduling *ReplicaSchedulingStrategy `json:"replicaScheduling,omitempty"`
+
+	// LatencyBudget declares the acceptable network latency to candidate
+	// member clusters for latency-sensitive services. The scheduler prefers
+	// clusters whose measured latency stays within the budget, the lower the
+	// latency the higher the preference. Latency is measured by the scheduler
+	// probes or ingested from a user-maintained ConfigMap; clusters without a
+	// measurement are treated neutrally.
+	// +optional
+	LatencyBudget *LatencyBudget `json:"latencyBudget,omitempty"`
+
+	// CarbonAware indicates the workload is flexible about where it runs and
+	// may be shifted toward member clusters with lower carbon intensity, e.g.
+	// batch jobs without data locality constraints. The scheduler prefers
+	// clusters reporting a lower carbon intensity; clusters without a signal
+	// are treated neutrally. Carbon intensity signals are ingested from a
+	// user-maintained ConfigMap or pluggable providers.
+	// +optional
+	CarbonAware bool `json:"carbonAware,omitempty"`
+
+	// CoScheduleWith declares workloads this workload must be co-scheduled
+	// with: only member clusters every referenced workload has been scheduled
+	// to are eligible, so chatty microservices land together. The scheduler
+	// keeps the workload pending until all referenced workloads have been
+	// scheduled.
+	// +optional
+	CoScheduleWith []WorkloadAffinityTerm `json:"coScheduleWith,omitempty"`
+
+	// ProviderAntiAffinity requires the workload to be spread across a
+	// minimum number of distinct cloud providers or failure domains, for
+	// regulatory disaster-recovery requirements. The scheduler rejects
+	// placements that would concentrate the workload in fewer domains.
+	// +optional
+	ProviderAntiAffinity *ProviderAntiAffinity `json:"providerAntiAffinity,omitempty"`
+}
+
+// LatencyBudget declares the acceptable network latency to member clusters.
+type LatencyBudget struct {
+	// MaxMilliseconds is the maximum acceptable latency, in milliseconds,
+	// between the Karmada control plane and a member cluster. Clusters whose
+	// measured latency exceeds the budget get the lowest preference.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	MaxMilliseconds int32 `json:"maxMilliseconds"`
+}
+
+// WorkloadAffinityTerm references a workload to be co-scheduled with, either
+// by name or by a label selector over its ResourceBinding.
+type WorkloadAffinityTerm struct {
+	// APIVersion represents the API version of the referenced workload.
+	// +required
+	APIVersion string `json:"apiVersion"`
+
+	// Kind represents the Kind of the referenced workload.
+	// +required
+	Kind string `json:"kind"`
+
+	// Namespace of the referenced workload. Defaults to the namespace of the
+	// workload declaring the affinity.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the referenced workload. Exactly one of Name and LabelSelector
+	// should be specified.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// LabelSelector selects the ResourceBindings of the referenced workloads
+	// by label. Exactly one of Name and LabelSelector should be specified.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// ProviderAntiAffinity requires the workload to be spread across distinct
+// cloud providers or failure domains.
+type ProviderAntiAffinity struct {
+	// MinProviders is the minimum number of distinct providers or failure
+	// domains the clusters running the workload must span.
+	// +kubebuilder:validation:Minimum=2
+	// +required
+	MinProviders int32 `json:"minProviders"`
+
+	// TopologyKey is the cluster label whose values identify the failure
+	// domain of a cluster. When empty, the provider declared in the cluster
+	// spec is used. Clusters without a value for the key do not count
+	// towards any domain.
+	// +optional
+	TopologyKey string `json:"topologyKey,omitempty"`
 }
 
 // SpreadFieldValue is the type to define valid values for SpreadConstraint.SpreadByField
@@ -503,6 +619,69 @@ type SpreadConstraint struct {
 	MinGroups int `json:"minGroups,omitempty"`
 }
 
+// UnsatisfiableConstraintAction defines the type of actions that can be taken
+// when a cluster topology spread constraint cannot be satisfied.
+type UnsatisfiableConstraintAction string
+
+const (
+	// DoNotSchedule instructs the scheduler to fail the scheduling when the
+	// constraint cannot be satisfied.
+	DoNotSchedule UnsatisfiableConstraintAction = "DoNotSchedule"
+	// ScheduleAnyway instructs the scheduler to keep the assignment and treat
+	// the constraint as a preference when it cannot be satisfied.
+	ScheduleAnyway UnsatisfiableConstraintAction = "ScheduleAnyway"
+)
+
+// ClusterTopologySpreadConstraint specifies how to spread replicas among
+// cluster topology domains.
+type ClusterTopologySpreadConstraint struct {
+	// TopologyKey is the field on Karmada cluster API used for grouping member
+	// clusters into topology domains. Replicas assigned to clusters reporting
+	// the same value for the field are summed up per domain.
+	// Available fields are: region, zone, and provider.
+	// +kubebuilder:validation:Enum=region;zone;provider
+	// +required
+	TopologyKey SpreadFieldValue `json:"topologyKey"`
+
+	// MaxSkew describes the maximum permitted difference between the number
+	// of replicas assigned to any two topology domains that hold at least one
+	// selected cluster.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// +optional
+	MaxSkew int32 `json:"maxSkew,omitempty"`
+
+	// WhenUnsatisfiable indicates how to deal with replicas that do not fit
+	// within MaxSkew after the scheduler has rebalanced the assignment:
+	// - DoNotSchedule (default) tells the scheduler to fail the scheduling.
+	// - ScheduleAnyway tells the scheduler to keep the assignment anyway.
+	// +kubebuilder:validation:Enum=DoNotSchedule;ScheduleAnyway
+	// +kubebuilder:default=DoNotSchedule
+	// +optional
+	WhenUnsatisfiable UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+}
+
+// ServiceAffinityTerm represents a MultiClusterService the referencing
+// resources communicate with.
+type ServiceAffinityTerm struct {
+	// Namespace is the namespace of the referenced MultiClusterService.
+	// Defaults to the namespace of the resource being scheduled.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the referenced MultiClusterService.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+
+	// Required indicates that clusters without healthy endpoints of the
+	// referenced service must not be selected. Defaults to false, which only
+	// prefers the clusters with healthy endpoints over the others.
+	// +optional
+	Required bool `json:"required,omitempty"`
+}
+
 // ClusterAffinity represents the filter to select clusters.
 type ClusterAffinity struct {
 	// LabelSelector is a filter to select member clusters by labels.
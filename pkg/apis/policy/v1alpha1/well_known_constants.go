@@ -53,4 +53,15 @@ const (
 
 	// ClusterPropagationPolicyAnnotation is added to objects to specify associated ClusterPropagationPolicy name.
 	ClusterPropagationPolicyAnnotation = "clusterpropagationpolicy.karmada.io/name"
+
+	// NotificationWebhookAnnotation is added to a PropagationPolicy(or
+	// ClusterPropagationPolicy) to have key lifecycle events of the resources
+	// it propagates, such as failover or policy preemption, POSTed to the
+	// given webhook endpoint(e.g. a Slack incoming webhook).
+	NotificationWebhookAnnotation = "notification.karmada.io/webhook-url"
+
+	// NotificationTemplateAnnotation optionally customizes the payload POSTed
+	// to the notification webhook. The value is a Go text/template rendered
+	// with the event payload, replacing the default JSON body.
+	NotificationTemplateAnnotation = "notification.karmada.io/payload-template"
 )
@@ -107,6 +107,73 @@ func (in *ClusterAffinityTerm) DeepCopy() *ClusterAffinityTerm {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterComplianceReport) DeepCopyInto(out *ClusterComplianceReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Summary = in.Summary
+	out.ClusterScoped = in.ClusterScoped
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]NamespaceComplianceSummary, len(*in))
+		copy(*out, *in)
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComplianceReport.
+func (in *ClusterComplianceReport) DeepCopy() *ClusterComplianceReport {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterComplianceReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterComplianceReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterComplianceReportList) DeepCopyInto(out *ClusterComplianceReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterComplianceReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComplianceReportList.
+func (in *ClusterComplianceReportList) DeepCopy() *ClusterComplianceReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterComplianceReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterComplianceReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterOverridePolicy) DeepCopyInto(out *ClusterOverridePolicy) {
 	*out = *in
@@ -267,6 +334,22 @@ func (in *ClusterQuotaStatus) DeepCopy() *ClusterQuotaStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTopologySpreadConstraint) DeepCopyInto(out *ClusterTopologySpreadConstraint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTopologySpreadConstraint.
+func (in *ClusterTopologySpreadConstraint) DeepCopy() *ClusterTopologySpreadConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTopologySpreadConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommandArgsOverrider) DeepCopyInto(out *CommandArgsOverrider) {
 	*out = *in
@@ -288,6 +371,22 @@ func (in *CommandArgsOverrider) DeepCopy() *CommandArgsOverrider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceSummary) DeepCopyInto(out *ComplianceSummary) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceSummary.
+func (in *ComplianceSummary) DeepCopy() *ComplianceSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DecisionConditions) DeepCopyInto(out *DecisionConditions) {
 	*out = *in
@@ -588,6 +687,39 @@ func (in *LabelAnnotationOverrider) DeepCopy() *LabelAnnotationOverrider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LatencyBudget) DeepCopyInto(out *LatencyBudget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatencyBudget.
+func (in *LatencyBudget) DeepCopy() *LatencyBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(LatencyBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceComplianceSummary) DeepCopyInto(out *NamespaceComplianceSummary) {
+	*out = *in
+	out.Summary = in.Summary
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceComplianceSummary.
+func (in *NamespaceComplianceSummary) DeepCopy() *NamespaceComplianceSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceComplianceSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OverridePolicy) DeepCopyInto(out *OverridePolicy) {
 	*out = *in
@@ -776,11 +908,38 @@ func (in *Placement) DeepCopyInto(out *Placement) {
 		*out = make([]SpreadConstraint, len(*in))
 		copy(*out, *in)
 	}
+	if in.ClusterTopologySpreadConstraints != nil {
+		in, out := &in.ClusterTopologySpreadConstraints, &out.ClusterTopologySpreadConstraints
+		*out = make([]ClusterTopologySpreadConstraint, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAffinity != nil {
+		in, out := &in.ServiceAffinity, &out.ServiceAffinity
+		*out = make([]ServiceAffinityTerm, len(*in))
+		copy(*out, *in)
+	}
 	if in.ReplicaScheduling != nil {
 		in, out := &in.ReplicaScheduling, &out.ReplicaScheduling
 		*out = new(ReplicaSchedulingStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LatencyBudget != nil {
+		in, out := &in.LatencyBudget, &out.LatencyBudget
+		*out = new(LatencyBudget)
+		**out = **in
+	}
+	if in.CoScheduleWith != nil {
+		in, out := &in.CoScheduleWith, &out.CoScheduleWith
+		*out = make([]WorkloadAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProviderAntiAffinity != nil {
+		in, out := &in.ProviderAntiAffinity, &out.ProviderAntiAffinity
+		*out = new(ProviderAntiAffinity)
+		**out = **in
+	}
 	return
 }
 
@@ -925,6 +1084,22 @@ func (in *PropagationSpec) DeepCopy() *PropagationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderAntiAffinity) DeepCopyInto(out *ProviderAntiAffinity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderAntiAffinity.
+func (in *ProviderAntiAffinity) DeepCopy() *ProviderAntiAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderAntiAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicaSchedulingStrategy) DeepCopyInto(out *ReplicaSchedulingStrategy) {
 	*out = *in
@@ -1005,6 +1180,22 @@ func (in *SchedulePriority) DeepCopy() *SchedulePriority {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAffinityTerm) DeepCopyInto(out *ServiceAffinityTerm) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAffinityTerm.
+func (in *ServiceAffinityTerm) DeepCopy() *ServiceAffinityTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAffinityTerm)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SpreadConstraint) DeepCopyInto(out *SpreadConstraint) {
 	*out = *in
@@ -1145,6 +1336,27 @@ func (in *Suspension) DeepCopy() *Suspension {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadAffinityTerm) DeepCopyInto(out *WorkloadAffinityTerm) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadAffinityTerm.
+func (in *WorkloadAffinityTerm) DeepCopy() *WorkloadAffinityTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadAffinityTerm)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *YAMLPatchOperation) DeepCopyInto(out *YAMLPatchOperation) {
 	*out = *in
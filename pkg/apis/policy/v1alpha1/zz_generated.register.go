@@ -61,6 +61,8 @@ func init() {
 // Adds the list of known types to Scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&ClusterComplianceReport{},
+		&ClusterComplianceReportList{},
 		&ClusterOverridePolicy{},
 		&ClusterOverridePolicyList{},
 		&ClusterPropagationPolicy{},
@@ -17,6 +17,7 @@ limitations under the License.
 package search
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
@@ -53,6 +54,14 @@ type ResourceRegistrySpec struct {
 	// BackendStore specifies the location where to store the cached items.
 	// +optional
 	BackendStore *BackendStoreConfig `json:"backendStore,omitempty"`
+
+	// MemoryBudget is the approximate upper bound of memory consumed by the
+	// in-memory caches serving this registry, e.g. 512Mi. When the budget is
+	// exceeded, the least-recently-queried resources are evicted from the
+	// cache until the remainder fits, preventing one registry from exhausting
+	// the memory of the cache system. No budget is applied when unset.
+	// +optional
+	MemoryBudget *resource.Quantity `json:"memoryBudget,omitempty"`
 }
 
 // ResourceSelector specifies the resources type and its scope.
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
@@ -34,6 +35,13 @@ const (
 	ResourceNamespaceScopedResourceRegistry = false
 )
 
+const (
+	// MemoryBudgetExceeded is the condition type reporting that the in-memory
+	// caches serving the registry exceeded spec.memoryBudget, and the
+	// least-recently-queried resources have been evicted from the cache.
+	MemoryBudgetExceeded = "MemoryBudgetExceeded"
+)
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -65,6 +73,14 @@ type ResourceRegistrySpec struct {
 	// BackendStore specifies the location where to store the cached items.
 	// +optional
 	BackendStore *BackendStoreConfig `json:"backendStore,omitempty"`
+
+	// MemoryBudget is the approximate upper bound of memory consumed by the
+	// in-memory caches serving this registry, e.g. 512Mi. When the budget is
+	// exceeded, the least-recently-queried resources are evicted from the
+	// cache until the remainder fits, preventing one registry from exhausting
+	// the memory of the cache system. No budget is applied when unset.
+	// +optional
+	MemoryBudget *resource.Quantity `json:"memoryBudget,omitempty"`
 }
 
 // ResourceSelector specifies the resources type and its scope.
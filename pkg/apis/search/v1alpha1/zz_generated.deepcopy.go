@@ -169,6 +169,11 @@ func (in *ResourceRegistrySpec) DeepCopyInto(out *ResourceRegistrySpec) {
 		*out = new(BackendStoreConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MemoryBudget != nil {
+		in, out := &in.MemoryBudget, &out.MemoryBudget
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -101,6 +101,42 @@ type WorkStatus struct {
 	// ManifestStatuses contains running status of manifests in spec.
 	// +optional
 	ManifestStatuses []ManifestStatus `json:"manifestStatuses,omitempty"`
+
+	// LastAppliedChanges contains, per manifest, a structured summary of the
+	// most recent change the execution controller applied to the member cluster.
+	// An entry is replaced each time the corresponding manifest actually changes
+	// the member cluster object, no-op syncs keep the previous summary.
+	// +optional
+	LastAppliedChanges []AppliedChange `json:"lastAppliedChanges,omitempty"`
+}
+
+// AppliedChange describes what updating a single manifest changed on the
+// member cluster object.
+type AppliedChange struct {
+	// Identifier represents the identity of the changed resource linking to manifests in spec.
+	// +required
+	Identifier ResourceIdentifier `json:"identifier"`
+
+	// AppliedTime is the time the change was applied to the member cluster.
+	// +required
+	AppliedTime metav1.Time `json:"appliedTime"`
+
+	// FieldsChanged lists the paths of the manifest fields that differed from
+	// the object observed in the member cluster, e.g. "spec.replicas".
+	// Nested changes are reported by their top two path segments and the list
+	// is capped, so it is a hint for auditing rather than a complete diff.
+	// +optional
+	FieldsChanged []string `json:"fieldsChanged,omitempty"`
+
+	// ImagesChanged lists container image transitions in the form
+	// "<container>: <observed image> -> <desired image>".
+	// +optional
+	ImagesChanged []string `json:"imagesChanged,omitempty"`
+
+	// ReplicasDelta is the difference between the desired and the observed
+	// .spec.replicas, absent when replicas did not change or do not apply.
+	// +optional
+	ReplicasDelta *int32 `json:"replicasDelta,omitempty"`
 }
 
 // ManifestStatus contains running status of a specific manifest in spec.
@@ -160,6 +196,9 @@ const (
 	// WorkDegraded represents that the current state of Work does not match
 	// the desired state for a certain period.
 	WorkDegraded string = "Degraded"
+	// WorkRolloutPending represents that applying the Work is postponed because
+	// the binding it belongs to has reached its maxConcurrentClusters budget.
+	WorkRolloutPending string = "RolloutPending"
 	// WorkDispatching represents the dispatching or suspension status of the Work resource
 	WorkDispatching string = "Dispatching"
 )
@@ -48,6 +48,39 @@ func (in *AggregatedStatusItem) DeepCopy() *AggregatedStatusItem {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedChange) DeepCopyInto(out *AppliedChange) {
+	*out = *in
+	out.Identifier = in.Identifier
+	in.AppliedTime.DeepCopyInto(&out.AppliedTime)
+	if in.FieldsChanged != nil {
+		in, out := &in.FieldsChanged, &out.FieldsChanged
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagesChanged != nil {
+		in, out := &in.ImagesChanged, &out.ImagesChanged
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReplicasDelta != nil {
+		in, out := &in.ReplicasDelta, &out.ReplicasDelta
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedChange.
+func (in *AppliedChange) DeepCopy() *AppliedChange {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterResourceBinding) DeepCopyInto(out *ClusterResourceBinding) {
 	*out = *in
@@ -421,6 +454,13 @@ func (in *WorkStatus) DeepCopyInto(out *WorkStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastAppliedChanges != nil {
+		in, out := &in.LastAppliedChanges, &out.LastAppliedChanges
+		*out = make([]AppliedChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
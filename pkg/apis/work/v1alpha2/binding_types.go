@@ -163,6 +163,23 @@ type ResourceBindingSpec struct {
 	// SchedulePriority represents the scheduling priority assigned to workloads.
 	// +optional
 	SchedulePriority *SchedulePriority `json:"schedulePriority,omitempty"`
+
+	// SchedulingGates holds the gates that must be cleared before the scheduler
+	// starts to schedule the binding. External controllers, such as capacity
+	// managers or approval workflows, can add a gate at binding creation time
+	// (e.g. via an admission webhook) and remove it once their precondition is
+	// met; the scheduler picks the binding up as soon as the list is empty.
+	// +optional
+	SchedulingGates []SchedulingGate `json:"schedulingGates,omitempty"`
+
+	// MaxConcurrentClusters limits how many member clusters may have their
+	// Works updated simultaneously when the referenced resource changes.
+	// It complements the batch rollout strategy at a finer, per-binding
+	// granularity, e.g. 1 for databases that must be updated one cluster at
+	// a time. nil means no limit.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrentClusters *int32 `json:"maxConcurrentClusters,omitempty"`
 }
 
 // ObjectReference contains enough information to locate the referenced object inside current cluster.
@@ -210,6 +227,13 @@ type ReplicaRequirements struct {
 	// PriorityClassName represents the resources priorityClassName
 	// +optional
 	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// VolumeClaims represents the PersistentVolumeClaim templates claimed by each
+	// replica, e.g. derived from the volumeClaimTemplates of a StatefulSet.
+	// It is used by the scheduler estimator to check whether a cluster's storage
+	// classes can satisfy the claims before assigning replicas to it.
+	// +optional
+	VolumeClaims []corev1.PersistentVolumeClaimSpec `json:"volumeClaims,omitempty"`
 }
 
 // NodeClaim represents the node claim HardNodeAffinity, NodeSelector and Tolerations required by each replica.
@@ -351,6 +375,15 @@ type SchedulePriority struct {
 	Priority int32 `json:"priority,omitempty"`
 }
 
+// SchedulingGate is associated with a precondition that must be met before the
+// binding can be scheduled, identified by the name of the controller that owns it.
+type SchedulingGate struct {
+	// Name of the scheduling gate.
+	// Each scheduling gate must have a unique name.
+	// +required
+	Name string `json:"name"`
+}
+
 // ResourceBindingStatus represents the overall status of the strategy as well as the referenced resources.
 type ResourceBindingStatus struct {
 	// SchedulerObservedGeneration is the generation(.metadata.generation) observed by the scheduler.
@@ -376,6 +409,30 @@ type ResourceBindingStatus struct {
 	// AggregatedStatus represents status list of the resource running in each member cluster.
 	// +optional
 	AggregatedStatus []AggregatedStatusItem `json:"aggregatedStatus,omitempty"`
+
+	// SchedulingHistory keeps a bounded record of the past replica assignments
+	// produced by the scheduler, most recent last, so that placement evolution
+	// after failovers and rebalances can be analyzed from the binding itself.
+	// Up to 10 records are retained.
+	// +optional
+	SchedulingHistory []ScheduleRecord `json:"schedulingHistory,omitempty"`
+}
+
+// ScheduleRecord represents one replica assignment produced by the scheduler.
+type ScheduleRecord struct {
+	// Clusters is the replica assignment, i.e. the target clusters and the
+	// replicas assigned to each of them.
+	// +optional
+	Clusters []TargetCluster `json:"clusters,omitempty"`
+
+	// Reason is a programmatic identifier indicating what triggered the
+	// assignment, e.g. the reason of the schedule condition at that time.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Timestamp is the time the assignment was recorded.
+	// +optional
+	Timestamp metav1.Time `json:"timestamp,omitempty"`
 }
 
 // AggregatedStatusItem represents status of the resource running in a member cluster.
@@ -414,6 +471,15 @@ const (
 	// FullyApplied represents the condition that the resource referencing by ResourceBinding or ClusterResourceBinding
 	// has been applied to all scheduled clusters.
 	FullyApplied string = "FullyApplied"
+
+	// DataReady represents the condition that the persistent data of the resource referencing by ResourceBinding
+	// has been migrated to the candidate clusters during failover, scheduling of a stateful binding that opts in
+	// to data migration stays suspended until this condition turns true.
+	DataReady string = "DataReady"
+
+	// SingletonHolder represents the condition that exposes which cluster currently holds a resource
+	// propagated with a singleton placement, the holder cluster is carried in the condition message.
+	SingletonHolder string = "SingletonHolder"
 )
 
 // These are reasons for a binding's transition to a Scheduled condition.
@@ -205,6 +205,12 @@ func (s *ResourceBindingSpec) SchedulingSuspended() bool {
 	return *s.Suspension.Scheduling
 }
 
+// SchedulingGated tells if the scheduling of ResourceBinding or
+// ClusterResourceBinding is held back by one or more scheduling gates.
+func (s *ResourceBindingSpec) SchedulingGated() bool {
+	return s != nil && len(s.SchedulingGates) > 0
+}
+
 // SchedulePriorityValue returns the scheduling priority declared
 // by '.spec.SchedulePriority.Priority'.
 func (s *ResourceBindingSpec) SchedulePriorityValue() int32 {
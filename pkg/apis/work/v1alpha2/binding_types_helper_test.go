@@ -385,6 +385,50 @@ func TestResourceBindingSpec_ClusterInGracefulEvictionTasks(t *testing.T) {
 	}
 }
 
+func TestResourceBindingSpec_SchedulingGated(t *testing.T) {
+	tests := []struct {
+		name   string
+		rbSpec *ResourceBindingSpec
+		Gated  bool
+	}{
+		{
+			name:   "nil ResourceBindingSpec results in not gated",
+			rbSpec: nil,
+			Gated:  false,
+		},
+		{
+			name: "nil SchedulingGates results in not gated",
+			rbSpec: &ResourceBindingSpec{
+				SchedulingGates: nil,
+			},
+			Gated: false,
+		},
+		{
+			name: "empty SchedulingGates results in not gated",
+			rbSpec: &ResourceBindingSpec{
+				SchedulingGates: []SchedulingGate{},
+			},
+			Gated: false,
+		},
+		{
+			name: "remaining SchedulingGates results in gated",
+			rbSpec: &ResourceBindingSpec{
+				SchedulingGates: []SchedulingGate{{Name: "example.com/capacity-approved"}},
+			},
+			Gated: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gated := tc.rbSpec.SchedulingGated()
+			if gated != tc.Gated {
+				t.Fatalf("SchedulingGated(): expected: %t, but got: %t", tc.Gated, gated)
+			}
+		})
+	}
+}
+
 func TestResourceBindingSpec_SchedulingSuspended(t *testing.T) {
 	tests := []struct {
 		name      string
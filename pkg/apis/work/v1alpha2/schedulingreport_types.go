@@ -0,0 +1,126 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ResourceKindSchedulingReport is kind name of SchedulingReport.
+	ResourceKindSchedulingReport = "SchedulingReport"
+	// ResourceSingularSchedulingReport is singular name of SchedulingReport.
+	ResourceSingularSchedulingReport = "schedulingreport"
+	// ResourcePluralSchedulingReport is plural name of SchedulingReport.
+	ResourcePluralSchedulingReport = "schedulingreports"
+	// ResourceNamespaceScopedSchedulingReport indicates if SchedulingReport is NamespaceScoped.
+	ResourceNamespaceScopedSchedulingReport = true
+
+	// DefaultSchedulingReportName is the name of the SchedulingReport object
+	// maintained in each namespace that has scheduled workloads.
+	DefaultSchedulingReportName = "scheduling-summary"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=schedulingreports,scope=Namespaced,categories={karmada-io}
+// +kubebuilder:printcolumn:JSONPath=`.lastUpdateTime`,name="LAST-UPDATE",type=date
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="AGE",type=date
+
+// SchedulingReport summarizes where the workloads of a namespace are scheduled.
+// It is maintained by the control plane and exists so that tenant users, who
+// usually may not read cluster-scoped Cluster objects or ResourceBinding
+// details, can still see the placement of their own workloads with
+// namespace-only RBAC.
+type SchedulingReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Workloads lists the workloads of the namespace and the clusters each one
+	// is currently scheduled to.
+	// +optional
+	Workloads []WorkloadSchedulingSummary `json:"workloads,omitempty"`
+
+	// RecentMigrations records the most recent cluster changes observed for
+	// workloads of the namespace, newest first.
+	// +optional
+	RecentMigrations []SchedulingMigration `json:"recentMigrations,omitempty"`
+
+	// LastUpdateTime is the last time the report content changed.
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// WorkloadSchedulingSummary summarizes the current placement of one workload.
+type WorkloadSchedulingSummary struct {
+	// APIVersion represents the API version of the workload.
+	// +required
+	APIVersion string `json:"apiVersion"`
+
+	// Kind represents the Kind of the workload.
+	// +required
+	Kind string `json:"kind"`
+
+	// Name represents the name of the workload.
+	// +required
+	Name string `json:"name"`
+
+	// Clusters represents the clusters the workload is scheduled to, along
+	// with the number of replicas placed on each of them.
+	// +optional
+	Clusters []TargetCluster `json:"clusters,omitempty"`
+}
+
+// SchedulingMigration records a single observed move of a workload between clusters.
+type SchedulingMigration struct {
+	// APIVersion represents the API version of the workload.
+	// +required
+	APIVersion string `json:"apiVersion"`
+
+	// Kind represents the Kind of the workload.
+	// +required
+	Kind string `json:"kind"`
+
+	// Name represents the name of the workload.
+	// +required
+	Name string `json:"name"`
+
+	// FromCluster is the cluster the workload was moved away from.
+	// Empty if the workload was scheduled to an additional cluster.
+	// +optional
+	FromCluster string `json:"fromCluster,omitempty"`
+
+	// ToCluster is the cluster the workload was moved to.
+	// Empty if the workload was removed from a cluster.
+	// +optional
+	ToCluster string `json:"toCluster,omitempty"`
+
+	// Timestamp is the time the migration was observed.
+	// +required
+	Timestamp metav1.Time `json:"timestamp"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SchedulingReportList contains a list of SchedulingReport.
+type SchedulingReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items holds a list of SchedulingReport.
+	Items []SchedulingReport `json:"items"`
+}
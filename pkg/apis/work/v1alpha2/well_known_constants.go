@@ -40,6 +40,13 @@ const (
 	// WorkNameAnnotation is added to objects to specify associated Work's name.
 	WorkNameAnnotation = "work.karmada.io/name"
 
+	// WorkLastAppliedHashAnnotation is added to Work objects by the execution
+	// controller and holds a hash over the manifests that were last applied
+	// to the member cluster successfully. Comparing the annotation against the
+	// current manifests tells whether the member cluster already runs the
+	// latest version of the workload.
+	WorkLastAppliedHashAnnotation = "work.karmada.io/last-applied-hash"
+
 	// ResourceBindingNamespaceAnnotationKey is added to object to describe the associated ResourceBinding's namespace.
 	// It is added to:
 	// - Work object: describes the namespace of ResourceBinding which the Work derived from.
@@ -117,6 +124,20 @@ const (
 	// Note: the keys will be sorted in alphabetical order.
 	ManagedAnnotation = "resourcetemplate.karmada.io/managed-annotations"
 
+	// PreserveOwnerReferencesAnnotation is added to the resource template to declare that its
+	// ownerReferences should be re-established in member clusters after the resource is applied.
+	// This is useful when propagating both an operator CR and its rendered children, in which
+	// case the children would otherwise lose their owner references and break member-side GC.
+	// The only valid value is "true", any other value means the default behavior, that is,
+	// ownerReferences are pruned and not propagated.
+	PreserveOwnerReferencesAnnotation = "work.karmada.io/preserve-owner-references"
+
+	// PreservedOwnerReferencesAnnotation is the annotation that is added to the manifest in the
+	// Work object. It records the ownerReferences(without UIDs) of the resource template before
+	// they are pruned, so that the references can be translated to member cluster UIDs and
+	// re-established after the object is applied there.
+	PreservedOwnerReferencesAnnotation = "resourcetemplate.karmada.io/owner-references"
+
 	// DeletionProtectionLabelKey If a user assigns the DeletionProtectionLabelKey label to a specific resource,
 	// and the value of this label is DeletionProtectionAlways, then deletion requests
 	// for this resource will be denied.
@@ -126,6 +147,61 @@ const (
 	DeletionProtectionAlways   = "Always"
 )
 
+// Define orphaned dependency garbage collection.
+const (
+	// DependencyGCEligibleSinceAnnotation is added to an attached ResourceBinding
+	// when the dependencies distributor finds the dependency is no longer
+	// referenced by an independent binding, e.g. an image change dropped a
+	// ConfigMap. The value is the RFC3339 timestamp at which the dependency
+	// became orphaned. The schedule result is removed from the attached binding
+	// only after the garbage collection grace period has elapsed, and the
+	// annotation is removed as soon as the dependency is referenced again.
+	DependencyGCEligibleSinceAnnotation = "resourcebinding.karmada.io/eligible-for-gc-since"
+)
+
+// Define per-cluster quota scaling.
+const (
+	// QuotaScaleFactorsAnnotation is added to a ResourceQuota or LimitRange resource
+	// template to scale its resource quantities per target cluster, so tenant quotas
+	// track where the workloads actually run instead of being duplicated at full size.
+	// The valid value is either:
+	//   - a comma separated list of explicit per-cluster factors, e.g.
+	//     "member1=0.5,member2=0.25", where "*" matches clusters not listed explicitly;
+	//   - the keyword "replicas", scaling each cluster by its share of the scheduled
+	//     replicas recorded in the binding.
+	// Clusters without a matching factor receive the quantities unscaled.
+	QuotaScaleFactorsAnnotation = "quota.karmada.io/scale-factors"
+
+	// QuotaScaleByReplicas is a value of QuotaScaleFactorsAnnotation, indicating
+	// the quantities should be scaled by each cluster's share of scheduled replicas.
+	QuotaScaleByReplicas = "replicas"
+)
+
+// Define binding-scope cluster exclusion.
+const (
+	// BindingExcludedClustersAnnotation is added to a ResourceBinding or
+	// ClusterResourceBinding to exclude specific clusters from this binding
+	// only, without touching the shared propagation policy. The value is a
+	// comma separated list of cluster names. The scheduler merges the list
+	// into the placement's cluster affinities before scheduling, so adding or
+	// removing the annotation triggers rescheduling just like a placement
+	// change. It is meant for one-off incident exclusions.
+	BindingExcludedClustersAnnotation = "resourcebinding.karmada.io/excluded-clusters"
+)
+
+// Define data migration assistance on failover.
+const (
+	// DataMigrationAnnotation is added to the resource template to opt a stateful workload in to
+	// data migration assistance on failover. When set to "true", scheduling of the binding stays
+	// suspended while a registered migrator orchestrates the vendor-specific snapshot/restore
+	// steps, and resumes once the DataReady condition turns true.
+	DataMigrationAnnotation = "failover.karmada.io/data-migration"
+
+	// DataMigrationAnnotationValueTrue is the only valid value of DataMigrationAnnotation that
+	// enables data migration assistance.
+	DataMigrationAnnotationValueTrue = "true"
+)
+
 // Define eviction reasons.
 const (
 	// EvictionReasonTaintUntolerated describes the eviction is triggered
@@ -244,6 +244,13 @@ func (in *ReplicaRequirements) DeepCopyInto(out *ReplicaRequirements) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.VolumeClaims != nil {
+		in, out := &in.VolumeClaims, &out.VolumeClaims
+		*out = make([]v1.PersistentVolumeClaimSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -375,6 +382,16 @@ func (in *ResourceBindingSpec) DeepCopyInto(out *ResourceBindingSpec) {
 		*out = new(SchedulePriority)
 		**out = **in
 	}
+	if in.SchedulingGates != nil {
+		in, out := &in.SchedulingGates, &out.SchedulingGates
+		*out = make([]SchedulingGate, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxConcurrentClusters != nil {
+		in, out := &in.MaxConcurrentClusters, &out.MaxConcurrentClusters
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -409,6 +426,13 @@ func (in *ResourceBindingStatus) DeepCopyInto(out *ResourceBindingStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SchedulingHistory != nil {
+		in, out := &in.SchedulingHistory, &out.SchedulingHistory
+		*out = make([]ScheduleRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -438,6 +462,135 @@ func (in *SchedulePriority) DeepCopy() *SchedulePriority {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleRecord) DeepCopyInto(out *ScheduleRecord) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]TargetCluster, len(*in))
+		copy(*out, *in)
+	}
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleRecord.
+func (in *ScheduleRecord) DeepCopy() *ScheduleRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingGate) DeepCopyInto(out *SchedulingGate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingGate.
+func (in *SchedulingGate) DeepCopy() *SchedulingGate {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingMigration) DeepCopyInto(out *SchedulingMigration) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingMigration.
+func (in *SchedulingMigration) DeepCopy() *SchedulingMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingReport) DeepCopyInto(out *SchedulingReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]WorkloadSchedulingSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RecentMigrations != nil {
+		in, out := &in.RecentMigrations, &out.RecentMigrations
+		*out = make([]SchedulingMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingReport.
+func (in *SchedulingReport) DeepCopy() *SchedulingReport {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchedulingReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingReportList) DeepCopyInto(out *SchedulingReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SchedulingReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingReportList.
+func (in *SchedulingReportList) DeepCopy() *SchedulingReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchedulingReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Suspension) DeepCopyInto(out *Suspension) {
 	*out = *in
@@ -513,3 +666,24 @@ func (in *TaskOptions) DeepCopy() *TaskOptions {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadSchedulingSummary) DeepCopyInto(out *WorkloadSchedulingSummary) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]TargetCluster, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadSchedulingSummary.
+func (in *WorkloadSchedulingSummary) DeepCopy() *WorkloadSchedulingSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadSchedulingSummary)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -65,6 +65,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterResourceBindingList{},
 		&ResourceBinding{},
 		&ResourceBindingList{},
+		&SchedulingReport{},
+		&SchedulingReportList{},
 	)
 	// AddToGroupVersion allows the serialization of client types like ListOptions.
 	v1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -0,0 +1,40 @@
+//go:build !chaos
+
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaos
+
+import (
+	"context"
+
+	"k8s.io/client-go/rest"
+)
+
+// Enabled reports whether the binary was built with fault injection support.
+func Enabled() bool { return false }
+
+// Setup is a no-op without the 'chaos' build tag.
+func Setup(context.Context, *rest.Config) error { return nil }
+
+// Delay is a no-op without the 'chaos' build tag.
+func Delay(context.Context, string, string) {}
+
+// DropStatusUpdate never drops without the 'chaos' build tag.
+func DropStatusUpdate(string, string) bool { return false }
+
+// ClusterFlapping never flaps without the 'chaos' build tag.
+func ClusterFlapping(string) bool { return false }
@@ -0,0 +1,132 @@
+//go:build chaos
+
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaos
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// faultInjectionGVR is the resource the rules are loaded from. The CRD ships
+// in artifacts/chaos and is meant to be installed in test environments only.
+var faultInjectionGVR = schema.GroupVersionResource{
+	Group:    "chaos.karmada.io",
+	Version:  "v1alpha1",
+	Resource: "faultinjections",
+}
+
+// faultInjectionSpec is the spec of a FaultInjection resource.
+type faultInjectionSpec struct {
+	Rules []Rule `json:"rules,omitempty"`
+}
+
+// Enabled reports whether the binary was built with fault injection support.
+func Enabled() bool { return true }
+
+// Setup watches the FaultInjection resources and keeps the active rules in
+// sync with them. It returns once the watch is running.
+func Setup(ctx context.Context, config *rest.Config) error {
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	informer := dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, 0).
+		ForResource(faultInjectionGVR).Informer()
+	reload := func(interface{}) { reloadRules(informer.GetStore()) }
+	if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    reload,
+		UpdateFunc: func(_, newObj interface{}) { reload(newObj) },
+		DeleteFunc: reload,
+	}); err != nil {
+		return err
+	}
+	go informer.Run(ctx.Done())
+	return nil
+}
+
+// reloadRules replaces the active rules with the union of the rules of every
+// FaultInjection currently in the store.
+func reloadRules(store cache.Store) {
+	var loaded []Rule
+	for _, obj := range store.List() {
+		fault, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		spec, exist, err := unstructured.NestedMap(fault.Object, "spec")
+		if err != nil || !exist {
+			continue
+		}
+		raw, err := json.Marshal(spec)
+		if err != nil {
+			klog.Errorf("Failed to marshal spec of FaultInjection(%s): %v", fault.GetName(), err)
+			continue
+		}
+		parsed := faultInjectionSpec{}
+		if err := json.Unmarshal(raw, &parsed); err != nil {
+			klog.Errorf("Failed to parse spec of FaultInjection(%s): %v", fault.GetName(), err)
+			continue
+		}
+		loaded = append(loaded, parsed.Rules...)
+	}
+	SetRules(loaded)
+	klog.Infof("Chaos fault injection loaded %d rule(s)", len(loaded))
+}
+
+// Delay stalls the operation at the point for the duration of a matching
+// Delay rule, honoring context cancellation.
+func Delay(ctx context.Context, point, cluster string) {
+	rule := pick(ActionDelay, point, cluster)
+	if rule == nil || rule.Duration.Duration <= 0 {
+		return
+	}
+	klog.Infof("Chaos: delaying %s for cluster(%s) by %s", point, cluster, rule.Duration.Duration)
+	select {
+	case <-time.After(rule.Duration.Duration):
+	case <-ctx.Done():
+	}
+}
+
+// DropStatusUpdate reports whether the status update at the point should be
+// dropped.
+func DropStatusUpdate(point, cluster string) bool {
+	if pick(ActionDropStatusUpdate, point, cluster) == nil {
+		return false
+	}
+	klog.Infof("Chaos: dropping status update at %s for cluster(%s)", point, cluster)
+	return true
+}
+
+// ClusterFlapping reports whether the cluster should be observed as offline.
+func ClusterFlapping(cluster string) bool {
+	if pick(ActionClusterFlap, PointClusterStatusCollect, cluster) == nil {
+		return false
+	}
+	klog.Infof("Chaos: simulating offline cluster(%s)", cluster)
+	return true
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package chaos provides fault injection hooks for resilience testing of the
+// failover, eviction and rescheduling paths. The hooks are compiled away
+// unless the binary is built with the 'chaos' build tag; with the tag, the
+// injected faults are configured through FaultInjection resources
+// (chaos.karmada.io/v1alpha1) so e2e suites and staging environments can
+// reproduce delays, dropped status updates and cluster flaps on demand.
+// Never ship a chaos build to production.
+package chaos
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Action is the kind of fault a rule injects.
+type Action string
+
+const (
+	// ActionDelay delays the hooked operation by the rule's duration.
+	ActionDelay Action = "Delay"
+	// ActionDropStatusUpdate drops the hooked status update.
+	ActionDropStatusUpdate Action = "DropStatusUpdate"
+	// ActionClusterFlap makes the cluster status collection observe the
+	// cluster as offline, driving it through the failure threshold exactly
+	// like a real outage.
+	ActionClusterFlap Action = "ClusterFlap"
+)
+
+// The injection points currently wired into the controllers.
+const (
+	// PointExecutionSyncWork is hit before a Work is synced to its cluster.
+	PointExecutionSyncWork = "execution/sync-work"
+	// PointWorkStatusReflect is hit before a collected status is written
+	// back to the Work.
+	PointWorkStatusReflect = "work-status/reflect"
+	// PointClusterStatusCollect is hit when the cluster health is probed.
+	PointClusterStatusCollect = "cluster-status/collect"
+)
+
+// Rule describes one fault to inject. The zero values of Point, Clusters and
+// Probability mean any point, any cluster and always, respectively.
+type Rule struct {
+	// Action is the kind of fault to inject.
+	Action Action `json:"action"`
+	// Point restricts the rule to one injection point.
+	Point string `json:"point,omitempty"`
+	// Clusters restricts the rule to the named member clusters.
+	Clusters []string `json:"clusters,omitempty"`
+	// Probability injects the fault on this fraction of the hits, in the
+	// interval (0, 1]. Zero means always.
+	Probability float64 `json:"probability,omitempty"`
+	// Duration is how long a Delay rule stalls the operation.
+	Duration metav1.Duration `json:"duration,omitempty"`
+}
+
+var (
+	mu    sync.RWMutex
+	rules []Rule
+)
+
+// SetRules replaces the active fault injection rules.
+func SetRules(newRules []Rule) {
+	mu.Lock()
+	defer mu.Unlock()
+	rules = slices.Clone(newRules)
+}
+
+// pick returns a copy of the first active rule matching the action, point and
+// cluster after its probability roll, or nil when no rule fires.
+func pick(action Action, point, cluster string) *Rule {
+	mu.RLock()
+	defer mu.RUnlock()
+	for i := range rules {
+		rule := rules[i]
+		if rule.Action != action {
+			continue
+		}
+		if rule.Point != "" && rule.Point != point {
+			continue
+		}
+		if len(rule.Clusters) > 0 && !slices.Contains(rule.Clusters, cluster) {
+			continue
+		}
+		if rule.Probability > 0 && rule.Probability < 1 && rand.Float64() >= rule.Probability { //nolint:gosec // non-cryptographic randomness is fine for fault injection
+			continue
+		}
+		return &rule
+	}
+	return nil
+}
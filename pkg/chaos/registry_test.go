@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaos
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPick(t *testing.T) {
+	defer SetRules(nil)
+	SetRules([]Rule{
+		{Action: ActionDelay, Point: PointExecutionSyncWork, Duration: metav1.Duration{Duration: time.Second}},
+		{Action: ActionClusterFlap, Clusters: []string{"member1"}},
+		{Action: ActionDropStatusUpdate},
+	})
+
+	tests := []struct {
+		name    string
+		action  Action
+		point   string
+		cluster string
+		want    bool
+	}{
+		{name: "delay at its point", action: ActionDelay, point: PointExecutionSyncWork, cluster: "member1", want: true},
+		{name: "delay at another point", action: ActionDelay, point: PointWorkStatusReflect, cluster: "member1", want: false},
+		{name: "flap of the named cluster", action: ActionClusterFlap, point: PointClusterStatusCollect, cluster: "member1", want: true},
+		{name: "flap of another cluster", action: ActionClusterFlap, point: PointClusterStatusCollect, cluster: "member2", want: false},
+		{name: "drop matches any point and cluster", action: ActionDropStatusUpdate, point: PointWorkStatusReflect, cluster: "member2", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pick(tt.action, tt.point, tt.cluster) != nil; got != tt.want {
+				t.Errorf("pick(%s, %s, %s) fired = %v, want %v", tt.action, tt.point, tt.cluster, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPickProbability(t *testing.T) {
+	defer SetRules(nil)
+	SetRules([]Rule{{Action: ActionDropStatusUpdate, Probability: 0.5}})
+	fired := 0
+	for i := 0; i < 1000; i++ {
+		if pick(ActionDropStatusUpdate, PointWorkStatusReflect, "member1") != nil {
+			fired++
+		}
+	}
+	if fired == 0 || fired == 1000 {
+		t.Errorf("a rule with probability 0.5 fired %d times out of 1000", fired)
+	}
+}
+
+func TestPickNoRules(t *testing.T) {
+	SetRules(nil)
+	if pick(ActionDelay, PointExecutionSyncWork, "member1") != nil {
+		t.Error("pick() fired without any rule")
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clientfanout provides reusable helpers for fanning an operation out
+// to member clusters through the cluster proxy of the Karmada control plane,
+// so commands and third-party tools do not have to re-implement the same
+// proxy plumbing, result collection and error aggregation.
+package clientfanout
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+// MemberFactoryProvider builds a cmdutil.Factory whose requests are routed to
+// one member cluster through the cluster proxy of the Karmada control plane.
+// The karmadactl util.Factory implements it.
+type MemberFactoryProvider interface {
+	FactoryForMemberCluster(clusterName string) (cmdutil.Factory, error)
+}
+
+// Func is the operation invoked against one member cluster. The factory
+// routes all requests to the given cluster.
+type Func[T any] func(ctx context.Context, cluster string, f cmdutil.Factory) (T, error)
+
+// Result holds the outcome of invoking an operation against one cluster.
+type Result[T any] struct {
+	// Cluster is the name of the member cluster.
+	Cluster string
+	// Value is the value returned by the operation, the zero value on error.
+	Value T
+	// Err is the error returned by the operation, including the failure to
+	// build the member cluster factory.
+	Err error
+}
+
+// Options control a fan-out invocation.
+type Options struct {
+	// Concurrency is the maximum number of clusters queried in parallel.
+	// Zero or negative means all clusters at once.
+	Concurrency int
+}
+
+// Invoke runs fn against every given cluster and returns one result per
+// cluster in input order. A failure in one cluster does not prevent the
+// remaining clusters from being processed. When the context is canceled, no
+// further clusters are started and their results carry the context error.
+func Invoke[T any](ctx context.Context, provider MemberFactoryProvider, clusters []string, opts Options, fn Func[T]) []Result[T] {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 || concurrency > len(clusters) {
+		concurrency = len(clusters)
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]Result[T], len(clusters))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range clusters {
+		results[i].Cluster = clusters[i]
+		if err := ctx.Err(); err != nil {
+			results[i].Err = err
+			continue
+		}
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(result *Result[T]) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			factory, err := provider.FactoryForMemberCluster(result.Cluster)
+			if err != nil {
+				result.Err = err
+				return
+			}
+			result.Value, result.Err = fn(ctx, result.Cluster, factory)
+		}(&results[i])
+	}
+	wg.Wait()
+	return results
+}
+
+// AggregateErrors merges the failed results into a single error with the
+// cluster name prefixed to each failure, or nil when every cluster succeeded.
+func AggregateErrors[T any](results []Result[T]) error {
+	var errs []error
+	for i := range results {
+		if results[i].Err != nil {
+			errs = append(errs, fmt.Errorf("cluster(%s): %v", results[i].Cluster, results[i].Err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientfanout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/spf13/cobra"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+// fakeProvider returns a nil factory for every cluster, or an error for the
+// clusters listed in failing.
+type fakeProvider struct {
+	failing map[string]bool
+}
+
+func (p *fakeProvider) FactoryForMemberCluster(clusterName string) (cmdutil.Factory, error) {
+	if p.failing[clusterName] {
+		return nil, fmt.Errorf("cluster %s is not joined", clusterName)
+	}
+	return nil, nil
+}
+
+func TestInvoke(t *testing.T) {
+	clusters := []string{"member1", "member2", "member3"}
+	results := Invoke(context.TODO(), &fakeProvider{}, clusters, Options{},
+		func(_ context.Context, cluster string, _ cmdutil.Factory) (string, error) {
+			if cluster == "member2" {
+				return "", errors.New("boom")
+			}
+			return "value-" + cluster, nil
+		})
+
+	if len(results) != len(clusters) {
+		t.Fatalf("got %d results, want %d", len(results), len(clusters))
+	}
+	for i, result := range results {
+		if result.Cluster != clusters[i] {
+			t.Errorf("result[%d].Cluster = %q, want input order %q", i, result.Cluster, clusters[i])
+		}
+	}
+	if results[0].Value != "value-member1" || results[0].Err != nil {
+		t.Errorf("unexpected result for member1: %+v", results[0])
+	}
+	if results[1].Err == nil || results[1].Value != "" {
+		t.Errorf("unexpected result for member2: %+v", results[1])
+	}
+}
+
+func TestInvokeConcurrencyLimit(t *testing.T) {
+	var current, peak int32
+	results := Invoke(context.TODO(), &fakeProvider{}, []string{"a", "b", "c", "d"}, Options{Concurrency: 2},
+		func(context.Context, string, cmdutil.Factory) (struct{}, error) {
+			n := atomic.AddInt32(&current, 1)
+			for {
+				old := atomic.LoadInt32(&peak)
+				if n <= old || atomic.CompareAndSwapInt32(&peak, old, n) {
+					break
+				}
+			}
+			atomic.AddInt32(&current, -1)
+			return struct{}{}, nil
+		})
+	if len(results) != 4 {
+		t.Fatalf("got %d results, want 4", len(results))
+	}
+	if got := atomic.LoadInt32(&peak); got > 2 {
+		t.Errorf("observed %d concurrent invocations, want at most 2", got)
+	}
+}
+
+func TestInvokeFactoryError(t *testing.T) {
+	provider := &fakeProvider{failing: map[string]bool{"member1": true}}
+	results := Invoke(context.TODO(), provider, []string{"member1"}, Options{},
+		func(context.Context, string, cmdutil.Factory) (struct{}, error) {
+			t.Error("the operation must not run when the factory cannot be built")
+			return struct{}{}, nil
+		})
+	if results[0].Err == nil {
+		t.Error("expected the factory error to be recorded, got nil")
+	}
+}
+
+func TestInvokeCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results := Invoke(ctx, &fakeProvider{}, []string{"member1", "member2"}, Options{},
+		func(context.Context, string, cmdutil.Factory) (struct{}, error) {
+			t.Error("no operation must start once the context is canceled")
+			return struct{}{}, nil
+		})
+	for i := range results {
+		if !errors.Is(results[i].Err, context.Canceled) {
+			t.Errorf("results[%d].Err = %v, want context.Canceled", i, results[i].Err)
+		}
+	}
+}
+
+func TestAggregateErrors(t *testing.T) {
+	results := []Result[struct{}]{
+		{Cluster: "member1"},
+		{Cluster: "member2", Err: errors.New("boom")},
+	}
+	err := AggregateErrors(results)
+	if err == nil || !strings.Contains(err.Error(), "cluster(member2): boom") {
+		t.Errorf("AggregateErrors() = %v, want the cluster name prefixed", err)
+	}
+	if AggregateErrors(results[:1]) != nil {
+		t.Error("AggregateErrors() should return nil when every cluster succeeded")
+	}
+}
+
+func TestCopyFlags(t *testing.T) {
+	from := &cobra.Command{}
+	from.Flags().String("selector", "", "")
+	from.Flags().StringSlice("clusters", nil, "")
+	if err := from.Flags().Parse([]string{"--selector=app=nginx", "--clusters=member1,member2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	to := &cobra.Command{}
+	to.Flags().String("selector", "", "")
+	to.Flags().StringSlice("clusters", nil, "")
+	to.Flags().String("untouched", "default", "")
+	CopyFlags(from, to)
+
+	if got, _ := to.Flags().GetString("selector"); got != "app=nginx" {
+		t.Errorf("selector = %q, want %q", got, "app=nginx")
+	}
+	if got, _ := to.Flags().GetStringSlice("clusters"); len(got) != 2 || got[0] != "member1" {
+		t.Errorf("clusters = %v, want [member1 member2]", got)
+	}
+	if !to.Flags().Lookup("selector").Changed {
+		t.Error("copied flag should be marked as changed")
+	}
+	if to.Flags().Lookup("untouched").Changed {
+		t.Error("flag absent on the outer command should stay unchanged")
+	}
+}
+
+func TestRunMemberCommand(t *testing.T) {
+	cmd := &cobra.Command{
+		Run: func(*cobra.Command, []string) {
+			cmdutil.CheckErr(errors.New("boom"))
+		},
+	}
+	err := RunMemberCommand(cmd, nil)
+	if err == nil || err.Error() != "boom" {
+		t.Errorf("RunMemberCommand() = %v, want the fatal message as error", err)
+	}
+
+	cmd = &cobra.Command{Run: func(*cobra.Command, []string) {}}
+	if err := RunMemberCommand(cmd, nil); err != nil {
+		t.Errorf("RunMemberCommand() = %v, want nil", err)
+	}
+}
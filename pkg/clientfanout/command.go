@@ -0,0 +1,71 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientfanout
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+// CopyFlags applies the flag values set on the outer command to the freshly
+// constructed member-scoped command.
+func CopyFlags(from, to *cobra.Command) {
+	from.Flags().Visit(func(f *pflag.Flag) {
+		t := to.Flags().Lookup(f.Name)
+		if t == nil {
+			return
+		}
+		if fv, ok := f.Value.(pflag.SliceValue); ok {
+			if tv, ok := t.Value.(pflag.SliceValue); ok {
+				_ = tv.Replace(fv.GetSlice())
+				t.Changed = true
+				return
+			}
+		}
+		_ = t.Value.Set(f.Value.String())
+		t.Changed = true
+	})
+}
+
+type fatalError struct {
+	msg string
+}
+
+// RunMemberCommand executes the member-scoped command and converts the fatal
+// behavior of kubectl into a returned error, so that a failure in one cluster
+// does not prevent the remaining clusters from being processed.
+func RunMemberCommand(cmd *cobra.Command, args []string) (err error) {
+	cmdutil.BehaviorOnFatal(func(msg string, _ int) {
+		panic(fatalError{msg: msg})
+	})
+	defer cmdutil.DefaultBehaviorOnFatal()
+	defer func() {
+		if r := recover(); r != nil {
+			fatal, ok := r.(fatalError)
+			if !ok {
+				panic(r)
+			}
+			err = errors.New(strings.TrimPrefix(fatal.msg, "error: "))
+		}
+	}()
+	cmd.Run(cmd, args)
+	return
+}
@@ -92,6 +92,16 @@ func ensureWork(
 			}
 		}
 
+		// Scale ResourceQuota/LimitRange quantities by the cluster's share before
+		// applying overrides, so explicit override rules can still win.
+		clonedWorkload, err = applyQuotaScaling(clonedWorkload, targetCluster, targetClusters)
+		if err != nil {
+			klog.Errorf("Failed to scale quota for %s/%s/%s in cluster %s, err is: %v",
+				workload.GetKind(), workload.GetNamespace(), workload.GetName(), targetCluster.Name, err)
+			errs = append(errs, err)
+			continue
+		}
+
 		// We should call ApplyOverridePolicies last, as override rules have the highest priority
 		cops, ops, err := overrideManager.ApplyOverridePolicies(clonedWorkload, targetCluster.Name)
 		if err != nil {
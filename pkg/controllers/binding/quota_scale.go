@@ -0,0 +1,185 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+// quotaScaleWildcard matches clusters not listed explicitly in the
+// QuotaScaleFactorsAnnotation factor list.
+const quotaScaleWildcard = "*"
+
+// applyQuotaScaling scales the resource quantities of a ResourceQuota or
+// LimitRange workload for the given target cluster according to the
+// QuotaScaleFactorsAnnotation on the resource template. Workloads of other
+// kinds, or without the annotation, are returned unchanged.
+func applyQuotaScaling(workload *unstructured.Unstructured, targetCluster workv1alpha2.TargetCluster, targetClusters []workv1alpha2.TargetCluster) (*unstructured.Unstructured, error) {
+	kind := workload.GetKind()
+	if kind != util.ResourceQuotaKind && kind != util.LimitRangeKind {
+		return workload, nil
+	}
+	value := util.GetAnnotationValue(workload.GetAnnotations(), workv1alpha2.QuotaScaleFactorsAnnotation)
+	if value == "" {
+		return workload, nil
+	}
+
+	factor, scale, err := resolveQuotaScaleFactor(value, targetCluster.Name, targetClusters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve quota scale factor for cluster %s: %v", targetCluster.Name, err)
+	}
+	if !scale {
+		return workload, nil
+	}
+
+	if kind == util.ResourceQuotaKind {
+		err = scaleResourceQuota(workload, factor)
+	} else {
+		err = scaleLimitRange(workload, factor)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scale %s/%s/%s for cluster %s: %v",
+			kind, workload.GetNamespace(), workload.GetName(), targetCluster.Name, err)
+	}
+	return workload, nil
+}
+
+// resolveQuotaScaleFactor resolves the scale factor the given cluster should
+// apply, from either the replicas keyword or an explicit factor list. The
+// second return value reports whether a factor applies to the cluster at all.
+func resolveQuotaScaleFactor(value, clusterName string, targetClusters []workv1alpha2.TargetCluster) (float64, bool, error) {
+	if value == workv1alpha2.QuotaScaleByReplicas {
+		var total, replicas int64
+		for _, cluster := range targetClusters {
+			total += int64(cluster.Replicas)
+			if cluster.Name == clusterName {
+				replicas = int64(cluster.Replicas)
+			}
+		}
+		if total <= 0 {
+			return 0, false, fmt.Errorf("binding has no scheduled replicas to scale by")
+		}
+		return float64(replicas) / float64(total), true, nil
+	}
+
+	var wildcard float64
+	var wildcardFound bool
+	for _, entry := range strings.Split(value, ",") {
+		cluster, factorStr, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found {
+			return 0, false, fmt.Errorf("invalid scale factor entry %q, expect CLUSTER=FACTOR", entry)
+		}
+		factor, err := strconv.ParseFloat(factorStr, 64)
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid scale factor %q of cluster %s: %v", factorStr, cluster, err)
+		}
+		if factor <= 0 {
+			return 0, false, fmt.Errorf("scale factor %v of cluster %s must be positive", factor, cluster)
+		}
+		if cluster == clusterName {
+			return factor, true, nil
+		}
+		if cluster == quotaScaleWildcard {
+			wildcard, wildcardFound = factor, true
+		}
+	}
+	return wildcard, wildcardFound, nil
+}
+
+// scaleResourceQuota scales every quantity in the hard limits of a
+// ResourceQuota by the given factor.
+func scaleResourceQuota(workload *unstructured.Unstructured, factor float64) error {
+	hard, found, err := unstructured.NestedStringMap(workload.Object, util.SpecField, "hard")
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+	if err = scaleQuantityMap(hard, factor); err != nil {
+		return err
+	}
+	return unstructured.SetNestedStringMap(workload.Object, hard, util.SpecField, "hard")
+}
+
+// scaleLimitRange scales the absolute quantities of every limit item of a
+// LimitRange by the given factor. Ratios(maxLimitRequestRatio) are left
+// untouched as they are independent of the cluster's share.
+func scaleLimitRange(workload *unstructured.Unstructured, factor float64) error {
+	limits, found, err := unstructured.NestedSlice(workload.Object, util.SpecField, "limits")
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+	for i := range limits {
+		limit, ok := limits[i].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected limit item type %T", limits[i])
+		}
+		for _, field := range []string{"max", "min", "default", "defaultRequest"} {
+			quantities, found, err := unstructured.NestedStringMap(limit, field)
+			if err != nil {
+				return err
+			}
+			if !found {
+				continue
+			}
+			if err = scaleQuantityMap(quantities, factor); err != nil {
+				return err
+			}
+			if err = unstructured.SetNestedStringMap(limit, quantities, field); err != nil {
+				return err
+			}
+		}
+		limits[i] = limit
+	}
+	return unstructured.SetNestedSlice(workload.Object, limits, util.SpecField, "limits")
+}
+
+// scaleQuantityMap scales every quantity in the map by the given factor,
+// rounding up so scaled quotas never add up to less than intended.
+func scaleQuantityMap(quantities map[string]string, factor float64) error {
+	for name, value := range quantities {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return fmt.Errorf("invalid quantity %q of %s: %v", value, name, err)
+		}
+		quantities[name] = scaleQuantity(quantity, factor).String()
+	}
+	return nil
+}
+
+// scaleQuantity multiplies a quantity by the given factor, rounding up at
+// milli precision and keeping the original format.
+func scaleQuantity(quantity resource.Quantity, factor float64) *resource.Quantity {
+	milli := int64(math.Ceil(float64(quantity.MilliValue()) * factor))
+	if milli%1000 == 0 {
+		return resource.NewQuantity(milli/1000, quantity.Format)
+	}
+	return resource.NewMilliQuantity(milli, quantity.Format)
+}
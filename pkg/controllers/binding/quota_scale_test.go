@@ -0,0 +1,229 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+func Test_resolveQuotaScaleFactor(t *testing.T) {
+	targetClusters := []workv1alpha2.TargetCluster{
+		{Name: "member1", Replicas: 3},
+		{Name: "member2", Replicas: 1},
+	}
+	tests := []struct {
+		name        string
+		value       string
+		clusterName string
+		wantFactor  float64
+		wantScale   bool
+		wantErr     bool
+	}{
+		{
+			name:        "explicit factor of the cluster",
+			value:       "member1=0.5,member2=0.25",
+			clusterName: "member2",
+			wantFactor:  0.25,
+			wantScale:   true,
+		},
+		{
+			name:        "wildcard factor for unlisted cluster",
+			value:       "member1=0.5,*=0.1",
+			clusterName: "member2",
+			wantFactor:  0.1,
+			wantScale:   true,
+		},
+		{
+			name:        "unlisted cluster without wildcard is not scaled",
+			value:       "member1=0.5",
+			clusterName: "member2",
+			wantScale:   false,
+		},
+		{
+			name:        "scale by replicas share",
+			value:       workv1alpha2.QuotaScaleByReplicas,
+			clusterName: "member1",
+			wantFactor:  0.75,
+			wantScale:   true,
+		},
+		{
+			name:        "malformed entry",
+			value:       "member1",
+			clusterName: "member1",
+			wantErr:     true,
+		},
+		{
+			name:        "non-positive factor",
+			value:       "member1=0",
+			clusterName: "member1",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factor, scale, err := resolveQuotaScaleFactor(tt.value, tt.clusterName, targetClusters)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveQuotaScaleFactor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if scale != tt.wantScale {
+				t.Fatalf("resolveQuotaScaleFactor() scale = %v, want %v", scale, tt.wantScale)
+			}
+			if scale && factor != tt.wantFactor {
+				t.Errorf("resolveQuotaScaleFactor() factor = %v, want %v", factor, tt.wantFactor)
+			}
+		})
+	}
+}
+
+func Test_resolveQuotaScaleFactor_noReplicas(t *testing.T) {
+	_, _, err := resolveQuotaScaleFactor(workv1alpha2.QuotaScaleByReplicas, "member1",
+		[]workv1alpha2.TargetCluster{{Name: "member1"}, {Name: "member2"}})
+	if err == nil {
+		t.Error("expected an error when scaling by replicas without scheduled replicas, but got none")
+	}
+}
+
+func Test_applyQuotaScaling(t *testing.T) {
+	targetClusters := []workv1alpha2.TargetCluster{
+		{Name: "member1", Replicas: 1},
+		{Name: "member2", Replicas: 1},
+	}
+	tests := []struct {
+		name     string
+		workload *unstructured.Unstructured
+		want     map[string]interface{}
+	}{
+		{
+			name: "resource quota with explicit factor",
+			workload: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ResourceQuota",
+				"metadata": map[string]interface{}{
+					"name":      "quota",
+					"namespace": "default",
+					"annotations": map[string]interface{}{
+						workv1alpha2.QuotaScaleFactorsAnnotation: "member1=0.5",
+					},
+				},
+				"spec": map[string]interface{}{
+					"hard": map[string]interface{}{
+						"cpu":    "10",
+						"memory": "8Gi",
+						"pods":   "5",
+					},
+				},
+			}},
+			want: map[string]interface{}{
+				"hard": map[string]interface{}{
+					"cpu":    "5",
+					"memory": "4Gi",
+					"pods":   "2500m",
+				},
+			},
+		},
+		{
+			name: "resource quota without annotation is untouched",
+			workload: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ResourceQuota",
+				"metadata": map[string]interface{}{
+					"name":      "quota",
+					"namespace": "default",
+				},
+				"spec": map[string]interface{}{
+					"hard": map[string]interface{}{"cpu": "10"},
+				},
+			}},
+			want: map[string]interface{}{
+				"hard": map[string]interface{}{"cpu": "10"},
+			},
+		},
+		{
+			name: "limit range scales absolute quantities only",
+			workload: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "LimitRange",
+				"metadata": map[string]interface{}{
+					"name":      "limits",
+					"namespace": "default",
+					"annotations": map[string]interface{}{
+						workv1alpha2.QuotaScaleFactorsAnnotation: workv1alpha2.QuotaScaleByReplicas,
+					},
+				},
+				"spec": map[string]interface{}{
+					"limits": []interface{}{
+						map[string]interface{}{
+							"type":                 "Container",
+							"max":                  map[string]interface{}{"cpu": "4"},
+							"defaultRequest":       map[string]interface{}{"cpu": "1"},
+							"maxLimitRequestRatio": map[string]interface{}{"cpu": "4"},
+						},
+					},
+				},
+			}},
+			want: map[string]interface{}{
+				"limits": []interface{}{
+					map[string]interface{}{
+						"type":                 "Container",
+						"max":                  map[string]interface{}{"cpu": "2"},
+						"defaultRequest":       map[string]interface{}{"cpu": "500m"},
+						"maxLimitRequestRatio": map[string]interface{}{"cpu": "4"},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := applyQuotaScaling(tt.workload, targetClusters[0], targetClusters)
+			if err != nil {
+				t.Fatalf("applyQuotaScaling() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got.Object["spec"], tt.want) {
+				t.Errorf("applyQuotaScaling() spec = %v, want %v", got.Object["spec"], tt.want)
+			}
+		})
+	}
+}
+
+func Test_applyQuotaScaling_invalidFactors(t *testing.T) {
+	workload := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ResourceQuota",
+		"metadata": map[string]interface{}{
+			"name":      "quota",
+			"namespace": "default",
+			"annotations": map[string]interface{}{
+				workv1alpha2.QuotaScaleFactorsAnnotation: "member1=-1",
+			},
+		},
+		"spec": map[string]interface{}{
+			"hard": map[string]interface{}{"cpu": "10"},
+		},
+	}}
+	if _, err := applyQuotaScaling(workload, workv1alpha2.TargetCluster{Name: "member1"}, nil); err == nil {
+		t.Error("expected an error for a negative scale factor, but got none")
+	}
+}
@@ -30,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
+	bootstraputil "k8s.io/cluster-bootstrap/token/util"
 	"k8s.io/klog/v2"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -45,6 +46,11 @@ const (
 	csrApprovingController = "agent-csr-approving-controller"
 	agentCSRGroup          = "system:karmada:agents"
 	agentCSRUserPrefix     = "system:karmada:agent:"
+	bootstrapUserPrefix    = "system:bootstrap:"
+	// boundClusterNameKey is the bootstrap token Secret data key that restricts
+	// the token to registering one specific named cluster, written by
+	// 'karmadactl token create --bound-cluster-name'.
+	boundClusterNameKey = "bound-cluster-name"
 )
 
 // AgentCSRApprovingController is used to automatically approve the agent's CSR.
@@ -95,6 +101,24 @@ func (a *AgentCSRApprovingController) handleCertificateSigningRequest(ctx contex
 	if err != nil {
 		return fmt.Errorf("unable to parse csr %q: %v", csr.Name, err)
 	}
+
+	// If the CSR was created with a bootstrap token that is bound to a specific
+	// cluster name, deny any request for another identity before trying to
+	// approve it. Bound tokens are single-use, the token is deleted once the
+	// certificate it was issued for has been approved.
+	boundTokenSecret, denyMessage, err := a.checkBoundToken(ctx, csr, x509cr)
+	if err != nil {
+		return err
+	}
+	if denyMessage != "" {
+		appendDenialCondition(csr, denyMessage)
+		_, err = a.Client.CertificatesV1().CertificateSigningRequests().UpdateApproval(ctx, csr.Name, csr, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("error updating denial for csr %s: %v", csr.Name, err)
+		}
+		return nil
+	}
+
 	var tried []string
 
 	for _, r := range agentCSRRecognizers() {
@@ -114,7 +138,7 @@ func (a *AgentCSRApprovingController) handleCertificateSigningRequest(ctx contex
 			if err != nil {
 				return fmt.Errorf("error updating approval for csr %s: %v", csr.Name, err)
 			}
-			return nil
+			return a.expireBoundToken(ctx, boundTokenSecret)
 		}
 	}
 
@@ -125,6 +149,50 @@ func (a *AgentCSRApprovingController) handleCertificateSigningRequest(ctx contex
 	return nil
 }
 
+// checkBoundToken inspects the bootstrap token the CSR was created with, if any.
+// It returns the name of the bound token Secret so the token can be expired after
+// approval, or a denial message when the requested identity does not match the
+// cluster name the token is bound to.
+func (a *AgentCSRApprovingController) checkBoundToken(ctx context.Context, csr *certificatesv1.CertificateSigningRequest, x509cr *x509.CertificateRequest) (tokenSecretName, denyMessage string, err error) {
+	if !strings.HasPrefix(csr.Spec.Username, bootstrapUserPrefix) {
+		return "", "", nil
+	}
+	tokenID := strings.TrimPrefix(csr.Spec.Username, bootstrapUserPrefix)
+	secretName := bootstraputil.BootstrapTokenSecretName(tokenID)
+	secret, err := a.Client.CoreV1().Secrets(metav1.NamespaceSystem).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		// The token might have been deleted or expired since authentication,
+		// in which case there is no constraint left to enforce.
+		if apierrors.IsNotFound(err) {
+			return "", "", nil
+		}
+		return "", "", err
+	}
+	boundClusterName := string(secret.Data[boundClusterNameKey])
+	if boundClusterName == "" {
+		return "", "", nil
+	}
+	if expected := agentCSRUserPrefix + boundClusterName; x509cr.Subject.CommonName != expected {
+		return "", fmt.Sprintf("Denying karmada agent certificate: bootstrap token %s is bound to cluster %q but the certificate was requested for %q.",
+			tokenID, boundClusterName, x509cr.Subject.CommonName), nil
+	}
+	return secretName, "", nil
+}
+
+// expireBoundToken deletes the bound bootstrap token Secret after the
+// certificate it was issued for has been approved, making the token single-use.
+func (a *AgentCSRApprovingController) expireBoundToken(ctx context.Context, tokenSecretName string) error {
+	if tokenSecretName == "" {
+		return nil
+	}
+	err := a.Client.CoreV1().Secrets(metav1.NamespaceSystem).Delete(ctx, tokenSecretName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting bound bootstrap token secret %s: %v", tokenSecretName, err)
+	}
+	klog.V(2).Infof("Deleted bound bootstrap token secret %s after successful registration", tokenSecretName)
+	return nil
+}
+
 func (a *AgentCSRApprovingController) authorize(ctx context.Context, csr *certificatesv1.CertificateSigningRequest, rattrs authorizationv1.ResourceAttributes) (bool, error) {
 	extra := make(map[string]authorizationv1.ExtraValue)
 	for k, v := range csr.Spec.Extra {
@@ -178,6 +246,15 @@ func agentCSRRecognizers() []csrRecognizer {
 	return recognizers
 }
 
+func appendDenialCondition(csr *certificatesv1.CertificateSigningRequest, message string) {
+	csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		Type:    certificatesv1.CertificateDenied,
+		Status:  corev1.ConditionTrue,
+		Reason:  "BoundTokenConstraintViolated",
+		Message: message,
+	})
+}
+
 func appendApprovalCondition(csr *certificatesv1.CertificateSigningRequest, message string) {
 	csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
 		Type:    certificatesv1.CertificateApproved,
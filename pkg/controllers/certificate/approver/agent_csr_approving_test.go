@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approver
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+)
+
+func newBoundTokenSecret(tokenID, boundClusterName string) *corev1.Secret {
+	data := map[string][]byte{
+		"token-id": []byte(tokenID),
+	}
+	if boundClusterName != "" {
+		data[boundClusterNameKey] = []byte(boundClusterName)
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bootstrap-token-" + tokenID,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Type: "bootstrap.kubernetes.io/token",
+		Data: data,
+	}
+}
+
+func TestCheckBoundToken(t *testing.T) {
+	tests := []struct {
+		name            string
+		username        string
+		commonName      string
+		secret          *corev1.Secret
+		wantSecretName  string
+		wantDenyMessage bool
+	}{
+		{
+			name:       "not a bootstrap user",
+			username:   "system:karmada:agent:member1",
+			commonName: "system:karmada:agent:member1",
+		},
+		{
+			name:       "token secret no longer exists",
+			username:   "system:bootstrap:abcdef",
+			commonName: "system:karmada:agent:member1",
+		},
+		{
+			name:       "unbound token",
+			username:   "system:bootstrap:abcdef",
+			commonName: "system:karmada:agent:member1",
+			secret:     newBoundTokenSecret("abcdef", ""),
+		},
+		{
+			name:           "bound token with matching cluster name",
+			username:       "system:bootstrap:abcdef",
+			commonName:     "system:karmada:agent:member1",
+			secret:         newBoundTokenSecret("abcdef", "member1"),
+			wantSecretName: "bootstrap-token-abcdef",
+		},
+		{
+			name:            "bound token with mismatched cluster name",
+			username:        "system:bootstrap:abcdef",
+			commonName:      "system:karmada:agent:member2",
+			secret:          newBoundTokenSecret("abcdef", "member1"),
+			wantDenyMessage: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fakeclientset.NewSimpleClientset()
+			if tt.secret != nil {
+				client = fakeclientset.NewSimpleClientset(tt.secret)
+			}
+			controller := &AgentCSRApprovingController{Client: client}
+			csr := &certificatesv1.CertificateSigningRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: "csr"},
+				Spec:       certificatesv1.CertificateSigningRequestSpec{Username: tt.username},
+			}
+			x509cr := &x509.CertificateRequest{Subject: pkix.Name{CommonName: tt.commonName}}
+
+			secretName, denyMessage, err := controller.checkBoundToken(context.TODO(), csr, x509cr)
+			if err != nil {
+				t.Fatalf("checkBoundToken() unexpected error: %v", err)
+			}
+			if secretName != tt.wantSecretName {
+				t.Errorf("checkBoundToken() secretName = %q, want %q", secretName, tt.wantSecretName)
+			}
+			if (denyMessage != "") != tt.wantDenyMessage {
+				t.Errorf("checkBoundToken() denyMessage = %q, wantDenyMessage %v", denyMessage, tt.wantDenyMessage)
+			}
+		})
+	}
+}
+
+func TestExpireBoundToken(t *testing.T) {
+	secret := newBoundTokenSecret("abcdef", "member1")
+	client := fakeclientset.NewSimpleClientset(secret)
+	controller := &AgentCSRApprovingController{Client: client}
+
+	if err := controller.expireBoundToken(context.TODO(), secret.Name); err != nil {
+		t.Fatalf("expireBoundToken() unexpected error: %v", err)
+	}
+	_, err := client.CoreV1().Secrets(metav1.NamespaceSystem).Get(context.TODO(), secret.Name, metav1.GetOptions{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected the token secret to be deleted, got err: %v", err)
+	}
+
+	// expiring an already deleted token or an empty name must not fail
+	if err := controller.expireBoundToken(context.TODO(), secret.Name); err != nil {
+		t.Errorf("expireBoundToken() on deleted secret returned error: %v", err)
+	}
+	if err := controller.expireBoundToken(context.TODO(), ""); err != nil {
+		t.Errorf("expireBoundToken() with empty name returned error: %v", err)
+	}
+}
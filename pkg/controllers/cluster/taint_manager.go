@@ -54,6 +54,15 @@ type NoExecuteTaintManager struct {
 	ClusterTaintEvictionRetryFrequency time.Duration
 	ConcurrentReconciles               int
 
+	// DefaultNotReadyTolerationSeconds is the tolerationSeconds applied to
+	// bindings whose placement does not carry its own toleration for the
+	// notReady:NoExecute taint.
+	DefaultNotReadyTolerationSeconds int64
+	// DefaultUnreachableTolerationSeconds is the tolerationSeconds applied to
+	// bindings whose placement does not carry its own toleration for the
+	// unreachable:NoExecute taint.
+	DefaultUnreachableTolerationSeconds int64
+
 	bindingEvictionWorker        util.AsyncWorker
 	clusterBindingEvictionWorker util.AsyncWorker
 	RateLimiterOptions           ratelimiterflag.Options
@@ -288,6 +297,16 @@ func (tc *NoExecuteTaintManager) needEviction(clusterName string, annotations ma
 	if len(taints) == 0 {
 		return false, -1, nil
 	}
+	// Fill in the default toleration windows for bindings whose placement
+	// does not declare its own, so every application gets a configurable
+	// grace period instead of immediate eviction. A non-positive default
+	// disables the corresponding toleration.
+	if tc.DefaultNotReadyTolerationSeconds > 0 {
+		helper.AddTolerations(placement, helper.NewNotReadyToleration(tc.DefaultNotReadyTolerationSeconds))
+	}
+	if tc.DefaultUnreachableTolerationSeconds > 0 {
+		helper.AddTolerations(placement, helper.NewUnreachableToleration(tc.DefaultUnreachableTolerationSeconds))
+	}
 	tolerations := placement.ClusterTolerations
 
 	allTolerated, usedTolerations := helper.GetMatchingTolerations(taints, tolerations)
@@ -0,0 +1,188 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterinventory
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/apis/cluster/validation"
+)
+
+// InventoryConfigMapKey is the ConfigMap data key the inventory document is read from.
+const InventoryConfigMapKey = "clusters.yaml"
+
+// ClusterEntry declares a single member cluster in the inventory document.
+type ClusterEntry struct {
+	// Name is the name of the Cluster object to reconcile.
+	Name string `json:"name"`
+
+	// SyncMode describes how the cluster synchronizes with the control plane.
+	// Defaults to Push.
+	// +optional
+	SyncMode clusterv1alpha1.ClusterSyncMode `json:"syncMode,omitempty"`
+
+	// APIEndpoint is the API endpoint of the member cluster.
+	// +optional
+	APIEndpoint string `json:"apiEndpoint,omitempty"`
+
+	// SecretRef references the secret that contains the credentials to access
+	// the member cluster. The secret is expected to be managed alongside the
+	// inventory, e.g. by the same Git repository.
+	// +optional
+	SecretRef *clusterv1alpha1.LocalSecretReference `json:"secretRef,omitempty"`
+
+	// ImpersonatorSecretRef references the secret that contains the token of
+	// the impersonator.
+	// +optional
+	ImpersonatorSecretRef *clusterv1alpha1.LocalSecretReference `json:"impersonatorSecretRef,omitempty"`
+
+	// Provider is the cloud provider name of the member cluster.
+	// +optional
+	Provider string `json:"provider,omitempty"`
+
+	// Region is the region of the member cluster.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Zones are the zones of the member cluster.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// Labels are the labels to be written to the Cluster object.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Taints are the taints to be written to the Cluster object.
+	// +optional
+	Taints []corev1.Taint `json:"taints,omitempty"`
+
+	// ProxyURL is the proxy URL for the member cluster.
+	// +optional
+	ProxyURL string `json:"proxyURL,omitempty"`
+
+	// InsecureSkipTLSVerification indicates that the karmada control plane
+	// should not confirm the validity of the serving certificate of the cluster.
+	// +optional
+	InsecureSkipTLSVerification bool `json:"insecureSkipTLSVerification,omitempty"`
+}
+
+// Inventory is the declarative description of a fleet of member clusters.
+type Inventory struct {
+	// Clusters enumerates the member clusters of the fleet.
+	Clusters []ClusterEntry `json:"clusters"`
+}
+
+// Backend loads the cluster inventory from a concrete source.
+type Backend interface {
+	// Load returns the current inventory document.
+	Load(ctx context.Context) (*Inventory, error)
+}
+
+// FileBackend loads the inventory from a YAML file on disk, typically a file
+// checked out from a Git repository and mounted into the controller pod.
+type FileBackend struct {
+	// Path is the path of the inventory file.
+	Path string
+}
+
+// Load implements Backend.
+func (b *FileBackend) Load(_ context.Context) (*Inventory, error) {
+	data, err := os.ReadFile(b.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read inventory file %s: %v", b.Path, err)
+	}
+	return parseInventory(data)
+}
+
+// ConfigMapBackend loads the inventory from a ConfigMap in the control plane.
+type ConfigMapBackend struct {
+	// Reader is used to fetch the ConfigMap.
+	Reader client.Reader
+
+	// Namespace is the namespace of the inventory ConfigMap.
+	Namespace string
+
+	// Name is the name of the inventory ConfigMap.
+	Name string
+}
+
+// Load implements Backend.
+func (b *ConfigMapBackend) Load(ctx context.Context) (*Inventory, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := b.Reader.Get(ctx, types.NamespacedName{Namespace: b.Namespace, Name: b.Name}, configMap); err != nil {
+		return nil, err
+	}
+	data, exist := configMap.Data[InventoryConfigMapKey]
+	if !exist {
+		return nil, fmt.Errorf("configmap %s/%s does not contain key %q", b.Namespace, b.Name, InventoryConfigMapKey)
+	}
+	return parseInventory([]byte(data))
+}
+
+// NewBackendFromSource builds the inventory backend described by source, which
+// takes the form 'file:<path>' or 'configmap:<namespace>/<name>'.
+func NewBackendFromSource(source string, reader client.Reader) (Backend, error) {
+	backendType, location, found := strings.Cut(source, ":")
+	if !found {
+		return nil, fmt.Errorf("invalid inventory source %q, expect 'file:<path>' or 'configmap:<namespace>/<name>'", source)
+	}
+	switch backendType {
+	case "file":
+		return &FileBackend{Path: location}, nil
+	case "configmap":
+		namespace, name, found := strings.Cut(location, "/")
+		if !found || namespace == "" || name == "" {
+			return nil, fmt.Errorf("invalid inventory configmap reference %q, expect '<namespace>/<name>'", location)
+		}
+		return &ConfigMapBackend{Reader: reader, Namespace: namespace, Name: name}, nil
+	default:
+		return nil, fmt.Errorf("unsupported inventory backend %q, expect 'file' or 'configmap'", backendType)
+	}
+}
+
+// parseInventory decodes and validates an inventory document.
+func parseInventory(data []byte) (*Inventory, error) {
+	inventory := &Inventory{}
+	if err := yaml.UnmarshalStrict(data, inventory); err != nil {
+		return nil, fmt.Errorf("failed to parse inventory: %v", err)
+	}
+
+	declared := make(map[string]struct{}, len(inventory.Clusters))
+	for i := range inventory.Clusters {
+		entry := &inventory.Clusters[i]
+		if errMsgs := validation.ValidateClusterName(entry.Name); len(errMsgs) != 0 {
+			return nil, fmt.Errorf("invalid cluster name(%s): %s", entry.Name, strings.Join(errMsgs, ";"))
+		}
+		if _, exist := declared[entry.Name]; exist {
+			return nil, fmt.Errorf("cluster %s is declared more than once", entry.Name)
+		}
+		declared[entry.Name] = struct{}{}
+		if entry.SyncMode == "" {
+			entry.SyncMode = clusterv1alpha1.Push
+		}
+	}
+	return inventory, nil
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterinventory
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/sharedcli/ratelimiterflag"
+)
+
+const (
+	// ControllerName is the controller name that will be used when reporting events and metrics.
+	ControllerName = "cluster-inventory-controller"
+
+	// ManagedByInventoryLabel marks Cluster objects whose lifecycle is owned by
+	// the inventory. Only clusters carrying this label are removed when they
+	// disappear from the inventory document.
+	ManagedByInventoryLabel = "cluster.karmada.io/managed-by-inventory"
+
+	// defaultResyncInterval is how often the inventory source is re-read even
+	// without a triggering event, so changes to file based inventories are
+	// picked up.
+	defaultResyncInterval = 1 * time.Minute
+)
+
+// ClusterInventoryController reconciles Cluster objects against a declarative
+// inventory document, creating clusters that are declared, keeping their spec
+// in line with the document and removing managed clusters that are no longer
+// declared.
+type ClusterInventoryController struct {
+	client.Client
+	Backend            Backend
+	ResyncInterval     time.Duration
+	RateLimiterOptions ratelimiterflag.Options
+}
+
+// Reconcile performs a full synchronization between the inventory document and
+// the Cluster objects in the control plane. The request is ignored, every event
+// triggers a full sync.
+func (c *ClusterInventoryController) Reconcile(ctx context.Context, _ controllerruntime.Request) (controllerruntime.Result, error) {
+	inventory, err := c.Backend.Load(ctx)
+	if err != nil {
+		klog.Errorf("Failed to load cluster inventory: %v", err)
+		return controllerruntime.Result{}, err
+	}
+
+	declared := sets.New[string]()
+	for i := range inventory.Clusters {
+		entry := &inventory.Clusters[i]
+		declared.Insert(entry.Name)
+		if err = c.syncCluster(ctx, entry); err != nil {
+			klog.Errorf("Failed to sync cluster(%s) from inventory: %v", entry.Name, err)
+			return controllerruntime.Result{}, err
+		}
+	}
+
+	if err = c.removeUndeclaredClusters(ctx, declared); err != nil {
+		return controllerruntime.Result{}, err
+	}
+
+	resyncInterval := c.ResyncInterval
+	if resyncInterval <= 0 {
+		resyncInterval = defaultResyncInterval
+	}
+	return controllerruntime.Result{RequeueAfter: resyncInterval}, nil
+}
+
+// syncCluster creates or updates the Cluster object declared by entry.
+func (c *ClusterInventoryController) syncCluster(ctx context.Context, entry *ClusterEntry) error {
+	cluster := &clusterv1alpha1.Cluster{}
+	cluster.Name = entry.Name
+	operationResult, err := controllerutil.CreateOrUpdate(ctx, c.Client, cluster, func() error {
+		if cluster.Labels == nil {
+			cluster.Labels = map[string]string{}
+		}
+		cluster.Labels[ManagedByInventoryLabel] = "true"
+		for key, value := range entry.Labels {
+			cluster.Labels[key] = value
+		}
+		cluster.Spec.SyncMode = entry.SyncMode
+		cluster.Spec.APIEndpoint = entry.APIEndpoint
+		cluster.Spec.SecretRef = entry.SecretRef
+		cluster.Spec.ImpersonatorSecretRef = entry.ImpersonatorSecretRef
+		cluster.Spec.Provider = entry.Provider
+		cluster.Spec.Region = entry.Region
+		cluster.Spec.Zones = entry.Zones
+		cluster.Spec.Taints = entry.Taints
+		cluster.Spec.ProxyURL = entry.ProxyURL
+		cluster.Spec.InsecureSkipTLSVerification = entry.InsecureSkipTLSVerification
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if operationResult != controllerutil.OperationResultNone {
+		klog.V(2).Infof("Cluster(%s) %s according to the inventory.", entry.Name, operationResult)
+	}
+	return nil
+}
+
+// removeUndeclaredClusters deletes managed clusters that disappeared from the
+// inventory document. Clusters joined imperatively are left alone as they do
+// not carry the managed label.
+func (c *ClusterInventoryController) removeUndeclaredClusters(ctx context.Context, declared sets.Set[string]) error {
+	clusterList := &clusterv1alpha1.ClusterList{}
+	if err := c.Client.List(ctx, clusterList, client.MatchingLabels{ManagedByInventoryLabel: "true"}); err != nil {
+		klog.Errorf("Failed to list inventory managed clusters: %v", err)
+		return err
+	}
+
+	for i := range clusterList.Items {
+		cluster := &clusterList.Items[i]
+		if declared.Has(cluster.Name) || !cluster.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := c.Client.Delete(ctx, cluster); err != nil && !apierrors.IsNotFound(err) {
+			klog.Errorf("Failed to remove cluster(%s) which is no longer declared in the inventory: %v", cluster.Name, err)
+			return err
+		}
+		klog.V(2).Infof("Removed cluster(%s) which is no longer declared in the inventory.", cluster.Name)
+	}
+	return nil
+}
+
+// SetupWithManager creates a controller and register to controller manager.
+func (c *ClusterInventoryController) SetupWithManager(mgr controllerruntime.Manager) error {
+	controllerBuilder := controllerruntime.NewControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&clusterv1alpha1.Cluster{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(object client.Object) bool {
+			return object.GetLabels()[ManagedByInventoryLabel] == "true"
+		}))).
+		WithOptions(controller.Options{RateLimiter: ratelimiterflag.DefaultControllerRateLimiter[controllerruntime.Request](c.RateLimiterOptions)})
+
+	if configMapBackend, ok := c.Backend.(*ConfigMapBackend); ok {
+		controllerBuilder = controllerBuilder.Watches(&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []controllerruntime.Request {
+				return []controllerruntime.Request{{}}
+			}),
+			builder.WithPredicates(predicate.NewPredicateFuncs(func(object client.Object) bool {
+				return object.GetNamespace() == configMapBackend.Namespace && object.GetName() == configMapBackend.Name
+			})))
+	}
+	return controllerBuilder.Complete(c)
+}
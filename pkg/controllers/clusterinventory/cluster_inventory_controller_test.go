@@ -0,0 +1,179 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterinventory
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+)
+
+func TestParseInventory(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "valid inventory with defaulted sync mode",
+			data: `
+clusters:
+  - name: member1
+    apiEndpoint: https://member1.example.com
+  - name: member2
+    syncMode: Pull
+`,
+			want: 2,
+		},
+		{
+			name: "invalid cluster name",
+			data: `
+clusters:
+  - name: Member_1
+`,
+			wantErr: true,
+		},
+		{
+			name: "duplicated cluster name",
+			data: `
+clusters:
+  - name: member1
+  - name: member1
+`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			data:    "members: []",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inventory, err := parseInventory([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseInventory() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(inventory.Clusters) != tt.want {
+				t.Fatalf("parseInventory() got %d clusters, want %d", len(inventory.Clusters), tt.want)
+			}
+			if inventory.Clusters[0].SyncMode != clusterv1alpha1.Push {
+				t.Errorf("parseInventory() sync mode = %v, want defaulted to %v", inventory.Clusters[0].SyncMode, clusterv1alpha1.Push)
+			}
+		})
+	}
+}
+
+func TestNewBackendFromSource(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		wantErr bool
+	}{
+		{name: "file backend", source: "file:/etc/karmada/clusters.yaml"},
+		{name: "configmap backend", source: "configmap:karmada-system/cluster-inventory"},
+		{name: "configmap without namespace", source: "configmap:cluster-inventory", wantErr: true},
+		{name: "unsupported backend", source: "s3:bucket/key", wantErr: true},
+		{name: "missing backend type", source: "/etc/karmada/clusters.yaml", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBackendFromSource(tt.source, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewBackendFromSource() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReconcileSyncsInventory(t *testing.T) {
+	existingManaged := &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "removed",
+			Labels: map[string]string{ManagedByInventoryLabel: "true"},
+		},
+	}
+	existingJoined := &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "joined"},
+	}
+	inventoryConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "karmada-system", Name: "cluster-inventory"},
+		Data: map[string]string{
+			InventoryConfigMapKey: `
+clusters:
+  - name: member1
+    apiEndpoint: https://member1.example.com
+    region: us-west
+    labels:
+      env: prod
+    secretRef:
+      namespace: karmada-cluster
+      name: member1
+`,
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).
+		WithObjects(existingManaged, existingJoined, inventoryConfigMap).Build()
+	c := &ClusterInventoryController{
+		Client:  fakeClient,
+		Backend: &ConfigMapBackend{Reader: fakeClient, Namespace: "karmada-system", Name: "cluster-inventory"},
+	}
+
+	result, err := c.Reconcile(context.TODO(), controllerruntime.Request{})
+	if err != nil {
+		t.Fatalf("Reconcile() unexpected error: %v", err)
+	}
+	if result.RequeueAfter == 0 {
+		t.Errorf("Reconcile() expected a resync requeue")
+	}
+
+	declaredCluster := &clusterv1alpha1.Cluster{}
+	if err = fakeClient.Get(context.TODO(), types.NamespacedName{Name: "member1"}, declaredCluster); err != nil {
+		t.Fatalf("declared cluster was not created: %v", err)
+	}
+	if declaredCluster.Labels[ManagedByInventoryLabel] != "true" || declaredCluster.Labels["env"] != "prod" {
+		t.Errorf("declared cluster labels = %v, want managed label and inventory labels", declaredCluster.Labels)
+	}
+	if declaredCluster.Spec.Region != "us-west" || declaredCluster.Spec.SecretRef == nil {
+		t.Errorf("declared cluster spec not synced from the inventory: %+v", declaredCluster.Spec)
+	}
+	if declaredCluster.Spec.SyncMode != clusterv1alpha1.Push {
+		t.Errorf("declared cluster sync mode = %v, want %v", declaredCluster.Spec.SyncMode, clusterv1alpha1.Push)
+	}
+
+	err = fakeClient.Get(context.TODO(), types.NamespacedName{Name: "removed"}, &clusterv1alpha1.Cluster{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("undeclared managed cluster should have been removed, got err: %v", err)
+	}
+	if err = fakeClient.Get(context.TODO(), types.NamespacedName{Name: "joined"}, &clusterv1alpha1.Cluster{}); err != nil {
+		t.Errorf("imperatively joined cluster must be left alone, got err: %v", err)
+	}
+}
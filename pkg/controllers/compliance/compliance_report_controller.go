@@ -0,0 +1,256 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compliance
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/sharedcli/ratelimiterflag"
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+// ControllerName is the controller name that will be used when reporting events and metrics.
+const ControllerName = "compliance-report-controller"
+
+// resyncInterval is how often the policy reports of a member cluster are
+// collected again. Policy engines update their reports continuously, so the
+// federated summary is refreshed on a fixed cadence rather than per event.
+const resyncInterval = 10 * time.Minute
+
+// policyReportResource and clusterPolicyReportResource identify the report
+// objects that Kyverno, Gatekeeper (via its export mechanisms) and other
+// engines maintain following the Kubernetes policy WG conventions.
+var (
+	policyReportResource        = schema.GroupVersionResource{Group: "wgpolicyk8s.io", Version: "v1alpha2", Resource: "policyreports"}
+	clusterPolicyReportResource = schema.GroupVersionResource{Group: "wgpolicyk8s.io", Version: "v1alpha2", Resource: "clusterpolicyreports"}
+)
+
+// ComplianceReportController maintains one ClusterComplianceReport per member
+// cluster, aggregating the policy-engine results found in that cluster so
+// that compliance can be queried fleet-wide from the control plane.
+type ComplianceReportController struct {
+	client.Client
+	ClusterDynamicClientSetFunc util.NewClusterDynamicClientSetFunc
+	ClusterClientOption         *util.ClientOption
+	RateLimiterOptions          ratelimiterflag.Options
+}
+
+// Reconcile rebuilds the ClusterComplianceReport of the cluster referred to by the Request.
+func (c *ComplianceReportController) Reconcile(ctx context.Context, req controllerruntime.Request) (controllerruntime.Result, error) {
+	klog.V(4).Infof("Reconciling ClusterComplianceReport for cluster %s.", req.Name)
+
+	cluster := &clusterv1alpha1.Cluster{}
+	if err := c.Client.Get(ctx, req.NamespacedName, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return controllerruntime.Result{}, c.deleteReport(ctx, req.Name)
+		}
+		return controllerruntime.Result{}, err
+	}
+	if !cluster.DeletionTimestamp.IsZero() {
+		return controllerruntime.Result{}, c.deleteReport(ctx, cluster.Name)
+	}
+	if !util.IsClusterReady(&cluster.Status) {
+		klog.V(4).Infof("Skip collecting policy reports from notReady cluster %s.", cluster.Name)
+		return controllerruntime.Result{RequeueAfter: resyncInterval}, nil
+	}
+
+	clusterClient, err := c.ClusterDynamicClientSetFunc(cluster.Name, c.Client, c.ClusterClientOption)
+	if err != nil {
+		klog.Errorf("Failed to build dynamic client for cluster %s: %v", cluster.Name, err)
+		return controllerruntime.Result{}, err
+	}
+
+	report, served, err := collectComplianceReport(ctx, clusterClient.DynamicClientSet)
+	if err != nil {
+		klog.Errorf("Failed to collect policy reports from cluster %s: %v", cluster.Name, err)
+		return controllerruntime.Result{}, err
+	}
+	if !served {
+		// The cluster runs no policy engine following the policy WG report
+		// conventions, a report would only state that nothing was evaluated.
+		return controllerruntime.Result{RequeueAfter: resyncInterval}, c.deleteReport(ctx, cluster.Name)
+	}
+
+	if err := c.applyReport(ctx, cluster.Name, report); err != nil {
+		return controllerruntime.Result{}, err
+	}
+	return controllerruntime.Result{RequeueAfter: resyncInterval}, nil
+}
+
+// applyReport creates or updates the ClusterComplianceReport of the cluster,
+// leaving it untouched when the collected content did not change.
+func (c *ComplianceReportController) applyReport(ctx context.Context, clusterName string, collected *policyv1alpha1.ClusterComplianceReport) error {
+	report := &policyv1alpha1.ClusterComplianceReport{}
+	err := c.Client.Get(ctx, client.ObjectKey{Name: clusterName}, report)
+	if apierrors.IsNotFound(err) {
+		collected.Name = clusterName
+		collected.LastUpdateTime = metav1.Now()
+		return c.Client.Create(ctx, collected)
+	}
+	if err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(report.Summary, collected.Summary) &&
+		reflect.DeepEqual(report.ClusterScoped, collected.ClusterScoped) &&
+		reflect.DeepEqual(report.Namespaces, collected.Namespaces) {
+		return nil
+	}
+	report.Summary = collected.Summary
+	report.ClusterScoped = collected.ClusterScoped
+	report.Namespaces = collected.Namespaces
+	report.LastUpdateTime = metav1.Now()
+	return c.Client.Update(ctx, report)
+}
+
+// deleteReport removes the ClusterComplianceReport of the cluster, if any.
+func (c *ComplianceReportController) deleteReport(ctx context.Context, clusterName string) error {
+	report := &policyv1alpha1.ClusterComplianceReport{ObjectMeta: metav1.ObjectMeta{Name: clusterName}}
+	if err := c.Client.Delete(ctx, report); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// collectComplianceReport lists the policy report objects of the member
+// cluster and rolls them up per namespace and cluster-wide. The served return
+// reports whether the cluster serves at least one of the report resources.
+func collectComplianceReport(ctx context.Context, clusterClient dynamic.Interface) (*policyv1alpha1.ClusterComplianceReport, bool, error) {
+	report := &policyv1alpha1.ClusterComplianceReport{}
+	served := false
+
+	policyReports, err := clusterClient.Resource(policyReportResource).List(ctx, metav1.ListOptions{})
+	if err != nil && !resourceNotServed(err) {
+		return nil, false, err
+	}
+	if err == nil {
+		served = true
+		perNamespace := make(map[string]policyv1alpha1.ComplianceSummary)
+		for i := range policyReports.Items {
+			summary := reportSummary(&policyReports.Items[i])
+			namespace := policyReports.Items[i].GetNamespace()
+			perNamespace[namespace] = addSummaries(perNamespace[namespace], summary)
+			report.Summary = addSummaries(report.Summary, summary)
+		}
+		report.Namespaces = sortedNamespaceSummaries(perNamespace)
+	}
+
+	clusterPolicyReports, err := clusterClient.Resource(clusterPolicyReportResource).List(ctx, metav1.ListOptions{})
+	if err != nil && !resourceNotServed(err) {
+		return nil, false, err
+	}
+	if err == nil {
+		served = true
+		for i := range clusterPolicyReports.Items {
+			summary := reportSummary(&clusterPolicyReports.Items[i])
+			report.ClusterScoped = addSummaries(report.ClusterScoped, summary)
+			report.Summary = addSummaries(report.Summary, summary)
+		}
+	}
+
+	return report, served, nil
+}
+
+// resourceNotServed tells whether the list failed because the member cluster
+// does not serve the resource at all, as opposed to a transient error.
+func resourceNotServed(err error) bool {
+	return meta.IsNoMatchError(err) || apierrors.IsNotFound(err)
+}
+
+// reportSummary reads the summary stanza of a policy report object.
+func reportSummary(report *unstructured.Unstructured) policyv1alpha1.ComplianceSummary {
+	summary := policyv1alpha1.ComplianceSummary{}
+	for _, field := range []struct {
+		name  string
+		value *int32
+	}{
+		{"pass", &summary.Pass},
+		{"fail", &summary.Fail},
+		{"warn", &summary.Warn},
+		{"error", &summary.Error},
+		{"skip", &summary.Skip},
+	} {
+		if count, found, err := unstructured.NestedInt64(report.Object, "summary", field.name); err == nil && found {
+			*field.value = int32(count) // #nosec G115 -- report summaries are far below the int32 range
+		}
+	}
+	return summary
+}
+
+func addSummaries(a, b policyv1alpha1.ComplianceSummary) policyv1alpha1.ComplianceSummary {
+	return policyv1alpha1.ComplianceSummary{
+		Pass:  a.Pass + b.Pass,
+		Fail:  a.Fail + b.Fail,
+		Warn:  a.Warn + b.Warn,
+		Error: a.Error + b.Error,
+		Skip:  a.Skip + b.Skip,
+	}
+}
+
+func sortedNamespaceSummaries(perNamespace map[string]policyv1alpha1.ComplianceSummary) []policyv1alpha1.NamespaceComplianceSummary {
+	if len(perNamespace) == 0 {
+		return nil
+	}
+	namespaces := make([]policyv1alpha1.NamespaceComplianceSummary, 0, len(perNamespace))
+	for namespace, summary := range perNamespace {
+		namespaces = append(namespaces, policyv1alpha1.NamespaceComplianceSummary{Namespace: namespace, Summary: summary})
+	}
+	sort.Slice(namespaces, func(i, j int) bool { return namespaces[i].Namespace < namespaces[j].Namespace })
+	return namespaces
+}
+
+// SetupWithManager creates a controller and register to controller manager.
+func (c *ComplianceReportController) SetupWithManager(mgr controllerruntime.Manager) error {
+	// The report content is refreshed periodically via RequeueAfter, cluster
+	// events only need to start and stop the per-cluster collection loop.
+	clusterPredicateFn := predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return true },
+		UpdateFunc: func(updateEvent event.UpdateEvent) bool {
+			oldObj := updateEvent.ObjectOld.(*clusterv1alpha1.Cluster)
+			newObj := updateEvent.ObjectNew.(*clusterv1alpha1.Cluster)
+			return util.IsClusterReady(&oldObj.Status) != util.IsClusterReady(&newObj.Status) ||
+				oldObj.DeletionTimestamp.IsZero() != newObj.DeletionTimestamp.IsZero()
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return true },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&clusterv1alpha1.Cluster{}, builder.WithPredicates(clusterPredicateFn)).
+		WithOptions(controller.Options{RateLimiter: ratelimiterflag.DefaultControllerRateLimiter[controllerruntime.Request](c.RateLimiterOptions)}).
+		Complete(c)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compliance
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	coretesting "k8s.io/client-go/testing"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+)
+
+func newPolicyReport(namespace, name string, pass, fail, warn int64) *unstructured.Unstructured {
+	report := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "wgpolicyk8s.io/v1alpha2",
+			"kind":       "PolicyReport",
+			"metadata":   map[string]interface{}{"namespace": namespace, "name": name},
+			"summary":    map[string]interface{}{"pass": pass, "fail": fail, "warn": warn},
+		},
+	}
+	if namespace == "" {
+		report.Object["kind"] = "ClusterPolicyReport"
+		unstructured.RemoveNestedField(report.Object, "metadata", "namespace")
+	}
+	return report
+}
+
+func newFakeMemberClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	listKinds := map[schema.GroupVersionResource]string{
+		policyReportResource:        "PolicyReportList",
+		clusterPolicyReportResource: "ClusterPolicyReportList",
+	}
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objects...)
+}
+
+func TestCollectComplianceReport(t *testing.T) {
+	memberClient := newFakeMemberClient(
+		newPolicyReport("default", "polr-a", 4, 1, 0),
+		newPolicyReport("default", "polr-b", 2, 0, 1),
+		newPolicyReport("kube-system", "polr-c", 3, 2, 0),
+		newPolicyReport("", "cpolr-a", 5, 1, 0),
+	)
+
+	report, served, err := collectComplianceReport(context.TODO(), memberClient)
+	if err != nil {
+		t.Fatalf("collectComplianceReport() unexpected error: %v", err)
+	}
+	if !served {
+		t.Fatal("collectComplianceReport() served = false, want true")
+	}
+	if want := (policyv1alpha1.ComplianceSummary{Pass: 14, Fail: 4, Warn: 1}); report.Summary != want {
+		t.Errorf("unexpected summary: %+v, want %+v", report.Summary, want)
+	}
+	if want := (policyv1alpha1.ComplianceSummary{Pass: 5, Fail: 1}); report.ClusterScoped != want {
+		t.Errorf("unexpected cluster-scoped summary: %+v, want %+v", report.ClusterScoped, want)
+	}
+	wantNamespaces := []policyv1alpha1.NamespaceComplianceSummary{
+		{Namespace: "default", Summary: policyv1alpha1.ComplianceSummary{Pass: 6, Fail: 1, Warn: 1}},
+		{Namespace: "kube-system", Summary: policyv1alpha1.ComplianceSummary{Pass: 3, Fail: 2}},
+	}
+	if !reflect.DeepEqual(report.Namespaces, wantNamespaces) {
+		t.Errorf("unexpected namespace rollups: %+v, want %+v", report.Namespaces, wantNamespaces)
+	}
+}
+
+func TestCollectComplianceReportNotServed(t *testing.T) {
+	memberClient := newFakeMemberClient()
+	notFound := func(coretesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(schema.GroupResource{Group: "wgpolicyk8s.io", Resource: "policyreports"}, "")
+	}
+	memberClient.PrependReactor("list", "policyreports", notFound)
+	memberClient.PrependReactor("list", "clusterpolicyreports", notFound)
+
+	_, served, err := collectComplianceReport(context.TODO(), memberClient)
+	if err != nil {
+		t.Fatalf("collectComplianceReport() unexpected error: %v", err)
+	}
+	if served {
+		t.Error("collectComplianceReport() served = true for a cluster without policy report resources")
+	}
+}
+
+func newReadyCluster(name string) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: clusterv1alpha1.ClusterStatus{
+			Conditions: []metav1.Condition{
+				{Type: clusterv1alpha1.ClusterConditionReady, Status: metav1.ConditionTrue},
+			},
+		},
+	}
+}
+
+func TestReconcileMaintainsReport(t *testing.T) {
+	cluster := newReadyCluster("member1")
+	memberClient := newFakeMemberClient(newPolicyReport("default", "polr-a", 7, 2, 0))
+	c := &ComplianceReportController{
+		Client: fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithObjects(cluster).Build(),
+		ClusterDynamicClientSetFunc: func(clusterName string, _ client.Client, _ *util.ClientOption) (*util.DynamicClusterClient, error) {
+			return &util.DynamicClusterClient{ClusterName: clusterName, DynamicClientSet: memberClient}, nil
+		},
+	}
+	req := controllerruntime.Request{NamespacedName: client.ObjectKey{Name: "member1"}}
+
+	if _, err := c.Reconcile(context.TODO(), req); err != nil {
+		t.Fatalf("Reconcile() unexpected error: %v", err)
+	}
+	report := &policyv1alpha1.ClusterComplianceReport{}
+	if err := c.Client.Get(context.TODO(), client.ObjectKey{Name: "member1"}, report); err != nil {
+		t.Fatalf("failed to get report: %v", err)
+	}
+	if want := (policyv1alpha1.ComplianceSummary{Pass: 7, Fail: 2}); report.Summary != want {
+		t.Errorf("unexpected summary: %+v, want %+v", report.Summary, want)
+	}
+
+	// removing the cluster removes its report
+	if err := c.Client.Delete(context.TODO(), cluster); err != nil {
+		t.Fatalf("failed to delete cluster: %v", err)
+	}
+	if _, err := c.Reconcile(context.TODO(), req); err != nil {
+		t.Fatalf("Reconcile() unexpected error after cluster deletion: %v", err)
+	}
+	err := c.Client.Get(context.TODO(), client.ObjectKey{Name: "member1"}, &policyv1alpha1.ClusterComplianceReport{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected the report to be deleted with the cluster, got err: %v", err)
+	}
+}
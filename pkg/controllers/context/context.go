@@ -80,6 +80,14 @@ type Options struct {
 	// If set to true enables NoExecute Taints and will evict all not-tolerating
 	// objects propagating on Clusters tainted with this kind of Taints.
 	EnableTaintManager bool
+	// DefaultNotReadyTolerationSeconds is the default tolerationSeconds the taint manager
+	// applies against the notReady:NoExecute taint for bindings whose placement does not
+	// declare its own toleration.
+	DefaultNotReadyTolerationSeconds int64
+	// DefaultUnreachableTolerationSeconds is the default tolerationSeconds the taint manager
+	// applies against the unreachable:NoExecute taint for bindings whose placement does not
+	// declare its own toleration.
+	DefaultUnreachableTolerationSeconds int64
 	// GracefulEvictionTimeout is the timeout period waiting for the grace-eviction-controller performs the final
 	// removal since the workload(resource) has been moved to the graceful eviction tasks.
 	GracefulEvictionTimeout metav1.Duration
@@ -97,6 +105,9 @@ type Options struct {
 	KarmadaKubeconfigNamespace string
 	// HPAControllerConfiguration is the config of federatedHPA-controller.
 	HPAControllerConfiguration config.HPAControllerConfiguration
+	// ClusterInventorySource is the source of the declarative cluster inventory,
+	// in the form 'file:<path>' or 'configmap:<namespace>/<name>'.
+	ClusterInventorySource string
 }
 
 // Context defines the context object for controller.
@@ -0,0 +1,275 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package crdlifecycle makes sure the CRDs a Work's custom resources rely on
+// are propagated to the target cluster and Established there before the
+// custom resources themselves are dispatched.
+package crdlifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/controllers/ctrlutil"
+	"github.com/karmada-io/karmada/pkg/sharedcli/ratelimiterflag"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+const (
+	// ControllerName is the controller name that will be used when reporting events and metrics.
+	ControllerName = "crd-lifecycle-controller"
+
+	// suspendedAnnotation marks Works whose dispatching has been suspended by
+	// this controller, so only those get resumed once the CRDs are ready.
+	suspendedAnnotation = "crdlifecycle.karmada.io/suspended"
+
+	// WorkRequiredCRDsEstablished is the condition type reporting whether all
+	// CRDs the Work's custom resources rely on are Established in the target
+	// cluster.
+	WorkRequiredCRDsEstablished = "RequiredCRDsEstablished"
+
+	// establishedRecheckInterval is how often a suspended Work is rechecked
+	// while waiting for its CRDs to become Established.
+	establishedRecheckInterval = 10 * time.Second
+)
+
+// crdGVK identifies the CustomResourceDefinition kind propagated ahead of
+// custom resources.
+var crdGVK = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}
+
+// Controller tracks the CRDs a Work's manifests require, propagates them to
+// the target cluster first, keeps the Work suspended until every CRD is
+// Established there, and reports the missing CRDs as a Work condition.
+type Controller struct {
+	client.Client
+	RateLimiterOptions ratelimiterflag.Options
+}
+
+// Reconcile performs a full reconciliation for the Work referred to by the Request.
+func (c *Controller) Reconcile(ctx context.Context, req controllerruntime.Request) (controllerruntime.Result, error) {
+	work := &workv1alpha1.Work{}
+	if err := c.Get(ctx, req.NamespacedName, work); err != nil {
+		if apierrors.IsNotFound(err) {
+			return controllerruntime.Result{}, nil
+		}
+		return controllerruntime.Result{}, err
+	}
+	if !work.DeletionTimestamp.IsZero() {
+		return controllerruntime.Result{}, nil
+	}
+	clusterName, err := names.GetClusterName(work.Namespace)
+	if err != nil {
+		// Works out of execution spaces are none of our business.
+		return controllerruntime.Result{}, nil
+	}
+
+	requiredCRDs, err := c.requiredCRDs(ctx, work)
+	if err != nil {
+		return controllerruntime.Result{}, err
+	}
+	if len(requiredCRDs) == 0 {
+		return controllerruntime.Result{}, c.resumeWork(ctx, work)
+	}
+
+	var missing []string
+	for _, crd := range requiredCRDs {
+		established, err := c.ensureCRDPropagated(ctx, work.Namespace, crd)
+		if err != nil {
+			return controllerruntime.Result{}, err
+		}
+		if !established {
+			missing = append(missing, crd.Name)
+		}
+	}
+
+	if len(missing) == 0 {
+		if err := c.resumeWork(ctx, work); err != nil {
+			return controllerruntime.Result{}, err
+		}
+		return controllerruntime.Result{}, c.setEstablishedCondition(ctx, work, metav1.ConditionTrue,
+			"CRDsEstablished", fmt.Sprintf("all CRDs required by the manifests are established in cluster(%s)", clusterName))
+	}
+
+	sort.Strings(missing)
+	if err := c.suspendWork(ctx, work); err != nil {
+		return controllerruntime.Result{}, err
+	}
+	if err := c.setEstablishedCondition(ctx, work, metav1.ConditionFalse,
+		"WaitingForCRDEstablished", fmt.Sprintf("waiting for CRDs [%s] to be established in cluster(%s)", strings.Join(missing, ", "), clusterName)); err != nil {
+		return controllerruntime.Result{}, err
+	}
+	return controllerruntime.Result{RequeueAfter: establishedRecheckInterval}, nil
+}
+
+// requiredCRDs returns the CRDs in the control plane that define the custom
+// resources contained in the Work's manifests.
+func (c *Controller) requiredCRDs(ctx context.Context, work *workv1alpha1.Work) ([]*apiextensionsv1.CustomResourceDefinition, error) {
+	crdList := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := c.List(ctx, crdList); err != nil {
+		return nil, fmt.Errorf("failed to list CRDs: %v", err)
+	}
+	crdsByGroupKind := make(map[schema.GroupKind]*apiextensionsv1.CustomResourceDefinition, len(crdList.Items))
+	for i := range crdList.Items {
+		crd := &crdList.Items[i]
+		crdsByGroupKind[schema.GroupKind{Group: crd.Spec.Group, Kind: crd.Spec.Names.Kind}] = crd
+	}
+
+	var required []*apiextensionsv1.CustomResourceDefinition
+	seen := make(map[string]struct{})
+	for i := range work.Spec.Workload.Manifests {
+		manifest := &unstructured.Unstructured{}
+		if err := manifest.UnmarshalJSON(work.Spec.Workload.Manifests[i].Raw); err != nil {
+			klog.Errorf("Failed to unmarshal manifest %d of work(%s/%s): %v", i, work.Namespace, work.Name, err)
+			continue
+		}
+		gvk := manifest.GroupVersionKind()
+		if gvk == crdGVK {
+			continue
+		}
+		crd, exist := crdsByGroupKind[gvk.GroupKind()]
+		if !exist {
+			// Not backed by a CRD in the control plane, e.g. a built-in or
+			// aggregated API resource.
+			continue
+		}
+		if _, duplicated := seen[crd.Name]; duplicated {
+			continue
+		}
+		seen[crd.Name] = struct{}{}
+		required = append(required, crd)
+	}
+	return required, nil
+}
+
+// ensureCRDPropagated makes sure a Work propagating the CRD exists in the
+// execution space and reports whether the CRD is already Established in the
+// member cluster.
+func (c *Controller) ensureCRDPropagated(ctx context.Context, executionSpace string, crd *apiextensionsv1.CustomResourceDefinition) (bool, error) {
+	workName := names.GenerateWorkName(crdGVK.Kind, crd.Name, "")
+	crdWork := &workv1alpha1.Work{}
+	err := c.Get(ctx, types.NamespacedName{Namespace: executionSpace, Name: workName}, crdWork)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		crdObj := &unstructured.Unstructured{}
+		crdObj.Object, err = runtime.DefaultUnstructuredConverter.ToUnstructured(crd)
+		if err != nil {
+			return false, err
+		}
+		crdObj.SetGroupVersionKind(crdGVK)
+		unstructured.RemoveNestedField(crdObj.Object, "status")
+		workMeta := metav1.ObjectMeta{
+			Name:       workName,
+			Namespace:  executionSpace,
+			Finalizers: []string{util.ExecutionControllerFinalizer},
+		}
+		if err := ctrlutil.CreateOrUpdateWork(ctx, c.Client, workMeta, crdObj); err != nil {
+			return false, fmt.Errorf("failed to propagate CRD %s: %v", crd.Name, err)
+		}
+		return false, nil
+	}
+	return crdEstablished(crdWork), nil
+}
+
+// crdEstablished reports whether the CRD propagated by the given Work carries
+// an Established=True condition in its collected status.
+func crdEstablished(crdWork *workv1alpha1.Work) bool {
+	for i := range crdWork.Status.ManifestStatuses {
+		status := crdWork.Status.ManifestStatuses[i].Status
+		if status == nil {
+			continue
+		}
+		crdStatus := &apiextensionsv1.CustomResourceDefinitionStatus{}
+		if err := json.Unmarshal(status.Raw, crdStatus); err != nil {
+			continue
+		}
+		for _, condition := range crdStatus.Conditions {
+			if condition.Type == apiextensionsv1.Established && condition.Status == apiextensionsv1.ConditionTrue {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// suspendWork stops dispatching of the Work and marks it as suspended by this
+// controller.
+func (c *Controller) suspendWork(ctx context.Context, work *workv1alpha1.Work) error {
+	if ptr.Deref(work.Spec.SuspendDispatching, false) && work.Annotations[suspendedAnnotation] == "true" {
+		return nil
+	}
+	work.Spec.SuspendDispatching = ptr.To(true)
+	if work.Annotations == nil {
+		work.Annotations = map[string]string{}
+	}
+	work.Annotations[suspendedAnnotation] = "true"
+	return c.Update(ctx, work)
+}
+
+// resumeWork reverts a suspension previously applied by this controller.
+// Suspensions from other sources are left untouched.
+func (c *Controller) resumeWork(ctx context.Context, work *workv1alpha1.Work) error {
+	if work.Annotations[suspendedAnnotation] != "true" {
+		return nil
+	}
+	work.Spec.SuspendDispatching = nil
+	delete(work.Annotations, suspendedAnnotation)
+	return c.Update(ctx, work)
+}
+
+// setEstablishedCondition updates the RequiredCRDsEstablished condition of
+// the Work if it changed.
+func (c *Controller) setEstablishedCondition(ctx context.Context, work *workv1alpha1.Work, status metav1.ConditionStatus, reason, message string) error {
+	if meta.SetStatusCondition(&work.Status.Conditions, metav1.Condition{
+		Type:    WorkRequiredCRDsEstablished,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	}) {
+		return c.Status().Update(ctx, work)
+	}
+	return nil
+}
+
+// SetupWithManager creates a controller and register to controller manager.
+func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&workv1alpha1.Work{}).
+		WithOptions(controller.Options{RateLimiter: ratelimiterflag.DefaultControllerRateLimiter[controllerruntime.Request](c.RateLimiterOptions)}).
+		Complete(c)
+}
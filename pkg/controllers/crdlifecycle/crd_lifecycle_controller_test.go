@@ -0,0 +1,162 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdlifecycle
+
+import (
+	"context"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+const testExecutionSpace = "karmada-es-member1"
+
+func newFooCRD() *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "foos.example.io"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "example.io",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Plural: "foos", Kind: "Foo"},
+		},
+	}
+}
+
+func newCRWork(name string) *workv1alpha1.Work {
+	return &workv1alpha1.Work{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testExecutionSpace},
+		Spec: workv1alpha1.WorkSpec{
+			Workload: workv1alpha1.WorkloadTemplate{
+				Manifests: []workv1alpha1.Manifest{
+					{RawExtension: runtime.RawExtension{Raw: []byte(`{"apiVersion":"example.io/v1","kind":"Foo","metadata":{"name":"foo1","namespace":"default"}}`)}},
+				},
+			},
+		},
+	}
+}
+
+func newEstablishedCRDWork(crdName string) *workv1alpha1.Work {
+	return &workv1alpha1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.GenerateWorkName("CustomResourceDefinition", crdName, ""),
+			Namespace: testExecutionSpace,
+		},
+		Status: workv1alpha1.WorkStatus{
+			ManifestStatuses: []workv1alpha1.ManifestStatus{
+				{Status: &runtime.RawExtension{Raw: []byte(`{"conditions":[{"type":"Established","status":"True"}]}`)}},
+			},
+		},
+	}
+}
+
+func TestReconcileCRDLifecycle(t *testing.T) {
+	tests := []struct {
+		name          string
+		objects       []runtime.Object
+		work          *workv1alpha1.Work
+		wantSuspended bool
+		wantCondition metav1.ConditionStatus
+		wantCRDWork   bool
+	}{
+		{
+			name:          "CRD not yet propagated, work suspended and CRD work created",
+			objects:       []runtime.Object{newFooCRD()},
+			work:          newCRWork("cr-work"),
+			wantSuspended: true,
+			wantCondition: metav1.ConditionFalse,
+			wantCRDWork:   true,
+		},
+		{
+			name:    "CRD established, previously suspended work resumed",
+			objects: []runtime.Object{newFooCRD(), newEstablishedCRDWork("foos.example.io")},
+			work: func() *workv1alpha1.Work {
+				w := newCRWork("cr-work")
+				w.Annotations = map[string]string{suspendedAnnotation: "true"}
+				w.Spec.SuspendDispatching = ptr.To(true)
+				return w
+			}(),
+			wantSuspended: false,
+			wantCondition: metav1.ConditionTrue,
+			wantCRDWork:   true,
+		},
+		{
+			name: "work without custom resources left untouched",
+			work: &workv1alpha1.Work{
+				ObjectMeta: metav1.ObjectMeta{Name: "plain-work", Namespace: testExecutionSpace},
+				Spec: workv1alpha1.WorkSpec{
+					Workload: workv1alpha1.WorkloadTemplate{
+						Manifests: []workv1alpha1.Manifest{
+							{RawExtension: runtime.RawExtension{Raw: []byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"nginx","namespace":"default"}}`)}},
+						},
+					},
+				},
+			},
+			wantSuspended: false,
+			wantCondition: "",
+			wantCRDWork:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).
+				WithRuntimeObjects(append(tt.objects, tt.work)...).
+				WithStatusSubresource(&workv1alpha1.Work{}).Build()
+			c := &Controller{Client: fakeClient}
+
+			_, err := c.Reconcile(context.TODO(), controllerruntime.Request{
+				NamespacedName: types.NamespacedName{Namespace: tt.work.Namespace, Name: tt.work.Name},
+			})
+			if err != nil {
+				t.Fatalf("Reconcile() returned error: %v", err)
+			}
+
+			got := &workv1alpha1.Work{}
+			if err := fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: tt.work.Namespace, Name: tt.work.Name}, got); err != nil {
+				t.Fatalf("failed to get work: %v", err)
+			}
+			if suspended := ptr.Deref(got.Spec.SuspendDispatching, false); suspended != tt.wantSuspended {
+				t.Errorf("work suspended = %v, want %v", suspended, tt.wantSuspended)
+			}
+			condition := meta.FindStatusCondition(got.Status.Conditions, WorkRequiredCRDsEstablished)
+			switch {
+			case tt.wantCondition == "" && condition != nil:
+				t.Errorf("unexpected condition %+v", condition)
+			case tt.wantCondition != "" && (condition == nil || condition.Status != tt.wantCondition):
+				t.Errorf("condition = %+v, want status %s", condition, tt.wantCondition)
+			}
+
+			crdWork := &workv1alpha1.Work{}
+			err = fakeClient.Get(context.TODO(), types.NamespacedName{
+				Namespace: testExecutionSpace,
+				Name:      names.GenerateWorkName("CustomResourceDefinition", "foos.example.io", ""),
+			}, crdWork)
+			if (err == nil) != tt.wantCRDWork {
+				t.Errorf("CRD work exists = %v, want %v", err == nil, tt.wantCRDWork)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package datamigration keeps scheduling of stateful bindings suspended during
+// failover until their persistent data has been migrated to the candidate
+// clusters. The actual snapshot/restore steps are delegated to storage-vendor
+// migrators registered in the DefaultMigratorRegistry.
+package datamigration
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/events"
+	"github.com/karmada-io/karmada/pkg/sharedcli/ratelimiterflag"
+)
+
+const (
+	// ControllerName is the controller name that will be used when reporting events and metrics.
+	ControllerName = "data-migration-controller"
+
+	// schedulingSuspendedAnnotation marks that the scheduling suspension on the
+	// binding was set by this controller, so that only suspensions of its own
+	// making are lifted afterwards.
+	schedulingSuspendedAnnotation = "data-migration.karmada.io/scheduling-suspended"
+
+	// migrationCheckInterval is how often a running migration is polled for readiness.
+	migrationCheckInterval = 10 * time.Second
+)
+
+// Controller suspends scheduling of bindings that opted in to data migration
+// assistance while failover is in progress, drives the registered migrator and
+// resumes scheduling once the DataReady condition turns true.
+type Controller struct {
+	client.Client
+	EventRecorder      record.EventRecorder
+	RateLimiterOptions ratelimiterflag.Options
+
+	// MigratorRegistry resolves the storage-vendor migrator for a binding.
+	MigratorRegistry *MigratorRegistry
+}
+
+// Reconcile performs a full reconciliation for the object referred to by the Request.
+func (c *Controller) Reconcile(ctx context.Context, req controllerruntime.Request) (controllerruntime.Result, error) {
+	klog.V(4).Infof("Reconciling ResourceBinding %s for data migration.", req.NamespacedName.String())
+
+	binding := &workv1alpha2.ResourceBinding{}
+	if err := c.Client.Get(ctx, req.NamespacedName, binding); err != nil {
+		if apierrors.IsNotFound(err) {
+			return controllerruntime.Result{}, nil
+		}
+		return controllerruntime.Result{}, err
+	}
+
+	if binding.Annotations[workv1alpha2.DataMigrationAnnotation] != workv1alpha2.DataMigrationAnnotationValueTrue {
+		return controllerruntime.Result{}, nil
+	}
+
+	if len(binding.Spec.GracefulEvictionTasks) == 0 {
+		// no failover in progress, lift a leftover suspension if this
+		// controller set one
+		return controllerruntime.Result{}, c.resumeScheduling(ctx, binding)
+	}
+
+	if apimeta.IsStatusConditionTrue(binding.Status.Conditions, workv1alpha2.DataReady) {
+		return controllerruntime.Result{}, c.resumeScheduling(ctx, binding)
+	}
+
+	migrator, supported := c.MigratorRegistry.MigratorFor(binding)
+	if !supported {
+		// without a migrator a suspension could never be lifted, let the
+		// binding schedule as if it had not opted in
+		c.EventRecorder.Eventf(binding, corev1.EventTypeWarning, events.EventReasonNoMigratorAvailable,
+			"No registered migrator supports binding %s/%s, data migration is skipped", binding.Namespace, binding.Name)
+		return controllerruntime.Result{}, nil
+	}
+
+	if err := c.suspendScheduling(ctx, binding); err != nil {
+		return controllerruntime.Result{}, err
+	}
+
+	ready, err := migrator.EnsureMigration(ctx, binding)
+	if err != nil {
+		c.EventRecorder.Eventf(binding, corev1.EventTypeWarning, events.EventReasonDataMigrationFailed,
+			"Migrator %s failed: %v", migrator.Name(), err)
+		return controllerruntime.Result{}, err
+	}
+	if !ready {
+		c.EventRecorder.Eventf(binding, corev1.EventTypeNormal, events.EventReasonDataMigrationPending,
+			"Scheduling stays suspended until migrator %s reports the data ready", migrator.Name())
+		return controllerruntime.Result{RequeueAfter: migrationCheckInterval}, nil
+	}
+
+	if err := c.markDataReady(ctx, binding, migrator.Name()); err != nil {
+		return controllerruntime.Result{}, err
+	}
+	if err := c.resumeScheduling(ctx, binding); err != nil {
+		return controllerruntime.Result{}, err
+	}
+	c.EventRecorder.Eventf(binding, corev1.EventTypeNormal, events.EventReasonDataMigrationSucceed,
+		"Migrator %s reported the data ready, scheduling resumed", migrator.Name())
+	return controllerruntime.Result{}, nil
+}
+
+// suspendScheduling pauses scheduling of the binding and marks the suspension
+// as owned by this controller.
+func (c *Controller) suspendScheduling(ctx context.Context, binding *workv1alpha2.ResourceBinding) error {
+	if binding.Spec.Suspension != nil && ptr.Deref(binding.Spec.Suspension.Scheduling, false) {
+		return nil
+	}
+
+	if binding.Spec.Suspension == nil {
+		binding.Spec.Suspension = &workv1alpha2.Suspension{}
+	}
+	binding.Spec.Suspension.Scheduling = ptr.To(true)
+	if binding.Annotations == nil {
+		binding.Annotations = map[string]string{}
+	}
+	binding.Annotations[schedulingSuspendedAnnotation] = "true"
+
+	// the DataReady condition from a previous failover no longer holds
+	if apimeta.RemoveStatusCondition(&binding.Status.Conditions, workv1alpha2.DataReady) {
+		if err := c.Client.Status().Update(ctx, binding); err != nil {
+			return err
+		}
+	}
+	return c.Client.Update(ctx, binding)
+}
+
+// resumeScheduling lifts the scheduling suspension, but only when it was set
+// by this controller.
+func (c *Controller) resumeScheduling(ctx context.Context, binding *workv1alpha2.ResourceBinding) error {
+	if binding.Annotations[schedulingSuspendedAnnotation] != "true" {
+		return nil
+	}
+
+	if binding.Spec.Suspension != nil {
+		binding.Spec.Suspension.Scheduling = nil
+	}
+	delete(binding.Annotations, schedulingSuspendedAnnotation)
+	return c.Client.Update(ctx, binding)
+}
+
+// markDataReady records the DataReady condition on the binding status.
+func (c *Controller) markDataReady(ctx context.Context, binding *workv1alpha2.ResourceBinding, migratorName string) error {
+	apimeta.SetStatusCondition(&binding.Status.Conditions, metav1.Condition{
+		Type:    workv1alpha2.DataReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  "MigrationCompleted",
+		Message: "Migrator " + migratorName + " reported the persistent data ready in the candidate clusters",
+	})
+	return c.Client.Status().Update(ctx, binding)
+}
+
+// SetupWithManager creates a controller and register to controller manager.
+func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
+	optedIn := func(obj client.Object) bool {
+		return obj.GetAnnotations()[workv1alpha2.DataMigrationAnnotation] == workv1alpha2.DataMigrationAnnotationValueTrue
+	}
+	dataMigrationPredicateFn := predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool { return optedIn(createEvent.Object) },
+		UpdateFunc: func(updateEvent event.UpdateEvent) bool {
+			return optedIn(updateEvent.ObjectOld) || optedIn(updateEvent.ObjectNew)
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&workv1alpha2.ResourceBinding{}, builder.WithPredicates(dataMigrationPredicateFn)).
+		WithOptions(controller.Options{RateLimiter: ratelimiterflag.DefaultControllerRateLimiter[controllerruntime.Request](c.RateLimiterOptions)}).
+		Complete(c)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamigration
+
+import (
+	"context"
+	"testing"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+)
+
+type fakeMigrator struct {
+	ready bool
+}
+
+func (f *fakeMigrator) Name() string { return "fake-vendor" }
+
+func (f *fakeMigrator) Supports(*workv1alpha2.ResourceBinding) bool { return true }
+
+func (f *fakeMigrator) EnsureMigration(context.Context, *workv1alpha2.ResourceBinding) (bool, error) {
+	return f.ready, nil
+}
+
+func newTestBinding(optIn, evicting bool) *workv1alpha2.ResourceBinding {
+	binding := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx-deployment"},
+	}
+	if optIn {
+		binding.Annotations = map[string]string{
+			workv1alpha2.DataMigrationAnnotation: workv1alpha2.DataMigrationAnnotationValueTrue,
+		}
+	}
+	if evicting {
+		binding.Spec.GracefulEvictionTasks = []workv1alpha2.GracefulEvictionTask{{FromCluster: "member1"}}
+	}
+	return binding
+}
+
+func TestReconcileDataMigration(t *testing.T) {
+	tests := []struct {
+		name          string
+		binding       *workv1alpha2.ResourceBinding
+		migratorReady bool
+		wantSuspended bool
+		wantDataReady bool
+		wantRequeue   bool
+		registerNoOne bool
+	}{
+		{
+			name:    "binding without opt-in annotation is ignored",
+			binding: newTestBinding(false, true),
+		},
+		{
+			name:          "pending migration keeps scheduling suspended",
+			binding:       newTestBinding(true, true),
+			migratorReady: false,
+			wantSuspended: true,
+			wantRequeue:   true,
+		},
+		{
+			name:          "completed migration marks data ready and resumes scheduling",
+			binding:       newTestBinding(true, true),
+			migratorReady: true,
+			wantDataReady: true,
+		},
+		{
+			name:          "no migrator available lets the binding schedule",
+			binding:       newTestBinding(true, true),
+			registerNoOne: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewMigratorRegistry()
+			if !tt.registerNoOne {
+				if err := registry.Register(&fakeMigrator{ready: tt.migratorReady}); err != nil {
+					t.Fatalf("Register() unexpected error: %v", err)
+				}
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).
+				WithObjects(tt.binding).WithStatusSubresource(tt.binding).Build()
+			controller := &Controller{
+				Client:           fakeClient,
+				EventRecorder:    record.NewFakeRecorder(10),
+				MigratorRegistry: registry,
+			}
+
+			key := types.NamespacedName{Namespace: tt.binding.Namespace, Name: tt.binding.Name}
+			result, err := controller.Reconcile(context.TODO(), controllerruntime.Request{NamespacedName: key})
+			if err != nil {
+				t.Fatalf("Reconcile() unexpected error: %v", err)
+			}
+			if gotRequeue := result.RequeueAfter > 0; gotRequeue != tt.wantRequeue {
+				t.Errorf("Reconcile() requeue = %v, want %v", gotRequeue, tt.wantRequeue)
+			}
+
+			got := &workv1alpha2.ResourceBinding{}
+			if err := fakeClient.Get(context.TODO(), key, got); err != nil {
+				t.Fatalf("failed to get binding: %v", err)
+			}
+			suspended := got.Spec.Suspension != nil && ptr.Deref(got.Spec.Suspension.Scheduling, false)
+			if suspended != tt.wantSuspended {
+				t.Errorf("scheduling suspended = %v, want %v", suspended, tt.wantSuspended)
+			}
+			if dataReady := apimeta.IsStatusConditionTrue(got.Status.Conditions, workv1alpha2.DataReady); dataReady != tt.wantDataReady {
+				t.Errorf("DataReady condition = %v, want %v", dataReady, tt.wantDataReady)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamigration
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+// Migrator orchestrates the vendor-specific data migration steps, typically
+// through VolumeSnapshot or storage replication CRDs, when failover moves a
+// stateful binding between clusters.
+type Migrator interface {
+	// Name returns the migrator name, used in logs and events.
+	Name() string
+	// Supports reports whether this migrator can handle the workload
+	// referenced by the binding, e.g. by checking its storage classes.
+	Supports(binding *workv1alpha2.ResourceBinding) bool
+	// EnsureMigration drives the snapshot/restore steps for the binding and
+	// reports whether the data is ready in the candidate clusters. It is
+	// called repeatedly until it reports ready, so it must be idempotent.
+	EnsureMigration(ctx context.Context, binding *workv1alpha2.ResourceBinding) (ready bool, err error)
+}
+
+// MigratorRegistry resolves the migrator responsible for a binding. The first
+// registered migrator supporting the binding wins.
+type MigratorRegistry struct {
+	lock      sync.RWMutex
+	migrators []Migrator
+	names     map[string]struct{}
+}
+
+// NewMigratorRegistry returns an empty MigratorRegistry.
+func NewMigratorRegistry() *MigratorRegistry {
+	return &MigratorRegistry{names: map[string]struct{}{}}
+}
+
+// DefaultMigratorRegistry is consulted by the data migration controller,
+// storage vendors register their migrators here, usually from an init function
+// in custom controller-manager builds.
+var DefaultMigratorRegistry = NewMigratorRegistry()
+
+// Register adds a migrator, rejecting duplicate names.
+func (r *MigratorRegistry) Register(migrator Migrator) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	name := migrator.Name()
+	if len(name) == 0 {
+		return fmt.Errorf("migrator name must not be empty")
+	}
+	if _, exist := r.names[name]; exist {
+		return fmt.Errorf("migrator %q is already registered", name)
+	}
+	r.names[name] = struct{}{}
+	r.migrators = append(r.migrators, migrator)
+	return nil
+}
+
+// MigratorFor returns the migrator responsible for the binding, or false when
+// no registered migrator supports it.
+func (r *MigratorRegistry) MigratorFor(binding *workv1alpha2.ResourceBinding) (Migrator, bool) {
+	if r == nil {
+		return nil, false
+	}
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	for _, migrator := range r.migrators {
+		if migrator.Supports(binding) {
+			return migrator, true
+		}
+	}
+	return nil, false
+}
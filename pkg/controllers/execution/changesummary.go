@@ -0,0 +1,206 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package execution
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+)
+
+const (
+	// maxChangedFields caps the number of field paths recorded per manifest so
+	// that a large diff cannot blow up the Work status.
+	maxChangedFields = 16
+	// changedFieldsMaxDepth is how deep changed field paths are resolved, deeper
+	// differences are reported by their ancestor path(e.g. "spec.template").
+	changedFieldsMaxDepth = 2
+)
+
+// podSpecPaths are the well-known locations of a pod spec within workload kinds,
+// used to collect container images for the change summary.
+var podSpecPaths = [][]string{
+	{"spec"},                     // Pod
+	{"spec", "template", "spec"}, // Deployment, StatefulSet, DaemonSet, ReplicaSet, Job
+	{"spec", "jobTemplate", "spec", "template", "spec"}, // CronJob
+}
+
+// manifestsHash returns a hex encoded SHA-256 over the raw manifests of the
+// given Work, identifying exactly what has been dispatched to the member cluster.
+func manifestsHash(work *workv1alpha1.Work) string {
+	hash := sha256.New()
+	for _, manifest := range work.Spec.Workload.Manifests {
+		hash.Write(manifest.Raw)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// buildAppliedChange summarizes what updating the observed member cluster object
+// to the desired workload changes: the manifest field paths that differ, the
+// container image transitions and the replicas delta.
+func buildAppliedChange(ordinal int, desired, observed *unstructured.Unstructured) workv1alpha1.AppliedChange {
+	groupVersion, _ := schema.ParseGroupVersion(desired.GetAPIVersion())
+	return workv1alpha1.AppliedChange{
+		Identifier: workv1alpha1.ResourceIdentifier{
+			Ordinal:   ordinal,
+			Group:     groupVersion.Group,
+			Version:   groupVersion.Version,
+			Kind:      desired.GetKind(),
+			Namespace: desired.GetNamespace(),
+			Name:      desired.GetName(),
+		},
+		AppliedTime:   metav1.Now(),
+		FieldsChanged: changedFields(desired.Object, observed.Object),
+		ImagesChanged: changedImages(desired, observed),
+		ReplicasDelta: replicasDelta(desired, observed),
+	}
+}
+
+// changedFields returns the sorted paths of the desired fields that differ from
+// the observed object. Only fields declared by the manifest are considered, the
+// server populated rest of the observed object is irrelevant for the summary.
+func changedFields(desired, observed map[string]interface{}) []string {
+	var paths []string
+	collectChangedFields(desired, observed, "", 1, &paths)
+	sort.Strings(paths)
+	if len(paths) > maxChangedFields {
+		paths = append(paths[:maxChangedFields:maxChangedFields], "...")
+	}
+	return paths
+}
+
+func collectChangedFields(desired, observed map[string]interface{}, prefix string, depth int, paths *[]string) {
+	for key, desiredValue := range desired {
+		// The type meta never changes on update and the status is owned by the member cluster.
+		if prefix == "" && (key == "apiVersion" || key == "kind" || key == "status") {
+			continue
+		}
+
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		observedValue, exist := observed[key]
+		if !exist {
+			*paths = append(*paths, path)
+			continue
+		}
+
+		if depth < changedFieldsMaxDepth {
+			desiredMap, desiredOK := desiredValue.(map[string]interface{})
+			observedMap, observedOK := observedValue.(map[string]interface{})
+			if desiredOK && observedOK {
+				collectChangedFields(desiredMap, observedMap, path, depth+1, paths)
+				continue
+			}
+		}
+
+		if !reflect.DeepEqual(desiredValue, observedValue) {
+			*paths = append(*paths, path)
+		}
+	}
+}
+
+// changedImages returns the container image transitions between the observed
+// and the desired object, in the form "<container>: <observed> -> <desired>".
+func changedImages(desired, observed *unstructured.Unstructured) []string {
+	desiredImages := containerImages(desired)
+	observedImages := containerImages(observed)
+
+	var changes []string
+	for container, desiredImage := range desiredImages {
+		if observedImage, exist := observedImages[container]; exist && observedImage != desiredImage {
+			changes = append(changes, fmt.Sprintf("%s: %s -> %s", container, observedImage, desiredImage))
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
+
+// containerImages collects the images of containers and init containers from
+// the well-known pod spec locations, keyed by container name.
+func containerImages(obj *unstructured.Unstructured) map[string]string {
+	images := map[string]string{}
+	for _, specPath := range podSpecPaths {
+		for _, field := range []string{"initContainers", "containers"} {
+			containers, exist, err := unstructured.NestedSlice(obj.Object, append(specPath[:len(specPath):len(specPath)], field)...)
+			if err != nil || !exist {
+				continue
+			}
+			for _, container := range containers {
+				containerMap, ok := container.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name, _, _ := unstructured.NestedString(containerMap, "name")
+				image, _, _ := unstructured.NestedString(containerMap, "image")
+				if name != "" && image != "" {
+					images[name] = image
+				}
+			}
+		}
+	}
+	return images
+}
+
+// replicasDelta returns the difference between the desired and the observed
+// .spec.replicas, nil when replicas do not apply or did not change.
+func replicasDelta(desired, observed *unstructured.Unstructured) *int32 {
+	desiredReplicas, desiredExist, err := unstructured.NestedInt64(desired.Object, "spec", "replicas")
+	if err != nil || !desiredExist {
+		return nil
+	}
+	observedReplicas, observedExist, err := unstructured.NestedInt64(observed.Object, "spec", "replicas")
+	if err != nil || !observedExist {
+		return nil
+	}
+	if desiredReplicas == observedReplicas {
+		return nil
+	}
+	return ptr.To(int32(desiredReplicas - observedReplicas)) // #nosec G115: replicas always fit in int32
+}
+
+// mergeAppliedChanges merges the changes of the current sync into the recorded
+// summaries, replacing the entries of the manifests that changed again and
+// keeping the rest, sorted by manifest ordinal.
+func mergeAppliedChanges(recorded, changes []workv1alpha1.AppliedChange) []workv1alpha1.AppliedChange {
+	merged := make([]workv1alpha1.AppliedChange, 0, len(recorded)+len(changes))
+	changedOrdinals := map[int]struct{}{}
+	for _, change := range changes {
+		changedOrdinals[change.Identifier.Ordinal] = struct{}{}
+	}
+	for _, summary := range recorded {
+		if _, changed := changedOrdinals[summary.Identifier.Ordinal]; !changed {
+			merged = append(merged, summary)
+		}
+	}
+	merged = append(merged, changes...)
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].Identifier.Ordinal < merged[j].Identifier.Ordinal
+	})
+	return merged
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package execution
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+)
+
+func newTestDeployment(replicas int64, image string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "nginx",
+			"namespace": "default",
+		},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "nginx", "image": image},
+					},
+				},
+			},
+		},
+	}}
+}
+
+func TestBuildAppliedChange(t *testing.T) {
+	desired := newTestDeployment(4, "nginx:1.20")
+	observed := newTestDeployment(2, "nginx:1.19")
+
+	change := buildAppliedChange(1, desired, observed)
+	wantIdentifier := workv1alpha1.ResourceIdentifier{
+		Ordinal:   1,
+		Group:     "apps",
+		Version:   "v1",
+		Kind:      "Deployment",
+		Namespace: "default",
+		Name:      "nginx",
+	}
+	if change.Identifier != wantIdentifier {
+		t.Errorf("buildAppliedChange() identifier = %+v, want %+v", change.Identifier, wantIdentifier)
+	}
+	if want := []string{"spec.replicas", "spec.template"}; !reflect.DeepEqual(change.FieldsChanged, want) {
+		t.Errorf("buildAppliedChange() fieldsChanged = %v, want %v", change.FieldsChanged, want)
+	}
+	if want := []string{"nginx: nginx:1.19 -> nginx:1.20"}; !reflect.DeepEqual(change.ImagesChanged, want) {
+		t.Errorf("buildAppliedChange() imagesChanged = %v, want %v", change.ImagesChanged, want)
+	}
+	if change.ReplicasDelta == nil || *change.ReplicasDelta != 2 {
+		t.Errorf("buildAppliedChange() replicasDelta = %v, want 2", change.ReplicasDelta)
+	}
+
+	unchanged := buildAppliedChange(0, desired, newTestDeployment(4, "nginx:1.20"))
+	if len(unchanged.FieldsChanged) != 0 || len(unchanged.ImagesChanged) != 0 || unchanged.ReplicasDelta != nil {
+		t.Errorf("buildAppliedChange() on identical objects reported changes: %+v", unchanged)
+	}
+}
+
+func TestChangedFields(t *testing.T) {
+	desired := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "cm", "labels": map[string]interface{}{"app": "demo"}},
+		"data":       map[string]interface{}{"key": "new"},
+	}
+	observed := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "cm", "resourceVersion": "100"},
+		"data":       map[string]interface{}{"key": "old"},
+		"status":     map[string]interface{}{},
+	}
+
+	got := changedFields(desired, observed)
+	// The missing labels and the differing data key should be reported, the
+	// server populated resourceVersion and the type meta should not.
+	want := []string{"data.key", "metadata.labels"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("changedFields() = %v, want %v", got, want)
+	}
+}
+
+func TestChangedFieldsCap(t *testing.T) {
+	desired := map[string]interface{}{}
+	for i := 0; i < maxChangedFields+5; i++ {
+		desired[string(rune('a'+i))] = i
+	}
+
+	got := changedFields(desired, map[string]interface{}{})
+	if len(got) != maxChangedFields+1 || got[maxChangedFields] != "..." {
+		t.Errorf("changedFields() = %v, want %d entries ending with the truncation marker", got, maxChangedFields+1)
+	}
+}
+
+func TestManifestsHash(t *testing.T) {
+	work := &workv1alpha1.Work{
+		Spec: workv1alpha1.WorkSpec{
+			Workload: workv1alpha1.WorkloadTemplate{
+				Manifests: []workv1alpha1.Manifest{
+					{RawExtension: runtime.RawExtension{Raw: []byte(`{"kind":"ConfigMap"}`)}},
+				},
+			},
+		},
+	}
+
+	hash := manifestsHash(work)
+	if hash != manifestsHash(work) {
+		t.Error("manifestsHash() is not deterministic")
+	}
+
+	work.Spec.Workload.Manifests[0].Raw = []byte(`{"kind":"Secret"}`)
+	if hash == manifestsHash(work) {
+		t.Error("manifestsHash() did not change with the manifests")
+	}
+}
+
+func TestMergeAppliedChanges(t *testing.T) {
+	changeFor := func(ordinal int, fields ...string) workv1alpha1.AppliedChange {
+		return workv1alpha1.AppliedChange{
+			Identifier:    workv1alpha1.ResourceIdentifier{Ordinal: ordinal},
+			FieldsChanged: fields,
+		}
+	}
+
+	recorded := []workv1alpha1.AppliedChange{changeFor(0, "spec.replicas"), changeFor(1, "data.key")}
+	merged := mergeAppliedChanges(recorded, []workv1alpha1.AppliedChange{changeFor(1, "data.other")})
+	if len(merged) != 2 {
+		t.Fatalf("mergeAppliedChanges() returned %d entries, want 2", len(merged))
+	}
+	if !reflect.DeepEqual(merged[0].FieldsChanged, []string{"spec.replicas"}) {
+		t.Errorf("mergeAppliedChanges() dropped the unchanged manifest summary: %+v", merged[0])
+	}
+	if !reflect.DeepEqual(merged[1].FieldsChanged, []string{"data.other"}) {
+		t.Errorf("mergeAppliedChanges() did not replace the changed manifest summary: %+v", merged[1])
+	}
+}
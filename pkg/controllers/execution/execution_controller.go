@@ -19,6 +19,7 @@ package execution
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -40,8 +41,11 @@ import (
 
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/chaos"
 	"github.com/karmada-io/karmada/pkg/detector"
 	"github.com/karmada-io/karmada/pkg/events"
+	"github.com/karmada-io/karmada/pkg/executor"
 	"github.com/karmada-io/karmada/pkg/metrics"
 	"github.com/karmada-io/karmada/pkg/sharedcli/ratelimiterflag"
 	"github.com/karmada-io/karmada/pkg/util"
@@ -63,6 +67,14 @@ const (
 	workSuspendDispatchingConditionReason = "SuspendDispatching"
 	// workDispatchingConditionReason is the reason for the WorkDispatching condition when dispatching is not suspended.
 	workDispatchingConditionReason = "Dispatching"
+	// workRolloutPendingConditionReason is the reason for the RolloutPending condition while the work
+	// waits for the rollout budget of its binding.
+	workRolloutPendingConditionReason = "WaitingForRolloutBudget"
+	// workRolloutProceedingConditionReason is the reason for the RolloutPending condition once the work
+	// acquired the rollout budget of its binding.
+	workRolloutProceedingConditionReason = "RolloutBudgetAcquired"
+	// rolloutBudgetRetryInterval is how long a work waits before rechecking the rollout budget.
+	rolloutBudgetRetryInterval = 10 * time.Second
 )
 
 // Controller is to sync Work.
@@ -74,6 +86,10 @@ type Controller struct {
 	PredicateFunc      predicate.Predicate
 	InformerManager    genericmanager.MultiClusterInformerManager
 	RateLimiterOptions ratelimiterflag.Options
+	// ExecutorRegistry holds alternative executors that take over dispatching
+	// for the manifest kinds they claim, may be nil when only the regular
+	// apiserver-based dispatching is needed.
+	ExecutorRegistry *executor.Registry
 }
 
 // Reconcile performs a full reconciliation for the object referred to by the Request.
@@ -127,9 +143,102 @@ func (c *Controller) Reconcile(ctx context.Context, req controllerruntime.Reques
 		return controllerruntime.Result{}, fmt.Errorf("cluster(%s) not ready", cluster.Name)
 	}
 
+	proceed, err := c.acquireRolloutBudget(ctx, work)
+	if err != nil {
+		return controllerruntime.Result{}, err
+	}
+	if !proceed {
+		klog.V(4).Infof("Postpone syncing work(%s/%s) to cluster(%s) as the rollout budget of its binding is exhausted.", work.Namespace, work.Name, cluster.Name)
+		return controllerruntime.Result{RequeueAfter: rolloutBudgetRetryInterval}, nil
+	}
+
 	return c.syncWork(ctx, clusterName, work)
 }
 
+// acquireRolloutBudget tells whether the work may be synced now with respect to
+// the maxConcurrentClusters limit of the ResourceBinding it belongs to. Among
+// all sibling works that still need to catch up with the binding, only the
+// first ones in name order, up to the limit, may proceed; the others wait and
+// expose a RolloutPending condition until budget becomes available.
+func (c *Controller) acquireRolloutBudget(ctx context.Context, work *workv1alpha1.Work) (bool, error) {
+	bindingNamespace := util.GetAnnotationValue(work.Annotations, workv1alpha2.ResourceBindingNamespaceAnnotationKey)
+	bindingName := util.GetAnnotationValue(work.Annotations, workv1alpha2.ResourceBindingNameAnnotationKey)
+	if bindingName == "" {
+		return true, nil
+	}
+
+	binding := &workv1alpha2.ResourceBinding{}
+	if err := c.Client.Get(ctx, client.ObjectKey{Namespace: bindingNamespace, Name: bindingName}, binding); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	if binding.Spec.MaxConcurrentClusters == nil || *binding.Spec.MaxConcurrentClusters <= 0 {
+		return true, nil
+	}
+	limit := int(*binding.Spec.MaxConcurrentClusters)
+
+	workList, err := helper.GetWorksByBindingID(ctx, c.Client, binding.Labels[workv1alpha2.ResourceBindingPermanentIDLabel], true)
+	if err != nil {
+		return false, err
+	}
+	var pending []string
+	for i := range workList.Items {
+		sibling := &workList.Items[i]
+		if !sibling.DeletionTimestamp.IsZero() || isWorkUpToDate(sibling) {
+			continue
+		}
+		pending = append(pending, names.NamespacedKey(sibling.Namespace, sibling.Name))
+	}
+	sort.Strings(pending)
+
+	position := -1
+	for i, key := range pending {
+		if key == names.NamespacedKey(work.Namespace, work.Name) {
+			position = i
+			break
+		}
+	}
+	// The work is already up to date or budget is available, let it proceed.
+	if position < limit {
+		if cond := meta.FindStatusCondition(work.Status.Conditions, workv1alpha1.WorkRolloutPending); cond != nil && cond.Status == metav1.ConditionTrue {
+			err = c.updateRolloutPendingCondition(ctx, work, metav1.ConditionFalse, workRolloutProceedingConditionReason,
+				fmt.Sprintf("Rollout budget of binding %s/%s acquired.", bindingNamespace, bindingName))
+			if err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+
+	err = c.updateRolloutPendingCondition(ctx, work, metav1.ConditionTrue, workRolloutPendingConditionReason,
+		fmt.Sprintf("Waiting for rollout budget of binding %s/%s: %d cluster(s) ahead, at most %d may update simultaneously.",
+			bindingNamespace, bindingName, position-limit+1, limit))
+	if err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// isWorkUpToDate tells whether the current spec of the work has already been
+// applied to its member cluster.
+func isWorkUpToDate(work *workv1alpha1.Work) bool {
+	cond := meta.FindStatusCondition(work.Status.Conditions, workv1alpha1.WorkApplied)
+	return cond != nil && cond.Status == metav1.ConditionTrue && cond.ObservedGeneration == work.Generation
+}
+
+func (c *Controller) updateRolloutPendingCondition(ctx context.Context, work *workv1alpha1.Work, status metav1.ConditionStatus, reason, message string) error {
+	return c.setStatusCondition(ctx, work, metav1.Condition{
+		Type:               workv1alpha1.WorkRolloutPending,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: work.Generation,
+		LastTransitionTime: metav1.Now(),
+	})
+}
+
 // SetupWithManager creates a controller and register to controller manager.
 func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
 	return controllerruntime.NewControllerManagedBy(mgr).
@@ -143,6 +252,7 @@ func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
 }
 
 func (c *Controller) syncWork(ctx context.Context, clusterName string, work *workv1alpha1.Work) (controllerruntime.Result, error) {
+	chaos.Delay(ctx, chaos.PointExecutionSyncWork, clusterName)
 	start := time.Now()
 	err := c.syncToClusters(ctx, clusterName, work)
 	metrics.ObserveSyncWorkloadLatency(err, start)
@@ -233,6 +343,15 @@ func (c *Controller) tryDeleteWorkload(ctx context.Context, clusterName string,
 			return err
 		}
 
+		if alternativeExecutor, claimed := c.ExecutorRegistry.ExecutorFor(workload); claimed {
+			if err := alternativeExecutor.Delete(ctx, clusterName, workload); err != nil {
+				klog.Errorf("Executor %s failed to delete resource(%v/%v) for cluster %s, err is %v",
+					alternativeExecutor.Name(), workload.GetNamespace(), workload.GetName(), clusterName, err)
+				return err
+			}
+			continue
+		}
+
 		err = c.ObjectWatcher.Delete(ctx, clusterName, workload)
 		metrics.CountDeleteResourceFromCluster(err, workload.GetAPIVersion(), workload.GetKind(), clusterName)
 		if err != nil {
@@ -261,8 +380,9 @@ func (c *Controller) removeFinalizer(ctx context.Context, work *workv1alpha1.Wor
 // syncToClusters ensures that the state of the given object is synchronized to member clusters.
 func (c *Controller) syncToClusters(ctx context.Context, clusterName string, work *workv1alpha1.Work) error {
 	var errs []error
+	var appliedChanges []workv1alpha1.AppliedChange
 	syncSucceedNum := 0
-	for _, manifest := range work.Spec.Workload.Manifests {
+	for i, manifest := range work.Spec.Workload.Manifests {
 		workload := &unstructured.Unstructured{}
 		err := workload.UnmarshalJSON(manifest.Raw)
 		if err != nil {
@@ -271,12 +391,16 @@ func (c *Controller) syncToClusters(ctx context.Context, clusterName string, wor
 			continue
 		}
 
-		if err = c.tryCreateOrUpdateWorkload(ctx, clusterName, workload); err != nil {
+		appliedChange, err := c.tryCreateOrUpdateWorkload(ctx, clusterName, i, workload)
+		if err != nil {
 			klog.Errorf("Failed to create or update resource(%v/%v) in the given member cluster %s, err is %v", workload.GetNamespace(), workload.GetName(), clusterName, err)
 			c.eventf(workload, corev1.EventTypeWarning, events.EventReasonSyncWorkloadFailed, "Failed to create or update resource(%s) in member cluster(%s): %v", klog.KObj(workload), clusterName, err)
 			errs = append(errs, err)
 			continue
 		}
+		if appliedChange != nil {
+			appliedChanges = append(appliedChanges, *appliedChange)
+		}
 		c.eventf(workload, corev1.EventTypeNormal, events.EventReasonSyncWorkloadSucceed, "Successfully applied resource(%v/%v) to cluster %s", workload.GetNamespace(), workload.GetName(), clusterName)
 		syncSucceedNum++
 	}
@@ -289,6 +413,12 @@ func (c *Controller) syncToClusters(ctx context.Context, clusterName string, wor
 			klog.Errorf("Failed to update applied status for given work %v, namespace is %v, err is %v", work.Name, work.Namespace, err)
 			errs = append(errs, err)
 		}
+		// The manifests that made it to the member cluster did change it, keep
+		// their summaries even though the work as a whole failed to apply.
+		if err := c.recordAppliedChanges(ctx, work, appliedChanges, ""); err != nil {
+			klog.Errorf("Failed to record applied changes for given work %v, namespace is %v, err is %v", work.Name, work.Namespace, err)
+			errs = append(errs, err)
+		}
 		return errors.NewAggregate(errs)
 	}
 
@@ -298,36 +428,55 @@ func (c *Controller) syncToClusters(ctx context.Context, clusterName string, wor
 		return err
 	}
 
+	if err := c.recordAppliedChanges(ctx, work, appliedChanges, manifestsHash(work)); err != nil {
+		klog.Errorf("Failed to record applied changes for given work %v, namespace is %v, err is %v", work.Name, work.Namespace, err)
+		return err
+	}
+
 	return nil
 }
 
-func (c *Controller) tryCreateOrUpdateWorkload(ctx context.Context, clusterName string, workload *unstructured.Unstructured) error {
+// tryCreateOrUpdateWorkload ensures the given workload exists on the member cluster.
+// When an existing member object is actually updated, it returns a summary of
+// what the update changed, in all other cases the summary is nil.
+func (c *Controller) tryCreateOrUpdateWorkload(ctx context.Context, clusterName string, ordinal int, workload *unstructured.Unstructured) (*workv1alpha1.AppliedChange, error) {
+	if alternativeExecutor, claimed := c.ExecutorRegistry.ExecutorFor(workload); claimed {
+		return nil, alternativeExecutor.Apply(ctx, clusterName, workload)
+	}
+
 	fedKey, err := keys.FederatedKeyFunc(clusterName, workload)
 	if err != nil {
 		klog.Errorf("Failed to get FederatedKey %s, error: %v", workload.GetName(), err)
-		return err
+		return nil, err
 	}
 
 	clusterObj, err := helper.GetObjectFromCache(c.RESTMapper, c.InformerManager, fedKey)
 	if err != nil {
 		if !apierrors.IsNotFound(err) {
 			klog.Errorf("Failed to get the resource(kind=%s, %s/%s) from member cluster(%s), err is %v ", workload.GetKind(), workload.GetNamespace(), workload.GetName(), clusterName, err)
-			return err
+			return nil, err
 		}
 		err = c.ObjectWatcher.Create(ctx, clusterName, workload)
 		metrics.CountCreateResourceToCluster(err, workload.GetAPIVersion(), workload.GetKind(), clusterName, false)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		return nil
+		return nil, nil
 	}
 
+	// Summarize the difference before the update since ObjectWatcher.Update
+	// mutates the desired object while retaining cluster fields.
+	appliedChange := buildAppliedChange(ordinal, workload, clusterObj)
+
 	operationResult, err := c.ObjectWatcher.Update(ctx, clusterName, workload, clusterObj)
 	metrics.CountUpdateResourceToCluster(err, workload.GetAPIVersion(), workload.GetKind(), clusterName, string(operationResult))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	if operationResult != objectwatcher.OperationResultUpdated {
+		return nil, nil
+	}
+	return &appliedChange, nil
 }
 
 func (c *Controller) updateWorkDispatchingConditionIfNeeded(ctx context.Context, work *workv1alpha1.Work) error {
@@ -370,6 +519,7 @@ func (c *Controller) updateAppliedCondition(ctx context.Context, work *workv1alp
 		Status:             status,
 		Reason:             reason,
 		Message:            message,
+		ObservedGeneration: work.Generation,
 		LastTransitionTime: metav1.Now(),
 	}
 
@@ -386,6 +536,43 @@ func (c *Controller) setStatusCondition(ctx context.Context, work *workv1alpha1.
 	})
 }
 
+// recordAppliedChanges records the change summaries of the manifests that just
+// changed the member cluster in the work status, and stamps the last applied
+// hash annotation. An empty hash means the sync did not fully succeed and the
+// annotation is left untouched.
+func (c *Controller) recordAppliedChanges(ctx context.Context, work *workv1alpha1.Work, changes []workv1alpha1.AppliedChange, appliedHash string) error {
+	if len(changes) > 0 {
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+			_, err = helper.UpdateStatus(ctx, c.Client, work, func() error {
+				work.Status.LastAppliedChanges = mergeAppliedChanges(work.Status.LastAppliedChanges, changes)
+				return nil
+			})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if appliedHash == "" || work.Annotations[workv1alpha2.WorkLastAppliedHashAnnotation] == appliedHash {
+		return nil
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		newer := &workv1alpha1.Work{}
+		if err := c.Client.Get(ctx, client.ObjectKeyFromObject(work), newer); err != nil {
+			return err
+		}
+		if newer.Annotations[workv1alpha2.WorkLastAppliedHashAnnotation] == appliedHash {
+			return nil
+		}
+		if newer.Annotations == nil {
+			newer.Annotations = map[string]string{}
+		}
+		newer.Annotations[workv1alpha2.WorkLastAppliedHashAnnotation] = appliedHash
+		return c.Client.Update(ctx, newer)
+	})
+}
+
 func (c *Controller) eventf(object *unstructured.Unstructured, eventType, reason, messageFmt string, args ...interface{}) {
 	ref, err := helper.GenEventRef(object)
 	if err != nil {
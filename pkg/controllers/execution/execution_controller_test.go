@@ -39,12 +39,14 @@ import (
 
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
 	"github.com/karmada-io/karmada/pkg/events"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter/default/native"
 	"github.com/karmada-io/karmada/pkg/util"
 	"github.com/karmada-io/karmada/pkg/util/fedinformer/genericmanager"
 	"github.com/karmada-io/karmada/pkg/util/gclient"
+	"github.com/karmada-io/karmada/pkg/util/indexregistry"
 	"github.com/karmada-io/karmada/pkg/util/objectwatcher"
 	testhelper "github.com/karmada-io/karmada/test/helper"
 )
@@ -275,3 +277,106 @@ func newCluster(name string, clusterType string, clusterStatus metav1.ConditionS
 func (f FakeResourceInterpreter) Start(context.Context) error {
 	return nil
 }
+
+func TestAcquireRolloutBudget(t *testing.T) {
+	bindingID := "93162d3c-8993-4467-b87f-78e3a0c2e942"
+	newBudgetWork := func(name string, generation, appliedGeneration int64) *workv1alpha1.Work {
+		work := testhelper.NewWork(name, "karmada-es-"+name, string(uuid.NewUUID()), nil)
+		work.Generation = generation
+		work.Labels = map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: bindingID}
+		work.Annotations = map[string]string{
+			workv1alpha2.ResourceBindingNamespaceAnnotationKey: "default",
+			workv1alpha2.ResourceBindingNameAnnotationKey:      "nginx-deployment",
+		}
+		if appliedGeneration > 0 {
+			work.Status.Conditions = []metav1.Condition{{
+				Type:               workv1alpha1.WorkApplied,
+				Status:             metav1.ConditionTrue,
+				Reason:             "AppliedSuccessful",
+				ObservedGeneration: appliedGeneration,
+				LastTransitionTime: metav1.Now(),
+			}}
+		}
+		return work
+	}
+	newBudgetBinding := func(maxConcurrentClusters *int32) *workv1alpha2.ResourceBinding {
+		return &workv1alpha2.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "nginx-deployment",
+				Labels:    map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: bindingID},
+			},
+			Spec: workv1alpha2.ResourceBindingSpec{MaxConcurrentClusters: maxConcurrentClusters},
+		}
+	}
+
+	tests := []struct {
+		name                  string
+		maxConcurrentClusters *int32
+		work                  *workv1alpha1.Work
+		siblings              []*workv1alpha1.Work
+		wantProceed           bool
+	}{
+		{
+			name:        "no limit set",
+			work:        newBudgetWork("member1", 2, 1),
+			wantProceed: true,
+		},
+		{
+			name:                  "first pending work within budget",
+			maxConcurrentClusters: ptr.To[int32](1),
+			work:                  newBudgetWork("member1", 2, 1),
+			siblings:              []*workv1alpha1.Work{newBudgetWork("member2", 2, 1)},
+			wantProceed:           true,
+		},
+		{
+			name:                  "second pending work exceeds budget",
+			maxConcurrentClusters: ptr.To[int32](1),
+			work:                  newBudgetWork("member2", 2, 1),
+			siblings:              []*workv1alpha1.Work{newBudgetWork("member1", 2, 1)},
+			wantProceed:           false,
+		},
+		{
+			name:                  "budget freed once sibling is up to date",
+			maxConcurrentClusters: ptr.To[int32](1),
+			work:                  newBudgetWork("member2", 2, 1),
+			siblings:              []*workv1alpha1.Work{newBudgetWork("member1", 2, 2)},
+			wantProceed:           true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects := []client.Object{newBudgetBinding(tt.maxConcurrentClusters), tt.work}
+			for _, sibling := range tt.siblings {
+				objects = append(objects, sibling)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).
+				WithIndex(&workv1alpha1.Work{}, indexregistry.WorkIndexByResourceBindingID,
+					indexregistry.GenLabelIndexerFunc(workv1alpha2.ResourceBindingPermanentIDLabel)).
+				WithObjects(objects...).
+				WithStatusSubresource(tt.work).
+				Build()
+			c := Controller{Client: fakeClient, EventRecorder: record.NewFakeRecorder(1024)}
+
+			proceed, err := c.acquireRolloutBudget(context.TODO(), tt.work)
+			if err != nil {
+				t.Fatalf("acquireRolloutBudget() unexpected error: %v", err)
+			}
+			if proceed != tt.wantProceed {
+				t.Errorf("acquireRolloutBudget() = %v, want %v", proceed, tt.wantProceed)
+			}
+
+			updatedWork := &workv1alpha1.Work{}
+			if err := fakeClient.Get(context.TODO(), client.ObjectKey{Namespace: tt.work.Namespace, Name: tt.work.Name}, updatedWork); err != nil {
+				t.Fatalf("failed to get work: %v", err)
+			}
+			pendingCondition := meta.FindStatusCondition(updatedWork.Status.Conditions, workv1alpha1.WorkRolloutPending)
+			if !tt.wantProceed && (pendingCondition == nil || pendingCondition.Status != metav1.ConditionTrue) {
+				t.Errorf("expected a true RolloutPending condition on a waiting work, got: %+v", pendingCondition)
+			}
+			if tt.wantProceed && pendingCondition != nil && pendingCondition.Status == metav1.ConditionTrue {
+				t.Errorf("expected no true RolloutPending condition on a proceeding work, got: %+v", pendingCondition)
+			}
+		})
+	}
+}
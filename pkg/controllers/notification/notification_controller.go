@@ -0,0 +1,258 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notification forwards key lifecycle events, e.g. successful
+// propagation, cluster failover or policy preemption, to a webhook endpoint
+// configured per policy via annotations. Delivery failures are retried with
+// the controller's rate-limited queue.
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"text/template"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/events"
+	"github.com/karmada-io/karmada/pkg/sharedcli/ratelimiterflag"
+)
+
+const (
+	// ControllerName is the controller name that will be used when reporting events and metrics.
+	ControllerName = "notification-controller"
+
+	// webhookTimeout bounds a single delivery attempt, failed attempts are
+	// retried by the rate-limited queue.
+	webhookTimeout = 10 * time.Second
+)
+
+// notifiableReasons are the event reasons considered key lifecycle events
+// worth forwarding to the configured webhook.
+var notifiableReasons = sets.New(
+	events.EventReasonSyncWorkSucceed,
+	events.EventReasonWorkDispatching,
+	events.EventReasonEvictWorkloadFromClusterSucceed,
+	events.EventReasonEvictWorkloadFromClusterFailed,
+	events.EventReasonPreemptPolicySucceed,
+	events.EventReasonPreemptPolicyFailed,
+)
+
+// Controller watches control plane events and POSTs the notifiable ones to
+// the webhook endpoint annotated on the policy that propagates the involved
+// resource.
+type Controller struct {
+	client.Client
+	RateLimiterOptions ratelimiterflag.Options
+
+	// HTTPClient performs the webhook deliveries, exposed for tests.
+	HTTPClient *http.Client
+}
+
+// Payload is the data rendered into the webhook body. The Text field makes
+// the default JSON body directly consumable by Slack incoming webhooks.
+type Payload struct {
+	Reason    string `json:"reason"`
+	Message   string `json:"message"`
+	Type      string `json:"type"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Timestamp string `json:"timestamp"`
+	Text      string `json:"text"`
+}
+
+// Reconcile performs a full reconciliation for the object referred to by the Request.
+func (c *Controller) Reconcile(ctx context.Context, req controllerruntime.Request) (controllerruntime.Result, error) {
+	evt := &corev1.Event{}
+	if err := c.Client.Get(ctx, req.NamespacedName, evt); err != nil {
+		if apierrors.IsNotFound(err) {
+			return controllerruntime.Result{}, nil
+		}
+		return controllerruntime.Result{}, err
+	}
+
+	if !notifiableReasons.Has(evt.Reason) {
+		return controllerruntime.Result{}, nil
+	}
+
+	webhookURL, payloadTemplate, err := c.notificationConfigFor(ctx, evt)
+	if err != nil {
+		return controllerruntime.Result{}, err
+	}
+	if len(webhookURL) == 0 {
+		return controllerruntime.Result{}, nil
+	}
+
+	body, err := renderPayload(newPayload(evt), payloadTemplate)
+	if err != nil {
+		// a broken template cannot be recovered by retrying
+		klog.Errorf("Failed to render notification payload for event %s: %v", req.NamespacedName.String(), err)
+		return controllerruntime.Result{}, nil
+	}
+
+	return controllerruntime.Result{}, c.deliver(ctx, webhookURL, body)
+}
+
+// notificationConfigFor resolves the webhook annotations, first from the
+// involved object itself, then from the policy that propagates it.
+func (c *Controller) notificationConfigFor(ctx context.Context, evt *corev1.Event) (webhookURL, payloadTemplate string, err error) {
+	objMeta := &metav1.PartialObjectMetadata{}
+	objMeta.SetGroupVersionKind(evt.InvolvedObject.GroupVersionKind())
+	key := types.NamespacedName{Namespace: evt.InvolvedObject.Namespace, Name: evt.InvolvedObject.Name}
+	if err := c.Client.Get(ctx, key, objMeta); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return "", "", nil
+		}
+		return "", "", err
+	}
+
+	if url, exist := objMeta.Annotations[policyv1alpha1.NotificationWebhookAnnotation]; exist {
+		return url, objMeta.Annotations[policyv1alpha1.NotificationTemplateAnnotation], nil
+	}
+
+	// events on bindings fall back to the annotations of the policy that
+	// produced the binding, located by the permanent-id label
+	policyAnnotations, err := c.policyAnnotationsFor(ctx, objMeta.Labels)
+	if err != nil {
+		return "", "", err
+	}
+	return policyAnnotations[policyv1alpha1.NotificationWebhookAnnotation],
+		policyAnnotations[policyv1alpha1.NotificationTemplateAnnotation], nil
+}
+
+func (c *Controller) policyAnnotationsFor(ctx context.Context, involvedLabels map[string]string) (map[string]string, error) {
+	if permanentID, exist := involvedLabels[policyv1alpha1.PropagationPolicyPermanentIDLabel]; exist {
+		policyList := &policyv1alpha1.PropagationPolicyList{}
+		if err := c.Client.List(ctx, policyList, client.MatchingLabels{policyv1alpha1.PropagationPolicyPermanentIDLabel: permanentID}); err != nil {
+			return nil, err
+		}
+		if len(policyList.Items) > 0 {
+			return policyList.Items[0].Annotations, nil
+		}
+	}
+	if permanentID, exist := involvedLabels[policyv1alpha1.ClusterPropagationPolicyPermanentIDLabel]; exist {
+		policyList := &policyv1alpha1.ClusterPropagationPolicyList{}
+		if err := c.Client.List(ctx, policyList, client.MatchingLabels{policyv1alpha1.ClusterPropagationPolicyPermanentIDLabel: permanentID}); err != nil {
+			return nil, err
+		}
+		if len(policyList.Items) > 0 {
+			return policyList.Items[0].Annotations, nil
+		}
+	}
+	return nil, nil
+}
+
+// deliver POSTs the body to the webhook, a non-2xx response is an error so
+// the delivery gets retried with backoff.
+func (c *Controller) deliver(ctx context.Context, webhookURL string, body []byte) error {
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: webhookTimeout}
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %s", response.Status)
+	}
+	return nil
+}
+
+// newPayload builds the payload for the event.
+func newPayload(evt *corev1.Event) Payload {
+	timestamp := evt.LastTimestamp.Time
+	if timestamp.IsZero() {
+		timestamp = evt.CreationTimestamp.Time
+	}
+	return Payload{
+		Reason:    evt.Reason,
+		Message:   evt.Message,
+		Type:      evt.Type,
+		Kind:      evt.InvolvedObject.Kind,
+		Namespace: evt.InvolvedObject.Namespace,
+		Name:      evt.InvolvedObject.Name,
+		Timestamp: timestamp.Format(time.RFC3339),
+		Text: fmt.Sprintf("[Karmada] %s %s/%s: %s", evt.InvolvedObject.Kind,
+			evt.InvolvedObject.Namespace, evt.InvolvedObject.Name, evt.Message),
+	}
+}
+
+// renderPayload renders the webhook body, either the default JSON envelope or
+// the custom template from the policy annotation.
+func renderPayload(payload Payload, payloadTemplate string) ([]byte, error) {
+	if len(payloadTemplate) == 0 {
+		return json.Marshal(payload)
+	}
+
+	tmpl, err := template.New("payload").Parse(payloadTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, payload); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// SetupWithManager creates a controller and register to controller manager.
+func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
+	notifiable := func(obj client.Object) bool {
+		evt, ok := obj.(*corev1.Event)
+		return ok && notifiableReasons.Has(evt.Reason)
+	}
+	// events are delivered once on creation, follow-up count bumps of the
+	// deduplicated event do not re-notify
+	notificationPredicateFn := predicate.Funcs{
+		CreateFunc:  func(createEvent event.CreateEvent) bool { return notifiable(createEvent.Object) },
+		UpdateFunc:  func(event.UpdateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&corev1.Event{}, builder.WithPredicates(notificationPredicateFn)).
+		WithOptions(controller.Options{RateLimiter: ratelimiterflag.DefaultControllerRateLimiter[controllerruntime.Request](c.RateLimiterOptions)}).
+		Complete(c)
+}
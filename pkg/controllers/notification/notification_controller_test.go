@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/events"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+)
+
+func TestRenderPayload(t *testing.T) {
+	payload := Payload{Reason: "PreemptPolicySucceed", Kind: "Deployment", Namespace: "default", Name: "nginx", Text: "hello"}
+
+	tests := []struct {
+		name            string
+		payloadTemplate string
+		want            string
+		wantErr         bool
+	}{
+		{
+			name: "default JSON envelope",
+			want: "",
+		},
+		{
+			name:            "custom template",
+			payloadTemplate: `{"summary":"{{.Kind}}/{{.Name}} {{.Reason}}"}`,
+			want:            `{"summary":"Deployment/nginx PreemptPolicySucceed"}`,
+		},
+		{
+			name:            "broken template",
+			payloadTemplate: `{{.Unclosed`,
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := renderPayload(payload, tt.payloadTemplate)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("renderPayload() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(tt.want) > 0 {
+				if string(body) != tt.want {
+					t.Errorf("renderPayload() = %s, want %s", body, tt.want)
+				}
+				return
+			}
+			decoded := Payload{}
+			if err := json.Unmarshal(body, &decoded); err != nil {
+				t.Fatalf("default payload is not valid JSON: %v", err)
+			}
+			if decoded.Text != payload.Text {
+				t.Errorf("decoded text = %s, want %s", decoded.Text, payload.Text)
+			}
+		})
+	}
+}
+
+func TestReconcileDeliversNotification(t *testing.T) {
+	var delivered []byte
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body := make([]byte, req.ContentLength)
+		_, _ = req.Body.Read(body)
+		delivered = body
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	policy := &policyv1alpha1.PropagationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "nginx-policy",
+			Annotations: map[string]string{
+				policyv1alpha1.NotificationWebhookAnnotation: server.URL,
+			},
+		},
+	}
+	evt := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx-policy.176e5"},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: policyv1alpha1.GroupVersion.String(),
+			Kind:       "PropagationPolicy",
+			Namespace:  "default",
+			Name:       "nginx-policy",
+		},
+		Reason:  events.EventReasonPreemptPolicySucceed,
+		Message: "policy preempted",
+		Type:    corev1.EventTypeNormal,
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithObjects(policy, evt).Build()
+	controller := &Controller{Client: fakeClient, HTTPClient: server.Client()}
+
+	_, err := controller.Reconcile(context.TODO(), controllerruntime.Request{
+		NamespacedName: types.NamespacedName{Namespace: evt.Namespace, Name: evt.Name},
+	})
+	if err != nil {
+		t.Fatalf("Reconcile() unexpected error: %v", err)
+	}
+	if len(delivered) == 0 {
+		t.Fatal("expected a webhook delivery, got none")
+	}
+	payload := Payload{}
+	if err := json.Unmarshal(delivered, &payload); err != nil {
+		t.Fatalf("delivered payload is not valid JSON: %v", err)
+	}
+	if payload.Reason != events.EventReasonPreemptPolicySucceed {
+		t.Errorf("delivered reason = %s, want %s", payload.Reason, events.EventReasonPreemptPolicySucceed)
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedulingreport
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/sharedcli/ratelimiterflag"
+)
+
+// ControllerName is the controller name that will be used when reporting events and metrics.
+const ControllerName = "scheduling-report-controller"
+
+// maxRecentMigrations limits how many migration records a report retains.
+const maxRecentMigrations = 20
+
+// SchedulingReportController maintains one SchedulingReport object per
+// namespace, summarizing where the namespace's workloads are scheduled based
+// on the ResourceBindings of that namespace. The report is readable with
+// namespace-only RBAC, unlike the bindings it is derived from.
+type SchedulingReportController struct {
+	client.Client
+	RateLimiterOptions ratelimiterflag.Options
+}
+
+// Reconcile rebuilds the SchedulingReport of the namespace referred to by the Request.
+func (c *SchedulingReportController) Reconcile(ctx context.Context, req controllerruntime.Request) (controllerruntime.Result, error) {
+	klog.V(4).Infof("Reconciling SchedulingReport for namespace %s.", req.Namespace)
+
+	bindingList := &workv1alpha2.ResourceBindingList{}
+	if err := c.Client.List(ctx, bindingList, client.InNamespace(req.Namespace)); err != nil {
+		return controllerruntime.Result{}, err
+	}
+
+	report := &workv1alpha2.SchedulingReport{}
+	reportKey := client.ObjectKey{Namespace: req.Namespace, Name: workv1alpha2.DefaultSchedulingReportName}
+	err := c.Client.Get(ctx, reportKey, report)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return controllerruntime.Result{}, err
+	}
+	reportExists := err == nil
+
+	workloads := buildWorkloadSummaries(bindingList.Items)
+	if len(workloads) == 0 {
+		// Nothing is scheduled in the namespace anymore, the report has no value.
+		if reportExists {
+			if err := c.Client.Delete(ctx, report); err != nil && !apierrors.IsNotFound(err) {
+				return controllerruntime.Result{}, err
+			}
+		}
+		return controllerruntime.Result{}, nil
+	}
+
+	migrations := detectMigrations(report.Workloads, workloads, metav1.Now())
+	if reportExists && len(migrations) == 0 && reflect.DeepEqual(report.Workloads, workloads) {
+		return controllerruntime.Result{}, nil
+	}
+
+	report.Namespace = req.Namespace
+	report.Name = workv1alpha2.DefaultSchedulingReportName
+	report.Workloads = workloads
+	report.RecentMigrations = append(migrations, report.RecentMigrations...)
+	if len(report.RecentMigrations) > maxRecentMigrations {
+		report.RecentMigrations = report.RecentMigrations[:maxRecentMigrations]
+	}
+	report.LastUpdateTime = metav1.Now()
+
+	if !reportExists {
+		err = c.Client.Create(ctx, report)
+	} else {
+		err = c.Client.Update(ctx, report)
+	}
+	if err != nil {
+		return controllerruntime.Result{}, err
+	}
+	return controllerruntime.Result{}, nil
+}
+
+// buildWorkloadSummaries converts the scheduled bindings of a namespace into
+// per-workload placement summaries, sorted by kind and name for stable output.
+func buildWorkloadSummaries(bindings []workv1alpha2.ResourceBinding) []workv1alpha2.WorkloadSchedulingSummary {
+	var workloads []workv1alpha2.WorkloadSchedulingSummary
+	for i := range bindings {
+		binding := &bindings[i]
+		if !binding.DeletionTimestamp.IsZero() || len(binding.Spec.Clusters) == 0 {
+			continue
+		}
+		clusters := make([]workv1alpha2.TargetCluster, len(binding.Spec.Clusters))
+		copy(clusters, binding.Spec.Clusters)
+		sort.Slice(clusters, func(i, j int) bool { return clusters[i].Name < clusters[j].Name })
+		workloads = append(workloads, workv1alpha2.WorkloadSchedulingSummary{
+			APIVersion: binding.Spec.Resource.APIVersion,
+			Kind:       binding.Spec.Resource.Kind,
+			Name:       binding.Spec.Resource.Name,
+			Clusters:   clusters,
+		})
+	}
+	sort.Slice(workloads, func(i, j int) bool {
+		if workloads[i].Kind != workloads[j].Kind {
+			return workloads[i].Kind < workloads[j].Kind
+		}
+		return workloads[i].Name < workloads[j].Name
+	})
+	return workloads
+}
+
+// detectMigrations diffs the previously reported placement against the
+// current one and records a migration for every cluster a workload gained or
+// lost. A simultaneously removed and added cluster pair is reported as a
+// single move. Workloads that appear in or disappear from the report entirely
+// are new or deleted rather than migrated, so they produce no records.
+func detectMigrations(old, current []workv1alpha2.WorkloadSchedulingSummary, now metav1.Time) []workv1alpha2.SchedulingMigration {
+	oldClusters := make(map[string][]workv1alpha2.TargetCluster, len(old))
+	for _, workload := range old {
+		oldClusters[workloadKey(workload)] = workload.Clusters
+	}
+
+	var migrations []workv1alpha2.SchedulingMigration
+	for _, workload := range current {
+		previous, ok := oldClusters[workloadKey(workload)]
+		if !ok {
+			continue
+		}
+		removed := clusterNameDiff(previous, workload.Clusters)
+		added := clusterNameDiff(workload.Clusters, previous)
+		for len(removed) > 0 || len(added) > 0 {
+			migration := workv1alpha2.SchedulingMigration{
+				APIVersion: workload.APIVersion,
+				Kind:       workload.Kind,
+				Name:       workload.Name,
+				Timestamp:  now,
+			}
+			if len(removed) > 0 {
+				migration.FromCluster = removed[0]
+				removed = removed[1:]
+			}
+			if len(added) > 0 {
+				migration.ToCluster = added[0]
+				added = added[1:]
+			}
+			migrations = append(migrations, migration)
+		}
+	}
+	return migrations
+}
+
+func workloadKey(workload workv1alpha2.WorkloadSchedulingSummary) string {
+	return workload.APIVersion + "/" + workload.Kind + "/" + workload.Name
+}
+
+// clusterNameDiff returns the names of clusters present in a but not in b, in order.
+func clusterNameDiff(a, b []workv1alpha2.TargetCluster) []string {
+	inB := make(map[string]struct{}, len(b))
+	for _, cluster := range b {
+		inB[cluster.Name] = struct{}{}
+	}
+	var names []string
+	for _, cluster := range a {
+		if _, ok := inB[cluster.Name]; !ok {
+			names = append(names, cluster.Name)
+		}
+	}
+	return names
+}
+
+// SetupWithManager creates a controller and register to controller manager.
+func (c *SchedulingReportController) SetupWithManager(mgr controllerruntime.Manager) error {
+	// All bindings of a namespace collapse into one reconciliation of that namespace.
+	mapFunc := handler.MapFunc(func(_ context.Context, obj client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: client.ObjectKey{Namespace: obj.GetNamespace()}}}
+	})
+	bindingPredicateFn := predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return true },
+		UpdateFunc: func(updateEvent event.UpdateEvent) bool {
+			oldObj := updateEvent.ObjectOld.(*workv1alpha2.ResourceBinding)
+			newObj := updateEvent.ObjectNew.(*workv1alpha2.ResourceBinding)
+			return !reflect.DeepEqual(oldObj.Spec.Clusters, newObj.Spec.Clusters)
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return true },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&workv1alpha2.SchedulingReport{}).
+		Watches(&workv1alpha2.ResourceBinding{}, handler.EnqueueRequestsFromMapFunc(mapFunc), builder.WithPredicates(bindingPredicateFn)).
+		WithOptions(controller.Options{RateLimiter: ratelimiterflag.DefaultControllerRateLimiter[controllerruntime.Request](c.RateLimiterOptions)}).
+		Complete(c)
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedulingreport
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+)
+
+func newBinding(namespace, name, workloadName string, clusters ...workv1alpha2.TargetCluster) *workv1alpha2.ResourceBinding {
+	return &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Resource: workv1alpha2.ObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Namespace:  namespace,
+				Name:       workloadName,
+			},
+			Clusters: clusters,
+		},
+	}
+}
+
+func TestReconcileCreatesAndUpdatesReport(t *testing.T) {
+	binding := newBinding("default", "nginx-deployment", "nginx",
+		workv1alpha2.TargetCluster{Name: "member1", Replicas: 2},
+		workv1alpha2.TargetCluster{Name: "member2", Replicas: 1})
+	c := &SchedulingReportController{
+		Client: fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithObjects(binding).Build(),
+	}
+	req := controllerruntime.Request{NamespacedName: client.ObjectKey{Namespace: "default"}}
+
+	if _, err := c.Reconcile(context.TODO(), req); err != nil {
+		t.Fatalf("Reconcile() unexpected error: %v", err)
+	}
+
+	report := &workv1alpha2.SchedulingReport{}
+	reportKey := client.ObjectKey{Namespace: "default", Name: workv1alpha2.DefaultSchedulingReportName}
+	if err := c.Client.Get(context.TODO(), reportKey, report); err != nil {
+		t.Fatalf("failed to get report: %v", err)
+	}
+	if len(report.Workloads) != 1 || report.Workloads[0].Name != "nginx" {
+		t.Fatalf("unexpected workloads in report: %+v", report.Workloads)
+	}
+	if !reflect.DeepEqual(report.Workloads[0].Clusters, []workv1alpha2.TargetCluster{
+		{Name: "member1", Replicas: 2}, {Name: "member2", Replicas: 1}}) {
+		t.Errorf("unexpected clusters in report: %+v", report.Workloads[0].Clusters)
+	}
+	if len(report.RecentMigrations) != 0 {
+		t.Errorf("a fresh report should not record migrations: %+v", report.RecentMigrations)
+	}
+
+	// move the workload from member2 to member3 and reconcile again
+	binding.Spec.Clusters = []workv1alpha2.TargetCluster{
+		{Name: "member1", Replicas: 2}, {Name: "member3", Replicas: 1}}
+	if err := c.Client.Update(context.TODO(), binding); err != nil {
+		t.Fatalf("failed to update binding: %v", err)
+	}
+	if _, err := c.Reconcile(context.TODO(), req); err != nil {
+		t.Fatalf("Reconcile() unexpected error: %v", err)
+	}
+	if err := c.Client.Get(context.TODO(), reportKey, report); err != nil {
+		t.Fatalf("failed to get report: %v", err)
+	}
+	if len(report.RecentMigrations) != 1 {
+		t.Fatalf("expected one migration record, got: %+v", report.RecentMigrations)
+	}
+	migration := report.RecentMigrations[0]
+	if migration.FromCluster != "member2" || migration.ToCluster != "member3" || migration.Name != "nginx" {
+		t.Errorf("unexpected migration record: %+v", migration)
+	}
+}
+
+func TestReconcileDeletesEmptyReport(t *testing.T) {
+	report := &workv1alpha2.SchedulingReport{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: workv1alpha2.DefaultSchedulingReportName},
+	}
+	c := &SchedulingReportController{
+		Client: fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithObjects(report).Build(),
+	}
+	req := controllerruntime.Request{NamespacedName: client.ObjectKey{Namespace: "default"}}
+
+	if _, err := c.Reconcile(context.TODO(), req); err != nil {
+		t.Fatalf("Reconcile() unexpected error: %v", err)
+	}
+
+	err := c.Client.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: workv1alpha2.DefaultSchedulingReportName}, report)
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected the report to be deleted, got err: %v", err)
+	}
+}
+
+func TestDetectMigrations(t *testing.T) {
+	now := metav1.Now()
+	tests := []struct {
+		name    string
+		old     []workv1alpha2.WorkloadSchedulingSummary
+		current []workv1alpha2.WorkloadSchedulingSummary
+		want    []workv1alpha2.SchedulingMigration
+	}{
+		{
+			name: "new workload produces no migration",
+			current: []workv1alpha2.WorkloadSchedulingSummary{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx", Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}}},
+			},
+		},
+		{
+			name: "move pairs removed and added clusters",
+			old: []workv1alpha2.WorkloadSchedulingSummary{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx", Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}}},
+			},
+			current: []workv1alpha2.WorkloadSchedulingSummary{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx", Clusters: []workv1alpha2.TargetCluster{{Name: "member2"}}},
+			},
+			want: []workv1alpha2.SchedulingMigration{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx", FromCluster: "member1", ToCluster: "member2", Timestamp: now},
+			},
+		},
+		{
+			name: "scale out to an additional cluster",
+			old: []workv1alpha2.WorkloadSchedulingSummary{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx", Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}}},
+			},
+			current: []workv1alpha2.WorkloadSchedulingSummary{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx", Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}, {Name: "member2"}}},
+			},
+			want: []workv1alpha2.SchedulingMigration{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx", ToCluster: "member2", Timestamp: now},
+			},
+		},
+		{
+			name: "removed from a cluster",
+			old: []workv1alpha2.WorkloadSchedulingSummary{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx", Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}, {Name: "member2"}}},
+			},
+			current: []workv1alpha2.WorkloadSchedulingSummary{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx", Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}}},
+			},
+			want: []workv1alpha2.SchedulingMigration{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx", FromCluster: "member2", Timestamp: now},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := detectMigrations(tt.old, tt.current, now)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("detectMigrations() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
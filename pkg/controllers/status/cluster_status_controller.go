@@ -47,6 +47,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/chaos"
 	"github.com/karmada-io/karmada/pkg/features"
 	"github.com/karmada-io/karmada/pkg/metrics"
 	"github.com/karmada-io/karmada/pkg/modeling"
@@ -195,6 +196,11 @@ func (c *ClusterStatusController) syncClusterStatus(ctx context.Context, cluster
 	}
 
 	online, healthy := getClusterHealthStatus(clusterClient)
+	if chaos.ClusterFlapping(cluster.Name) {
+		// Drive the simulated outage through the regular failure threshold
+		// machinery, exactly like a real one.
+		online, healthy = false, false
+	}
 	observedReadyCondition := generateReadyCondition(online, healthy)
 	readyCondition := c.clusterConditionCache.thresholdAdjustedReadyCondition(cluster, &observedReadyCondition)
 
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package uncordon uncordons clusters whose scheduled uncordon deadline,
+// recorded by `karmadactl cordon --until`, has passed, so maintenance
+// automations don't forget to reopen clusters for scheduling.
+package uncordon
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/sharedcli/ratelimiterflag"
+)
+
+// ControllerName is the controller name that will be used when reporting events and metrics.
+const ControllerName = "scheduled-uncordon-controller"
+
+// Controller watches clusters carrying a scheduled uncordon deadline and
+// removes the unschedulable taint once the deadline has passed.
+type Controller struct {
+	client.Client
+	RateLimiterOptions ratelimiterflag.Options
+}
+
+// Reconcile uncordons the cluster when its deadline has passed, or requeues
+// the cluster until then.
+func (c *Controller) Reconcile(ctx context.Context, req controllerruntime.Request) (controllerruntime.Result, error) {
+	cluster := &clusterv1alpha1.Cluster{}
+	if err := c.Get(ctx, req.NamespacedName, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return controllerruntime.Result{}, nil
+		}
+		return controllerruntime.Result{}, err
+	}
+	if !cluster.DeletionTimestamp.IsZero() {
+		return controllerruntime.Result{}, nil
+	}
+
+	deadlineStr, exist := cluster.Annotations[clusterv1alpha1.UncordonAfterAnnotationKey]
+	if !exist {
+		return controllerruntime.Result{}, nil
+	}
+	deadline, err := time.Parse(time.RFC3339, deadlineStr)
+	if err != nil {
+		// A malformed deadline can never fire, drop it instead of retrying.
+		klog.Errorf("Dropping malformed uncordon deadline %q of cluster(%s): %v", deadlineStr, cluster.Name, err)
+		delete(cluster.Annotations, clusterv1alpha1.UncordonAfterAnnotationKey)
+		return controllerruntime.Result{}, c.Update(ctx, cluster)
+	}
+
+	if remaining := time.Until(deadline); remaining > 0 {
+		return controllerruntime.Result{RequeueAfter: remaining}, nil
+	}
+
+	removeUnschedulerTaint(cluster)
+	delete(cluster.Annotations, clusterv1alpha1.UncordonAfterAnnotationKey)
+	delete(cluster.Annotations, clusterv1alpha1.CordonReasonAnnotationKey)
+	if err := c.Update(ctx, cluster); err != nil {
+		return controllerruntime.Result{}, err
+	}
+	klog.Infof("Uncordoned cluster(%s), its scheduled uncordon deadline(%s) has passed", cluster.Name, deadlineStr)
+	return controllerruntime.Result{}, nil
+}
+
+// removeUnschedulerTaint drops the unschedulable taint set by cordon.
+func removeUnschedulerTaint(cluster *clusterv1alpha1.Cluster) {
+	unschedulerTaint := corev1.Taint{
+		Key:    clusterv1alpha1.TaintClusterUnscheduler,
+		Effect: corev1.TaintEffectNoSchedule,
+	}
+	for i, n := 0, len(cluster.Spec.Taints); i < n; i++ {
+		if cluster.Spec.Taints[i].MatchTaint(&unschedulerTaint) {
+			cluster.Spec.Taints[i] = cluster.Spec.Taints[n-1]
+			cluster.Spec.Taints = cluster.Spec.Taints[:n-1]
+			break
+		}
+	}
+}
+
+// SetupWithManager creates a controller and register to controller manager.
+func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
+	hasDeadline := func(obj client.Object) bool {
+		_, exist := obj.GetAnnotations()[clusterv1alpha1.UncordonAfterAnnotationKey]
+		return exist
+	}
+	clusterPredicate := predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return hasDeadline(e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return hasDeadline(e.ObjectNew)
+		},
+		DeleteFunc: func(event.DeleteEvent) bool {
+			return false
+		},
+	}
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&clusterv1alpha1.Cluster{}, builder.WithPredicates(clusterPredicate)).
+		WithOptions(controller.Options{RateLimiter: ratelimiterflag.DefaultControllerRateLimiter[controllerruntime.Request](c.RateLimiterOptions)}).
+		Complete(c)
+}
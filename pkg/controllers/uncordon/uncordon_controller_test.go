@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uncordon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+)
+
+func newCordonedCluster(name, deadline string) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{clusterv1alpha1.UncordonAfterAnnotationKey: deadline},
+		},
+		Spec: clusterv1alpha1.ClusterSpec{
+			Taints: []corev1.Taint{
+				{Key: clusterv1alpha1.TaintClusterUnscheduler, Effect: corev1.TaintEffectNoSchedule},
+			},
+		},
+	}
+}
+
+func TestReconcileScheduledUncordon(t *testing.T) {
+	tests := []struct {
+		name          string
+		deadline      string
+		wantRequeue   bool
+		wantTaintGone bool
+	}{
+		{
+			name:          "deadline passed, cluster uncordoned",
+			deadline:      time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+			wantTaintGone: true,
+		},
+		{
+			name:        "deadline in the future, requeued",
+			deadline:    time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+			wantRequeue: true,
+		},
+		{
+			name:          "malformed deadline, annotation dropped",
+			deadline:      "not-a-timestamp",
+			wantTaintGone: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := newCordonedCluster("member1", tt.deadline)
+			fakeClient := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithObjects(cluster).Build()
+			c := &Controller{Client: fakeClient}
+
+			result, err := c.Reconcile(context.TODO(), controllerruntime.Request{NamespacedName: types.NamespacedName{Name: cluster.Name}})
+			if err != nil {
+				t.Fatalf("Reconcile() returned error: %v", err)
+			}
+			if (result.RequeueAfter > 0) != tt.wantRequeue {
+				t.Errorf("Reconcile() requeueAfter = %v, want requeue %v", result.RequeueAfter, tt.wantRequeue)
+			}
+
+			got := &clusterv1alpha1.Cluster{}
+			if err := fakeClient.Get(context.TODO(), types.NamespacedName{Name: cluster.Name}, got); err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			hasTaint := false
+			for _, taint := range got.Spec.Taints {
+				if taint.Key == clusterv1alpha1.TaintClusterUnscheduler {
+					hasTaint = true
+				}
+			}
+			if hasTaint == tt.wantTaintGone {
+				t.Errorf("cluster taint present = %v, want gone %v", hasTaint, tt.wantTaintGone)
+			}
+			if _, exist := got.Annotations[clusterv1alpha1.UncordonAfterAnnotationKey]; exist != tt.wantRequeue {
+				t.Errorf("deadline annotation present = %v, want %v", exist, tt.wantRequeue)
+			}
+		})
+	}
+}
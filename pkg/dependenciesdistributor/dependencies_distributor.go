@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -113,6 +114,11 @@ type DependenciesDistributor struct {
 	genericEvent      chan event.TypedGenericEvent[*workv1alpha2.ResourceBinding]
 	// ConcurrentDependentResourceSyncs is the number of dependent resource that are allowed to sync concurrently.
 	ConcurrentDependentResourceSyncs int
+	// DependencyGCGracePeriod is how long an attached binding stays orphaned
+	// before its schedule result is removed, protecting dependencies from
+	// being cleaned up by transient reference changes. Zero means orphaned
+	// dependencies are cleaned up immediately.
+	DependencyGCGracePeriod time.Duration
 }
 
 // Check if our DependenciesDistributor implements necessary interfaces
@@ -290,7 +296,13 @@ func (d *DependenciesDistributor) Reconcile(ctx context.Context, request reconci
 		klog.Errorf("Failed to add finalizer(%s) for ResourceBinding(%s): %v", util.BindingDependenciesDistributorFinalizer, request.NamespacedName, err)
 		return reconcile.Result{}, err
 	}
-	return reconcile.Result{}, d.syncScheduleResultToAttachedBindings(ctx, bindingObject, dependencies)
+	requeueAfter, err := d.syncScheduleResultToAttachedBindings(ctx, bindingObject, dependencies)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	// Some orphaned dependencies may still be within the GC grace period,
+	// come back when the earliest one is due.
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (d *DependenciesDistributor) addFinalizer(ctx context.Context, independentBinding *workv1alpha2.ResourceBinding) error {
@@ -316,23 +328,87 @@ func (d *DependenciesDistributor) handleIndependentBindingDeletion(id, namespace
 	return d.removeScheduleResultFromAttachedBindings(namespace, name, attachedBindings)
 }
 
-func (d *DependenciesDistributor) removeOrphanAttachedBindings(ctx context.Context, independentBinding *workv1alpha2.ResourceBinding, dependencies []configv1alpha1.DependentObjectReference) error {
+func (d *DependenciesDistributor) removeOrphanAttachedBindings(ctx context.Context, independentBinding *workv1alpha2.ResourceBinding, dependencies []configv1alpha1.DependentObjectReference) (time.Duration, error) {
 	// remove orphan attached bindings
 	orphanBindings, err := d.findOrphanAttachedBindings(ctx, independentBinding, dependencies)
 	if err != nil {
 		klog.Errorf("Failed to find orphan attached bindings for resourceBinding(%s/%s). Error: %v.",
 			independentBinding.GetNamespace(), independentBinding.GetName(), err)
-		return err
+		return 0, err
 	}
-	err = d.removeScheduleResultFromAttachedBindings(independentBinding.Namespace, independentBinding.Name, orphanBindings)
+	dueBindings, requeueAfter, err := d.filterDueOrphanBindings(ctx, orphanBindings)
+	if err != nil {
+		klog.Errorf("Failed to mark orphan attached bindings of resourceBinding(%s/%s) eligible for GC. Error: %v.",
+			independentBinding.GetNamespace(), independentBinding.GetName(), err)
+		return 0, err
+	}
+	err = d.removeScheduleResultFromAttachedBindings(independentBinding.Namespace, independentBinding.Name, dueBindings)
 	if err != nil {
 		klog.Errorf("Failed to remove orphan attached bindings by resourceBinding(%s/%s). Error: %v.",
 			independentBinding.GetNamespace(), independentBinding.GetName(), err)
+		return 0, err
+	}
+	for _, binding := range dueBindings {
+		d.EventRecorder.Eventf(independentBinding, corev1.EventTypeNormal, events.EventReasonCleanupOrphanDependencySucceed,
+			"Removed orphan dependency %s(%s/%s).", binding.Spec.Resource.Kind, binding.Spec.Resource.Namespace, binding.Spec.Resource.Name)
+	}
+	return requeueAfter, nil
+}
+
+// filterDueOrphanBindings implements the garbage collection grace period for
+// orphaned dependencies. Bindings found orphan for the first time are only
+// marked eligible for GC, and are returned as due once the grace period has
+// elapsed. The returned duration tells the caller when to reconcile again to
+// collect the bindings which are still within their grace period.
+func (d *DependenciesDistributor) filterDueOrphanBindings(ctx context.Context, orphanBindings []*workv1alpha2.ResourceBinding) ([]*workv1alpha2.ResourceBinding, time.Duration, error) {
+	if d.DependencyGCGracePeriod <= 0 {
+		return orphanBindings, 0, nil
+	}
+
+	now := time.Now()
+	var dueBindings []*workv1alpha2.ResourceBinding
+	var requeueAfter time.Duration
+	var errs []error
+	for _, binding := range orphanBindings {
+		eligibleSince, err := time.Parse(time.RFC3339, binding.Annotations[workv1alpha2.DependencyGCEligibleSinceAnnotation])
+		if err != nil {
+			// The binding just became orphan(or carries an unparsable
+			// timestamp), start its grace period from now.
+			if err := d.markEligibleForGC(ctx, binding, now); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			requeueAfter = minRequeueAfter(requeueAfter, d.DependencyGCGracePeriod)
+			continue
+		}
+		if wait := eligibleSince.Add(d.DependencyGCGracePeriod).Sub(now); wait > 0 {
+			requeueAfter = minRequeueAfter(requeueAfter, wait)
+			continue
+		}
+		dueBindings = append(dueBindings, binding)
+	}
+	return dueBindings, requeueAfter, utilerrors.NewAggregate(errs)
+}
+
+func (d *DependenciesDistributor) markEligibleForGC(ctx context.Context, binding *workv1alpha2.ResourceBinding, now time.Time) error {
+	if binding.Annotations == nil {
+		binding.Annotations = make(map[string]string, 1)
+	}
+	binding.Annotations[workv1alpha2.DependencyGCEligibleSinceAnnotation] = now.UTC().Format(time.RFC3339)
+	if err := d.Client.Update(ctx, binding); err != nil {
+		klog.Errorf("Failed to mark binding(%s/%s) eligible for GC: %v", binding.Namespace, binding.Name, err)
 		return err
 	}
 	return nil
 }
 
+func minRequeueAfter(current, candidate time.Duration) time.Duration {
+	if current == 0 || candidate < current {
+		return candidate
+	}
+	return current
+}
+
 func (d *DependenciesDistributor) handleDependentResource(
 	ctx context.Context,
 	independentBinding *workv1alpha2.ResourceBinding,
@@ -378,7 +454,7 @@ func (d *DependenciesDistributor) handleDependentResource(
 	return fmt.Errorf("the Name and LabelSelector in the DependentObjectReference cannot be empty at the same time")
 }
 
-func (d *DependenciesDistributor) syncScheduleResultToAttachedBindings(ctx context.Context, independentBinding *workv1alpha2.ResourceBinding, dependencies []configv1alpha1.DependentObjectReference) (err error) {
+func (d *DependenciesDistributor) syncScheduleResultToAttachedBindings(ctx context.Context, independentBinding *workv1alpha2.ResourceBinding, dependencies []configv1alpha1.DependentObjectReference) (requeueAfter time.Duration, err error) {
 	defer func() {
 		if err != nil {
 			d.EventRecorder.Eventf(independentBinding, corev1.EventTypeWarning, events.EventReasonSyncScheduleResultToDependenciesFailed, err.Error())
@@ -388,10 +464,10 @@ func (d *DependenciesDistributor) syncScheduleResultToAttachedBindings(ctx conte
 	}()
 
 	if err = d.recordDependencies(ctx, independentBinding, dependencies); err != nil {
-		return err
+		return 0, err
 	}
-	if err = d.removeOrphanAttachedBindings(ctx, independentBinding, dependencies); err != nil {
-		return err
+	if requeueAfter, err = d.removeOrphanAttachedBindings(ctx, independentBinding, dependencies); err != nil {
+		return 0, err
 	}
 
 	// create or update attached bindings
@@ -413,7 +489,7 @@ func (d *DependenciesDistributor) syncScheduleResultToAttachedBindings(ctx conte
 		d.InformerManager.Start()
 		d.InformerManager.WaitForCacheSync()
 	}
-	return utilerrors.NewAggregate(errs)
+	return requeueAfter, utilerrors.NewAggregate(errs)
 }
 
 func (d *DependenciesDistributor) recordDependencies(ctx context.Context, independentBinding *workv1alpha2.ResourceBinding, dependencies []configv1alpha1.DependentObjectReference) error {
@@ -554,6 +630,7 @@ func (d *DependenciesDistributor) removeScheduleResultFromAttachedBindings(bindi
 	var errs []error
 	for index, binding := range attachedBindings {
 		delete(attachedBindings[index].Labels, bindingLabelKey)
+		delete(attachedBindings[index].Annotations, workv1alpha2.DependencyGCEligibleSinceAnnotation)
 		updatedSnapshot := deleteBindingFromSnapshot(bindingNamespace, bindingName, attachedBindings[index].Spec.RequiredBy)
 		attachedBindings[index].Spec.RequiredBy = updatedSnapshot
 		attachedBindings[index].Spec.PreserveResourcesOnDeletion = nil
@@ -583,6 +660,8 @@ func (d *DependenciesDistributor) createOrUpdateAttachedBinding(attachedBinding
 		if existBinding.Spec.Placement == nil {
 			existBinding.Spec.ConflictResolution = attachedBinding.Spec.ConflictResolution
 		}
+		// The dependency is referenced again, cancel any pending garbage collection.
+		delete(existBinding.Annotations, workv1alpha2.DependencyGCEligibleSinceAnnotation)
 		existBinding.Spec.RequiredBy = mergeBindingSnapshot(existBinding.Spec.RequiredBy, attachedBinding.Spec.RequiredBy)
 		existBinding.Labels = util.DedupeAndMergeLabels(existBinding.Labels, attachedBinding.Labels)
 		existBinding.Spec.Resource = attachedBinding.Spec.Resource
@@ -34,6 +34,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -919,8 +920,9 @@ func Test_removeOrphanAttachedBindings(t *testing.T) {
 				DynamicClient:   tt.fields.DynamicClient,
 				InformerManager: tt.fields.InformerManager,
 				RESTMapper:      tt.fields.RESTMapper,
+				EventRecorder:   record.NewFakeRecorder(1024),
 			}
-			err := d.removeOrphanAttachedBindings(context.Background(), tt.args.independentBinding, tt.args.dependencies)
+			_, err := d.removeOrphanAttachedBindings(context.Background(), tt.args.independentBinding, tt.args.dependencies)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("removeOrphanAttachedBindings() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -937,6 +939,92 @@ func Test_removeOrphanAttachedBindings(t *testing.T) {
 	}
 }
 
+func Test_filterDueOrphanBindings(t *testing.T) {
+	newScheme := func() *runtime.Scheme {
+		Scheme := runtime.NewScheme()
+		utilruntime.Must(scheme.AddToScheme(Scheme))
+		utilruntime.Must(workv1alpha2.Install(Scheme))
+		return Scheme
+	}
+	newBinding := func(eligibleSince string) *workv1alpha2.ResourceBinding {
+		rb := &workv1alpha2.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-binding-1",
+				Namespace: "test",
+			},
+		}
+		if eligibleSince != "" {
+			rb.Annotations = map[string]string{workv1alpha2.DependencyGCEligibleSinceAnnotation: eligibleSince}
+		}
+		return rb
+	}
+
+	tests := []struct {
+		name          string
+		gracePeriod   time.Duration
+		binding       *workv1alpha2.ResourceBinding
+		wantDue       int
+		wantRequeue   bool
+		wantAnnotated bool
+	}{
+		{
+			name:        "zero grace period collects immediately",
+			gracePeriod: 0,
+			binding:     newBinding(""),
+			wantDue:     1,
+		},
+		{
+			name:          "first seen orphan is only marked",
+			gracePeriod:   time.Hour,
+			binding:       newBinding(""),
+			wantDue:       0,
+			wantRequeue:   true,
+			wantAnnotated: true,
+		},
+		{
+			name:          "orphan within grace period is kept",
+			gracePeriod:   time.Hour,
+			binding:       newBinding(time.Now().UTC().Format(time.RFC3339)),
+			wantDue:       0,
+			wantRequeue:   true,
+			wantAnnotated: true,
+		},
+		{
+			name:          "orphan past grace period is due",
+			gracePeriod:   time.Hour,
+			binding:       newBinding(time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)),
+			wantDue:       1,
+			wantAnnotated: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &DependenciesDistributor{
+				Client:                  fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(tt.binding).Build(),
+				DependencyGCGracePeriod: tt.gracePeriod,
+			}
+			due, requeueAfter, err := d.filterDueOrphanBindings(context.Background(), []*workv1alpha2.ResourceBinding{tt.binding})
+			if err != nil {
+				t.Fatalf("filterDueOrphanBindings() unexpected error: %v", err)
+			}
+			if len(due) != tt.wantDue {
+				t.Errorf("filterDueOrphanBindings() due = %d, want %d", len(due), tt.wantDue)
+			}
+			if (requeueAfter > 0) != tt.wantRequeue {
+				t.Errorf("filterDueOrphanBindings() requeueAfter = %v, wantRequeue %v", requeueAfter, tt.wantRequeue)
+			}
+			updated := &workv1alpha2.ResourceBinding{}
+			if err := d.Client.Get(context.Background(), client.ObjectKeyFromObject(tt.binding), updated); err != nil {
+				t.Fatalf("failed to get binding: %v", err)
+			}
+			_, annotated := updated.Annotations[workv1alpha2.DependencyGCEligibleSinceAnnotation]
+			if annotated != tt.wantAnnotated {
+				t.Errorf("filterDueOrphanBindings() annotated = %v, want %v", annotated, tt.wantAnnotated)
+			}
+		})
+	}
+}
+
 func Test_handleDependentResource(t *testing.T) {
 	type fields struct {
 		Client          client.Client
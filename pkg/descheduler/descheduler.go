@@ -74,6 +74,11 @@ type Descheduler struct {
 	unschedulableThreshold time.Duration
 	deschedulingInterval   time.Duration
 	deschedulerWorker      util.AsyncWorker
+
+	// pressureTracker records the utilization trend of member clusters to
+	// trigger rescheduling on sustained resource pressure. It is nil unless
+	// cluster pressure descheduling is enabled.
+	pressureTracker *clusterPressureTracker
 }
 
 // NewDescheduler instantiates a descheduler
@@ -100,6 +105,13 @@ func NewDescheduler(karmadaClient karmadaclientset.Interface, kubeClient kuberne
 		unschedulableThreshold:             opts.UnschedulableThreshold.Duration,
 		deschedulingInterval:               opts.DeschedulingInterval.Duration,
 	}
+	if opts.EnableClusterPressureDescheduling {
+		desched.pressureTracker = newClusterPressureTracker(pressureThresholds{
+			cpu:    opts.ClusterPressureCPUThreshold,
+			memory: opts.ClusterPressureMemoryThreshold,
+			pods:   opts.ClusterPressurePodThreshold,
+		}, opts.ClusterPressureRequiredSamples)
+	}
 	// ignore the error here because the informers haven't been started
 	_ = desched.bindingInformer.SetTransform(fedinformer.StripUnusedFields)
 	_ = desched.clusterInformer.SetTransform(fedinformer.StripUnusedFields)
@@ -153,6 +165,9 @@ func (d *Descheduler) Run(ctx context.Context) {
 	}
 
 	go wait.Until(d.descheduleOnce, d.deschedulingInterval, ctx.Done())
+	if d.pressureTracker != nil {
+		go wait.Until(d.detectClusterPressureOnce, d.deschedulingInterval, ctx.Done())
+	}
 	d.deschedulerWorker.Run(ctx, 1)
 
 	<-ctx.Done()
@@ -276,6 +291,9 @@ func (d *Descheduler) deleteCluster(obj interface{}) {
 		return
 	}
 	klog.V(4).Infof("Receiving delete event for cluster %s", cluster.Name)
+	if d.pressureTracker != nil {
+		d.pressureTracker.Forget(cluster.Name)
+	}
 	d.schedulerEstimatorWorker.Add(cluster.Name)
 }
 
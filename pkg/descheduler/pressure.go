@@ -0,0 +1,191 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/descheduler/core"
+	"github.com/karmada-io/karmada/pkg/events"
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+// pressureThresholds holds the utilization percentages above which a member
+// cluster is considered under resource pressure. A non-positive threshold
+// disables the corresponding dimension.
+type pressureThresholds struct {
+	// cpu is the allocated CPU percentage of the allocatable CPU.
+	cpu int
+	// memory is the allocated memory percentage of the allocatable memory.
+	memory int
+	// pods is the allocated pod count percentage of the allocatable pod count.
+	pods int
+}
+
+// clusterPressureTracker records the recent utilization trend of member
+// clusters and reports clusters whose utilization stays above the thresholds,
+// so that a single spiky resource summary does not trigger rescheduling.
+type clusterPressureTracker struct {
+	thresholds pressureThresholds
+	// requiredSamples is the number of consecutive over-threshold
+	// observations before a cluster is reported as under pressure.
+	requiredSamples int
+
+	mu sync.Mutex
+	// overThreshold counts the consecutive over-threshold observations per
+	// cluster, one observation under the thresholds resets the count.
+	overThreshold map[string]int
+}
+
+func newClusterPressureTracker(thresholds pressureThresholds, requiredSamples int) *clusterPressureTracker {
+	return &clusterPressureTracker{
+		thresholds:      thresholds,
+		requiredSamples: requiredSamples,
+		overThreshold:   make(map[string]int),
+	}
+}
+
+// Observe records one utilization sample of the cluster. It returns true when
+// the cluster has been over at least one threshold for the required number of
+// consecutive samples, and resets the trend afterwards so that another full
+// window must elapse before the cluster is reported again.
+func (t *clusterPressureTracker) Observe(cluster *clusterv1alpha1.Cluster) bool {
+	pressured := clusterUnderPressure(cluster.Status.ResourceSummary, t.thresholds)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !pressured {
+		delete(t.overThreshold, cluster.Name)
+		return false
+	}
+	t.overThreshold[cluster.Name]++
+	if t.overThreshold[cluster.Name] < t.requiredSamples {
+		return false
+	}
+	delete(t.overThreshold, cluster.Name)
+	return true
+}
+
+// Forget drops the recorded trend of the cluster.
+func (t *clusterPressureTracker) Forget(cluster string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.overThreshold, cluster)
+}
+
+// clusterUnderPressure tells if any resource dimension of the summary exceeds
+// its threshold.
+func clusterUnderPressure(summary *clusterv1alpha1.ResourceSummary, thresholds pressureThresholds) bool {
+	if summary == nil {
+		return false
+	}
+	return resourceExceedsThreshold(summary, corev1.ResourceCPU, thresholds.cpu) ||
+		resourceExceedsThreshold(summary, corev1.ResourceMemory, thresholds.memory) ||
+		resourceExceedsThreshold(summary, corev1.ResourcePods, thresholds.pods)
+}
+
+func resourceExceedsThreshold(summary *clusterv1alpha1.ResourceSummary, name corev1.ResourceName, threshold int) bool {
+	if threshold <= 0 {
+		return false
+	}
+	allocatable, ok := summary.Allocatable[name]
+	if !ok || allocatable.IsZero() {
+		return false
+	}
+	allocated := summary.Allocated[name]
+	return allocated.MilliValue()*100 >= allocatable.MilliValue()*int64(threshold)
+}
+
+// detectClusterPressureOnce samples the resource summary of every ready
+// member cluster and triggers the rescheduling of bindings scheduled to
+// clusters under sustained resource pressure.
+func (d *Descheduler) detectClusterPressureOnce() {
+	clusters, err := d.clusterLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("List all clusters error: %v", err)
+		return
+	}
+	for _, cluster := range clusters {
+		if !util.IsClusterReady(&cluster.Status) {
+			d.pressureTracker.Forget(cluster.Name)
+			continue
+		}
+		if d.pressureTracker.Observe(cluster) {
+			klog.V(2).Infof("Cluster(%s) is under sustained resource pressure, trigger rescheduling of its bindings", cluster.Name)
+			d.rescheduleBindingsOnPressuredCluster(cluster.Name)
+		}
+	}
+}
+
+// rescheduleBindingsOnPressuredCluster triggers a fresh scheduling of every
+// reschedulable binding with replicas in the pressured cluster, the same
+// mechanism the `karmadactl reschedule` command uses. The scheduler then
+// recalculates the replica distribution against the current cluster load.
+func (d *Descheduler) rescheduleBindingsOnPressuredCluster(clusterName string) {
+	bindings, err := d.bindingLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("List all ResourceBindings error: %v", err)
+		return
+	}
+	bindings = core.FilterBindings(bindings)
+	for _, binding := range bindings {
+		if !binding.DeletionTimestamp.IsZero() || binding.Spec.SchedulingSuspended() {
+			continue
+		}
+		// Skip bindings that are already about to be rescheduled.
+		if util.RescheduleRequired(binding.Spec.RescheduleTriggeredAt, binding.Status.LastScheduledTime) {
+			continue
+		}
+		if !scheduledToCluster(&binding.Spec, clusterName) {
+			continue
+		}
+
+		patchBytes := []byte(fmt.Sprintf(`{"spec":{"rescheduleTriggeredAt":%q}}`, metav1.Now().UTC().Format(time.RFC3339)))
+		_, err := d.KarmadaClient.WorkV1alpha2().ResourceBindings(binding.Namespace).Patch(
+			context.TODO(), binding.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+		if err != nil {
+			klog.Errorf("Failed to trigger rescheduling of ResourceBinding(%s/%s) on pressured cluster %s: %v",
+				binding.Namespace, binding.Name, clusterName, err)
+			d.eventRecorder.Event(binding, corev1.EventTypeWarning, events.EventReasonClusterPressureRescheduleFailed,
+				fmt.Sprintf("Failed to trigger rescheduling for pressured cluster %s: %v", clusterName, err))
+			continue
+		}
+		d.eventRecorder.Event(binding, corev1.EventTypeNormal, events.EventReasonClusterPressureRescheduleSucceed,
+			fmt.Sprintf("Rescheduling triggered as cluster %s is under sustained resource pressure.", clusterName))
+	}
+}
+
+// scheduledToCluster tells if the binding holds replicas in the given cluster.
+func scheduledToCluster(spec *workv1alpha2.ResourceBindingSpec, clusterName string) bool {
+	for _, target := range spec.Clusters {
+		if target.Name == clusterName && target.Replicas > 0 {
+			return true
+		}
+	}
+	return false
+}
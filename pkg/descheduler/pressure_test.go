@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/karmada-io/karmada/cmd/descheduler/app/options"
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	fakekarmadaclient "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+func buildResourceSummary(cpuAllocated, cpuAllocatable, memAllocated, memAllocatable string) *clusterv1alpha1.ResourceSummary {
+	return &clusterv1alpha1.ResourceSummary{
+		Allocatable: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpuAllocatable),
+			corev1.ResourceMemory: resource.MustParse(memAllocatable),
+		},
+		Allocated: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpuAllocated),
+			corev1.ResourceMemory: resource.MustParse(memAllocated),
+		},
+	}
+}
+
+func TestClusterUnderPressure(t *testing.T) {
+	thresholds := pressureThresholds{cpu: 80, memory: 80, pods: 90}
+	tests := []struct {
+		name    string
+		summary *clusterv1alpha1.ResourceSummary
+		want    bool
+	}{
+		{
+			name:    "nil summary is not under pressure",
+			summary: nil,
+			want:    false,
+		},
+		{
+			name:    "low utilization is not under pressure",
+			summary: buildResourceSummary("2", "10", "2Gi", "10Gi"),
+			want:    false,
+		},
+		{
+			name:    "cpu over threshold",
+			summary: buildResourceSummary("9", "10", "2Gi", "10Gi"),
+			want:    true,
+		},
+		{
+			name:    "memory over threshold",
+			summary: buildResourceSummary("2", "10", "9Gi", "10Gi"),
+			want:    true,
+		},
+		{
+			name: "pod count over threshold",
+			summary: &clusterv1alpha1.ResourceSummary{
+				Allocatable: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("100")},
+				Allocated:   corev1.ResourceList{corev1.ResourcePods: resource.MustParse("95")},
+			},
+			want: true,
+		},
+		{
+			name:    "zero allocatable is ignored",
+			summary: buildResourceSummary("2", "0", "2Gi", "10Gi"),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, clusterUnderPressure(tt.summary, thresholds))
+		})
+	}
+}
+
+func TestClusterPressureTrackerObserve(t *testing.T) {
+	pressured := &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+		Status: clusterv1alpha1.ClusterStatus{
+			ResourceSummary: buildResourceSummary("9", "10", "9Gi", "10Gi"),
+		},
+	}
+	idle := pressured.DeepCopy()
+	idle.Status.ResourceSummary = buildResourceSummary("1", "10", "1Gi", "10Gi")
+
+	tracker := newClusterPressureTracker(pressureThresholds{cpu: 80, memory: 80, pods: 90}, 2)
+
+	assert.False(t, tracker.Observe(pressured), "first over-threshold sample must not report pressure")
+	assert.True(t, tracker.Observe(pressured), "second consecutive sample must report pressure")
+	assert.False(t, tracker.Observe(pressured), "the trend must reset after reporting")
+
+	assert.False(t, tracker.Observe(idle), "an idle sample resets the trend")
+	assert.False(t, tracker.Observe(pressured))
+	assert.True(t, tracker.Observe(pressured))
+
+	tracker.Forget("member1")
+	assert.False(t, tracker.Observe(pressured), "forgotten clusters start a fresh trend")
+}
+
+func buildReschedulableBinding(t *testing.T, name string, clusters ...workv1alpha2.TargetCluster) *workv1alpha2.ResourceBinding {
+	t.Helper()
+	placement := &policyv1alpha1.Placement{
+		ReplicaScheduling: &policyv1alpha1.ReplicaSchedulingStrategy{
+			ReplicaSchedulingType:     policyv1alpha1.ReplicaSchedulingTypeDivided,
+			ReplicaDivisionPreference: policyv1alpha1.ReplicaDivisionPreferenceAggregated,
+		},
+	}
+	marshaledBytes, err := json.Marshal(placement)
+	assert.NoError(t, err)
+	return &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "default",
+			Annotations: map[string]string{util.PolicyPlacementAnnotation: string(marshaledBytes)},
+		},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Resource: workv1alpha2.ObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Namespace:  "default",
+				Name:       name,
+			},
+			Clusters: clusters,
+		},
+	}
+}
+
+func TestRescheduleBindingsOnPressuredCluster(t *testing.T) {
+	pressuredBinding := buildReschedulableBinding(t, "on-pressured", workv1alpha2.TargetCluster{Name: "member1", Replicas: 2})
+	otherBinding := buildReschedulableBinding(t, "elsewhere", workv1alpha2.TargetCluster{Name: "member2", Replicas: 2})
+
+	karmadaClient := fakekarmadaclient.NewSimpleClientset(pressuredBinding, otherBinding)
+	kubeClient := fake.NewSimpleClientset()
+	opts := &options.Options{
+		UnschedulableThreshold:            metav1.Duration{Duration: 5 * time.Minute},
+		DeschedulingInterval:              metav1.Duration{Duration: 1 * time.Minute},
+		SchedulerEstimatorPort:            8080,
+		EnableClusterPressureDescheduling: true,
+		ClusterPressureCPUThreshold:       80,
+		ClusterPressureMemoryThreshold:    80,
+		ClusterPressurePodThreshold:       90,
+		ClusterPressureRequiredSamples:    1,
+	}
+
+	descheduler := NewDescheduler(karmadaClient, kubeClient, opts)
+	assert.NotNil(t, descheduler.pressureTracker)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	descheduler.informerFactory.Start(ctx.Done())
+	assert.True(t, cache.WaitForCacheSync(ctx.Done(), descheduler.bindingInformer.HasSynced))
+
+	descheduler.rescheduleBindingsOnPressuredCluster("member1")
+
+	updated, err := karmadaClient.WorkV1alpha2().ResourceBindings("default").Get(context.TODO(), "on-pressured", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, updated.Spec.RescheduleTriggeredAt, "binding on the pressured cluster must be marked for rescheduling")
+
+	untouched, err := karmadaClient.WorkV1alpha2().ResourceBindings("default").Get(context.TODO(), "elsewhere", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, untouched.Spec.RescheduleTriggeredAt, "bindings without replicas on the pressured cluster must be left alone")
+}
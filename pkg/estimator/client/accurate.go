@@ -93,6 +93,7 @@ func (se *SchedulerEstimator) maxAvailableReplicas(ctx context.Context, cluster
 		req.ReplicaRequirements.ResourceRequest = replicaRequirements.ResourceRequest
 		req.ReplicaRequirements.Namespace = replicaRequirements.Namespace
 		req.ReplicaRequirements.PriorityClassName = replicaRequirements.PriorityClassName
+		req.ReplicaRequirements.VolumeClaims = replicaRequirements.VolumeClaims
 		if replicaRequirements.NodeClaim != nil {
 			req.ReplicaRequirements.NodeClaim = &pb.NodeClaim{
 				NodeAffinity: replicaRequirements.NodeClaim.HardNodeAffinity,
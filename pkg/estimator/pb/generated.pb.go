@@ -519,6 +519,20 @@ func (m *ReplicaRequirements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VolumeClaims) > 0 {
+		for iNdEx := len(m.VolumeClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	i -= len(m.PriorityClassName)
 	copy(dAtA[i:], m.PriorityClassName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PriorityClassName)))
@@ -741,6 +755,12 @@ func (m *ReplicaRequirements) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.PriorityClassName)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.VolumeClaims) > 0 {
+		for _, e := range m.VolumeClaims {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -849,11 +869,17 @@ func (this *ReplicaRequirements) String() string {
 		mapStringForResourceRequest += fmt.Sprintf("%v: %v,", k, this.ResourceRequest[k8s_io_api_core_v1.ResourceName(k)])
 	}
 	mapStringForResourceRequest += "}"
+	repeatedStringForVolumeClaims := "[]PersistentVolumeClaimSpec{"
+	for _, f := range this.VolumeClaims {
+		repeatedStringForVolumeClaims += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumeClaims += "}"
 	s := strings.Join([]string{`&ReplicaRequirements{`,
 		`NodeClaim:` + strings.Replace(this.NodeClaim.String(), "NodeClaim", "NodeClaim", 1) + `,`,
 		`ResourceRequest:` + mapStringForResourceRequest + `,`,
 		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
+		`VolumeClaims:` + repeatedStringForVolumeClaims + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1755,6 +1781,40 @@ func (m *ReplicaRequirements) Unmarshal(dAtA []byte) error {
 			}
 			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeClaims = append(m.VolumeClaims, v1.PersistentVolumeClaimSpec{})
+			if err := m.VolumeClaims[len(m.VolumeClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
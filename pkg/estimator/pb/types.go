@@ -66,6 +66,12 @@ type ReplicaRequirements struct {
 	// ResourceQuota have an associated set of scopes, one of them is priority class
 	// +optional
 	PriorityClassName string `json:"priorityClassName,omitempty" protobuf:"bytes,4,opt,name=priorityClassName"`
+	// VolumeClaims represents the PersistentVolumeClaim templates claimed by each
+	// replica, e.g. derived from the volumeClaimTemplates of a StatefulSet.
+	// It is used by the scheduler estimator to check whether a cluster's storage
+	// classes can satisfy the claims before assigning replicas to it.
+	// +optional
+	VolumeClaims []corev1.PersistentVolumeClaimSpec `json:"volumeClaims,omitempty" protobuf:"bytes,5,rep,name=volumeClaims"`
 }
 
 // MaxAvailableReplicasResponse represents the response that sent by gRPC server to calculate max available replicas.
@@ -74,6 +74,13 @@ func (es *AccurateSchedulerEstimatorServer) estimateReplicas(
 		tolerations = requirements.NodeClaim.Tolerations
 	}
 
+	// Replicas claiming volumes can only go to clusters and nodes where the
+	// storage classes can provision them.
+	volumeFilter := nodeutil.NewVolumeClaimFilter(requirements.VolumeClaims, es.storageClassLister)
+	if volumeFilter.Unschedulable() {
+		return 0, nil
+	}
+
 	var res int32
 	replicas, ret := es.estimateFramework.RunEstimateReplicasPlugins(ctx, snapshot, &requirements)
 
@@ -87,7 +94,8 @@ func (es *AccurateSchedulerEstimatorServer) estimateReplicas(
 	}
 	processNode := func(i int) {
 		node := allNodes[i]
-		if !nodeutil.IsNodeAffinityMatched(node.Node(), affinity) || !nodeutil.IsTolerationMatched(node.Node(), tolerations) {
+		if !nodeutil.IsNodeAffinityMatched(node.Node(), affinity) || !nodeutil.IsTolerationMatched(node.Node(), tolerations) ||
+			!volumeFilter.NodeFits(node.Node()) {
 			return
 		}
 		maxReplica := es.nodeMaxAvailableReplica(node, requirements.ResourceRequest)
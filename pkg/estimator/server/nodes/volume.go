@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
+	"k8s.io/klog/v2"
+)
+
+// isDefaultStorageClassAnnotation represents a StorageClass annotation that
+// marks a class as the default one for the cluster.
+const isDefaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+
+// VolumeClaimFilter checks whether the storage claimed by a pod's PVC
+// templates can be provisioned in the cluster, and on which nodes.
+type VolumeClaimFilter struct {
+	// unschedulable marks that at least one claim references a storage class
+	// that does not exist in the cluster, so no replica can ever bind.
+	unschedulable bool
+	// claimTopologies holds the allowed topologies of each claim whose storage
+	// class restricts provisioning. A node must match one term of every entry.
+	claimTopologies [][]corev1.TopologySelectorTerm
+}
+
+// NewVolumeClaimFilter resolves the storage class of each claim and builds a
+// filter out of the class topology restrictions. Claims without an explicit
+// class fall back to the cluster default one; if no default exists they are
+// skipped rather than marked unschedulable, since pre-provisioned volumes may
+// still satisfy them.
+func NewVolumeClaimFilter(claims []corev1.PersistentVolumeClaimSpec, scLister storagelisters.StorageClassLister) *VolumeClaimFilter {
+	f := &VolumeClaimFilter{}
+	for i := range claims {
+		var sc *storagev1.StorageClass
+		var err error
+		if scName := claims[i].StorageClassName; scName != nil && *scName != "" {
+			sc, err = scLister.Get(*scName)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					f.unschedulable = true
+					continue
+				}
+				klog.Errorf("Failed to get storage class %s: %v", *scName, err)
+				continue
+			}
+		} else if sc = defaultStorageClass(scLister); sc == nil {
+			continue
+		}
+		if len(sc.AllowedTopologies) > 0 {
+			f.claimTopologies = append(f.claimTopologies, sc.AllowedTopologies)
+		}
+	}
+	return f
+}
+
+// Unschedulable returns whether some claim can never be satisfied by the
+// cluster, no matter the node.
+func (f *VolumeClaimFilter) Unschedulable() bool {
+	return f.unschedulable
+}
+
+// NodeFits returns whether the node lies in the allowed topologies of every
+// claim, i.e. volumes of each claim can be provisioned for pods on this node.
+func (f *VolumeClaimFilter) NodeFits(node *corev1.Node) bool {
+	for _, terms := range f.claimTopologies {
+		if !matchTopologySelectorTerms(terms, node.Labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchTopologySelectorTerms checks whether the labels match at least one of
+// the topology selector terms. The terms are ORed, the expressions of one
+// term are ANDed.
+func matchTopologySelectorTerms(terms []corev1.TopologySelectorTerm, lbls labels.Set) bool {
+	for _, term := range terms {
+		matched := true
+		for _, exp := range term.MatchLabelExpressions {
+			value, ok := lbls[exp.Key]
+			if !ok || !containsString(exp.Values, value) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(slice []string, s string) bool {
+	for _, item := range slice {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultStorageClass returns the default storage class of the cluster, or nil
+// if none is marked as default.
+func defaultStorageClass(scLister storagelisters.StorageClassLister) *storagev1.StorageClass {
+	scList, err := scLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list storage classes: %v", err)
+		return nil
+	}
+	for _, sc := range scList {
+		if sc.Annotations[isDefaultStorageClassAnnotation] == "true" {
+			return sc
+		}
+	}
+	return nil
+}
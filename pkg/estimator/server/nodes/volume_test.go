@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodes
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+)
+
+func newStorageClassLister(t *testing.T, classes ...*storagev1.StorageClass) storagelisters.StorageClassLister {
+	t.Helper()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, sc := range classes {
+		if err := indexer.Add(sc); err != nil {
+			t.Fatalf("failed to add storage class %s: %v", sc.Name, err)
+		}
+	}
+	return storagelisters.NewStorageClassLister(indexer)
+}
+
+func TestVolumeClaimFilter(t *testing.T) {
+	zonalClass := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "zonal-ssd"},
+		AllowedTopologies: []corev1.TopologySelectorTerm{
+			{
+				MatchLabelExpressions: []corev1.TopologySelectorLabelRequirement{
+					{Key: corev1.LabelTopologyZone, Values: []string{"zone-a"}},
+				},
+			},
+		},
+	}
+	defaultClass := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "standard",
+			Annotations: map[string]string{isDefaultStorageClassAnnotation: "true"},
+		},
+	}
+	nodeInZoneA := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node-a",
+			Labels: map[string]string{corev1.LabelTopologyZone: "zone-a"},
+		},
+	}
+	nodeInZoneB := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node-b",
+			Labels: map[string]string{corev1.LabelTopologyZone: "zone-b"},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		claims            []corev1.PersistentVolumeClaimSpec
+		classes           []*storagev1.StorageClass
+		wantUnschedulable bool
+		wantNodeAFits     bool
+		wantNodeBFits     bool
+	}{
+		{
+			name:          "no claims",
+			wantNodeAFits: true,
+			wantNodeBFits: true,
+		},
+		{
+			name: "storage class missing",
+			claims: []corev1.PersistentVolumeClaimSpec{
+				{StorageClassName: ptr.To("non-existent")},
+			},
+			classes:           []*storagev1.StorageClass{defaultClass},
+			wantUnschedulable: true,
+			wantNodeAFits:     true,
+			wantNodeBFits:     true,
+		},
+		{
+			name: "class restricts topology",
+			claims: []corev1.PersistentVolumeClaimSpec{
+				{StorageClassName: ptr.To("zonal-ssd")},
+			},
+			classes:       []*storagev1.StorageClass{zonalClass},
+			wantNodeAFits: true,
+			wantNodeBFits: false,
+		},
+		{
+			name: "class without topology restriction",
+			claims: []corev1.PersistentVolumeClaimSpec{
+				{StorageClassName: ptr.To("standard")},
+			},
+			classes:       []*storagev1.StorageClass{defaultClass},
+			wantNodeAFits: true,
+			wantNodeBFits: true,
+		},
+		{
+			name:          "claim without class falls back to default",
+			claims:        []corev1.PersistentVolumeClaimSpec{{}},
+			classes:       []*storagev1.StorageClass{defaultClass, zonalClass},
+			wantNodeAFits: true,
+			wantNodeBFits: true,
+		},
+		{
+			name:          "claim without class and no default is skipped",
+			claims:        []corev1.PersistentVolumeClaimSpec{{}},
+			classes:       []*storagev1.StorageClass{zonalClass},
+			wantNodeAFits: true,
+			wantNodeBFits: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := NewVolumeClaimFilter(tt.claims, newStorageClassLister(t, tt.classes...))
+			if got := filter.Unschedulable(); got != tt.wantUnschedulable {
+				t.Errorf("Unschedulable() = %v, want %v", got, tt.wantUnschedulable)
+			}
+			if got := filter.NodeFits(nodeInZoneA); got != tt.wantNodeAFits {
+				t.Errorf("NodeFits(node-a) = %v, want %v", got, tt.wantNodeAFits)
+			}
+			if got := filter.NodeFits(nodeInZoneB); got != tt.wantNodeBFits {
+				t.Errorf("NodeFits(node-b) = %v, want %v", got, tt.wantNodeBFits)
+			}
+		})
+	}
+}
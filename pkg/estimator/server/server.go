@@ -36,6 +36,7 @@ import (
 	infov1 "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
 	listv1 "k8s.io/client-go/listers/core/v1"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
@@ -73,15 +74,16 @@ var (
 // AccurateSchedulerEstimatorServer is the gRPC server of a cluster accurate scheduler estimator.
 // Please see https://github.com/karmada-io/karmada/pull/580 (#580).
 type AccurateSchedulerEstimatorServer struct {
-	clusterName       string
-	kubeClient        kubernetes.Interface
-	restMapper        meta.RESTMapper
-	informerFactory   informers.SharedInformerFactory
-	nodeLister        listv1.NodeLister
-	replicaLister     *replica.ListerWrapper
-	informerManager   genericmanager.SingleClusterInformerManager
-	parallelizer      parallelize.Parallelizer
-	estimateFramework framework.Framework
+	clusterName        string
+	kubeClient         kubernetes.Interface
+	restMapper         meta.RESTMapper
+	informerFactory    informers.SharedInformerFactory
+	nodeLister         listv1.NodeLister
+	storageClassLister storagelisters.StorageClassLister
+	replicaLister      *replica.ListerWrapper
+	informerManager    genericmanager.SingleClusterInformerManager
+	parallelizer       parallelize.Parallelizer
+	estimateFramework  framework.Framework
 
 	Cache schedcache.Cache
 
@@ -102,11 +104,12 @@ func NewEstimatorServer(
 	informerFactory.InformerFor(&corev1.Pod{}, newPodInformer)
 
 	es := &AccurateSchedulerEstimatorServer{
-		clusterName:     opts.ClusterName,
-		kubeClient:      kubeClient,
-		restMapper:      restMapper,
-		informerFactory: informerFactory,
-		nodeLister:      informerFactory.Core().V1().Nodes().Lister(),
+		clusterName:        opts.ClusterName,
+		kubeClient:         kubeClient,
+		restMapper:         restMapper,
+		informerFactory:    informerFactory,
+		nodeLister:         informerFactory.Core().V1().Nodes().Lister(),
+		storageClassLister: informerFactory.Storage().V1().StorageClasses().Lister(),
 		replicaLister: &replica.ListerWrapper{
 			PodLister:        informerFactory.Core().V1().Pods().Lister(),
 			ReplicaSetLister: informerFactory.Apps().V1().ReplicaSets().Lister(),
@@ -125,6 +128,7 @@ func NewEstimatorServer(
 	_ = informerFactory.Core().V1().Nodes().Informer().SetTransform(fedinformer.StripUnusedFields)
 	_ = informerFactory.Core().V1().Pods().Informer().SetTransform(fedinformer.StripUnusedFields)
 	_ = informerFactory.Apps().V1().ReplicaSets().Informer().SetTransform(fedinformer.StripUnusedFields)
+	_ = informerFactory.Storage().V1().StorageClasses().Informer().SetTransform(fedinformer.StripUnusedFields)
 
 	es.informerManager = genericmanager.NewSingleClusterInformerManager(ctx, dynamicClient, 0)
 	for _, gvr := range supportedGVRs {
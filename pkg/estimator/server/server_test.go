@@ -38,6 +38,10 @@ import (
 	testhelper "github.com/karmada-io/karmada/test/helper"
 )
 
+// resourceGPU is the extended resource used to verify that estimation is
+// aware of node-level device fragmentation.
+const resourceGPU corev1.ResourceName = "nvidia.com/gpu"
+
 func TestAccurateSchedulerEstimatorServer_MaxAvailableReplicas(t *testing.T) {
 	opt := &options.Options{
 		ClusterName: "fake",
@@ -222,6 +226,57 @@ func TestAccurateSchedulerEstimatorServer_MaxAvailableReplicas(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "request with extended resource",
+			// node 1(with 4 gpu) left: 7 cpu, 13 mem, 10 pod, 2 gpu
+			// node 2(with 2 gpu) left: 8 cpu, 16 mem, 11 pod, 2 gpu
+			// node 3(without gpu) left: 8 cpu, 16 mem, 11 pod
+			objs: []runtime.Object{
+				testhelper.MakeNodeWithExtendedResource("machine1", 8*testhelper.ResourceUnitCPU, 16*testhelper.ResourceUnitMem, 11*testhelper.ResourceUnitPod, 16*testhelper.ResourceUnitEphemeralStorage, map[corev1.ResourceName]int64{resourceGPU: 4 * testhelper.ResourceUnitGPU}),
+				testhelper.MakeNodeWithExtendedResource("machine2", 8*testhelper.ResourceUnitCPU, 16*testhelper.ResourceUnitMem, 11*testhelper.ResourceUnitPod, 16*testhelper.ResourceUnitEphemeralStorage, map[corev1.ResourceName]int64{resourceGPU: 2 * testhelper.ResourceUnitGPU}),
+				testhelper.NewNode("machine3", 8*testhelper.ResourceUnitCPU, 16*testhelper.ResourceUnitMem, 11*testhelper.ResourceUnitPod, 16*testhelper.ResourceUnitEphemeralStorage),
+				testhelper.NewPodWithExtendedRequest("pod1", "machine1", 1*testhelper.ResourceUnitCPU, 3*testhelper.ResourceUnitMem, map[corev1.ResourceName]int64{resourceGPU: 2 * testhelper.ResourceUnitGPU}),
+			},
+			// request 1 cpu, 2 mem, 1 gpu
+			args: args{
+				request: &pb.MaxAvailableReplicasRequest{
+					Cluster: "fake",
+					ReplicaRequirements: pb.ReplicaRequirements{
+						ResourceRequest: testhelper.NewResourceListWithExtended(1*testhelper.ResourceUnitCPU, 2*testhelper.ResourceUnitMem, testhelper.ResourceUnitZero, map[corev1.ResourceName]int64{resourceGPU: 1 * testhelper.ResourceUnitGPU}),
+					},
+				},
+			},
+			wantResponse: &pb.MaxAvailableReplicasResponse{
+				MaxReplicas: 4,
+			},
+			wantErr: false,
+		},
+		{
+			name: "request with extended resource fragmented across nodes",
+			// Each node has 1 gpu left, so the cluster owns 3 free gpus in
+			// aggregate but no node can place a replica requesting 2 gpus.
+			objs: []runtime.Object{
+				testhelper.MakeNodeWithExtendedResource("machine1", 8*testhelper.ResourceUnitCPU, 16*testhelper.ResourceUnitMem, 11*testhelper.ResourceUnitPod, 16*testhelper.ResourceUnitEphemeralStorage, map[corev1.ResourceName]int64{resourceGPU: 2 * testhelper.ResourceUnitGPU}),
+				testhelper.MakeNodeWithExtendedResource("machine2", 8*testhelper.ResourceUnitCPU, 16*testhelper.ResourceUnitMem, 11*testhelper.ResourceUnitPod, 16*testhelper.ResourceUnitEphemeralStorage, map[corev1.ResourceName]int64{resourceGPU: 2 * testhelper.ResourceUnitGPU}),
+				testhelper.MakeNodeWithExtendedResource("machine3", 8*testhelper.ResourceUnitCPU, 16*testhelper.ResourceUnitMem, 11*testhelper.ResourceUnitPod, 16*testhelper.ResourceUnitEphemeralStorage, map[corev1.ResourceName]int64{resourceGPU: 2 * testhelper.ResourceUnitGPU}),
+				testhelper.NewPodWithExtendedRequest("pod1", "machine1", 1*testhelper.ResourceUnitCPU, 3*testhelper.ResourceUnitMem, map[corev1.ResourceName]int64{resourceGPU: 1 * testhelper.ResourceUnitGPU}),
+				testhelper.NewPodWithExtendedRequest("pod2", "machine2", 1*testhelper.ResourceUnitCPU, 3*testhelper.ResourceUnitMem, map[corev1.ResourceName]int64{resourceGPU: 1 * testhelper.ResourceUnitGPU}),
+				testhelper.NewPodWithExtendedRequest("pod3", "machine3", 1*testhelper.ResourceUnitCPU, 3*testhelper.ResourceUnitMem, map[corev1.ResourceName]int64{resourceGPU: 1 * testhelper.ResourceUnitGPU}),
+			},
+			// request 1 cpu, 2 mem, 2 gpu
+			args: args{
+				request: &pb.MaxAvailableReplicasRequest{
+					Cluster: "fake",
+					ReplicaRequirements: pb.ReplicaRequirements{
+						ResourceRequest: testhelper.NewResourceListWithExtended(1*testhelper.ResourceUnitCPU, 2*testhelper.ResourceUnitMem, testhelper.ResourceUnitZero, map[corev1.ResourceName]int64{resourceGPU: 2 * testhelper.ResourceUnitGPU}),
+					},
+				},
+			},
+			wantResponse: &pb.MaxAvailableReplicasResponse{
+				MaxReplicas: 0,
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
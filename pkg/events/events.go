@@ -66,6 +66,8 @@ const (
 	EventReasonSyncScheduleResultToDependenciesSucceed = "SyncScheduleResultToDependenciesSucceed"
 	// EventReasonSyncScheduleResultToDependenciesFailed indicates sync schedule result to attached bindings failed.
 	EventReasonSyncScheduleResultToDependenciesFailed = "SyncScheduleResultToDependenciesFailed"
+	// EventReasonCleanupOrphanDependencySucceed indicates an orphaned dependency was removed from attached bindings.
+	EventReasonCleanupOrphanDependencySucceed = "CleanupOrphanDependencySucceed"
 )
 
 // Define events for ResourceBinding, ClusterResourceBinding objects and their associated resources.
@@ -82,10 +84,20 @@ const (
 	EventReasonScheduleBindingFailed = "ScheduleBindingFailed"
 	// EventReasonScheduleBindingSucceed indicates that schedule binding succeed.
 	EventReasonScheduleBindingSucceed = "ScheduleBindingSucceed"
+	// EventReasonPreemptBindingSucceed indicates that the binding preempted lower priority bindings.
+	EventReasonPreemptBindingSucceed = "PreemptBindingSucceed"
+	// EventReasonPreemptBindingFailed indicates that the binding failed to preempt lower priority bindings.
+	EventReasonPreemptBindingFailed = "PreemptBindingFailed"
+	// EventReasonBindingPreempted indicates that the binding was preempted by a higher priority binding.
+	EventReasonBindingPreempted = "BindingPreempted"
 	// EventReasonDescheduleBindingFailed indicates that deschedule binding failed.
 	EventReasonDescheduleBindingFailed = "DescheduleBindingFailed"
 	// EventReasonDescheduleBindingSucceed indicates that deschedule binding succeed.
 	EventReasonDescheduleBindingSucceed = "DescheduleBindingSucceed"
+	// EventReasonClusterPressureRescheduleSucceed indicates that rescheduling was triggered due to cluster resource pressure.
+	EventReasonClusterPressureRescheduleSucceed = "ClusterPressureRescheduleSucceed"
+	// EventReasonClusterPressureRescheduleFailed indicates that triggering rescheduling due to cluster resource pressure failed.
+	EventReasonClusterPressureRescheduleFailed = "ClusterPressureRescheduleFailed"
 	// EventReasonEvictWorkloadFromClusterSucceed indicates that evict workload from cluster succeed.
 	EventReasonEvictWorkloadFromClusterSucceed = "EvictWorkloadFromClusterSucceed"
 	// EventReasonEvictWorkloadFromClusterFailed indicates that evict workload from cluster failed.
@@ -147,3 +159,15 @@ const (
 	// EventReasonAPIIncompatible indicates that the MultiClusterService may not function properly as some member clusters do not support EndpointSlice.
 	EventReasonAPIIncompatible = "APIIncompatible"
 )
+
+// Define events for data migration assistance on failover.
+const (
+	// EventReasonDataMigrationPending indicates that scheduling stays suspended while data migration is running.
+	EventReasonDataMigrationPending = "DataMigrationPending"
+	// EventReasonDataMigrationSucceed indicates that data migration finished and scheduling resumed.
+	EventReasonDataMigrationSucceed = "DataMigrationSucceed"
+	// EventReasonDataMigrationFailed indicates that data migration failed.
+	EventReasonDataMigrationFailed = "DataMigrationFailed"
+	// EventReasonNoMigratorAvailable indicates that no registered migrator supports the workload.
+	EventReasonNoMigratorAvailable = "NoMigratorAvailable"
+)
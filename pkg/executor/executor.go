@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package executor defines the extension point that lets the agent hand
+// selected Work manifests to alternative executors instead of applying them
+// through the member cluster apiserver. This enables edge scenarios where a
+// "cluster" is a thin agent in front of a device API or a local config store
+// rather than a full Kubernetes cluster.
+package executor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Executor applies and removes Work manifests of the kinds it claims. An
+// executor takes full responsibility for the claimed manifests, the regular
+// apiserver-based dispatching is skipped for them.
+type Executor interface {
+	// Name returns the executor name, used in logs and events.
+	Name() string
+	// CanExecute reports whether this executor claims the given manifest.
+	CanExecute(workload *unstructured.Unstructured) bool
+	// Apply creates or updates the manifest on the target the executor
+	// manages, e.g. a device twin or a local store.
+	Apply(ctx context.Context, clusterName string, workload *unstructured.Unstructured) error
+	// Delete removes the manifest from the target the executor manages.
+	Delete(ctx context.Context, clusterName string, workload *unstructured.Unstructured) error
+}
+
+// Registry holds the registered executors and resolves which one, if any,
+// claims a given manifest. Executors are consulted in registration order.
+type Registry struct {
+	lock      sync.RWMutex
+	executors []Executor
+	names     map[string]struct{}
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{names: map[string]struct{}{}}
+}
+
+// DefaultRegistry is the registry the agent consults. Custom agent builds
+// typically register their executors here from an init function.
+var DefaultRegistry = NewRegistry()
+
+// Register adds an executor to the registry. Registering two executors with
+// the same name is rejected.
+func (r *Registry) Register(executor Executor) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	name := executor.Name()
+	if len(name) == 0 {
+		return fmt.Errorf("executor name must not be empty")
+	}
+	if _, exist := r.names[name]; exist {
+		return fmt.Errorf("executor %q is already registered", name)
+	}
+	r.names[name] = struct{}{}
+	r.executors = append(r.executors, executor)
+	return nil
+}
+
+// ExecutorFor returns the first registered executor claiming the given
+// manifest, or false when the manifest should go through the regular
+// apiserver-based dispatching.
+func (r *Registry) ExecutorFor(workload *unstructured.Unstructured) (Executor, bool) {
+	if r == nil {
+		return nil, false
+	}
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	for _, executor := range r.executors {
+		if executor.CanExecute(workload) {
+			return executor, true
+		}
+	}
+	return nil, false
+}
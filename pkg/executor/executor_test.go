@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type fakeExecutor struct {
+	name string
+	kind string
+}
+
+func (f *fakeExecutor) Name() string { return f.name }
+
+func (f *fakeExecutor) CanExecute(workload *unstructured.Unstructured) bool {
+	return workload.GetKind() == f.kind
+}
+
+func (f *fakeExecutor) Apply(_ context.Context, _ string, _ *unstructured.Unstructured) error {
+	return nil
+}
+
+func (f *fakeExecutor) Delete(_ context.Context, _ string, _ *unstructured.Unstructured) error {
+	return nil
+}
+
+func workloadOfKind(kind string) *unstructured.Unstructured {
+	workload := &unstructured.Unstructured{}
+	workload.SetKind(kind)
+	return workload
+}
+
+func TestRegistryRegister(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.Register(&fakeExecutor{name: "device-twin", kind: "DeviceConfig"}); err != nil {
+		t.Fatalf("Register() unexpected error: %v", err)
+	}
+	if err := registry.Register(&fakeExecutor{name: "device-twin", kind: "OtherKind"}); err == nil {
+		t.Error("Register() with duplicate name expected error, got nil")
+	}
+	if err := registry.Register(&fakeExecutor{name: "", kind: "DeviceConfig"}); err == nil {
+		t.Error("Register() with empty name expected error, got nil")
+	}
+}
+
+func TestRegistryExecutorFor(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.Register(&fakeExecutor{name: "device-twin", kind: "DeviceConfig"}); err != nil {
+		t.Fatalf("Register() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		registry    *Registry
+		workload    *unstructured.Unstructured
+		wantClaimed bool
+	}{
+		{
+			name:        "claimed kind is handed to the executor",
+			registry:    registry,
+			workload:    workloadOfKind("DeviceConfig"),
+			wantClaimed: true,
+		},
+		{
+			name:        "unclaimed kind goes through regular dispatching",
+			registry:    registry,
+			workload:    workloadOfKind("Deployment"),
+			wantClaimed: false,
+		},
+		{
+			name:        "nil registry claims nothing",
+			registry:    nil,
+			workload:    workloadOfKind("DeviceConfig"),
+			wantClaimed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, claimed := tt.registry.ExecutorFor(tt.workload)
+			if claimed != tt.wantClaimed {
+				t.Errorf("ExecutorFor() claimed = %v, want %v", claimed, tt.wantClaimed)
+			}
+		})
+	}
+}
@@ -72,6 +72,15 @@ const (
 	// owner: @LeonZh0u, @seanlaii, @wengyao04, @whitewindmills, @zclyne
 	// alpha: v1.13
 	PriorityBasedScheduling featuregate.Feature = "PriorityBasedScheduling"
+
+	// PriorityBasedPreemptiveScheduling controls whether the scheduler may preempt
+	// lower-priority bindings when a high-priority binding can not be scheduled due
+	// to insufficient cluster capacity. When enabled, the scheduler triggers the
+	// rescheduling of victim bindings in a candidate cluster to make room for the
+	// high-priority binding.
+	//
+	// alpha: v1.15
+	PriorityBasedPreemptiveScheduling featuregate.Feature = "PriorityBasedPreemptiveScheduling"
 )
 
 var (
@@ -93,6 +102,7 @@ var (
 		ResourceQuotaEstimate:             {Default: false, PreRelease: featuregate.Alpha},
 		StatefulFailoverInjection:         {Default: false, PreRelease: featuregate.Alpha},
 		PriorityBasedScheduling:           {Default: false, PreRelease: featuregate.Alpha},
+		PriorityBasedPreemptiveScheduling: {Default: false, PreRelease: featuregate.Alpha},
 	}
 )
 
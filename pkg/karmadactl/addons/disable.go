@@ -23,6 +23,7 @@ import (
 	"k8s.io/kubectl/pkg/util/templates"
 
 	addoninit "github.com/karmada-io/karmada/pkg/karmadactl/addons/init"
+	"github.com/karmada-io/karmada/pkg/karmadactl/addons/thirdparty"
 )
 
 var (
@@ -53,6 +54,7 @@ var (
 // NewCmdAddonsDisable disable Karmada addons on Kubernetes
 func NewCmdAddonsDisable(parentCommand string) *cobra.Command {
 	opts := addoninit.CommandAddonsDisableOption{}
+	var addonConfigs []string
 	cmd := &cobra.Command{
 		Use:                   "disable",
 		Short:                 "Disable karmada addons from Kubernetes",
@@ -61,6 +63,9 @@ func NewCmdAddonsDisable(parentCommand string) *cobra.Command {
 		SilenceUsage:          true,
 		DisableFlagsInUseLine: true,
 		RunE: func(_ *cobra.Command, args []string) error {
+			if err := thirdparty.LoadAndRegister(addonConfigs); err != nil {
+				return err
+			}
 			if err := opts.Complete(); err != nil {
 				return err
 			}
@@ -75,6 +80,7 @@ func NewCmdAddonsDisable(parentCommand string) *cobra.Command {
 	}
 	flags := cmd.Flags()
 	opts.GlobalCommandOptions.AddFlags(flags)
+	flags.StringSliceVar(&addonConfigs, "addon-config", nil, "Paths to third-party addon descriptor files, allowing custom addons to be disabled next to the built-in ones.")
 	flags.BoolVarP(&opts.Force, "force", "f", false, "Disable addons without prompting for confirmation.")
 	return cmd
 }
@@ -23,6 +23,7 @@ import (
 	"k8s.io/kubectl/pkg/util/templates"
 
 	addoninit "github.com/karmada-io/karmada/pkg/karmadactl/addons/init"
+	"github.com/karmada-io/karmada/pkg/karmadactl/addons/thirdparty"
 	"github.com/karmada-io/karmada/pkg/karmadactl/cmdinit/options"
 	globaloptions "github.com/karmada-io/karmada/pkg/karmadactl/options"
 )
@@ -52,12 +53,16 @@ var (
 
 	# Specify the namespace where Karmada components are installed
 	%[1]s enable karmada-search --namespace karmada-system
+
+	# Enable a third-party addon described by a descriptor file
+	%[1]s enable my-addon --addon-config my-addon.yaml
 	`)
 )
 
 // NewCmdAddonsEnable enable Karmada addons on Kubernetes
 func NewCmdAddonsEnable(parentCommand string) *cobra.Command {
 	opts := addoninit.CommandAddonsEnableOption{}
+	var addonConfigs []string
 	cmd := &cobra.Command{
 		Use:                   "enable",
 		Short:                 "Enable Karmada addons from Kubernetes",
@@ -66,6 +71,9 @@ func NewCmdAddonsEnable(parentCommand string) *cobra.Command {
 		SilenceUsage:          true,
 		DisableFlagsInUseLine: true,
 		RunE: func(_ *cobra.Command, args []string) error {
+			if err := thirdparty.LoadAndRegister(addonConfigs); err != nil {
+				return err
+			}
 			if err := opts.Complete(); err != nil {
 				return err
 			}
@@ -81,6 +89,7 @@ func NewCmdAddonsEnable(parentCommand string) *cobra.Command {
 
 	flags := cmd.Flags()
 	opts.GlobalCommandOptions.AddFlags(flags)
+	flags.StringSliceVar(&addonConfigs, "addon-config", nil, "Paths to third-party addon descriptor files, allowing custom addons to be enabled next to the built-in ones.")
 	flags.StringVarP(&opts.ImageRegistry, "private-image-registry", "", "", "Private image registry where pull images from. If set, all required images will be downloaded from it, it would be useful in offline installation scenarios.")
 	flags.IntVar(&opts.WaitComponentReadyTimeout, "pod-timeout", options.WaitComponentReadyTimeout, "Wait pod ready timeout.")
 	flags.IntVar(&opts.WaitAPIServiceReadyTimeout, "apiservice-timeout", 30, "Wait apiservice ready timeout.")
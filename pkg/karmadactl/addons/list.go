@@ -23,6 +23,7 @@ import (
 	"k8s.io/kubectl/pkg/util/templates"
 
 	addoninit "github.com/karmada-io/karmada/pkg/karmadactl/addons/init"
+	"github.com/karmada-io/karmada/pkg/karmadactl/addons/thirdparty"
 )
 
 var (
@@ -47,6 +48,7 @@ var (
 // NewCmdAddonsList list Karmada addons on Kubernetes
 func NewCmdAddonsList(parentCommand string) *cobra.Command {
 	opts := addoninit.CommandAddonsListOption{}
+	var addonConfigs []string
 	cmd := &cobra.Command{
 		Use:                   "list",
 		Short:                 "List karmada addons from Kubernetes",
@@ -55,6 +57,9 @@ func NewCmdAddonsList(parentCommand string) *cobra.Command {
 		SilenceUsage:          true,
 		DisableFlagsInUseLine: true,
 		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := thirdparty.LoadAndRegister(addonConfigs); err != nil {
+				return err
+			}
 			if err := opts.Complete(); err != nil {
 				return err
 			}
@@ -66,5 +71,6 @@ func NewCmdAddonsList(parentCommand string) *cobra.Command {
 	}
 
 	opts.GlobalCommandOptions.AddFlags(cmd.Flags())
+	cmd.Flags().StringSliceVar(&addonConfigs, "addon-config", nil, "Paths to third-party addon descriptor files, allowing custom addons to be listed next to the built-in ones.")
 	return cmd
 }
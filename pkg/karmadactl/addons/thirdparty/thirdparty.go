@@ -0,0 +1,310 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdparty
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+
+	addoninit "github.com/karmada-io/karmada/pkg/karmadactl/addons/init"
+	addonutils "github.com/karmada-io/karmada/pkg/karmadactl/addons/utils"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util/apiclient"
+)
+
+// AddonDescriptor describes a user provided addon so that it can be managed by
+// `karmadactl addons` next to the built-in addon list. The manifests are Go
+// templates of YAML documents, e.g. the rendered output of a Helm chart, that
+// are applied to the host cluster when the addon is enabled.
+type AddonDescriptor struct {
+	// Name is the addon name used on the `addons enable/disable` command line.
+	// It must not collide with a built-in addon.
+	Name string `json:"name"`
+
+	// Version is the addon version, exposed to the manifest templates as
+	// {{ .Version }}.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Values are user supplied values, exposed to the manifest templates as
+	// {{ .Values.<key> }}.
+	// +optional
+	Values map[string]string `json:"values,omitempty"`
+
+	// Manifests are templates of the YAML documents that make up the addon.
+	// Each entry may hold multiple documents separated by '---'. Namespaced
+	// objects without an explicit namespace are applied to the namespace the
+	// Karmada components are installed in.
+	Manifests []string `json:"manifests"`
+
+	// HealthCheck describes how to determine the addon health.
+	// +optional
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
+}
+
+// HealthCheck points at the workload whose readiness reflects the addon health.
+type HealthCheck struct {
+	// Deployment is the name of a deployment in the host cluster. The addon is
+	// reported unhealthy until the deployment is fully rolled out.
+	Deployment string `json:"deployment"`
+}
+
+// manifestData is the data the manifest templates are rendered with.
+type manifestData struct {
+	Namespace string
+	Version   string
+	Values    map[string]string
+}
+
+// LoadDescriptor reads and validates an addon descriptor file.
+func LoadDescriptor(path string) (*AddonDescriptor, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read addon descriptor %s: %v", path, err)
+	}
+	descriptor := &AddonDescriptor{}
+	if err = yaml.UnmarshalStrict(data, descriptor); err != nil {
+		return nil, fmt.Errorf("failed to parse addon descriptor %s: %v", path, err)
+	}
+	if descriptor.Name == "" {
+		return nil, fmt.Errorf("addon descriptor %s must declare a name", path)
+	}
+	if len(descriptor.Manifests) == 0 {
+		return nil, fmt.Errorf("addon descriptor %s must declare at least one manifest", path)
+	}
+	return descriptor, nil
+}
+
+// LoadAndRegister loads the given descriptor files and registers them in the
+// addon list, so the enable/disable/list machinery treats them like built-in
+// addons. Descriptors must not collide with built-in addon names.
+func LoadAndRegister(paths []string) error {
+	for _, path := range paths {
+		descriptor, err := LoadDescriptor(path)
+		if err != nil {
+			return err
+		}
+		if _, exist := addoninit.Addons[descriptor.Name]; exist {
+			return fmt.Errorf("addon %s from descriptor %s conflicts with an already registered addon", descriptor.Name, path)
+		}
+		addoninit.Addons[descriptor.Name] = NewAddon(descriptor)
+	}
+	return nil
+}
+
+// NewAddon wraps a descriptor into the common addon interface.
+func NewAddon(descriptor *AddonDescriptor) *addoninit.Addon {
+	return &addoninit.Addon{
+		Name: descriptor.Name,
+		Status: func(opts *addoninit.CommandAddonsListOption) (string, error) {
+			return status(descriptor, opts)
+		},
+		Enable: func(opts *addoninit.CommandAddonsEnableOption) error {
+			return enable(descriptor, opts)
+		},
+		Disable: func(opts *addoninit.CommandAddonsDisableOption) error {
+			return disable(descriptor, opts)
+		},
+	}
+}
+
+func status(descriptor *AddonDescriptor, opts *addoninit.CommandAddonsListOption) (string, error) {
+	if descriptor.HealthCheck == nil {
+		return addoninit.AddonUnknownStatus, nil
+	}
+	deployment, err := opts.KubeClientSet.AppsV1().Deployments(opts.Namespace).Get(context.TODO(), descriptor.HealthCheck.Deployment, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return addoninit.AddonDisabledStatus, nil
+		}
+		return addoninit.AddonUnknownStatus, err
+	}
+	if deployment.Status.Replicas != deployment.Status.ReadyReplicas ||
+		deployment.Status.Replicas != deployment.Status.AvailableReplicas {
+		return addoninit.AddonUnhealthyStatus, nil
+	}
+
+	return addoninit.AddonEnabledStatus, nil
+}
+
+func enable(descriptor *AddonDescriptor, opts *addoninit.CommandAddonsEnableOption) error {
+	objects, err := renderManifests(descriptor, opts.Namespace)
+	if err != nil {
+		return err
+	}
+
+	dynamicClient, mapper, err := hostClusterApplier(opts.Context, opts.KubeConfig)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objects {
+		if err := applyObject(dynamicClient, mapper, obj); err != nil {
+			return fmt.Errorf("failed to apply %s %s of addon %s: %v", obj.GetKind(), obj.GetName(), descriptor.Name, err)
+		}
+	}
+
+	if descriptor.HealthCheck != nil {
+		healthCheckDeployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: descriptor.HealthCheck.Deployment, Namespace: opts.Namespace},
+		}
+		if err := addonutils.WaitForDeploymentRollout(opts.KubeClientSet, healthCheckDeployment, opts.WaitComponentReadyTimeout); err != nil {
+			return fmt.Errorf("wait addon %s pod timeout: %v", descriptor.Name, err)
+		}
+	}
+
+	klog.Infof("Install addon %s on host cluster successfully", descriptor.Name)
+	return nil
+}
+
+func disable(descriptor *AddonDescriptor, opts *addoninit.CommandAddonsDisableOption) error {
+	objects, err := renderManifests(descriptor, opts.Namespace)
+	if err != nil {
+		return err
+	}
+
+	dynamicClient, mapper, err := hostClusterApplier(opts.Context, opts.KubeConfig)
+	if err != nil {
+		return err
+	}
+	// delete in reverse order so that workloads disappear before the
+	// resources they depend on.
+	for i := len(objects) - 1; i >= 0; i-- {
+		obj := objects[i]
+		if err := deleteObject(dynamicClient, mapper, obj); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete %s %s of addon %s: %v", obj.GetKind(), obj.GetName(), descriptor.Name, err)
+		}
+	}
+
+	klog.Infof("Uninstall addon %s on host cluster successfully", descriptor.Name)
+	return nil
+}
+
+// renderManifests renders the manifest templates and decodes the resulting
+// YAML documents, defaulting the namespace of namespaced objects.
+func renderManifests(descriptor *AddonDescriptor, namespace string) ([]*unstructured.Unstructured, error) {
+	data := manifestData{
+		Namespace: namespace,
+		Version:   descriptor.Version,
+		Values:    descriptor.Values,
+	}
+
+	var objects []*unstructured.Unstructured
+	for i, manifest := range descriptor.Manifests {
+		rendered, err := addonutils.ParseTemplate(manifest, data)
+		if err != nil {
+			return nil, fmt.Errorf("error when parsing manifest %d of addon %s: %v", i, descriptor.Name, err)
+		}
+
+		decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(rendered), 4096)
+		for {
+			obj := &unstructured.Unstructured{}
+			if err := decoder.Decode(obj); err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, fmt.Errorf("failed to decode manifest %d of addon %s: %v", i, descriptor.Name, err)
+			}
+			if len(obj.Object) == 0 {
+				continue
+			}
+			if obj.GetKind() == "" || obj.GetName() == "" {
+				return nil, fmt.Errorf("manifest %d of addon %s contains an object without kind or name", i, descriptor.Name)
+			}
+			if obj.GetNamespace() == "" {
+				obj.SetNamespace(namespace)
+			}
+			objects = append(objects, obj)
+		}
+	}
+	return objects, nil
+}
+
+// hostClusterApplier builds the dynamic client and REST mapper used to apply
+// arbitrary addon objects to the host cluster.
+func hostClusterApplier(kubeContext, kubeConfig string) (dynamic.Interface, meta.RESTMapper, error) {
+	restConfig, err := apiclient.RestConfig(kubeContext, kubeConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get karmada-host config. error: %v", err)
+	}
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+	return dynamicClient, mapper, nil
+}
+
+func resourceInterface(dynamicClient dynamic.Interface, mapper meta.RESTMapper, obj *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
+	gvk := obj.GroupVersionKind()
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return dynamicClient.Resource(mapping.Resource).Namespace(obj.GetNamespace()), nil
+	}
+	return dynamicClient.Resource(mapping.Resource), nil
+}
+
+func applyObject(dynamicClient dynamic.Interface, mapper meta.RESTMapper, obj *unstructured.Unstructured) error {
+	resource, err := resourceInterface(dynamicClient, mapper, obj)
+	if err != nil {
+		return err
+	}
+	existing, err := resource.Get(context.TODO(), obj.GetName(), metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		_, err = resource.Create(context.TODO(), obj, metav1.CreateOptions{})
+		return err
+	}
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	_, err = resource.Update(context.TODO(), obj, metav1.UpdateOptions{})
+	return err
+}
+
+func deleteObject(dynamicClient dynamic.Interface, mapper meta.RESTMapper, obj *unstructured.Unstructured) error {
+	resource, err := resourceInterface(dynamicClient, mapper, obj)
+	if err != nil {
+		if meta.IsNoMatchError(err) || strings.Contains(err.Error(), "no matches for kind") {
+			return nil
+		}
+		return err
+	}
+	return resource.Delete(context.TODO(), obj.GetName(), metav1.DeleteOptions{})
+}
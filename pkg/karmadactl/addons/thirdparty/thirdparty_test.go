@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdparty
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	addoninit "github.com/karmada-io/karmada/pkg/karmadactl/addons/init"
+)
+
+func writeDescriptor(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "addon.yaml")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write descriptor: %v", err)
+	}
+	return path
+}
+
+func TestLoadDescriptor(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{
+			name: "valid descriptor",
+			content: `
+name: my-addon
+version: v1.0.0
+values:
+  replicas: "2"
+manifests:
+  - |
+    apiVersion: apps/v1
+    kind: Deployment
+    metadata:
+      name: my-addon
+healthCheck:
+  deployment: my-addon
+`,
+		},
+		{
+			name: "missing name",
+			content: `
+manifests:
+  - "apiVersion: v1"
+`,
+			wantErr: true,
+		},
+		{
+			name:    "missing manifests",
+			content: "name: my-addon",
+			wantErr: true,
+		},
+		{
+			name: "unknown field",
+			content: `
+name: my-addon
+chart: stable/my-addon
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadDescriptor(writeDescriptor(t, tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadDescriptor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadAndRegisterRejectsConflicts(t *testing.T) {
+	addoninit.Addons["existing-addon"] = &addoninit.Addon{Name: "existing-addon"}
+	defer delete(addoninit.Addons, "existing-addon")
+
+	path := writeDescriptor(t, `
+name: existing-addon
+manifests:
+  - "apiVersion: v1"
+`)
+	if err := LoadAndRegister([]string{path}); err == nil {
+		t.Errorf("LoadAndRegister() expected a conflict error")
+	}
+}
+
+func TestRenderManifests(t *testing.T) {
+	descriptor := &AddonDescriptor{
+		Name:    "my-addon",
+		Version: "v1.0.0",
+		Values:  map[string]string{"replicas": "2"},
+		Manifests: []string{`
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: my-addon
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-addon
+  namespace: custom-namespace
+  labels:
+    version: {{ .Version }}
+spec:
+  replicas: {{ .Values.replicas }}
+`},
+	}
+
+	objects, err := renderManifests(descriptor, "karmada-system")
+	if err != nil {
+		t.Fatalf("renderManifests() unexpected error: %v", err)
+	}
+	if len(objects) != 2 {
+		t.Fatalf("renderManifests() got %d objects, want 2", len(objects))
+	}
+	if objects[0].GetNamespace() != "karmada-system" {
+		t.Errorf("object without namespace should default to the component namespace, got %q", objects[0].GetNamespace())
+	}
+	if objects[1].GetNamespace() != "custom-namespace" {
+		t.Errorf("explicit namespace must be kept, got %q", objects[1].GetNamespace())
+	}
+	if objects[1].GetLabels()["version"] != "v1.0.0" {
+		t.Errorf("version was not templated, labels: %v", objects[1].GetLabels())
+	}
+	replicas, _, _ := unstructured.NestedInt64(objects[1].Object, "spec", "replicas")
+	if replicas != 2 {
+		t.Errorf("values were not templated, replicas = %d, want 2", replicas)
+	}
+}
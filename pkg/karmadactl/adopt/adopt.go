@@ -0,0 +1,269 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package adopt implements the takeover of existing member cluster objects
+// into Karmada management without recreating them.
+package adopt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/default/native/prune"
+	u "github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+var (
+	adoptLong = templates.LongDesc(`
+		Take over an existing member cluster object into Karmada management.
+
+		The object is labeled as managed by Karmada and linked to the Work and
+		ResourceBinding derived from the control-plane resource template of the
+		same kind, namespace and name, which must already exist. When the member
+		cluster object differs from the template, the diff is printed and the
+		adoption is refused unless --force is given, in which case the template
+		overwrites the member cluster object on the next sync.`)
+
+	adoptExample = templates.Examples(`
+		# Adopt the deployment nginx in cluster member1
+		%[1]s adopt deployment/nginx -n default --cluster member1
+
+		# Adopt even if the member cluster object differs from the template
+		%[1]s adopt deployment/nginx -n default --cluster member1 --force`)
+)
+
+// CommandAdoptOptions contains the input to the adopt command.
+type CommandAdoptOptions struct {
+	// Cluster is the member cluster holding the object to adopt.
+	Cluster string
+	// Namespace is the namespace of the object.
+	Namespace string
+	// Force adopts the object even if it differs from the control-plane
+	// resource template.
+	Force bool
+
+	gvr          schema.GroupVersionResource
+	gvk          schema.GroupVersionKind
+	name         string
+	template     *unstructured.Unstructured
+	memberClient dynamic.Interface
+
+	karmadaClient karmadaclientset.Interface
+
+	genericiooptions.IOStreams
+}
+
+// NewCmdAdopt creates the `adopt` command.
+func NewCmdAdopt(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandAdoptOptions{IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:                   "adopt (TYPE[.VERSION][.GROUP] NAME | TYPE[.VERSION][.GROUP]/NAME) --cluster CLUSTER",
+		Short:                 "Take over an existing member cluster object into Karmada management",
+		Long:                  adoptLong,
+		Example:               fmt.Sprintf(adoptExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.Complete(f, args); err != nil {
+				return err
+			}
+			return o.Run()
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupAdvancedCommands,
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVarP(&o.Cluster, "cluster", "C", "", "The member cluster holding the object to adopt.")
+	flags.StringVarP(&o.Namespace, "namespace", "n", "", "The namespace of the object.")
+	flags.BoolVar(&o.Force, "force", false, "Adopt the object even if it differs from the control-plane resource template. The template overwrites the object on the next sync.")
+	return cmd
+}
+
+// Complete resolves the control-plane template and the member cluster client.
+func (o *CommandAdoptOptions) Complete(f util.Factory, args []string) error {
+	if len(o.Cluster) == 0 {
+		return fmt.Errorf("the --cluster flag is required")
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("the resource to adopt is required, e.g. deployment/nginx")
+	}
+
+	if len(o.Namespace) == 0 {
+		namespace, _, err := f.ToRawKubeConfigLoader().Namespace()
+		if err != nil {
+			return err
+		}
+		o.Namespace = namespace
+	}
+
+	r := f.NewBuilder().
+		Unstructured().
+		NamespaceParam(o.Namespace).DefaultNamespace().
+		ResourceTypeOrNameArgs(false, args...).
+		SingleResourceType().
+		RequireObject(true).
+		Do()
+	infos, err := r.Infos()
+	if err != nil {
+		return fmt.Errorf("failed to get the resource template from the control plane, it must exist before adoption: %v", err)
+	}
+	if len(infos) != 1 {
+		return fmt.Errorf("expected exactly one resource, got %d", len(infos))
+	}
+	template, ok := infos[0].Object.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("failed to decode resource %s as unstructured object", infos[0].Name)
+	}
+	o.template = template
+	o.name = infos[0].Name
+	o.gvr = infos[0].Mapping.Resource
+	o.gvk = infos[0].Mapping.GroupVersionKind
+
+	o.karmadaClient, err = f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+
+	memberFactory, err := f.FactoryForMemberCluster(o.Cluster)
+	if err != nil {
+		return err
+	}
+	o.memberClient, err = memberFactory.DynamicClient()
+	return err
+}
+
+// Run adopts the member cluster object.
+func (o *CommandAdoptOptions) Run() error {
+	memberObj, err := o.memberResource().Get(context.TODO(), o.name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get %s/%s in cluster %s: %v", o.gvr.Resource, o.name, o.Cluster, err)
+	}
+
+	if diff, err := o.templateDiff(memberObj); err != nil {
+		return err
+	} else if len(diff) != 0 && !o.Force {
+		fmt.Fprintf(o.Out, "The object in cluster %s differs from the control-plane template:\n%s\n", o.Cluster, diff)
+		return fmt.Errorf("refusing to adopt a conflicting object, rerun with --force to let the template overwrite it")
+	}
+
+	workName := names.GenerateWorkName(o.gvk.Kind, o.name, o.template.GetNamespace())
+	workNamespace := names.GenerateExecutionSpaceName(o.Cluster)
+
+	// Link the target cluster into the existing binding so the binding
+	// controller maintains a Work for it.
+	if err := o.linkIntoBinding(); err != nil {
+		return err
+	}
+
+	// Mark the member cluster object as managed by Karmada and point it at
+	// the Work it will be reconciled from, matching what the execution
+	// controller stamps on objects it creates itself.
+	u.MergeLabel(memberObj, u.ManagedByKarmadaLabel, u.ManagedByKarmadaLabelValue)
+	u.MergeAnnotation(memberObj, workv1alpha2.WorkNameAnnotation, workName)
+	u.MergeAnnotation(memberObj, workv1alpha2.WorkNamespaceAnnotation, workNamespace)
+	u.MergeAnnotation(memberObj, workv1alpha2.ResourceTemplateUIDAnnotation, string(o.template.GetUID()))
+	if o.Force {
+		u.MergeAnnotation(memberObj, workv1alpha2.ResourceConflictResolutionAnnotation, workv1alpha2.ResourceConflictResolutionOverwrite)
+	}
+	if _, err := o.memberResource().Update(context.TODO(), memberObj, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update %s/%s in cluster %s: %v", o.gvr.Resource, o.name, o.Cluster, err)
+	}
+
+	fmt.Fprintf(o.Out, "%s/%s in cluster %s is adopted successfully\n", o.gvr.Resource, o.name, o.Cluster)
+	return nil
+}
+
+// memberResource returns the namespaced (or cluster scoped) resource client
+// of the member cluster.
+func (o *CommandAdoptOptions) memberResource() dynamic.ResourceInterface {
+	if len(o.template.GetNamespace()) == 0 {
+		return o.memberClient.Resource(o.gvr)
+	}
+	return o.memberClient.Resource(o.gvr).Namespace(o.template.GetNamespace())
+}
+
+// templateDiff compares the member cluster object against the control-plane
+// template after pruning the server-populated fields on both sides.
+func (o *CommandAdoptOptions) templateDiff(memberObj *unstructured.Unstructured) (string, error) {
+	prunedMember := memberObj.DeepCopy()
+	if err := prune.RemoveIrrelevantFields(prunedMember); err != nil {
+		return "", err
+	}
+	prunedTemplate := o.template.DeepCopy()
+	if err := prune.RemoveIrrelevantFields(prunedTemplate); err != nil {
+		return "", err
+	}
+	// Labels and annotations diverge by design, Karmada stamps its own on
+	// the member side; the diff should only surface spec-level conflicts.
+	for _, obj := range []*unstructured.Unstructured{prunedMember, prunedTemplate} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "labels")
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+		unstructured.RemoveNestedField(obj.Object, "status")
+	}
+	return cmp.Diff(prunedTemplate.Object, prunedMember.Object), nil
+}
+
+// linkIntoBinding appends the target cluster to the scheduling result of the
+// ResourceBinding (or ClusterResourceBinding) derived from the template, so
+// the existing binding machinery creates and maintains the Work.
+func (o *CommandAdoptOptions) linkIntoBinding() error {
+	bindingName := names.GenerateBindingName(o.gvk.Kind, o.name)
+	if len(o.template.GetNamespace()) == 0 {
+		binding, err := o.karmadaClient.WorkV1alpha2().ClusterResourceBindings().Get(context.TODO(), bindingName, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return fmt.Errorf("ClusterResourceBinding %s not found, make sure a policy propagates the template before adopting", bindingName)
+			}
+			return err
+		}
+		if binding.Spec.TargetContains(o.Cluster) {
+			return nil
+		}
+		binding.Spec.Clusters = append(binding.Spec.Clusters, workv1alpha2.TargetCluster{Name: o.Cluster})
+		_, err = o.karmadaClient.WorkV1alpha2().ClusterResourceBindings().Update(context.TODO(), binding, metav1.UpdateOptions{})
+		return err
+	}
+
+	namespace := o.template.GetNamespace()
+	binding, err := o.karmadaClient.WorkV1alpha2().ResourceBindings(namespace).Get(context.TODO(), bindingName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("ResourceBinding %s/%s not found, make sure a policy propagates the template before adopting", namespace, bindingName)
+		}
+		return err
+	}
+	if binding.Spec.TargetContains(o.Cluster) {
+		return nil
+	}
+	binding.Spec.Clusters = append(binding.Spec.Clusters, workv1alpha2.TargetCluster{Name: o.Cluster})
+	_, err = o.karmadaClient.WorkV1alpha2().ResourceBindings(namespace).Update(context.TODO(), binding, metav1.UpdateOptions{})
+	return err
+}
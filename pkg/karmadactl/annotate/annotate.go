@@ -14,16 +14,24 @@ limitations under the License.
 package annotate
 
 import (
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/resource"
 	kubectlannotate "k8s.io/kubectl/pkg/cmd/annotate"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/util/templates"
 
+	"github.com/karmada-io/karmada/pkg/clientfanout"
 	"github.com/karmada-io/karmada/pkg/karmadactl/options"
 	"github.com/karmada-io/karmada/pkg/karmadactl/util"
 	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
 )
 
 var (
@@ -46,11 +54,28 @@ var (
 
     # Update deployment 'foo' by removing an annotation named 'work.karmada.io/conflict-resolution' if it exists
     # Does not require the --overwrite flag
-    [1]%s annotate deployment foo work.karmada.io/conflict-resolution-`)
+    [1]%s annotate deployment foo work.karmada.io/conflict-resolution-
+
+    # Update deployment 'foo' in member cluster 'member1' with the annotation 'owner' and the value 'dev'
+    [1]%s annotate deployment foo owner='dev' --operation-scope=members --clusters=member1
+
+    # Update deployment 'foo' in member clusters 'member1' and 'member2' by removing an annotation named 'owner' if it exists
+    [1]%s annotate deployment foo owner- --operation-scope=members --clusters=member1,member2`)
 )
 
+// CommandAnnotateOptions declares the arguments specific to the annotate command of karmadactl.
+type CommandAnnotateOptions struct {
+	// OperationScope is the operation scope of the command.
+	OperationScope options.OperationScope
+	// Clusters is the names of the target member clusters, and only takes
+	// effect when the operation scope is members.
+	Clusters []string
+}
+
 // NewCmdAnnotate returns new initialized instance of annotate sub command
 func NewCmdAnnotate(f util.Factory, parentCommand string, ioStreams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandAnnotateOptions{OperationScope: options.KarmadaControlPlane}
+
 	cmd := kubectlannotate.NewCmdAnnotate(parentCommand, f, ioStreams)
 	cmd.Example = fmt.Sprintf(annotateExample, parentCommand)
 	cmd.Annotations = map[string]string{
@@ -58,7 +83,99 @@ func NewCmdAnnotate(f util.Factory, parentCommand string, ioStreams genericioopt
 	}
 	options.AddKubeConfigFlags(cmd.Flags())
 	options.AddNamespaceFlag(cmd.Flags())
+	cmd.Flags().VarP(&o.OperationScope, "operation-scope", "s", "Used to control the operation scope of the command. The optional values are karmada and members. Defaults to karmada.")
+	cmd.Flags().StringSliceVarP(&o.Clusters, "clusters", "C", []string{}, "Used to specify target member clusters and only takes effect when the command's operation scope is members, for example: --operation-scope=members --clusters=member1,member2")
+
+	// Keep the behavior of kubectl for the Karmada control plane, and fan the
+	// command out to the specified member clusters for the members scope.
+	controlPlaneRun := cmd.Run
+	cmd.Run = nil
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if err := o.Validate(); err != nil {
+			return err
+		}
+		if o.OperationScope == options.KarmadaControlPlane {
+			controlPlaneRun(cmd, args)
+			return nil
+		}
+		return o.RunAcrossClusters(f, parentCommand, cmd, args, ioStreams)
+	}
+
 	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
 	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	utilcomp.RegisterCompletionFuncForOperationScopeFlag(cmd, options.KarmadaControlPlane, options.Members)
+	utilcomp.RegisterCompletionFuncForClustersFlag(cmd)
 	return cmd
 }
+
+// Validate checks that the provided annotate options are specified.
+func (o *CommandAnnotateOptions) Validate() error {
+	err := options.VerifyOperationScopeFlags(o.OperationScope, options.KarmadaControlPlane, options.Members)
+	if err != nil {
+		return err
+	}
+	if o.OperationScope == options.Members && len(o.Clusters) == 0 {
+		return errors.New("must specify member clusters by --clusters")
+	}
+	return nil
+}
+
+// RunAcrossClusters runs the annotate command against every target member cluster.
+func (o *CommandAnnotateOptions) RunAcrossClusters(f util.Factory, parentCommand string, cmd *cobra.Command, args []string, ioStreams genericiooptions.IOStreams) error {
+	var errs []error
+	for _, cluster := range o.Clusters {
+		memberFactory, err := f.FactoryForMemberCluster(cluster)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cluster(%s): %v", cluster, err))
+			continue
+		}
+		if len(o.Clusters) > 1 {
+			fmt.Fprintf(ioStreams.Out, "# cluster: %s\n", cluster)
+		}
+		warnManagedObjects(memberFactory, cmd, args, cluster, ioStreams.ErrOut)
+
+		memberCmd := kubectlannotate.NewCmdAnnotate(parentCommand, memberFactory, ioStreams)
+		clientfanout.CopyFlags(cmd, memberCmd)
+		if err = clientfanout.RunMemberCommand(memberCmd, args); err != nil {
+			errs = append(errs, fmt.Errorf("cluster(%s): %v", cluster, err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// warnManagedObjects prints a warning for every selected object that is
+// propagated by Karmada, since changes made directly on the member cluster may
+// be overwritten by the control plane.
+func warnManagedObjects(f cmdutil.Factory, cmd *cobra.Command, args []string, cluster string, errOut io.Writer) {
+	resources, _, err := cmdutil.GetResourcesAndPairs(args, "annotate")
+	if err != nil || len(resources) == 0 {
+		return
+	}
+	namespace, _, err := f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return
+	}
+	result := f.NewBuilder().
+		Unstructured().
+		ContinueOnError().
+		NamespaceParam(namespace).DefaultNamespace().
+		LabelSelectorParam(cmdutil.GetFlagString(cmd, "selector")).
+		FieldSelectorParam(cmdutil.GetFlagString(cmd, "field-selector")).
+		SelectAllParam(cmdutil.GetFlagBool(cmd, "all")).
+		ResourceTypeOrNameArgs(false, resources...).
+		Flatten().
+		Do()
+	_ = result.Visit(func(info *resource.Info, err error) error {
+		if err != nil {
+			return nil
+		}
+		accessor, err := meta.Accessor(info.Object)
+		if err != nil {
+			return nil
+		}
+		if _, managed := accessor.GetLabels()[karmadautil.ManagedByKarmadaLabel]; managed {
+			fmt.Fprintf(errOut, "Warning: %s in cluster %s is managed by Karmada, the change may be overwritten by the control plane\n", info.ObjectName(), cluster)
+		}
+		return nil
+	})
+}
@@ -38,6 +38,9 @@ var (
 		# Print the supported API resources with more information in cluster(member1)
 		%[1]s api-resources -o wide --operation-scope=members --cluster=member1
 
+		# Print a matrix showing which member clusters support which API resources
+		%[1]s api-resources --operation-scope=members
+
 		# Print the supported API resources sorted by a column in Karmada control plane
 		%[1]s api-resources --sort-by=name
 
@@ -64,7 +67,7 @@ func NewCmdAPIResources(f util.Factory, parentCommand string, ioStreams generici
 		Run: func(cmd *cobra.Command, args []string) {
 			cmdutil.CheckErr(o.Complete(f, cmd, args))
 			cmdutil.CheckErr(o.Validate())
-			cmdutil.CheckErr(o.RunAPIResources())
+			cmdutil.CheckErr(o.Run(f))
 		},
 		Annotations: map[string]string{
 			util.TagCommandGroup: util.GroupOtherCommands,
@@ -74,7 +77,7 @@ func NewCmdAPIResources(f util.Factory, parentCommand string, ioStreams generici
 	o.OperationScope = options.KarmadaControlPlane
 	options.AddKubeConfigFlags(cmd.Flags())
 	cmd.Flags().VarP(&o.OperationScope, "operation-scope", "s", "Used to control the operation scope of the command. The optional values are karmada and members. Defaults to karmada.")
-	cmd.Flags().StringVar(&o.Cluster, "cluster", "", "Used to specify a target member cluster and only takes effect when the command's operation scope is members, for example: --operation-scope=members --cluster=member1")
+	cmd.Flags().StringVar(&o.Cluster, "cluster", "", "Used to specify a target member cluster and only takes effect when the command's operation scope is members, for example: --operation-scope=members --cluster=member1. If not specified, the supported API resources of every member cluster are printed as an availability matrix.")
 	cmd.Flags().BoolVar(&o.NoHeaders, "no-headers", o.NoHeaders, "When using the default or custom-column output format, don't print headers (default print headers).")
 	cmd.Flags().StringVarP(&o.Output, "output", "o", o.Output, `Output format. One of: (wide, name).`)
 
@@ -97,10 +100,20 @@ type CommandAPIResourcesOptions struct {
 	*kubectlapiresources.APIResourceOptions
 	Cluster        string
 	OperationScope options.OperationScope
+
+	// matrix indicates that the supported API resources of every member
+	// cluster should be printed as an availability matrix.
+	matrix       bool
+	groupChanged bool
+	nsChanged    bool
 }
 
 // Complete adapts from the command line args and validates them
 func (o *CommandAPIResourcesOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
+	o.matrix = o.OperationScope == options.Members && len(o.Cluster) == 0
+	o.groupChanged = cmd.Flags().Changed("api-group")
+	o.nsChanged = cmd.Flags().Changed("namespaced")
+
 	var apiFactory cmdutil.Factory = f
 	if o.OperationScope == options.Members && len(o.Cluster) != 0 {
 		memberFactory, err := f.FactoryForMemberCluster(o.Cluster)
@@ -118,13 +131,16 @@ func (o *CommandAPIResourcesOptions) Validate() error {
 	if err != nil {
 		return err
 	}
-	if o.OperationScope == options.Members && len(o.Cluster) == 0 {
-		return fmt.Errorf("must specify a member cluster")
+	if o.matrix && len(o.Output) != 0 {
+		return fmt.Errorf("--output is not supported when printing the availability matrix across member clusters")
 	}
 	return o.APIResourceOptions.Validate()
 }
 
 // Run does the work
-func (o *CommandAPIResourcesOptions) Run() error {
+func (o *CommandAPIResourcesOptions) Run(f util.Factory) error {
+	if o.matrix {
+		return o.runAPIResourceMatrix(f)
+	}
 	return o.APIResourceOptions.RunAPIResources()
 }
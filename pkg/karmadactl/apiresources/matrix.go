@@ -0,0 +1,210 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiresources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+const (
+	resourceSupported   = "Y"
+	resourceUnsupported = "N"
+)
+
+// apiResourceRow is one row of the availability matrix, identifying a
+// resource and the member clusters serving it.
+type apiResourceRow struct {
+	Name       string
+	APIGroup   string
+	Namespaced bool
+	Kind       string
+	Clusters   sets.Set[string]
+}
+
+// runAPIResourceMatrix discovers the API resources of every member cluster
+// and prints a matrix showing which clusters support which resources, so a
+// missing CRD in a single member stands out.
+func (o *CommandAPIResourcesOptions) runAPIResourceMatrix(f util.Factory) error {
+	karmadaClient, err := f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+	clusterList, err := karmadaClient.ClusterV1alpha1().Clusters().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list member clusters: %v", err)
+	}
+	if len(clusterList.Items) == 0 {
+		return fmt.Errorf("no member clusters found in Karmada control plane")
+	}
+	clusters := make([]string, 0, len(clusterList.Items))
+	for i := range clusterList.Items {
+		clusters = append(clusters, clusterList.Items[i].Name)
+	}
+	sort.Strings(clusters)
+
+	rows := map[string]*apiResourceRow{}
+	var discoveryErrs []error
+	for _, cluster := range clusters {
+		resources, err := o.discoverClusterAPIResources(f, cluster)
+		if err != nil {
+			discoveryErrs = append(discoveryErrs, fmt.Errorf("cluster(%s): %v", cluster, err))
+			continue
+		}
+		for i := range resources {
+			key := resources[i].Group + "/" + resources[i].Name
+			row, exist := rows[key]
+			if !exist {
+				row = &apiResourceRow{
+					Name:       resources[i].Name,
+					APIGroup:   resources[i].Group,
+					Namespaced: resources[i].Namespaced,
+					Kind:       resources[i].Kind,
+					Clusters:   sets.New[string](),
+				}
+				rows[key] = row
+			}
+			row.Clusters.Insert(cluster)
+		}
+	}
+	if len(discoveryErrs) == len(clusters) {
+		return fmt.Errorf("failed to discover API resources in all member clusters: %v", discoveryErrs)
+	}
+
+	o.printAPIResourceMatrix(rows, clusters)
+	for _, err := range discoveryErrs {
+		fmt.Fprintf(o.ErrOut, "warning: failed to discover API resources in %v\n", err)
+	}
+	return nil
+}
+
+// discoverClusterAPIResources returns the preferred API resources of a member
+// cluster that match the command's filter flags.
+func (o *CommandAPIResourcesOptions) discoverClusterAPIResources(f util.Factory, cluster string) ([]metav1.APIResource, error) {
+	memberFactory, err := f.FactoryForMemberCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	discoveryClient, err := memberFactory.ToDiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+	if !o.Cached {
+		discoveryClient.Invalidate()
+	}
+
+	lists, err := discoveryClient.ServerPreferredResources()
+	if err != nil {
+		// Aggregate what has been discovered, a single broken aggregated API
+		// should not hide the rest of the matrix.
+		if len(lists) == 0 {
+			return nil, err
+		}
+		fmt.Fprintf(o.ErrOut, "warning: partial discovery failure in cluster(%s): %v\n", cluster, err)
+	}
+
+	var resources []metav1.APIResource
+	for _, list := range lists {
+		if len(list.APIResources) == 0 {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for i := range list.APIResources {
+			resource := list.APIResources[i]
+			// subresources are not listed by kubectl api-resources either
+			if strings.Contains(resource.Name, "/") {
+				continue
+			}
+			resource.Group = gv.Group
+			resource.Version = gv.Version
+			if o.matchAPIResource(&resource) {
+				resources = append(resources, resource)
+			}
+		}
+	}
+	return resources, nil
+}
+
+// matchAPIResource applies the api-group, namespaced, verbs and categories
+// filter flags to a discovered resource.
+func (o *CommandAPIResourcesOptions) matchAPIResource(resource *metav1.APIResource) bool {
+	if o.groupChanged && o.APIGroup != resource.Group {
+		return false
+	}
+	if o.nsChanged && o.Namespaced != resource.Namespaced {
+		return false
+	}
+	if len(o.Verbs) > 0 && !sets.NewString(resource.Verbs...).HasAll(o.Verbs...) {
+		return false
+	}
+	if len(o.Categories) > 0 && !sets.NewString(resource.Categories...).HasAll(o.Categories...) {
+		return false
+	}
+	return true
+}
+
+// printAPIResourceMatrix renders the availability matrix with one column per
+// member cluster.
+func (o *CommandAPIResourcesOptions) printAPIResourceMatrix(rows map[string]*apiResourceRow, clusters []string) {
+	sorted := make([]*apiResourceRow, 0, len(rows))
+	for _, row := range rows {
+		sorted = append(sorted, row)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		switch o.SortBy {
+		case "name":
+			return sorted[i].Name < sorted[j].Name
+		case "kind":
+			return sorted[i].Kind < sorted[j].Kind
+		default:
+			if sorted[i].APIGroup != sorted[j].APIGroup {
+				return sorted[i].APIGroup < sorted[j].APIGroup
+			}
+			return sorted[i].Name < sorted[j].Name
+		}
+	})
+
+	w := tabwriter.NewWriter(o.Out, 0, 8, 2, ' ', 0)
+	defer w.Flush()
+	if !o.NoHeaders {
+		headers := append([]string{"NAME", "APIGROUP", "NAMESPACED", "KIND"}, clusters...)
+		fmt.Fprintln(w, strings.Join(headers, "\t"))
+	}
+	for _, row := range sorted {
+		cells := []string{row.Name, row.APIGroup, fmt.Sprintf("%v", row.Namespaced), row.Kind}
+		for _, cluster := range clusters {
+			if row.Clusters.Has(cluster) {
+				cells = append(cells, resourceSupported)
+			} else {
+				cells = append(cells, resourceUnsupported)
+			}
+		}
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+}
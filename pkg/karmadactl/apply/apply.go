@@ -49,7 +49,9 @@ type CommandApplyOptions struct {
 	UtilFactory       util.Factory
 	AllClusters       bool
 	Clusters          []string
+	ClusterSelector   string
 
+	clusterSelector     *metav1.LabelSelector
 	kubectlApplyOptions *kubectlapply.ApplyOptions
 	karmadaClient       karmadaclientset.Interface
 }
@@ -74,6 +76,9 @@ var (
 		# Apply the configuration with propagation into specific member clusters.
 		%[1]s apply -f manifest.yaml --cluster member1,member2
 
+		# Apply the configuration with propagation into member clusters matching a label selector.
+		%[1]s apply -f manifest.yaml --cluster-selector region=us,env=prod
+
 		# Apply resources from a directory and propagate them into all member clusters.
 		%[1]s apply -f dir/ --all-clusters
 
@@ -116,10 +121,12 @@ func NewCmdApply(f util.Factory, parentCommand string, streams genericiooptions.
 	options.AddNamespaceFlag(flags)
 	flags.BoolVarP(&o.AllClusters, "all-clusters", "", o.AllClusters, "If present, propagates a group of resources to all member clusters.")
 	flags.StringSliceVarP(&o.Clusters, "cluster", "C", o.Clusters, "If present, propagates a group of resources to specified clusters.")
+	flags.StringVar(&o.ClusterSelector, "cluster-selector", o.ClusterSelector, "If present, propagates a group of resources to member clusters matching the label selector(e.g. --cluster-selector region=us,env=prod).")
 
 	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
 	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
 	utilcomp.RegisterCompletionFuncForClusterFlag(cmd)
+	utilcomp.RegisterCompletionFuncForClusterSelectorFlag(cmd)
 
 	return cmd
 }
@@ -144,6 +151,16 @@ func (o *CommandApplyOptions) Validate() error {
 	if o.AllClusters && len(o.Clusters) > 0 {
 		return errors.New("--all-clusters and --cluster cannot be used together")
 	}
+	if len(o.ClusterSelector) > 0 {
+		if o.AllClusters || len(o.Clusters) > 0 {
+			return errors.New("--cluster-selector cannot be used together with --all-clusters or --cluster")
+		}
+		selector, err := metav1.ParseToLabelSelector(o.ClusterSelector)
+		if err != nil {
+			return fmt.Errorf("invalid cluster selector %q: %v", o.ClusterSelector, err)
+		}
+		o.clusterSelector = selector
+	}
 	if len(o.Clusters) > 0 {
 		clusters, err := o.karmadaClient.ClusterV1alpha1().Clusters().List(context.TODO(), metav1.ListOptions{})
 		if err != nil {
@@ -166,7 +183,7 @@ func (o *CommandApplyOptions) Validate() error {
 
 // Run executes the `apply` command.
 func (o *CommandApplyOptions) Run() error {
-	if !o.AllClusters && len(o.Clusters) == 0 {
+	if !o.AllClusters && len(o.Clusters) == 0 && o.clusterSelector == nil {
 		return o.kubectlApplyOptions.Run()
 	}
 
@@ -241,6 +258,12 @@ func (o *CommandApplyOptions) generatePropagationObject(info *resource.Info) run
 		spec.Placement.ClusterAffinity = &policyv1alpha1.ClusterAffinity{}
 	}
 
+	if o.clusterSelector != nil {
+		spec.Placement.ClusterAffinity = &policyv1alpha1.ClusterAffinity{
+			LabelSelector: o.clusterSelector,
+		}
+	}
+
 	// for a namespaced-scope resource, we need to generate a PropagationPolicy object.
 	// for a cluster-scope resource, we need to generate a ClusterPropagationPolicy object.
 	var obj runtime.Object
@@ -54,6 +54,25 @@ func TestValidateApplyCommand(t *testing.T) {
 			wantErr: true,
 			errMsg:  "--all-clusters and --cluster cannot be used together",
 		},
+		{
+			name: "Validate_WithClusterSelectorAndClusterArgs_CanNotBeUsedTogether",
+			applyOpts: &CommandApplyOptions{
+				Clusters:        []string{"member1"},
+				ClusterSelector: "region=us",
+			},
+			prep:    func(karmadaclientset.Interface) error { return nil },
+			wantErr: true,
+			errMsg:  "--cluster-selector cannot be used together with --all-clusters or --cluster",
+		},
+		{
+			name: "Validate_WithInvalidClusterSelector_ParseFailed",
+			applyOpts: &CommandApplyOptions{
+				ClusterSelector: "region==us==invalid",
+			},
+			prep:    func(karmadaclientset.Interface) error { return nil },
+			wantErr: true,
+			errMsg:  "invalid cluster selector",
+		},
 		{
 			name: "Validate_WithNonExistentCluster_ClusterDoesNotExist",
 			applyOpts: &CommandApplyOptions{
@@ -84,7 +84,7 @@ func NewCmdAttach(f util.Factory, parentCommand string, streams genericiooptions
 	cmd.Flags().BoolVarP(&o.TTY, "tty", "t", o.TTY, "Stdin is a TTY")
 	cmd.Flags().BoolVarP(&o.Quiet, "quiet", "q", o.Quiet, "Only print output from the remote session")
 	cmd.Flags().VarP(&o.OperationScope, "operation-scope", "s", "Used to control the operation scope of the command. The optional values are karmada and members. Defaults to karmada.")
-	cmd.Flags().StringVar(&o.Cluster, "cluster", "", "Used to specify a target member cluster and only takes effect when the command's operation scope is members, for example: --operation-scope=members --cluster=member1")
+	cmd.Flags().StringVar(&o.Cluster, "cluster", "", "Used to specify a target member cluster to attach through the cluster proxy, for example: --cluster=member1. Implies --operation-scope=members.")
 
 	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
 	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
@@ -103,6 +103,11 @@ type CommandAttachOptions struct {
 
 // Complete verifies command line arguments and loads data from the command environment
 func (o *CommandAttachOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
+	// Naming a member cluster is enough to attach through the cluster proxy,
+	// the scope flag does not have to be spelled out.
+	if len(o.Cluster) != 0 {
+		o.OperationScope = options.Members
+	}
 	var attachFactory cmdutil.Factory = f
 	if o.OperationScope == options.Members && len(o.Cluster) != 0 {
 		memberFactory, err := f.FactoryForMemberCluster(o.Cluster)
@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+var (
+	whoAmILong = templates.LongDesc(`
+		Report the identity the karmada control plane sees for the current
+		credentials, which member clusters can be reached through the cluster
+		proxy and with which impersonated identity.
+
+		This is backed by the whoami resource of the karmada
+		aggregated-apiserver and is mainly useful for debugging unified-auth
+		mappings.`)
+
+	whoAmIExample = templates.Examples(`
+		# Report the caller's identity and member cluster access
+		%[1]s auth whoami`)
+)
+
+// whoAmIGVR is the resource the aggregated-apiserver serves the identity review on.
+var whoAmIGVR = clusterv1alpha1.SchemeGroupVersion.WithResource("whoamis")
+
+// NewCmdAuth returns new initialized instance of auth sub command.
+func NewCmdAuth(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auth SUBCOMMAND",
+		Short: "Inspect authentication and authorization",
+		Long:  "Inspect how the karmada control plane authenticates and authorizes the current credentials.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmd.Help()
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterTroubleshootingAndDebugging,
+		},
+	}
+	cmd.AddCommand(NewCmdAuthWhoAmI(f, parentCommand, streams))
+	return cmd
+}
+
+// CommandAuthWhoAmIOption holds all command options for auth whoami.
+type CommandAuthWhoAmIOption struct {
+	genericiooptions.IOStreams
+
+	DynamicClient dynamic.Interface
+}
+
+// NewCmdAuthWhoAmI returns cobra.Command to report the caller's identity.
+func NewCmdAuthWhoAmI(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	opts := CommandAuthWhoAmIOption{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "whoami",
+		Short:                 "Report the caller's identity and member cluster access",
+		Long:                  whoAmILong,
+		Example:               fmt.Sprintf(whoAmIExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := opts.Complete(f); err != nil {
+				return err
+			}
+			return opts.Run()
+		},
+	}
+
+	options.AddKubeConfigFlags(cmd.Flags())
+	return cmd
+}
+
+// Complete ensures that options are valid and marshals them if necessary.
+func (o *CommandAuthWhoAmIOption) Complete(f util.Factory) error {
+	var err error
+	o.DynamicClient, err = f.DynamicClient()
+	return err
+}
+
+// Run resolves and prints the caller's identity.
+func (o *CommandAuthWhoAmIOption) Run() error {
+	review := &unstructured.Unstructured{}
+	review.SetAPIVersion(clusterv1alpha1.SchemeGroupVersion.String())
+	review.SetKind("WhoAmI")
+
+	result, err := o.DynamicClient.Resource(whoAmIGVR).Create(context.TODO(), review, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to resolve the caller identity: %v", err)
+	}
+
+	whoAmI := &clusterv1alpha1.WhoAmI{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(result.Object, whoAmI); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "Username:\t%s\n", whoAmI.Status.Username)
+	if whoAmI.Status.UID != "" {
+		fmt.Fprintf(o.Out, "UID:\t%s\n", whoAmI.Status.UID)
+	}
+	if len(whoAmI.Status.Groups) > 0 {
+		fmt.Fprintf(o.Out, "Groups:\t%s\n", strings.Join(whoAmI.Status.Groups, ", "))
+	}
+	if len(whoAmI.Status.Clusters) == 0 {
+		fmt.Fprintln(o.Out, "No member clusters registered.")
+		return nil
+	}
+
+	fmt.Fprintln(o.Out)
+	writer := tabwriter.NewWriter(o.Out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(writer, "CLUSTER\tPROXY\tIMPERSONATED USER\tIMPERSONATED GROUPS\tREASON")
+	for _, access := range whoAmI.Status.Clusters {
+		proxyState := "Unavailable"
+		if access.ProxyAvailable {
+			proxyState = "Available"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", access.Name, proxyState,
+			access.ImpersonatedUser, strings.Join(access.ImpersonatedGroups, ","), access.Reason)
+	}
+	return writer.Flush()
+}
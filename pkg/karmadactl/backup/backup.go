@@ -0,0 +1,168 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+var backupLong = templates.LongDesc(`
+	Back up and restore the state of the Karmada control plane.
+
+	A backup is an archive of all Karmada custom resources (policies, clusters,
+	overrides, and so on) plus the secrets referenced by Cluster objects, so
+	that cluster access can be re-established after a restore. Derived objects
+	such as ResourceBindings and Works are not included because the control
+	plane regenerates them from the backed up resources.
+
+	The archive can be written to a local file or transferred to any
+	S3-compatible object store via a presigned HTTP(S) URL.`)
+
+// derivedResources are regenerated by the control plane from the resources we
+// do back up, so archiving them would only cause conflicts on restore.
+var derivedResources = map[schema.GroupResource]struct{}{
+	{Group: "work.karmada.io", Resource: "resourcebindings"}:        {},
+	{Group: "work.karmada.io", Resource: "clusterresourcebindings"}: {},
+	{Group: "work.karmada.io", Resource: "works"}:                   {},
+}
+
+// Manifest is the serialized content of a backup archive.
+type Manifest struct {
+	// Version is the version of the control plane the backup was taken from.
+	Version string `json:"version"`
+	// CreationTime is the time the backup was taken.
+	CreationTime metav1.Time `json:"creationTime"`
+	// Resources holds the backed up objects.
+	Resources []unstructured.Unstructured `json:"resources"`
+}
+
+// NewCmdBackup returns cobra.Command for control plane backup and restore.
+func NewCmdBackup(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Back up and restore Karmada control plane state",
+		Long:  backupLong,
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterRegistration,
+		},
+	}
+
+	cmd.AddCommand(NewCmdBackupCreate(f, parentCommand, streams))
+	cmd.AddCommand(NewCmdBackupRestore(f, parentCommand, streams))
+
+	return cmd
+}
+
+// isRemoteLocation tells whether the backup location is an HTTP(S) URL, such
+// as a presigned URL of an S3-compatible object store, rather than a local path.
+func isRemoteLocation(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
+
+// writeManifest gzips the manifest and writes it to a local file or uploads
+// it to a presigned HTTP(S) URL.
+func writeManifest(manifest *Manifest, location string) error {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("failed to marshal backup manifest: %v", err)
+	}
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	if _, err = gzipWriter.Write(data); err != nil {
+		return err
+	}
+	if err = gzipWriter.Close(); err != nil {
+		return err
+	}
+
+	if !isRemoteLocation(location) {
+		return os.WriteFile(location, buf.Bytes(), 0600)
+	}
+
+	req, err := http.NewRequest(http.MethodPut, location, &buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/gzip")
+	resp, err := (&http.Client{Timeout: 5 * time.Minute}).Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload backup: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to upload backup: unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// readManifest reads a gzipped manifest from a local file or downloads it
+// from a presigned HTTP(S) URL.
+func readManifest(location string) (*Manifest, error) {
+	var reader io.ReadCloser
+	if isRemoteLocation(location) {
+		resp, err := (&http.Client{Timeout: 5 * time.Minute}).Get(location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to download backup: %v", err)
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to download backup: unexpected status %s", resp.Status)
+		}
+		reader = resp.Body
+	} else {
+		file, err := os.Open(location)
+		if err != nil {
+			return nil, err
+		}
+		reader = file
+	}
+	defer reader.Close()
+
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup archive: %v", err)
+	}
+	defer gzipReader.Close()
+
+	data, err := io.ReadAll(gzipReader)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err = json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse backup manifest: %v", err)
+	}
+	return manifest, nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newUnstructured(apiVersion, kind, namespace, name string) unstructured.Unstructured {
+	obj := unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func TestManifestRoundTrip(t *testing.T) {
+	manifest := &Manifest{
+		Version:      "v1.15.0",
+		CreationTime: metav1.Now(),
+		Resources: []unstructured.Unstructured{
+			newUnstructured("cluster.karmada.io/v1alpha1", "Cluster", "", "member1"),
+			newUnstructured("policy.karmada.io/v1alpha1", "PropagationPolicy", "default", "nginx"),
+		},
+	}
+
+	file := filepath.Join(t.TempDir(), "backup.json.gz")
+	if err := writeManifest(manifest, file); err != nil {
+		t.Fatalf("writeManifest() unexpected error: %v", err)
+	}
+	got, err := readManifest(file)
+	if err != nil {
+		t.Fatalf("readManifest() unexpected error: %v", err)
+	}
+	if got.Version != manifest.Version || !reflect.DeepEqual(got.Resources, manifest.Resources) {
+		t.Errorf("round-tripped manifest differs: got %+v, want %+v", got, manifest)
+	}
+}
+
+func TestSortForRestore(t *testing.T) {
+	resources := []unstructured.Unstructured{
+		newUnstructured("policy.karmada.io/v1alpha1", "PropagationPolicy", "default", "nginx"),
+		newUnstructured("cluster.karmada.io/v1alpha1", "Cluster", "", "member1"),
+		newUnstructured("v1", "Secret", "karmada-cluster", "member1"),
+	}
+	sortForRestore(resources)
+
+	gotKinds := []string{resources[0].GetKind(), resources[1].GetKind(), resources[2].GetKind()}
+	wantKinds := []string{"Secret", "Cluster", "PropagationPolicy"}
+	if !reflect.DeepEqual(gotKinds, wantKinds) {
+		t.Errorf("sortForRestore() order = %v, want %v", gotKinds, wantKinds)
+	}
+}
+
+func TestSanitizeForRestore(t *testing.T) {
+	obj := newUnstructured("cluster.karmada.io/v1alpha1", "Cluster", "", "member1")
+	obj.SetResourceVersion("12345")
+	obj.SetUID("deadbeef")
+	obj.SetGeneration(3)
+	_ = unstructured.SetNestedField(obj.Object, "True", "status", "phase")
+
+	sanitized := sanitizeForRestore(&obj)
+	if _, found, _ := unstructured.NestedMap(sanitized.Object, "status"); found {
+		t.Errorf("sanitizeForRestore() kept status")
+	}
+	if sanitized.GetResourceVersion() != "" || string(sanitized.GetUID()) != "" || sanitized.GetGeneration() != 0 {
+		t.Errorf("sanitizeForRestore() kept server populated metadata: %+v", sanitized.Object["metadata"])
+	}
+	if sanitized.GetName() != "member1" {
+		t.Errorf("sanitizeForRestore() lost the object name")
+	}
+	// the original must stay untouched
+	if obj.GetResourceVersion() != "12345" {
+		t.Errorf("sanitizeForRestore() modified the input object")
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util/steprunner"
+	"github.com/karmada-io/karmada/pkg/version"
+)
+
+var backupCreateExample = templates.Examples(`
+	# Back up the control plane to a local file
+	%[1]s backup create --file karmada-backup.json.gz
+
+	# Back up the control plane to an S3-compatible bucket via a presigned URL
+	%[1]s backup create --file https://bucket.s3.example.com/karmada-backup.json.gz?X-Amz-Signature=...`)
+
+// CommandBackupCreateOption holds all command options for backup create.
+type CommandBackupCreateOption struct {
+	genericiooptions.IOStreams
+
+	// File is the local path or presigned HTTP(S) URL the archive is written to.
+	File string
+
+	// Verbose enables per-step log output.
+	Verbose bool
+
+	// StepOutput selects a machine-readable rendering of the step results,
+	// currently only 'json' is supported.
+	StepOutput string
+
+	KubeClientSet   kubernetes.Interface
+	DynamicClient   dynamic.Interface
+	DiscoveryClient discovery.DiscoveryInterface
+}
+
+// NewCmdBackupCreate returns cobra.Command to back up the control plane.
+func NewCmdBackupCreate(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	opts := CommandBackupCreateOption{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "create",
+		Short:                 "Back up Karmada control plane state to an archive",
+		Example:               fmt.Sprintf(backupCreateExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := opts.Complete(f); err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Run()
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	flags.StringVar(&opts.File, "file", "", "Local file path or presigned HTTP(S) URL to write the backup archive to.")
+	flags.BoolVar(&opts.Verbose, "verbose", false, "Show detailed log output for each step.")
+	flags.StringVar(&opts.StepOutput, "step-output", "", "Emit machine-readable step results after the command finishes. Only 'json' is supported.")
+
+	return cmd
+}
+
+// Complete ensures that options are valid and marshals them if necessary.
+func (o *CommandBackupCreateOption) Complete(f util.Factory) error {
+	var err error
+	if o.KubeClientSet, err = f.KubernetesClientSet(); err != nil {
+		return err
+	}
+	if o.DynamicClient, err = f.DynamicClient(); err != nil {
+		return err
+	}
+	if o.DiscoveryClient, err = f.ToDiscoveryClient(); err != nil {
+		return err
+	}
+	if o.File == "" {
+		o.File = fmt.Sprintf("karmada-backup-%s.json.gz", time.Now().Format("20060102-150405"))
+	}
+	return nil
+}
+
+// Validate checks option and return a slice of found errs.
+func (o *CommandBackupCreateOption) Validate() error {
+	if strings.HasPrefix(o.File, "http://") {
+		return fmt.Errorf("refusing to upload the backup over plain HTTP, use an https:// URL")
+	}
+	if o.StepOutput != "" && o.StepOutput != "json" {
+		return fmt.Errorf("invalid --step-output %q, only 'json' is supported", o.StepOutput)
+	}
+	return nil
+}
+
+// Run backs up all Karmada resources and referenced secrets to the archive.
+func (o *CommandBackupCreateOption) Run() error {
+	var resources, secrets []unstructured.Unstructured
+	manifest := &Manifest{}
+
+	runner := steprunner.NewRunner(o.Out).WithVerbose(o.Verbose)
+	runner.AddStep("Collect Karmada resources", func(logf steprunner.LogFunc) error {
+		var err error
+		resources, err = o.collectKarmadaResources()
+		if err != nil {
+			return err
+		}
+		logf("collected %d objects", len(resources))
+		return nil
+	})
+	runner.AddStep("Collect cluster access secrets", func(logf steprunner.LogFunc) error {
+		var err error
+		secrets, err = o.collectClusterSecrets(resources)
+		if err != nil {
+			return err
+		}
+		logf("collected %d secrets", len(secrets))
+		return nil
+	})
+	runner.AddStep("Write backup archive", func(steprunner.LogFunc) error {
+		manifest.Version = version.Get().GitVersion
+		manifest.CreationTime = metav1.Now()
+		// Secrets come first so that cluster access is re-established before
+		// the control plane starts acting on the restored Cluster objects.
+		manifest.Resources = append(secrets, resources...)
+		return writeManifest(manifest, o.File)
+	})
+
+	err := runner.Run()
+	if o.StepOutput == "json" {
+		if writeErr := runner.WriteResults(o.Out); writeErr != nil {
+			return writeErr
+		}
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "Backed up %d objects to %s\n", len(manifest.Resources), o.File)
+	return nil
+}
+
+// collectKarmadaResources lists all objects of the Karmada API groups, except
+// derived resources the control plane regenerates on its own.
+func (o *CommandBackupCreateOption) collectKarmadaResources() ([]unstructured.Unstructured, error) {
+	resourceLists, err := o.DiscoveryClient.ServerPreferredResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+
+	var resources []unstructured.Unstructured
+	for _, resourceList := range resourceLists {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil || !strings.HasSuffix(gv.Group, "karmada.io") {
+			continue
+		}
+		for i := range resourceList.APIResources {
+			apiResource := resourceList.APIResources[i]
+			if strings.Contains(apiResource.Name, "/") {
+				continue
+			}
+			if _, derived := derivedResources[schema.GroupResource{Group: gv.Group, Resource: apiResource.Name}]; derived {
+				continue
+			}
+			verbs := sets.New(apiResource.Verbs...)
+			if !verbs.HasAll("list", "create") {
+				continue
+			}
+			gvr := gv.WithResource(apiResource.Name)
+			list, err := o.DynamicClient.Resource(gvr).List(context.TODO(), metav1.ListOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list %s: %v", gvr.String(), err)
+			}
+			resources = append(resources, list.Items...)
+		}
+	}
+	return resources, nil
+}
+
+// collectClusterSecrets fetches the secrets referenced by the backed up
+// Cluster objects, they are needed to re-establish cluster access on restore.
+func (o *CommandBackupCreateOption) collectClusterSecrets(resources []unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+	collected := sets.New[string]()
+	var secrets []unstructured.Unstructured
+	for i := range resources {
+		if resources[i].GetKind() != "Cluster" || !strings.HasPrefix(resources[i].GetAPIVersion(), "cluster.karmada.io/") {
+			continue
+		}
+		for _, fields := range [][]string{{"spec", "secretRef"}, {"spec", "impersonatorSecretRef"}} {
+			namespace, _, _ := unstructured.NestedString(resources[i].Object, append(fields, "namespace")...)
+			name, _, _ := unstructured.NestedString(resources[i].Object, append(fields, "name")...)
+			if name == "" || collected.Has(namespace+"/"+name) {
+				continue
+			}
+			secret, err := o.KubeClientSet.CoreV1().Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get secret %s/%s referenced by cluster %s: %v",
+					namespace, name, resources[i].GetName(), err)
+			}
+			secret.APIVersion = "v1"
+			secret.Kind = "Secret"
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(secret)
+			if err != nil {
+				return nil, err
+			}
+			secrets = append(secrets, unstructured.Unstructured{Object: obj})
+			collected.Insert(namespace + "/" + name)
+		}
+	}
+	return secrets, nil
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util/steprunner"
+)
+
+var backupRestoreExample = templates.Examples(`
+	# Restore a backup from a local file into a fresh control plane
+	%[1]s backup restore --file karmada-backup.json.gz
+
+	# Restore a backup downloaded from an S3-compatible bucket via a presigned URL
+	%[1]s backup restore --file https://bucket.s3.example.com/karmada-backup.json.gz?X-Amz-Signature=...`)
+
+// CommandBackupRestoreOption holds all command options for backup restore.
+type CommandBackupRestoreOption struct {
+	genericiooptions.IOStreams
+
+	// File is the local path or presigned HTTP(S) URL the archive is read from.
+	File string
+
+	// Verbose enables per-step log output.
+	Verbose bool
+
+	// StepOutput selects a machine-readable rendering of the step results,
+	// currently only 'json' is supported.
+	StepOutput string
+
+	KubeClientSet kubernetes.Interface
+	DynamicClient dynamic.Interface
+	RESTMapper    meta.RESTMapper
+}
+
+// NewCmdBackupRestore returns cobra.Command to restore the control plane.
+func NewCmdBackupRestore(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	opts := CommandBackupRestoreOption{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "restore",
+		Short:                 "Restore Karmada control plane state from a backup archive",
+		Example:               fmt.Sprintf(backupRestoreExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := opts.Complete(f); err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Run()
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	flags.StringVar(&opts.File, "file", "", "Local file path or presigned HTTP(S) URL to read the backup archive from.")
+	flags.BoolVar(&opts.Verbose, "verbose", false, "Show detailed log output for each step.")
+	flags.StringVar(&opts.StepOutput, "step-output", "", "Emit machine-readable step results after the command finishes. Only 'json' is supported.")
+
+	return cmd
+}
+
+// Complete ensures that options are valid and marshals them if necessary.
+func (o *CommandBackupRestoreOption) Complete(f util.Factory) error {
+	var err error
+	if o.KubeClientSet, err = f.KubernetesClientSet(); err != nil {
+		return err
+	}
+	if o.DynamicClient, err = f.DynamicClient(); err != nil {
+		return err
+	}
+	if o.RESTMapper, err = f.ToRESTMapper(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks option and return a slice of found errs.
+func (o *CommandBackupRestoreOption) Validate() error {
+	if o.File == "" {
+		return fmt.Errorf("--file is required")
+	}
+	if o.StepOutput != "" && o.StepOutput != "json" {
+		return fmt.Errorf("invalid --step-output %q, only 'json' is supported", o.StepOutput)
+	}
+	return nil
+}
+
+// Run restores the backed up objects into the target control plane.
+func (o *CommandBackupRestoreOption) Run() error {
+	var resources []unstructured.Unstructured
+	var restored, skipped int
+
+	runner := steprunner.NewRunner(o.Out).WithVerbose(o.Verbose)
+	runner.AddStep("Read backup archive", func(logf steprunner.LogFunc) error {
+		manifest, err := readManifest(o.File)
+		if err != nil {
+			return err
+		}
+		logf("backup taken at %s from control plane version %s",
+			manifest.CreationTime.Format("2006-01-02 15:04:05"), manifest.Version)
+		resources = make([]unstructured.Unstructured, len(manifest.Resources))
+		copy(resources, manifest.Resources)
+		sortForRestore(resources)
+		return nil
+	})
+	runner.AddStep("Restore objects", func(logf steprunner.LogFunc) error {
+		for i := range resources {
+			obj := sanitizeForRestore(&resources[i])
+			if err := o.restoreObject(obj); err != nil {
+				if apierrors.IsAlreadyExists(err) {
+					logf("%s %s already exists, skipping", obj.GetKind(), objectName(obj))
+					skipped++
+					continue
+				}
+				return fmt.Errorf("failed to restore %s %s: %v", obj.GetKind(), obj.GetName(), err)
+			}
+			restored++
+		}
+		return nil
+	})
+
+	err := runner.Run()
+	if o.StepOutput == "json" {
+		if writeErr := runner.WriteResults(o.Out); writeErr != nil {
+			return writeErr
+		}
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "Restored %d objects, skipped %d existing objects\n", restored, skipped)
+	return nil
+}
+
+func (o *CommandBackupRestoreOption) restoreObject(obj *unstructured.Unstructured) error {
+	gvk := obj.GroupVersionKind()
+	mapping, err := o.RESTMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return err
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if err = o.ensureNamespace(obj.GetNamespace()); err != nil {
+			return err
+		}
+		_, err = o.DynamicClient.Resource(mapping.Resource).Namespace(obj.GetNamespace()).Create(context.TODO(), obj, metav1.CreateOptions{})
+		return err
+	}
+	_, err = o.DynamicClient.Resource(mapping.Resource).Create(context.TODO(), obj, metav1.CreateOptions{})
+	return err
+}
+
+func (o *CommandBackupRestoreOption) ensureNamespace(namespace string) error {
+	_, err := o.KubeClientSet.CoreV1().Namespaces().Create(context.TODO(), &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: namespace},
+	}, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+func objectName(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// sortForRestore orders objects so that secrets are restored before Cluster
+// objects, and Cluster objects before everything else. This re-establishes
+// cluster access before the control plane starts propagating resources.
+func sortForRestore(resources []unstructured.Unstructured) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		return restorePriority(&resources[i]) < restorePriority(&resources[j])
+	})
+}
+
+func restorePriority(obj *unstructured.Unstructured) int {
+	switch obj.GetKind() {
+	case "Secret":
+		return 0
+	case "Cluster":
+		return 1
+	default:
+		return 2
+	}
+}
+
+// sanitizeForRestore strips the server populated fields so the object can be
+// recreated in a fresh control plane.
+func sanitizeForRestore(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	sanitized := obj.DeepCopy()
+	unstructured.RemoveNestedField(sanitized.Object, "status")
+	for _, field := range []string{"resourceVersion", "uid", "generation", "creationTimestamp", "managedFields", "ownerReferences", "selfLink"} {
+		unstructured.RemoveNestedField(sanitized.Object, "metadata", field)
+	}
+	return sanitized
+}
@@ -31,6 +31,7 @@ import (
 	"math"
 	"math/big"
 	"net"
+	"os"
 	"path/filepath"
 	"time"
 
@@ -279,6 +280,14 @@ func GenCerts(pkiPath, caCertFile, caKeyFile string, etcdServerCertCfg, etcdClie
 		return err
 	}
 
+	// The user-provided CA may be an intermediate, carry the rest of its
+	// chain over so issued certificates verify up to the root.
+	if caCertFile != "" {
+		if err = appendCAChain(pkiPath, caCertFile); err != nil {
+			return err
+		}
+	}
+
 	karmadaCert, karmadaKey, err := NewCertAndKey(caCert, *caKey, karmadaCertCfg)
 	if err != nil {
 		return err
@@ -318,6 +327,64 @@ func GenCerts(pkiPath, caCertFile, caKeyFile string, etcdServerCertCfg, etcdClie
 	return genEtcdCerts(pkiPath, etcdServerCertCfg, etcdClientCertCfg)
 }
 
+// ValidateCACertAndKey checks that the user-provided CA certificate and key
+// can actually issue certificates: the key must match the certificate, the
+// certificate must be a CA and must be valid at the time of the check. It
+// accepts either a root or an intermediate CA.
+func ValidateCACertAndKey(caCertFile, caKeyFile string) error {
+	certificate, err := tls.LoadX509KeyPair(caCertFile, caKeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load CA certificate and key: %v", err)
+	}
+	caCert, err := x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		return fmt.Errorf("failed to parse CA certificate: %v", err)
+	}
+	if !caCert.IsCA {
+		return fmt.Errorf("certificate %s is not a CA certificate", caCertFile)
+	}
+	now := time.Now()
+	if now.Before(caCert.NotBefore) {
+		return fmt.Errorf("CA certificate %s is not valid until %s", caCertFile, caCert.NotBefore)
+	}
+	if now.After(caCert.NotAfter) {
+		return fmt.Errorf("CA certificate %s expired at %s", caCertFile, caCert.NotAfter)
+	}
+	return nil
+}
+
+// appendCAChain appends any intermediate or root certificates present in the
+// user-provided CA file to the written ca.crt, so that certificates issued by
+// an intermediate CA can be verified up to the enterprise root.
+func appendCAChain(pkiPath, caCertFile string) error {
+	caCertData, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return err
+	}
+
+	// skip the first certificate, WriteCert has written it already
+	var chain []byte
+	block, rest := pem.Decode(caCertData)
+	for block != nil {
+		block, rest = pem.Decode(rest)
+		if block != nil && block.Type == certificateBlockType {
+			chain = append(chain, pem.EncodeToMemory(block)...)
+		}
+	}
+	if len(chain) == 0 {
+		return nil
+	}
+
+	certificatePath := PathForCert(pkiPath, globaloptions.CaCertAndKeyName)
+	file, err := os.OpenFile(certificatePath, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = file.Write(chain)
+	return err
+}
+
 func getCACertAndKey(caCertFile, caKeyFile string) (caCert *x509.Certificate, caKey *crypto.Signer, err error) {
 	if caKeyFile != "" && caCertFile != "" {
 		certificate, err := tls.LoadX509KeyPair(caCertFile, caKeyFile)
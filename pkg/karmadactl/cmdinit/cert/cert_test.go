@@ -189,3 +189,64 @@ func compareCertFilesInDirs(dir1, dir2, filename string) (bool, error) {
 	file2 := filepath.Join(dir2, filename)
 	return compareFiles(file1, file2)
 }
+
+func TestValidateCACertAndKey(t *testing.T) {
+	pkiPath := t.TempDir()
+
+	caCert, caKey, err := NewCACertAndKey("test-ca")
+	if err != nil {
+		t.Fatalf("failed to create CA: %v", err)
+	}
+	if err := WriteCertAndKey(pkiPath, "ca", caCert, caKey); err != nil {
+		t.Fatalf("failed to write CA: %v", err)
+	}
+
+	notAfter := time.Now().Add(Duration365d).UTC()
+	leafCfg := NewCertConfig("test-leaf", []string{}, certutil.AltNames{}, &notAfter)
+	leafCert, leafKey, err := NewCertAndKey(caCert, *caKey, leafCfg)
+	if err != nil {
+		t.Fatalf("failed to create leaf certificate: %v", err)
+	}
+	if err := WriteCertAndKey(pkiPath, "leaf", leafCert, &leafKey); err != nil {
+		t.Fatalf("failed to write leaf certificate: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		certFile string
+		keyFile  string
+		wantErr  bool
+	}{
+		{
+			name:     "valid CA",
+			certFile: filepath.Join(pkiPath, "ca.crt"),
+			keyFile:  filepath.Join(pkiPath, "ca.key"),
+		},
+		{
+			name:     "not a CA certificate",
+			certFile: filepath.Join(pkiPath, "leaf.crt"),
+			keyFile:  filepath.Join(pkiPath, "leaf.key"),
+			wantErr:  true,
+		},
+		{
+			name:     "mismatched key",
+			certFile: filepath.Join(pkiPath, "ca.crt"),
+			keyFile:  filepath.Join(pkiPath, "leaf.key"),
+			wantErr:  true,
+		},
+		{
+			name:     "missing files",
+			certFile: filepath.Join(pkiPath, "absent.crt"),
+			keyFile:  filepath.Join(pkiPath, "absent.key"),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCACertAndKey(tt.certFile, tt.keyFile)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCACertAndKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cert
+
+import (
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/keyutil"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/cmdinit/options"
+	globaloptions "github.com/karmada-io/karmada/pkg/karmadactl/options"
+)
+
+// certificateRequestBlockType is a possible value for pem.Block.Type.
+const certificateRequestBlockType = "CERTIFICATE REQUEST"
+
+// PathForCSR returns the path for the certificate signing request given the
+// path and basename.
+func PathForCSR(csrPath, name string) string {
+	return filepath.Join(csrPath, fmt.Sprintf("%s.csr", name))
+}
+
+// GenerateCSRs writes a private key and a PEM-encoded certificate signing
+// request for every given certificate config, so the certificates can be
+// issued by an external CA and fed back via --external-cert-dir.
+func GenerateCSRs(csrPath string, cfgs map[string]*CertsConfig) error {
+	if err := os.MkdirAll(csrPath, os.FileMode(0700)); err != nil {
+		return fmt.Errorf("failed to create directory %s: %v", csrPath, err)
+	}
+	for name, cfg := range cfgs {
+		key, err := NewPrivateKey(cfg.PublicKeyAlgorithm)
+		if err != nil {
+			return fmt.Errorf("unable to create private key for %s: %v", name, err)
+		}
+
+		RemoveDuplicateAltNames(&cfg.AltNames)
+		csrTmpl := x509.CertificateRequest{
+			Subject: pkix.Name{
+				CommonName:   cfg.CommonName,
+				Organization: cfg.Organization,
+			},
+			DNSNames:    cfg.AltNames.DNSNames,
+			IPAddresses: cfg.AltNames.IPs,
+		}
+		csrDERBytes, err := x509.CreateCertificateRequest(rand.Reader, &csrTmpl, key)
+		if err != nil {
+			return fmt.Errorf("unable to create certificate signing request for %s: %v", name, err)
+		}
+
+		if err = WriteKey(csrPath, name, key); err != nil {
+			return err
+		}
+		csrPEM := pem.EncodeToMemory(&pem.Block{Type: certificateRequestBlockType, Bytes: csrDERBytes})
+		if err = certutil.WriteCert(PathForCSR(csrPath, name), csrPEM); err != nil {
+			return fmt.Errorf("unable to write certificate signing request for %s: %v", name, err)
+		}
+		klog.Infof("Generate %s certificate signing request success.", name)
+	}
+	return nil
+}
+
+// GenCertsFromExternalCA consumes certificates issued by an external CA: the
+// CA certificate (without its private key) and, per component, the signed
+// certificate together with the private key generated by --csr-output-dir.
+// The certificates are verified against the CA and staged into pkiPath the
+// same way internally issued ones would be. Etcd certificates keep using an
+// internally generated etcd CA since etcd is not exposed outside the control
+// plane.
+func GenCertsFromExternalCA(pkiPath, externalCACertPath, externalCertPath string, etcdServerCertCfg, etcdClientCertCfg *CertsConfig) error {
+	caCert, err := loadSingleCert(externalCACertPath)
+	if err != nil {
+		return fmt.Errorf("failed to load external CA certificate: %v", err)
+	}
+	if err = writeCertWithoutKey(pkiPath, globaloptions.CaCertAndKeyName, caCert); err != nil {
+		return err
+	}
+
+	for _, name := range []string{options.KarmadaCertAndKeyName, options.ApiserverCertAndKeyName} {
+		if err = loadExternalCertAndKey(pkiPath, externalCertPath, name, caCert); err != nil {
+			return err
+		}
+	}
+
+	// The front proxy CA may be the same external CA or a dedicated one,
+	// whatever signed front-proxy-client.crt.
+	frontProxyCaCert, err := loadSingleCert(filepath.Join(externalCertPath, fmt.Sprintf("%s.crt", options.FrontProxyCaCertAndKeyName)))
+	if err != nil {
+		return fmt.Errorf("failed to load external front proxy CA certificate: %v", err)
+	}
+	if err = writeCertWithoutKey(pkiPath, options.FrontProxyCaCertAndKeyName, frontProxyCaCert); err != nil {
+		return err
+	}
+	if err = loadExternalCertAndKey(pkiPath, externalCertPath, options.FrontProxyClientCertAndKeyName, frontProxyCaCert); err != nil {
+		return err
+	}
+
+	if etcdServerCertCfg == nil && etcdClientCertCfg == nil {
+		// use external etcd
+		return nil
+	}
+	return genEtcdCerts(pkiPath, etcdServerCertCfg, etcdClientCertCfg)
+}
+
+// loadExternalCertAndKey reads the signed certificate and its private key of
+// the named component from externalCertPath, verifies the pair and the issuer
+// and stages them into pkiPath.
+func loadExternalCertAndKey(pkiPath, externalCertPath, name string, caCert *x509.Certificate) error {
+	certPath := PathForCert(externalCertPath, name)
+	keyPath := PathForKey(externalCertPath, name)
+	pair, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return fmt.Errorf("failed to load externally issued certificate %s: %v", name, err)
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return fmt.Errorf("failed to parse externally issued certificate %s: %v", name, err)
+	}
+	if err = leaf.CheckSignatureFrom(caCert); err != nil {
+		return fmt.Errorf("externally issued certificate %s is not signed by the given CA: %v", name, err)
+	}
+
+	certData, err := os.ReadFile(certPath)
+	if err != nil {
+		return err
+	}
+	if err = certutil.WriteCert(PathForCert(pkiPath, name), certData); err != nil {
+		return fmt.Errorf("unable to write certificate %s: %v", name, err)
+	}
+	keyData, err := os.ReadFile(keyPath)
+	if err != nil {
+		return err
+	}
+	if err = keyutil.WriteKey(PathForKey(pkiPath, name), keyData); err != nil {
+		return fmt.Errorf("unable to write private key %s: %v", name, err)
+	}
+	klog.Infof("Load externally issued %s certificate success.", name)
+	return nil
+}
+
+// writeCertWithoutKey stages a CA certificate whose private key stays with
+// the external PKI. An empty key file is written so consumers expecting the
+// usual layout keep working; features requiring the CA key, such as signing
+// CSRs inside the control plane, are unavailable in this mode.
+func writeCertWithoutKey(pkiPath, name string, cert *x509.Certificate) error {
+	if err := WriteCert(pkiPath, name, cert); err != nil {
+		return err
+	}
+	if err := keyutil.WriteKey(PathForKey(pkiPath, name), nil); err != nil {
+		return fmt.Errorf("unable to write placeholder key for %s: %v", name, err)
+	}
+	return nil
+}
+
+func loadSingleCert(path string) (*x509.Certificate, error) {
+	certs, err := certutil.CertsFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return certs[0], nil
+}
@@ -75,11 +75,17 @@ var (
 		# Deploy highly available(HA) karmada
 		%[1]s init --karmada-apiserver-replicas 3 --etcd-replicas 3 --etcd-storage-mode PVC --storage-classes-name {StorageClassesName}
 
+		# Deploy highly available(HA) karmada with three replicas of every component and PodDisruptionBudgets
+		%[1]s init --ha --replicas 3 --etcd-storage-mode PVC --storage-classes-name {StorageClassesName}
+
 		# Specify external IPs(load balancer or HA IP) which used to sign the certificate
 		%[1]s init --cert-external-ip 10.235.1.2 --cert-external-dns www.karmada.io
 
 		# Install Karmada using a configuration file
-		%[1]s init --config /path/to/your/config/file.yaml`)
+		%[1]s init --config /path/to/your/config/file.yaml
+
+		# Install Karmada in a disconnected environment from an offline bundle
+		%[1]s init --offline-bundle /root/karmada-offline.tar.gz --private-image-registry local.registry.com/library`)
 )
 
 // NewCmdInit install Karmada on Kubernetes
@@ -130,6 +136,9 @@ func NewCmdInit(parentCommand string) *cobra.Command {
 	flags.DurationVar(&opts.CertValidity, "cert-validity-period", cert.Duration365d, "the validity period of Karmada certificate (e.g 8760h0m0s, that is 365 days)")
 	flags.StringVarP(&opts.CaCertFile, "ca-cert-file", "", "", "The root CA certificate file which will be used to issue new certificates for Karmada components. If not set, a new self-signed root CA certificate will be generated. This must be used together with --ca-key-file.")
 	flags.StringVarP(&opts.CaKeyFile, "ca-key-file", "", "", "The root CA private key file which will be used to issue new certificates for Karmada components. If not set, a new self-signed root CA key will be generated. This must be used together with --ca-cert-file.")
+	flags.StringVar(&opts.CSROutputDir, "csr-output-dir", "", "Generate private keys and certificate signing requests for the Karmada components into this directory and exit without installing. The requests can be signed by an external CA and the issued certificates fed back via --external-cert-dir.")
+	flags.StringVar(&opts.ExternalCACertFile, "external-ca-cert-file", "", "The CA certificate file of an external PKI whose private key is not available. The certificates in --external-cert-dir must be issued by this CA. Features requiring the CA private key, such as signing CSRs inside the control plane, are unavailable in this mode. This must be used together with --external-cert-dir.")
+	flags.StringVar(&opts.ExternalCertDir, "external-cert-dir", "", "Directory containing the externally issued certificates and the private keys generated by --csr-output-dir, e.g. karmada.crt/karmada.key, apiserver.crt/apiserver.key, front-proxy-ca.crt and front-proxy-client.crt/front-proxy-client.key. This must be used together with --external-ca-cert-file.")
 	// Kubernetes
 	flags.StringVarP(&opts.Namespace, "namespace", "n", "karmada-system", "Kubernetes namespace")
 	flags.StringVar(&opts.StorageClassesName, "storage-classes-name", "", "Kubernetes StorageClasses Name")
@@ -152,7 +161,10 @@ func NewCmdInit(parentCommand string) *cobra.Command {
 	flags.StringVar(&opts.ExternalEtcdKeyPrefix, "external-etcd-key-prefix", "", "The key prefix to be configured to kube-apiserver through --etcd-prefix.")
 	flags.StringVar(&opts.EtcdPriorityClass, "etcd-priority-class", "system-node-critical", "The priority class name for the component etcd.")
 	// karmada
+	flags.BoolVar(&opts.HighAvailability, "ha", false, "Deploy a highly available control plane: multi-replica components with pod anti-affinity, a multi-member etcd and PodDisruptionBudgets.")
+	flags.Int32Var(&opts.Replicas, "replicas", 3, "Replicas of each control plane component in high availability mode. Only works with --ha.")
 	flags.StringVar(&opts.CRDs, "crds", kubernetes.DefaultCrdURL, "Karmada crds resource.(local file e.g. --crds /root/crds.tar.gz)")
+	flags.StringVar(&opts.OfflineBundle, "offline-bundle", "", "Path to an offline installation bundle, a tar.gz containing crds.tar.gz and optionally images.tar. The bundled CRDs are used instead of downloading --crds, and images.tar is loaded into the local container runtime. Use together with --private-image-registry when images are served from a private registry.")
 	flags.StringVar(&opts.KarmadaInitFilePath, "config", "", "Karmada init file path")
 	flags.StringVarP(&opts.KarmadaAPIServerAdvertiseAddress, "karmada-apiserver-advertise-address", "", "", "The IP address the Karmada API Server will advertise it's listening on. If not set, the address on the master node will be used.")
 	flags.Int32VarP(&opts.KarmadaAPIServerNodePort, "port", "p", 32443, "Karmada apiserver service node port")
@@ -21,6 +21,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
@@ -138,6 +139,15 @@ func init() {
 
 // CommandInitOption holds all flags options for init.
 type CommandInitOption struct {
+	// HighAvailability deploys a highly available control plane: every
+	// component that is still at its single-replica default is raised to
+	// Replicas, etcd becomes a three-member cluster and PodDisruptionBudgets
+	// are created for all multi-replica components.
+	HighAvailability bool
+	// Replicas is the replicas of each control plane component in high
+	// availability mode.
+	Replicas int32
+
 	ImageRegistry          string
 	ImagePullPolicy        string
 	KubeImageRegistry      string
@@ -201,6 +211,10 @@ type CommandInitOption struct {
 	KarmadaDataPath    string
 	KarmadaPkiPath     string
 	CRDs               string
+	// OfflineBundle is the path of an offline installation bundle, a tar.gz
+	// containing crds.tar.gz and optionally images.tar, for installing in
+	// disconnected environments.
+	OfflineBundle      string
 	ExternalIP         string
 	ExternalDNS        string
 	PullSecrets        []string
@@ -213,6 +227,9 @@ type CommandInitOption struct {
 	WaitComponentReadyTimeout int
 	CaCertFile                string
 	CaKeyFile                 string
+	CSROutputDir              string
+	ExternalCACertFile        string
+	ExternalCertDir           string
 	KarmadaInitFilePath       string
 }
 
@@ -250,6 +267,21 @@ func (i *CommandInitOption) validateExternalEtcd(_ string) error {
 		(i.ExternalEtcdClientCertPath != "" && i.ExternalEtcdClientKeyPath == "") {
 		return fmt.Errorf("etcd client cert and key should be specified both or none")
 	}
+	for _, server := range strings.Split(i.ExternalEtcdServers, ",") {
+		parsed, err := url.Parse(server)
+		if err != nil || parsed.Host == "" {
+			return fmt.Errorf("invalid external etcd server %q", server)
+		}
+		switch parsed.Scheme {
+		case "http":
+		case "https":
+			if i.ExternalEtcdCACertPath == "" {
+				return fmt.Errorf("external-etcd-ca-cert-path is required when the external etcd is served over https")
+			}
+		default:
+			return fmt.Errorf("invalid external etcd server %q, the scheme must be http or https", server)
+		}
+	}
 	return nil
 }
 
@@ -269,6 +301,13 @@ func (i *CommandInitOption) Validate(parentCommand string) error {
 		}
 	}
 
+	if i.HighAvailability {
+		if i.Replicas < 2 {
+			return fmt.Errorf("replicas must be at least 2 in high availability mode")
+		}
+		i.applyHighAvailabilityDefaults()
+	}
+
 	if i.KarmadaAPIServerAdvertiseAddress != "" {
 		if netutils.ParseIPSloppy(i.KarmadaAPIServerAdvertiseAddress) == nil {
 			return fmt.Errorf("karmada apiserver advertise address is not valid")
@@ -277,6 +316,25 @@ func (i *CommandInitOption) Validate(parentCommand string) error {
 	if (i.CaCertFile != "") != (i.CaKeyFile != "") {
 		return fmt.Errorf("ca-cert-file and ca-key-file must be used together")
 	}
+	if i.CaCertFile != "" {
+		if err := cert.ValidateCACertAndKey(i.CaCertFile, i.CaKeyFile); err != nil {
+			return err
+		}
+	}
+	if (i.ExternalCACertFile != "") != (i.ExternalCertDir != "") {
+		return fmt.Errorf("external-ca-cert-file and external-cert-dir must be used together")
+	}
+	if i.ExternalCACertFile != "" && i.CaCertFile != "" {
+		return fmt.Errorf("external-ca-cert-file cannot be used together with ca-cert-file")
+	}
+	if i.CSROutputDir != "" && i.ExternalCACertFile != "" {
+		return fmt.Errorf("csr-output-dir cannot be used together with external-ca-cert-file")
+	}
+	if i.OfflineBundle != "" {
+		if exist, _ := utils.PathExists(i.OfflineBundle); !exist {
+			return fmt.Errorf("offline bundle %s not found", i.OfflineBundle)
+		}
+	}
 
 	switch i.ImagePullPolicy {
 	case string(corev1.PullAlways), string(corev1.PullIfNotPresent), string(corev1.PullNever):
@@ -291,6 +349,28 @@ func (i *CommandInitOption) Validate(parentCommand string) error {
 	return i.validateLocalEtcd(parentCommand)
 }
 
+// applyHighAvailabilityDefaults raises the replicas of every control plane
+// component that is still at its single-replica default. Replicas specified
+// explicitly, via flags or the init configuration file, are preserved.
+func (i *CommandInitOption) applyHighAvailabilityDefaults() {
+	for _, replicas := range []*int32{
+		&i.KarmadaAPIServerReplicas,
+		&i.KarmadaSchedulerReplicas,
+		&i.KubeControllerManagerReplicas,
+		&i.KarmadaControllerManagerReplicas,
+		&i.KarmadaWebhookReplicas,
+		&i.KarmadaAggregatedAPIServerReplicas,
+	} {
+		if *replicas == 1 {
+			*replicas = i.Replicas
+		}
+	}
+	// etcd requires an odd cluster size, three members tolerate one failure.
+	if !i.isExternalEtcdProvided() && i.EtcdReplicas == 1 {
+		i.EtcdReplicas = 3
+	}
+}
+
 // Complete Initialize k8s client
 func (i *CommandInitOption) Complete() error {
 	restConfig, err := apiclient.RestConfig(i.Context, i.KubeConfig)
@@ -411,6 +491,16 @@ func (i *CommandInitOption) genCerts() error {
 	apiserverCertCfg := cert.NewCertConfig("karmada-apiserver", []string{""}, karmadaAltNames, &notAfter)
 
 	frontProxyClientCertCfg := cert.NewCertConfig("front-proxy-client", []string{}, certutil.AltNames{}, &notAfter)
+	if i.CSROutputDir != "" {
+		return cert.GenerateCSRs(i.CSROutputDir, map[string]*cert.CertsConfig{
+			options.KarmadaCertAndKeyName:          karmadaCertCfg,
+			options.ApiserverCertAndKeyName:        apiserverCertCfg,
+			options.FrontProxyClientCertAndKeyName: frontProxyClientCertCfg,
+		})
+	}
+	if i.ExternalCACertFile != "" {
+		return cert.GenCertsFromExternalCA(i.KarmadaPkiPath, i.ExternalCACertFile, i.ExternalCertDir, etcdServerCertConfig, etcdClientCertCfg)
+	}
 	if err = cert.GenCerts(i.KarmadaPkiPath, i.CaCertFile, i.CaKeyFile, etcdServerCertConfig, etcdClientCertCfg, karmadaCertCfg, apiserverCertCfg, frontProxyClientCertCfg); err != nil {
 		return err
 	}
@@ -602,6 +692,12 @@ func (i *CommandInitOption) RunInit(parentCommand string) error {
 		return fmt.Errorf("certificate generation failed.%v", err)
 	}
 
+	if i.CSROutputDir != "" {
+		klog.Infof("Certificate signing requests have been written to %s.", i.CSROutputDir)
+		klog.Infof("Sign them with your CA, then rerun init with --external-ca-cert-file and --external-cert-dir to install with the issued certificates.")
+		return nil
+	}
+
 	i.CertAndKeyFileData = map[string][]byte{}
 
 	for _, v := range certList {
@@ -623,6 +719,14 @@ func (i *CommandInitOption) RunInit(parentCommand string) error {
 		i.CertAndKeyFileData[fmt.Sprintf("%s.key", v)] = key
 	}
 
+	// extract the offline bundle first, so the bundled CRDs take
+	// precedence over any remote URL
+	if i.OfflineBundle != "" {
+		if err := i.prepareOfflineBundle(); err != nil {
+			return fmt.Errorf("prepare offline bundle failed.%v", err)
+		}
+	}
+
 	// prepare karmada CRD resources
 	if err := i.prepareCRD(); err != nil {
 		return fmt.Errorf("prepare karmada failed.%v", err)
@@ -660,6 +764,14 @@ func (i *CommandInitOption) RunInit(parentCommand string) error {
 		return err
 	}
 
+	// Create PodDisruptionBudgets to keep the control plane available
+	// during voluntary disruptions on the host cluster.
+	if i.HighAvailability {
+		if err := i.createPodDisruptionBudgets(); err != nil {
+			return err
+		}
+	}
+
 	utils.GenExamples(i.KarmadaDataPath, parentCommand)
 	return nil
 }
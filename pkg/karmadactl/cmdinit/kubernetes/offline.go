@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/cmdinit/utils"
+)
+
+const (
+	// offlineBundleCRDsFile is the CRD tarball expected inside an offline bundle.
+	offlineBundleCRDsFile = "crds.tar.gz"
+	// offlineBundleImagesFile is the optional image archive expected inside
+	// an offline bundle, produced by `docker save`.
+	offlineBundleImagesFile = "images.tar"
+)
+
+// imageLoadCommands are the container runtime commands tried in order to load
+// the bundled images into the local node.
+var imageLoadCommands = [][]string{
+	{"docker", "load", "-i"},
+	{"nerdctl", "--namespace", "k8s.io", "load", "-i"},
+	{"ctr", "--namespace", "k8s.io", "images", "import"},
+}
+
+// prepareOfflineBundle extracts the offline bundle, points the CRD preparation
+// at the bundled crds.tar.gz instead of a remote URL, and loads the bundled
+// images, if any, into the local container runtime. It enables installation in
+// environments without access to the internet.
+func (i *CommandInitOption) prepareOfflineBundle() error {
+	bundleDir := filepath.Join(i.KarmadaDataPath, "offline-bundle")
+	if err := os.MkdirAll(bundleDir, 0700); err != nil {
+		return err
+	}
+	klog.Infof("extract offline bundle %s to %s", i.OfflineBundle, bundleDir)
+	if err := utils.DeCompress(i.OfflineBundle, bundleDir); err != nil {
+		return fmt.Errorf("failed to extract offline bundle %s, %v", i.OfflineBundle, err)
+	}
+
+	crdsFile := filepath.Join(bundleDir, offlineBundleCRDsFile)
+	if exist, _ := utils.PathExists(crdsFile); exist {
+		klog.Infof("use bundled crds file: %s", crdsFile)
+		i.CRDs = crdsFile
+	} else if strings.HasPrefix(i.CRDs, "http") {
+		return fmt.Errorf("offline bundle %s does not contain %s and --crds still points at a remote URL", i.OfflineBundle, offlineBundleCRDsFile)
+	}
+
+	imagesFile := filepath.Join(bundleDir, offlineBundleImagesFile)
+	if exist, _ := utils.PathExists(imagesFile); exist {
+		return loadImages(imagesFile)
+	}
+	return nil
+}
+
+// loadImages imports the image archive with the first container runtime CLI
+// found on the local node.
+func loadImages(imagesFile string) error {
+	for _, cmdline := range imageLoadCommands {
+		if _, err := exec.LookPath(cmdline[0]); err != nil {
+			continue
+		}
+		klog.Infof("load images from %s via %s", imagesFile, cmdline[0])
+		cmd := exec.Command(cmdline[0], append(cmdline[1:], imagesFile)...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to load images from %s, %v", imagesFile, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("no container runtime CLI found to load %s, load the images on every node manually or remove it from the bundle", imagesFile)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+// makePodDisruptionBudget generates a PodDisruptionBudget that allows at most
+// one pod of the component to be disrupted voluntarily at a time.
+func (i *CommandInitOption) makePodDisruptionBudget(name string, selectorLabels map[string]string) *policyv1.PodDisruptionBudget {
+	maxUnavailable := intstr.FromInt32(1)
+	return &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "policy/v1",
+			Kind:       "PodDisruptionBudget",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: i.Namespace,
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: &maxUnavailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: selectorLabels,
+			},
+		},
+	}
+}
+
+// createPodDisruptionBudgets creates a PodDisruptionBudget for every
+// multi-replica control plane component, so node drains on the host cluster
+// cannot take the whole control plane down at once. Single-replica components
+// are skipped, a budget would block their only pod from being evicted.
+func (i *CommandInitOption) createPodDisruptionBudgets() error {
+	components := []struct {
+		name     string
+		labels   map[string]string
+		replicas int32
+	}{
+		{etcdStatefulSetAndServiceName, etcdLabels, i.EtcdReplicas},
+		{karmadaAPIServerDeploymentAndServiceName, apiServerLabels, i.KarmadaAPIServerReplicas},
+		{kubeControllerManagerClusterRoleAndDeploymentAndServiceName, kubeControllerManagerLabels, i.KubeControllerManagerReplicas},
+		{schedulerDeploymentNameAndServiceAccountName, schedulerLabels, i.KarmadaSchedulerReplicas},
+		{controllerManagerDeploymentAndServiceName, controllerManagerLabels, i.KarmadaControllerManagerReplicas},
+		{webhookDeploymentAndServiceAccountAndServiceName, webhookLabels, i.KarmadaWebhookReplicas},
+		{karmadaAggregatedAPIServerDeploymentAndServiceName, aggregatedAPIServerLabels, i.KarmadaAggregatedAPIServerReplicas},
+	}
+
+	for _, component := range components {
+		if component.replicas < 2 {
+			continue
+		}
+		klog.Infof("Create %s PodDisruptionBudget", component.name)
+		if err := util.CreateOrUpdatePodDisruptionBudget(i.KubeClientSet, i.makePodDisruptionBudget(component.name, component.labels)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
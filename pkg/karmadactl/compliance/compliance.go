@@ -0,0 +1,223 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package compliance implements the `karmadactl compliance` command displaying
+// the federated policy-engine results collected from the member clusters.
+package compliance
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+)
+
+var (
+	complianceLong = templates.LongDesc(`
+		Display the policy-engine compliance of the member fleet.
+
+		The compliance-report controller collects the PolicyReport and
+		ClusterPolicyReport objects (wgpolicyk8s.io) that engines such as
+		Kyverno or Gatekeeper maintain in the member clusters and aggregates
+		them into one ClusterComplianceReport per cluster on the control
+		plane. This command prints those reports as per-cluster rollups, and
+		with --show-namespaces as per-namespace rollups.`)
+
+	complianceExample = templates.Examples(`
+		# Display the compliance rollup of every member cluster
+		%[1]s compliance
+
+		# Display the compliance rollup of cluster member1 only
+		%[1]s compliance member1
+
+		# Display the per-namespace rollups of every member cluster
+		%[1]s compliance --show-namespaces`)
+)
+
+var clusterComplianceReportResource = schema.GroupVersionResource{
+	Group:    policyv1alpha1.GroupName,
+	Version:  policyv1alpha1.GroupVersion.Version,
+	Resource: policyv1alpha1.ResourcePluralClusterComplianceReport,
+}
+
+// CommandComplianceOptions contains the input to the compliance command.
+type CommandComplianceOptions struct {
+	// Clusters limits the output to the given member clusters. Empty means
+	// all clusters that have a compliance report.
+	Clusters []string
+
+	// ShowNamespaces prints one row per namespace instead of one per cluster.
+	ShowNamespaces bool
+
+	genericiooptions.IOStreams
+}
+
+// NewCmdCompliance returns the compliance command displaying federated
+// policy-engine results.
+func NewCmdCompliance(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandComplianceOptions{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "compliance [CLUSTER...]",
+		Short:                 "Display the policy-engine compliance of the member fleet",
+		Long:                  complianceLong,
+		Example:               fmt.Sprintf(complianceExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		ValidArgsFunction:     utilcomp.ClusterNameCompletionFunc(),
+		RunE: func(_ *cobra.Command, args []string) error {
+			o.Clusters = args
+			return o.Run(f)
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterTroubleshootingAndDebugging,
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	flags.BoolVar(&o.ShowNamespaces, "show-namespaces", false, "Print the per-namespace rollups of each cluster instead of one row per cluster.")
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	return cmd
+}
+
+// Run lists the compliance reports of the fleet and prints the rollups.
+func (o *CommandComplianceOptions) Run(f util.Factory) error {
+	restConfig, err := f.ToRawKubeConfigLoader().ClientConfig()
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+
+	reports, err := listComplianceReports(context.TODO(), dynamicClient, o.Clusters)
+	if err != nil {
+		return err
+	}
+	if len(reports) == 0 {
+		fmt.Fprintln(o.Out, "No compliance reports found. The member clusters run no policy engine, or the complianceReport controller is disabled.")
+		return nil
+	}
+
+	if o.ShowNamespaces {
+		printNamespaceRollups(o.Out, reports)
+	} else {
+		printClusterRollups(o.Out, reports)
+	}
+	return nil
+}
+
+// listComplianceReports returns the ClusterComplianceReports of the fleet,
+// limited to the given clusters when any are named.
+func listComplianceReports(ctx context.Context, dynamicClient dynamic.Interface, clusters []string) ([]policyv1alpha1.ClusterComplianceReport, error) {
+	list, err := dynamicClient.Resource(clusterComplianceReportResource).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list compliance reports: %v", err)
+	}
+
+	selected := sets.New[string](clusters...)
+	var reports []policyv1alpha1.ClusterComplianceReport
+	for i := range list.Items {
+		if selected.Len() > 0 && !selected.Has(list.Items[i].GetName()) {
+			continue
+		}
+		report := policyv1alpha1.ClusterComplianceReport{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, &report); err != nil {
+			return nil, fmt.Errorf("failed to decode compliance report %s: %v", list.Items[i].GetName(), err)
+		}
+		reports = append(reports, report)
+	}
+
+	for _, cluster := range sets.List(selected) {
+		if !hasReport(reports, cluster) {
+			return nil, fmt.Errorf("no compliance report for cluster %s, the cluster runs no policy engine or is not collected yet", cluster)
+		}
+	}
+	return reports, nil
+}
+
+func hasReport(reports []policyv1alpha1.ClusterComplianceReport, cluster string) bool {
+	for i := range reports {
+		if reports[i].Name == cluster {
+			return true
+		}
+	}
+	return false
+}
+
+// printClusterRollups prints one row per cluster plus a fleet-wide total.
+func printClusterRollups(out io.Writer, reports []policyv1alpha1.ClusterComplianceReport) {
+	writer := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(writer, "CLUSTER\tPASS\tFAIL\tWARN\tERROR\tSKIP\tLAST-UPDATE")
+	total := policyv1alpha1.ComplianceSummary{}
+	for i := range reports {
+		report := &reports[i]
+		printSummaryRow(writer, report.Name, report.Summary, report.LastUpdateTime)
+		total.Pass += report.Summary.Pass
+		total.Fail += report.Summary.Fail
+		total.Warn += report.Summary.Warn
+		total.Error += report.Summary.Error
+		total.Skip += report.Summary.Skip
+	}
+	if len(reports) > 1 {
+		fmt.Fprintf(writer, "TOTAL\t%d\t%d\t%d\t%d\t%d\t\n", total.Pass, total.Fail, total.Warn, total.Error, total.Skip)
+	}
+	writer.Flush()
+}
+
+// printNamespaceRollups prints one row per cluster and namespace. The results
+// of cluster-scoped reports show up under the synthetic (cluster) namespace.
+func printNamespaceRollups(out io.Writer, reports []policyv1alpha1.ClusterComplianceReport) {
+	writer := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(writer, "CLUSTER\tNAMESPACE\tPASS\tFAIL\tWARN\tERROR\tSKIP\tLAST-UPDATE")
+	for i := range reports {
+		report := &reports[i]
+		if report.ClusterScoped != (policyv1alpha1.ComplianceSummary{}) {
+			printSummaryRow(writer, report.Name+"\t(cluster)", report.ClusterScoped, report.LastUpdateTime)
+		}
+		for _, namespace := range report.Namespaces {
+			printSummaryRow(writer, report.Name+"\t"+namespace.Namespace, namespace.Summary, report.LastUpdateTime)
+		}
+	}
+	writer.Flush()
+}
+
+func printSummaryRow(out io.Writer, key string, summary policyv1alpha1.ComplianceSummary, lastUpdate metav1.Time) {
+	age := "<unknown>"
+	if !lastUpdate.IsZero() {
+		age = duration.HumanDuration(time.Since(lastUpdate.Time))
+	}
+	fmt.Fprintf(out, "%s\t%d\t%d\t%d\t%d\t%d\t%s\n", key, summary.Pass, summary.Fail, summary.Warn, summary.Error, summary.Skip, age)
+}
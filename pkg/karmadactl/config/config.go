@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package config implements the karmadactl configuration file and the
+// `karmadactl config` command managing it. The file maps named Karmada
+// instances to kubeconfig paths, so users operating several control planes
+// (dev/staging/prod) do not have to juggle --kubeconfig flags.
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"sigs.k8s.io/yaml"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+// PathEnv overrides the location of the karmadactl configuration file.
+const PathEnv = "KARMADACTL_CONFIG"
+
+// Config is the karmadactl configuration stored at ~/.karmada/config.yaml.
+type Config struct {
+	// CurrentContext is the name of the context whose kubeconfig is used by
+	// default.
+	CurrentContext string `json:"current-context,omitempty"`
+	// Contexts maps named Karmada instances to kubeconfig paths.
+	Contexts []Context `json:"contexts,omitempty"`
+	// Defaults maps flag names to per-user default values, merged under
+	// explicit flags for every command carrying the flag.
+	Defaults map[string]string `json:"defaults,omitempty"`
+}
+
+// Context names one Karmada control plane.
+type Context struct {
+	// Name is the name of the context, e.g. "prod".
+	Name string `json:"name"`
+	// KubeConfig is the path of the kubeconfig file pointing at the Karmada
+	// control plane.
+	KubeConfig string `json:"kubeconfig"`
+}
+
+// Context returns the named context, nil if it does not exist.
+func (c *Config) Context(name string) *Context {
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == name {
+			return &c.Contexts[i]
+		}
+	}
+	return nil
+}
+
+// DefaultPath returns the location of the karmadactl configuration file.
+func DefaultPath() (string, error) {
+	if path := os.Getenv(PathEnv); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".karmada", "config.yaml"), nil
+}
+
+// Load reads the karmadactl configuration file, a missing file yields an
+// empty configuration.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &Config{}, nil
+		}
+		return nil, err
+	}
+	config := &Config{}
+	if err = yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return config, nil
+}
+
+// Save writes the karmadactl configuration file.
+func Save(config *Config, path string) error {
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// ApplyCurrentContext makes the kubeconfig of the current context the default
+// for CLI requests. Explicit --kubeconfig flags and the KUBECONFIG
+// environment variable still win.
+func ApplyCurrentContext(configFlags *genericclioptions.ConfigFlags) {
+	if *configFlags.KubeConfig != "" || os.Getenv("KUBECONFIG") != "" {
+		return
+	}
+	path, err := DefaultPath()
+	if err != nil {
+		return
+	}
+	config, err := Load(path)
+	if err != nil || config.CurrentContext == "" {
+		return
+	}
+	if context := config.Context(config.CurrentContext); context != nil {
+		*configFlags.KubeConfig = context.KubeConfig
+	}
+}
+
+// ApplyDefaults merges the per-user default flag values of the karmadactl
+// configuration file under the flags of the command. Flags set explicitly on
+// the command line win, flags the command does not carry are skipped.
+func ApplyDefaults(cmd *cobra.Command) error {
+	path, err := DefaultPath()
+	if err != nil {
+		return err
+	}
+	config, err := Load(path)
+	if err != nil {
+		return err
+	}
+	return applyDefaults(cmd, config)
+}
+
+func applyDefaults(cmd *cobra.Command, config *Config) error {
+	for name, value := range config.Defaults {
+		flag := cmd.Flags().Lookup(name)
+		if flag == nil || flag.Changed {
+			continue
+		}
+		var err error
+		if sliceValue, ok := flag.Value.(pflag.SliceValue); ok {
+			err = sliceValue.Replace(strings.Split(value, ","))
+		} else {
+			err = flag.Value.Set(value)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid default %s=%q in the karmadactl configuration file: %v", name, value, err)
+		}
+	}
+	return nil
+}
+
+// NewCmdConfig returns the config command managing the karmadactl
+// configuration file.
+func NewCmdConfig(parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config SUBCOMMAND",
+		Short: "Modify the karmadactl configuration file",
+		Long:  fmt.Sprintf("Modify the karmadactl configuration file mapping named Karmada instances to kubeconfig paths. Run '%s config SUBCOMMAND --help' for more information.", parentCommand),
+		Run:   cmdutil.DefaultSubCommandRun(streams.ErrOut),
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupSettingsCommands,
+		},
+	}
+
+	cmd.AddCommand(NewCmdGetContexts(parentCommand, streams))
+	cmd.AddCommand(NewCmdUseContext(parentCommand, streams))
+	cmd.AddCommand(NewCmdSetContext(parentCommand, streams))
+	cmd.AddCommand(NewCmdSet(parentCommand, streams))
+	cmd.AddCommand(NewCmdUnset(parentCommand, streams))
+	return cmd
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"bytes"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func TestLoadSaveRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+
+	config, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() of missing file unexpected error: %v", err)
+	}
+	if config.CurrentContext != "" || len(config.Contexts) != 0 {
+		t.Fatalf("Load() of missing file = %+v, want empty config", config)
+	}
+
+	config = &Config{
+		CurrentContext: "prod",
+		Contexts: []Context{
+			{Name: "dev", KubeConfig: "/etc/karmada/dev.config"},
+			{Name: "prod", KubeConfig: "/etc/karmada/prod.config"},
+		},
+	}
+	if err = Save(config, path); err != nil {
+		t.Fatalf("Save() unexpected error: %v", err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(loaded, config) {
+		t.Errorf("Load() = %+v, want %+v", loaded, config)
+	}
+}
+
+func TestUseContext(t *testing.T) {
+	config := &Config{Contexts: []Context{{Name: "dev", KubeConfig: "/etc/karmada/dev.config"}}}
+
+	if err := useContext(config, "prod"); err == nil {
+		t.Error("useContext() of unknown context expected error, got nil")
+	}
+	if err := useContext(config, "dev"); err != nil {
+		t.Fatalf("useContext() unexpected error: %v", err)
+	}
+	if config.CurrentContext != "dev" {
+		t.Errorf("CurrentContext = %q, want %q", config.CurrentContext, "dev")
+	}
+}
+
+func TestSetContext(t *testing.T) {
+	config := &Config{}
+
+	if modified := setContext(config, "dev", "/etc/karmada/dev.config"); modified {
+		t.Error("setContext() of new context reported modified")
+	}
+	if config.CurrentContext != "dev" {
+		t.Errorf("first context did not become current, CurrentContext = %q", config.CurrentContext)
+	}
+
+	if modified := setContext(config, "prod", "/etc/karmada/prod.config"); modified {
+		t.Error("setContext() of new context reported modified")
+	}
+	if config.CurrentContext != "dev" {
+		t.Errorf("CurrentContext changed to %q by adding a context", config.CurrentContext)
+	}
+
+	if modified := setContext(config, "dev", "/etc/karmada/dev2.config"); !modified {
+		t.Error("setContext() of existing context did not report modified")
+	}
+	if got := config.Context("dev").KubeConfig; got != "/etc/karmada/dev2.config" {
+		t.Errorf("KubeConfig = %q, want %q", got, "/etc/karmada/dev2.config")
+	}
+}
+
+func TestApplyCurrentContext(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv(PathEnv, path)
+	t.Setenv("KUBECONFIG", "")
+
+	config := &Config{
+		CurrentContext: "prod",
+		Contexts:       []Context{{Name: "prod", KubeConfig: "/etc/karmada/prod.config"}},
+	}
+	if err := Save(config, path); err != nil {
+		t.Fatal(err)
+	}
+
+	configFlags := genericclioptions.NewConfigFlags(true)
+	ApplyCurrentContext(configFlags)
+	if *configFlags.KubeConfig != "/etc/karmada/prod.config" {
+		t.Errorf("KubeConfig = %q, want %q", *configFlags.KubeConfig, "/etc/karmada/prod.config")
+	}
+
+	// An explicit kubeconfig must not be overwritten.
+	explicit := "/tmp/explicit.config"
+	configFlags.KubeConfig = &explicit
+	ApplyCurrentContext(configFlags)
+	if *configFlags.KubeConfig != explicit {
+		t.Errorf("KubeConfig = %q, want explicit %q preserved", *configFlags.KubeConfig, explicit)
+	}
+}
+
+func TestPrintContexts(t *testing.T) {
+	config := &Config{
+		CurrentContext: "prod",
+		Contexts: []Context{
+			{Name: "dev", KubeConfig: "/etc/karmada/dev.config"},
+			{Name: "prod", KubeConfig: "/etc/karmada/prod.config"},
+		},
+	}
+	out := &bytes.Buffer{}
+	if err := printContexts(out, config); err != nil {
+		t.Fatalf("printContexts() unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("printContexts() printed %d lines, want 3:\n%s", len(lines), out.String())
+	}
+	if !strings.HasPrefix(lines[0], "CURRENT") {
+		t.Errorf("header = %q, want CURRENT prefix", lines[0])
+	}
+	if strings.HasPrefix(lines[1], "*") || !strings.Contains(lines[1], "dev") {
+		t.Errorf("dev line = %q, want unmarked dev context", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], "*") || !strings.Contains(lines[2], "prod") {
+		t.Errorf("prod line = %q, want current marker", lines[2])
+	}
+}
+
+func TestApplyDefaults(t *testing.T) {
+	cmd := &cobra.Command{Use: "fake"}
+	scope := cmd.Flags().String("operation-scope", "karmada", "")
+	clusters := cmd.Flags().StringSlice("clusters", nil, "")
+	output := cmd.Flags().String("output", "", "")
+	if err := cmd.Flags().Set("output", "json"); err != nil {
+		t.Fatal(err)
+	}
+
+	config := &Config{Defaults: map[string]string{
+		"operation-scope": "members",
+		"clusters":        "member1,member2",
+		"output":          "wide",
+		"unknown-flag":    "ignored",
+	}}
+	if err := applyDefaults(cmd, config); err != nil {
+		t.Fatalf("applyDefaults() unexpected error: %v", err)
+	}
+
+	if *scope != "members" {
+		t.Errorf("operation-scope = %q, want default %q", *scope, "members")
+	}
+	if want := []string{"member1", "member2"}; !reflect.DeepEqual(*clusters, want) {
+		t.Errorf("clusters = %v, want %v", *clusters, want)
+	}
+	if *output != "json" {
+		t.Errorf("output = %q, want explicit %q preserved", *output, "json")
+	}
+	if cmd.Flags().Changed("operation-scope") {
+		t.Error("applying a default marked the flag as changed")
+	}
+}
+
+func TestApplyDefaultsInvalidValue(t *testing.T) {
+	cmd := &cobra.Command{Use: "fake"}
+	cmd.Flags().Int("chunk-size", 500, "")
+
+	config := &Config{Defaults: map[string]string{"chunk-size": "many"}}
+	if err := applyDefaults(cmd, config); err == nil {
+		t.Error("applyDefaults() with invalid value expected error, got nil")
+	}
+}
+
+func TestDefaultFlagName(t *testing.T) {
+	name, err := defaultFlagName("--operation-scope")
+	if err != nil || name != "operation-scope" {
+		t.Errorf("defaultFlagName(--operation-scope) = (%q, %v), want (%q, nil)", name, err, "operation-scope")
+	}
+	if _, err = defaultFlagName("--"); err == nil {
+		t.Error("defaultFlagName(--) expected error, got nil")
+	}
+}
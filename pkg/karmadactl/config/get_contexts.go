@@ -0,0 +1,77 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	getContextsLong = templates.LongDesc(`
+		Display the contexts of the karmadactl configuration file.
+
+		The current context is marked with an asterisk.`)
+
+	getContextsExample = templates.Examples(`
+		# List all Karmada contexts
+		%[1]s config get-contexts`)
+)
+
+// NewCmdGetContexts returns the config get-contexts command.
+func NewCmdGetContexts(parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "get-contexts",
+		Short:                 "Display the contexts of the karmadactl configuration file",
+		Long:                  getContextsLong,
+		Example:               fmt.Sprintf(getContextsExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			path, err := DefaultPath()
+			if err != nil {
+				return err
+			}
+			config, err := Load(path)
+			if err != nil {
+				return err
+			}
+			return printContexts(streams.Out, config)
+		},
+	}
+	return cmd
+}
+
+// printContexts prints the contexts as a table, marking the current one.
+func printContexts(out io.Writer, config *Config) error {
+	writer := tabwriter.NewWriter(out, 0, 8, 3, ' ', 0)
+	fmt.Fprintln(writer, "CURRENT\tNAME\tKUBECONFIG")
+	for _, context := range config.Contexts {
+		current := ""
+		if context.Name == config.CurrentContext {
+			current = "*"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", current, context.Name, context.KubeConfig)
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	setLong = templates.LongDesc(`
+		Set a default flag value in the karmadactl configuration file.
+
+		The default applies to every command carrying the flag, an explicit
+		flag on the command line still wins. Values of list flags are
+		comma-separated.`)
+
+	setExample = templates.Examples(`
+		# Target member clusters by default
+		%[1]s config set operation-scope members
+
+		# Target a fixed set of member clusters by default
+		%[1]s config set clusters member1,member2
+
+		# Always print the output in a wide format
+		%[1]s config set output wide`)
+
+	unsetExample = templates.Examples(`
+		# Remove the default operation scope
+		%[1]s config unset operation-scope`)
+)
+
+// NewCmdSet returns the config set command.
+func NewCmdSet(parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "set FLAG_NAME VALUE",
+		Short:                 "Set a default flag value in the karmadactl configuration file",
+		Long:                  setLong,
+		Example:               fmt.Sprintf(setExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE: func(_ *cobra.Command, args []string) error {
+			name, err := defaultFlagName(args[0])
+			if err != nil {
+				return err
+			}
+			path, err := DefaultPath()
+			if err != nil {
+				return err
+			}
+			config, err := Load(path)
+			if err != nil {
+				return err
+			}
+			if config.Defaults == nil {
+				config.Defaults = map[string]string{}
+			}
+			config.Defaults[name] = args[1]
+			if err = Save(config, path); err != nil {
+				return err
+			}
+			fmt.Fprintf(streams.Out, "Default %s=%q set.\n", name, args[1])
+			return nil
+		},
+	}
+	return cmd
+}
+
+// NewCmdUnset returns the config unset command.
+func NewCmdUnset(parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "unset FLAG_NAME",
+		Short:                 "Remove a default flag value from the karmadactl configuration file",
+		Example:               fmt.Sprintf(unsetExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			name, err := defaultFlagName(args[0])
+			if err != nil {
+				return err
+			}
+			path, err := DefaultPath()
+			if err != nil {
+				return err
+			}
+			config, err := Load(path)
+			if err != nil {
+				return err
+			}
+			if _, exist := config.Defaults[name]; !exist {
+				return fmt.Errorf("no default exists for flag %q", name)
+			}
+			delete(config.Defaults, name)
+			if err = Save(config, path); err != nil {
+				return err
+			}
+			fmt.Fprintf(streams.Out, "Default %q unset.\n", name)
+			return nil
+		},
+	}
+	return cmd
+}
+
+// defaultFlagName normalizes the flag name of a default, tolerating leading
+// dashes.
+func defaultFlagName(arg string) (string, error) {
+	name := strings.TrimLeft(arg, "-")
+	if name == "" {
+		return "", errors.New("flag name must not be empty")
+	}
+	return name, nil
+}
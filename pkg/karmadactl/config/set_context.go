@@ -0,0 +1,95 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	setContextLong = templates.LongDesc(`
+		Set a context in the karmadactl configuration file.
+
+		Creates the context if it does not exist yet. The first context ever
+		created becomes the current one.`)
+
+	setContextExample = templates.Examples(`
+		# Register the prod Karmada control plane
+		%[1]s config set-context prod --kubeconfig-path=/etc/karmada/prod.config`)
+)
+
+// NewCmdSetContext returns the config set-context command.
+func NewCmdSetContext(parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	var kubeConfigPath string
+
+	cmd := &cobra.Command{
+		Use:                   "set-context CONTEXT_NAME --kubeconfig-path=<KUBECONFIG>",
+		Short:                 "Set a context in the karmadactl configuration file",
+		Long:                  setContextLong,
+		Example:               fmt.Sprintf(setContextExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		ValidArgsFunction:     contextNameCompletionFunc,
+		RunE: func(_ *cobra.Command, args []string) error {
+			if kubeConfigPath == "" {
+				return errors.New("must specify the kubeconfig of the context by --kubeconfig-path")
+			}
+			path, err := DefaultPath()
+			if err != nil {
+				return err
+			}
+			config, err := Load(path)
+			if err != nil {
+				return err
+			}
+			modified := setContext(config, args[0], kubeConfigPath)
+			if err = Save(config, path); err != nil {
+				return err
+			}
+			if modified {
+				fmt.Fprintf(streams.Out, "Context %q modified.\n", args[0])
+			} else {
+				fmt.Fprintf(streams.Out, "Context %q created.\n", args[0])
+			}
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&kubeConfigPath, "kubeconfig-path", "", "Path of the kubeconfig file pointing at the Karmada control plane.")
+	return cmd
+}
+
+// setContext creates or updates the named context and reports whether it
+// already existed.
+func setContext(config *Config, name, kubeConfigPath string) bool {
+	if context := config.Context(name); context != nil {
+		context.KubeConfig = kubeConfigPath
+		return true
+	}
+	config.Contexts = append(config.Contexts, Context{Name: name, KubeConfig: kubeConfigPath})
+	if config.CurrentContext == "" {
+		config.CurrentContext = name
+	}
+	return false
+}
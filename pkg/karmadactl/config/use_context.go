@@ -0,0 +1,96 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	useContextLong = templates.LongDesc(`
+		Set the current context of the karmadactl configuration file.
+
+		The kubeconfig of the current context is used by default, an explicit
+		--kubeconfig flag or the KUBECONFIG environment variable still wins.`)
+
+	useContextExample = templates.Examples(`
+		# Use the context of the prod Karmada control plane
+		%[1]s config use-context prod`)
+)
+
+// NewCmdUseContext returns the config use-context command.
+func NewCmdUseContext(parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "use-context CONTEXT_NAME",
+		Short:                 "Set the current context of the karmadactl configuration file",
+		Long:                  useContextLong,
+		Example:               fmt.Sprintf(useContextExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		ValidArgsFunction:     contextNameCompletionFunc,
+		RunE: func(_ *cobra.Command, args []string) error {
+			path, err := DefaultPath()
+			if err != nil {
+				return err
+			}
+			config, err := Load(path)
+			if err != nil {
+				return err
+			}
+			if err = useContext(config, args[0]); err != nil {
+				return err
+			}
+			if err = Save(config, path); err != nil {
+				return err
+			}
+			fmt.Fprintf(streams.Out, "Switched to context %q.\n", args[0])
+			return nil
+		},
+	}
+	return cmd
+}
+
+// useContext makes the named context the current one.
+func useContext(config *Config, name string) error {
+	if config.Context(name) == nil {
+		return fmt.Errorf("no context exists with the name %q", name)
+	}
+	config.CurrentContext = name
+	return nil
+}
+
+// contextNameCompletionFunc completes the names of the configured contexts.
+func contextNameCompletionFunc(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	path, err := DefaultPath()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	config, err := Load(path)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var names []string
+	for _, context := range config.Contexts {
+		names = append(names, context.Name)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
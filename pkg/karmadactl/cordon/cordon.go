@@ -21,11 +21,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/kubectl/pkg/util/templates"
 
@@ -33,6 +35,7 @@ import (
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
 	"github.com/karmada-io/karmada/pkg/karmadactl/options"
 	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util/cmdresult"
 	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
 )
 
@@ -45,10 +48,22 @@ var (
 
 	cordonExample = templates.Examples(`
 		# Mark cluster "foo" as unschedulable.
-		%[1]s cordon foo`)
+		%[1]s cordon foo
+
+		# Mark all clusters labeled region=us-east as unschedulable.
+		%[1]s cordon --cluster-selector region=us-east
+
+		# Mark cluster "foo" as unschedulable and automatically uncordon it after two hours.
+		%[1]s cordon foo --until 2h
+
+		# Mark cluster "foo" as unschedulable and record why.
+		%[1]s cordon foo --reason "kernel upgrade, see ticket OPS-1234"`)
 	uncordonExample = templates.Examples(`
 		# Mark cluster "foo" as schedulable.
-		%[1]s uncordon foo`)
+		%[1]s uncordon foo
+
+		# Mark all clusters labeled region=us-east as schedulable.
+		%[1]s uncordon --cluster-selector region=us-east`)
 )
 
 const (
@@ -64,21 +79,24 @@ func NewCmdCordon(f util.Factory, parentCommand string) *cobra.Command {
 	opts := CommandCordonOption{}
 
 	cmd := &cobra.Command{
-		Use:                   "cordon CLUSTER",
+		Use:                   "cordon (CLUSTER... | --cluster-selector SELECTOR)",
 		Short:                 "Mark cluster as unschedulable",
 		Long:                  cordonLong,
 		Example:               fmt.Sprintf(cordonExample, parentCommand),
 		SilenceUsage:          true,
 		DisableFlagsInUseLine: true,
 		ValidArgsFunction:     utilcomp.SpecifiedResourceTypeAndNameCompletionFunc(f, []string{"cluster"}),
-		RunE: func(_ *cobra.Command, args []string) error {
-			if err := opts.Complete(args); err != nil {
-				return err
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Output == cmdresult.OutputFormatJSON {
+				cmd.SilenceErrors = true
 			}
-			if err := RunCordonOrUncordon(DesiredCordon, f, opts); err != nil {
-				return err
-			}
-			return nil
+			runErr := func() error {
+				if err := opts.Complete(args); err != nil {
+					return cmdresult.NewError(cmdresult.ValidationFailed, err)
+				}
+				return RunCordonOrUncordon(DesiredCordon, f, opts)
+			}()
+			return cmdresult.Print(cmd.OutOrStdout(), opts.Output, "cordon", runErr)
 		},
 		Annotations: map[string]string{
 			util.TagCommandGroup: util.GroupClusterManagement,
@@ -88,6 +106,10 @@ func NewCmdCordon(f util.Factory, parentCommand string) *cobra.Command {
 	flags := cmd.Flags()
 	options.AddKubeConfigFlags(flags)
 	flags.BoolVar(&opts.DryRun, "dry-run", false, "Run the command in dry-run mode, without making any server requests.")
+	flags.StringVarP(&opts.Output, "output", "o", "", "Output format. One of: json.")
+	flags.StringVar(&opts.ClusterSelector, "cluster-selector", "", "Label selector to select target clusters instead of naming them, for example: region=us-east. Cannot be combined with cluster names.")
+	flags.DurationVar(&opts.Until, "until", 0, "Automatically uncordon the cluster after the given duration, for example: 2h. Relies on the scheduled-uncordon controller of karmada-controller-manager.")
+	flags.StringVar(&opts.Reason, "reason", "", "Record why the cluster is cordoned as an annotation on the Cluster object, for example: a maintenance ticket reference.")
 
 	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
 	return cmd
@@ -98,21 +120,24 @@ func NewCmdUncordon(f util.Factory, parentCommand string) *cobra.Command {
 	opts := CommandCordonOption{}
 
 	cmd := &cobra.Command{
-		Use:                   "uncordon CLUSTER",
+		Use:                   "uncordon (CLUSTER... | --cluster-selector SELECTOR)",
 		Short:                 "Mark cluster as schedulable",
 		Long:                  uncordonLong,
 		Example:               fmt.Sprintf(uncordonExample, parentCommand),
 		SilenceUsage:          true,
 		DisableFlagsInUseLine: true,
 		ValidArgsFunction:     utilcomp.SpecifiedResourceTypeAndNameCompletionFunc(f, []string{"cluster"}),
-		RunE: func(_ *cobra.Command, args []string) error {
-			if err := opts.Complete(args); err != nil {
-				return err
-			}
-			if err := RunCordonOrUncordon(DesiredUnCordon, f, opts); err != nil {
-				return err
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Output == cmdresult.OutputFormatJSON {
+				cmd.SilenceErrors = true
 			}
-			return nil
+			runErr := func() error {
+				if err := opts.Complete(args); err != nil {
+					return cmdresult.NewError(cmdresult.ValidationFailed, err)
+				}
+				return RunCordonOrUncordon(DesiredUnCordon, f, opts)
+			}()
+			return cmdresult.Print(cmd.OutOrStdout(), opts.Output, "uncordon", runErr)
 		},
 		Annotations: map[string]string{
 			util.TagCommandGroup: util.GroupClusterManagement,
@@ -122,6 +147,8 @@ func NewCmdUncordon(f util.Factory, parentCommand string) *cobra.Command {
 	flags := cmd.Flags()
 	options.AddKubeConfigFlags(flags)
 	flags.BoolVar(&opts.DryRun, "dry-run", false, "Run the command in dry-run mode, without making any server requests.")
+	flags.StringVarP(&opts.Output, "output", "o", "", "Output format. One of: json.")
+	flags.StringVar(&opts.ClusterSelector, "cluster-selector", "", "Label selector to select target clusters instead of naming them, for example: region=us-east. Cannot be combined with cluster names.")
 
 	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
 	return cmd
@@ -132,48 +159,122 @@ type CommandCordonOption struct {
 	// ClusterName is the cluster's name that we are going to join with.
 	ClusterName string
 
+	// ClusterNames are the clusters' names when more than one cluster is given.
+	ClusterNames []string
+
+	// ClusterSelector is a label selector to select target clusters instead
+	// of naming them.
+	ClusterSelector string
+
+	// Until is the duration after which a cordoned cluster is automatically
+	// uncordoned by the scheduled-uncordon controller.
+	Until time.Duration
+
+	// Reason records why the cluster is cordoned, kept as an annotation on
+	// the Cluster object until the cluster is uncordoned.
+	Reason string
+
 	// DryRun tells if run the command in dry-run mode, without making any server requests.
 	DryRun bool
+
+	// Output is the output format of the command result, either empty for
+	// human-readable output or "json" for a machine-readable result envelope.
+	Output string
 }
 
 // Complete ensures that options are valid and marshals them if necessary.
 func (o *CommandCordonOption) Complete(args []string) error {
-	// Get cluster name from the command args.
-	if len(args) == 0 {
-		return errors.New("cluster name is required")
+	// Get cluster names from the command args.
+	if len(args) == 0 && len(o.ClusterSelector) == 0 {
+		return errors.New("cluster name or --cluster-selector is required")
 	}
-	if len(args) > 1 {
-		return errors.New("more than one cluster name is not supported")
+	if len(args) > 0 && len(o.ClusterSelector) > 0 {
+		return errors.New("cluster names and --cluster-selector cannot be used together")
+	}
+	if o.Until < 0 {
+		return errors.New("--until must be a positive duration")
+	}
+	o.ClusterNames = args
+	if len(args) == 1 {
+		o.ClusterName = args[0]
 	}
-	o.ClusterName = args[0]
 	return nil
 }
 
 // RunCordonOrUncordon exec marks the cluster unschedulable or schedulable according to desired.
 // if true cordon cluster otherwise uncordon cluster.
 func RunCordonOrUncordon(desired int, f util.Factory, opts CommandCordonOption) error {
-	cordonOrUncordon := "cordon"
-	if desired == DesiredUnCordon {
-		cordonOrUncordon = "un" + cordonOrUncordon
-	}
-
 	karmadaClient, err := f.KarmadaClientSet()
 	if err != nil {
 		return err
 	}
 
-	cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), opts.ClusterName, metav1.GetOptions{})
+	clusters, err := targetClusters(karmadaClient, opts)
 	if err != nil {
 		return err
 	}
 
-	cordonHelper := newCordonHelper(cluster)
+	var uncordonAfter string
+	if desired == DesiredCordon && opts.Until > 0 {
+		uncordonAfter = time.Now().Add(opts.Until).UTC().Format(time.RFC3339)
+	}
+
+	var errs []error
+	for _, cluster := range clusters {
+		if err := cordonOrUncordonCluster(desired, karmadaClient, cluster, uncordonAfter, opts.Reason, opts.DryRun); err != nil {
+			errs = append(errs, fmt.Errorf("cluster(%s): %v", cluster.Name, err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// targetClusters resolves the clusters the command operates on, either from
+// the given names or from the cluster label selector.
+func targetClusters(karmadaClient karmadaclientset.Interface, opts CommandCordonOption) ([]*clusterv1alpha1.Cluster, error) {
+	if len(opts.ClusterSelector) > 0 {
+		clusterList, err := karmadaClient.ClusterV1alpha1().Clusters().List(context.TODO(), metav1.ListOptions{LabelSelector: opts.ClusterSelector})
+		if err != nil {
+			return nil, err
+		}
+		if len(clusterList.Items) == 0 {
+			return nil, fmt.Errorf("no clusters match label selector %q", opts.ClusterSelector)
+		}
+		clusters := make([]*clusterv1alpha1.Cluster, 0, len(clusterList.Items))
+		for i := range clusterList.Items {
+			clusters = append(clusters, &clusterList.Items[i])
+		}
+		return clusters, nil
+	}
+
+	names := opts.ClusterNames
+	if len(names) == 0 && len(opts.ClusterName) > 0 {
+		names = []string{opts.ClusterName}
+	}
+	clusters := make([]*clusterv1alpha1.Cluster, 0, len(names))
+	for _, name := range names {
+		cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, cluster)
+	}
+	return clusters, nil
+}
+
+// cordonOrUncordonCluster marks a single cluster unschedulable or schedulable.
+func cordonOrUncordonCluster(desired int, karmadaClient karmadaclientset.Interface, cluster *clusterv1alpha1.Cluster, uncordonAfter, reason string, dryRun bool) error {
+	cordonOrUncordon := "cordon"
+	if desired == DesiredUnCordon {
+		cordonOrUncordon = "un" + cordonOrUncordon
+	}
+
+	cordonHelper := newCordonHelper(cluster, uncordonAfter, reason)
 	if !cordonHelper.updateIfRequired(desired) {
 		fmt.Printf("%s cluster %s\n", cluster.Name, alreadyStr(desired))
 		return nil
 	}
 
-	if !opts.DryRun {
+	if !dryRun {
 		err := cordonHelper.patchOrReplace(karmadaClient)
 		if err != nil {
 			return err
@@ -181,6 +282,9 @@ func RunCordonOrUncordon(desired int, f util.Factory, opts CommandCordonOption)
 	}
 
 	fmt.Printf("%s cluster %sed\n", cluster.Name, cordonOrUncordon)
+	if len(uncordonAfter) > 0 {
+		fmt.Printf("%s cluster will be uncordoned automatically after %s\n", cluster.Name, uncordonAfter)
+	}
 	return nil
 }
 
@@ -188,18 +292,26 @@ func RunCordonOrUncordon(desired int, f util.Factory, opts CommandCordonOption)
 type cordonHelper struct {
 	cluster *clusterv1alpha1.Cluster
 	desired int
+	// uncordonAfter is the RFC3339 deadline recorded on the cluster when a
+	// scheduled uncordon was requested, empty otherwise.
+	uncordonAfter string
+	// reason is the cordon reason recorded on the cluster, empty when no
+	// reason was given.
+	reason string
 }
 
 // newCordonHelper returns a new CordonHelper that help execute
 // the cordon and uncordon commands
-func newCordonHelper(cluster *clusterv1alpha1.Cluster) *cordonHelper {
+func newCordonHelper(cluster *clusterv1alpha1.Cluster, uncordonAfter, reason string) *cordonHelper {
 	return &cordonHelper{
-		cluster: cluster,
+		cluster:       cluster,
+		uncordonAfter: uncordonAfter,
+		reason:        reason,
 	}
 }
 
-// updateIfRequired returns true if unscheduler taint isn't already set,
-// or false when no change is needed
+// updateIfRequired returns true if unscheduler taint or the scheduled
+// uncordon deadline isn't already set, or false when no change is needed
 func (c *cordonHelper) updateIfRequired(desired int) bool {
 	c.desired = desired
 
@@ -207,6 +319,14 @@ func (c *cordonHelper) updateIfRequired(desired int) bool {
 		return true
 	}
 
+	if desired == DesiredCordon && len(c.uncordonAfter) > 0 && c.cluster.Annotations[clusterv1alpha1.UncordonAfterAnnotationKey] != c.uncordonAfter {
+		return true
+	}
+
+	if desired == DesiredCordon && len(c.reason) > 0 && c.cluster.Annotations[clusterv1alpha1.CordonReasonAnnotationKey] != c.reason {
+		return true
+	}
+
 	if desired == DesiredUnCordon && c.hasUnschedulerTaint() {
 		return true
 	}
@@ -245,7 +365,21 @@ func (c *cordonHelper) patchOrReplace(karmadaClient karmadaclientset.Interface)
 	}
 
 	if c.desired == DesiredCordon {
-		c.cluster.Spec.Taints = append(c.cluster.Spec.Taints, unschedulerTaint)
+		if !c.hasUnschedulerTaint() {
+			c.cluster.Spec.Taints = append(c.cluster.Spec.Taints, unschedulerTaint)
+		}
+		if len(c.uncordonAfter) > 0 {
+			if c.cluster.Annotations == nil {
+				c.cluster.Annotations = map[string]string{}
+			}
+			c.cluster.Annotations[clusterv1alpha1.UncordonAfterAnnotationKey] = c.uncordonAfter
+		}
+		if len(c.reason) > 0 {
+			if c.cluster.Annotations == nil {
+				c.cluster.Annotations = map[string]string{}
+			}
+			c.cluster.Annotations[clusterv1alpha1.CordonReasonAnnotationKey] = c.reason
+		}
 	}
 
 	if c.desired == DesiredUnCordon {
@@ -256,6 +390,8 @@ func (c *cordonHelper) patchOrReplace(karmadaClient karmadaclientset.Interface)
 				break
 			}
 		}
+		delete(c.cluster.Annotations, clusterv1alpha1.UncordonAfterAnnotationKey)
+		delete(c.cluster.Annotations, clusterv1alpha1.CordonReasonAnnotationKey)
 	}
 
 	newData, err := json.Marshal(c.cluster)
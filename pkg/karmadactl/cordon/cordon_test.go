@@ -102,6 +102,31 @@ func TestRunCordonOrUncordon(t *testing.T) {
 			wantErr: false,
 			logMsg:  fmt.Sprintf("%s cluster cordoned", clusterName),
 		},
+		{
+			name:                "RunCordonOrUncordon_CordonWithReason_ReasonRecorded",
+			desiredCordonStatus: DesiredCordon,
+			f:                   testFactory{client: fakekarmadaclient.NewSimpleClientset()},
+			opts:                &CommandCordonOption{ClusterName: clusterName, Reason: "kernel upgrade"},
+			prep: func(f util.Factory, _ *CommandCordonOption, _ int) error {
+				return prepClusterCreation(f, clusterName)
+			},
+			verify: func(f util.Factory) error {
+				client, err := f.KarmadaClientSet()
+				if err != nil {
+					return err
+				}
+				cluster, err := client.ClusterV1alpha1().Clusters().Get(context.TODO(), clusterName, metav1.GetOptions{})
+				if err != nil {
+					return fmt.Errorf("failed to get cluster %s, got error: %v", clusterName, err)
+				}
+				if reason := cluster.Annotations[clusterv1alpha1.CordonReasonAnnotationKey]; reason != "kernel upgrade" {
+					return fmt.Errorf("expected cordon reason annotation to be %q, got %q", "kernel upgrade", reason)
+				}
+				return verifyClusterCordoned(f, clusterName, checkClusterCordonedCondition)
+			},
+			wantErr: false,
+			logMsg:  fmt.Sprintf("%s cluster cordoned", clusterName),
+		},
 		{
 			name:                "RunCordonOrUncordon_CordonCordonedCluster_ClusterAlreadyCordoned",
 			desiredCordonStatus: DesiredCordon,
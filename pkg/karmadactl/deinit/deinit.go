@@ -19,6 +19,7 @@ package deinit
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -57,6 +58,9 @@ type CommandDeInitOption struct {
 	Force          bool
 	PurgeNamespace bool
 
+	// Confirmation gates the deinit behind a risk prompt, skipped with --yes.
+	util.Confirmation
+
 	KubeClientSet kubernetes.Interface
 }
 
@@ -99,6 +103,7 @@ func NewCmdDeInit(parentCommand string) *cobra.Command {
 	flags.BoolVar(&opts.DryRun, "dry-run", false, "Run the command in dry-run mode, without making any server requests.")
 	flags.BoolVarP(&opts.Force, "force", "f", false, "Reset cluster without prompting for confirmation.")
 	flags.BoolVar(&opts.PurgeNamespace, "purge-namespace", false, "Run the command with purge-namespace, the namespace which Karmada components were installed will be deleted.")
+	opts.Confirmation.AddFlags(flags)
 	return cmd
 }
 
@@ -244,10 +249,22 @@ func removeLabels(node *corev1.Node, removesLabel string) {
 
 // Run start delete
 func (o *CommandDeInitOption) Run() error {
-	fmt.Println("removes Karmada from Kubernetes")
 	// delete confirmation,exit the delete action when false.
-	if !o.Force && !util.DeleteConfirmation() {
-		return nil
+	if !o.Force {
+		summary := util.RiskSummary{
+			Operation: fmt.Sprintf("remove the Karmada control plane from namespace %q of the host cluster", o.Namespace),
+			Details:   []string{"all member clusters registered to this control plane stop being managed"},
+		}
+		if o.PurgeNamespace {
+			summary.Details = append(summary.Details, fmt.Sprintf("namespace %q will be deleted", o.Namespace))
+		}
+		confirmed, err := o.Confirm(summary, os.Stdin, os.Stdout)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
 	}
 
 	if err := o.delete(); err != nil {
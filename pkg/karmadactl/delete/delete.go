@@ -14,16 +14,24 @@
 package delete
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/resource"
 	kubectldelete "k8s.io/kubectl/pkg/cmd/delete"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/util/templates"
 
+	"github.com/karmada-io/karmada/pkg/clientfanout"
 	"github.com/karmada-io/karmada/pkg/karmadactl/options"
 	"github.com/karmada-io/karmada/pkg/karmadactl/util"
 	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
 )
 
 var (
@@ -86,11 +94,39 @@ var (
 		[1]%s delete propagationpolicy foo --force
 
 		# Delete all propagationpolicies
-		[1]%s delete propagationpolicies --all`)
+		[1]%s delete propagationpolicies --all
+
+		# Delete deployment 'foo' in member cluster 'member1'
+		[1]%s delete deployment foo --operation-scope=members --clusters=member1
+
+		# Delete deployment 'foo' in member clusters 'member1' and 'member2' without deleting its dependents
+		[1]%s delete deployment foo --orphan-dependents --operation-scope=members --clusters=member1,member2`)
 )
 
+// CommandDeleteOptions declares the arguments specific to the delete command of karmadactl.
+type CommandDeleteOptions struct {
+	// OperationScope is the operation scope of the command.
+	OperationScope options.OperationScope
+	// Clusters is the names of the target member clusters, and only takes
+	// effect when the operation scope is members.
+	Clusters []string
+	// OrphanDependents indicates the dependents of the deleted objects are
+	// orphaned instead of being deleted along with them.
+	OrphanDependents bool
+	// KarmadaManagedGuard refuses to delete objects in member clusters that
+	// are propagated by Karmada, since the control plane will immediately
+	// recreate them.
+	KarmadaManagedGuard bool
+
+	// Confirmation gates a `delete --all` behind a risk prompt, skipped
+	// with --yes.
+	util.Confirmation
+}
+
 // NewCmdDelete returns new initialized instance of delete sub command
 func NewCmdDelete(f util.Factory, parentCommand string, ioStreams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandDeleteOptions{OperationScope: options.KarmadaControlPlane, KarmadaManagedGuard: true}
+
 	cmd := kubectldelete.NewCmdDelete(f, ioStreams)
 	cmd.Long = fmt.Sprintf(deleteLong, parentCommand)
 	cmd.Example = fmt.Sprintf(deleteExample, parentCommand)
@@ -99,8 +135,184 @@ func NewCmdDelete(f util.Factory, parentCommand string, ioStreams genericiooptio
 	}
 	options.AddKubeConfigFlags(cmd.Flags())
 	options.AddNamespaceFlag(cmd.Flags())
+	cmd.Flags().VarP(&o.OperationScope, "operation-scope", "s", "Used to control the operation scope of the command. The optional values are karmada and members. Defaults to karmada.")
+	cmd.Flags().StringSliceVarP(&o.Clusters, "clusters", "C", []string{}, "Used to specify target member clusters and only takes effect when the command's operation scope is members, for example: --operation-scope=members --clusters=member1,member2")
+	cmd.Flags().BoolVar(&o.OrphanDependents, "orphan-dependents", o.OrphanDependents, "If true, orphan the dependents of the deleted objects instead of deleting them. Equivalent to --cascade=orphan.")
+	cmd.Flags().BoolVar(&o.KarmadaManagedGuard, "karmada-managed-guard", o.KarmadaManagedGuard, "If true, refuse to delete objects in member clusters that are propagated by Karmada, since the control plane will immediately recreate them. Specify --force to delete them anyway.")
+	o.Confirmation.AddFlags(cmd.Flags())
+
+	// Keep the behavior of kubectl for the Karmada control plane, and fan the
+	// command out to the specified member clusters for the members scope.
+	controlPlaneRun := cmd.Run
+	cmd.Run = nil
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if err := o.Validate(); err != nil {
+			return err
+		}
+		if o.OrphanDependents {
+			if err := cmd.Flags().Set("cascade", "orphan"); err != nil {
+				return err
+			}
+		}
+		if cmdutil.GetFlagBool(cmd, "all") && cmdutil.GetFlagString(cmd, "dry-run") == "none" {
+			confirmed, err := o.confirmDeleteAll(f, cmd, args, ioStreams)
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				return nil
+			}
+		}
+		if o.OperationScope == options.KarmadaControlPlane {
+			controlPlaneRun(cmd, args)
+			return nil
+		}
+		return o.RunAcrossClusters(f, cmd, args, ioStreams)
+	}
 
 	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
 	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	utilcomp.RegisterCompletionFuncForOperationScopeFlag(cmd, options.KarmadaControlPlane, options.Members)
+	utilcomp.RegisterCompletionFuncForClustersFlag(cmd)
 	return cmd
 }
+
+// Validate checks that the provided delete options are specified.
+func (o *CommandDeleteOptions) Validate() error {
+	err := options.VerifyOperationScopeFlags(o.OperationScope, options.KarmadaControlPlane, options.Members)
+	if err != nil {
+		return err
+	}
+	if o.OperationScope == options.Members && len(o.Clusters) == 0 {
+		return errors.New("must specify member clusters by --clusters")
+	}
+	return nil
+}
+
+// RunAcrossClusters runs the delete command against every target member cluster.
+func (o *CommandDeleteOptions) RunAcrossClusters(f util.Factory, cmd *cobra.Command, args []string, ioStreams genericiooptions.IOStreams) error {
+	var errs []error
+	for _, cluster := range o.Clusters {
+		memberFactory, err := f.FactoryForMemberCluster(cluster)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cluster(%s): %v", cluster, err))
+			continue
+		}
+		if o.KarmadaManagedGuard && !cmdutil.GetFlagBool(cmd, "force") {
+			managed, err := managedObjects(memberFactory, cmd, args)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("cluster(%s): %v", cluster, err))
+				continue
+			}
+			if len(managed) > 0 {
+				errs = append(errs, fmt.Errorf("cluster(%s): refusing to delete %s propagated by Karmada, the control plane will immediately recreate them, specify --force to delete them anyway", cluster, strings.Join(managed, ", ")))
+				continue
+			}
+		}
+		if len(o.Clusters) > 1 {
+			fmt.Fprintf(ioStreams.Out, "# cluster: %s\n", cluster)
+		}
+
+		memberCmd := kubectldelete.NewCmdDelete(memberFactory, ioStreams)
+		clientfanout.CopyFlags(cmd, memberCmd)
+		if err = clientfanout.RunMemberCommand(memberCmd, args); err != nil {
+			errs = append(errs, fmt.Errorf("cluster(%s): %v", cluster, err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// confirmDeleteAll presents the blast radius of a `delete --all` and asks the
+// user to acknowledge it. The counts are collected best effort: a cluster
+// that cannot be queried simply does not contribute to the summary.
+func (o *CommandDeleteOptions) confirmDeleteAll(f util.Factory, cmd *cobra.Command, args []string, ioStreams genericiooptions.IOStreams) (bool, error) {
+	var summary util.RiskSummary
+	if o.OperationScope == options.KarmadaControlPlane {
+		summary.Operation = fmt.Sprintf("delete all %s from the Karmada control plane", strings.Join(args, ","))
+		if count, err := countSelectedObjects(f, cmd, args); err == nil {
+			summary.Workloads = count
+		}
+	} else {
+		summary.Operation = fmt.Sprintf("delete all %s from member clusters %s", strings.Join(args, ","), strings.Join(o.Clusters, ","))
+		summary.Clusters = len(o.Clusters)
+		for _, cluster := range o.Clusters {
+			memberFactory, err := f.FactoryForMemberCluster(cluster)
+			if err != nil {
+				continue
+			}
+			if count, err := countSelectedObjects(memberFactory, cmd, args); err == nil {
+				summary.Workloads += count
+			}
+		}
+	}
+	return o.Confirm(summary, ioStreams.In, ioStreams.Out)
+}
+
+// countSelectedObjects returns the number of objects the delete would select,
+// used to size the risk summary of a `delete --all`.
+func countSelectedObjects(f cmdutil.Factory, cmd *cobra.Command, args []string) (int, error) {
+	if len(args) == 0 {
+		return 0, nil
+	}
+	namespace, _, err := f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return 0, err
+	}
+	result := f.NewBuilder().
+		Unstructured().
+		ContinueOnError().
+		NamespaceParam(namespace).DefaultNamespace().
+		AllNamespaces(cmdutil.GetFlagBool(cmd, "all-namespaces")).
+		LabelSelectorParam(cmdutil.GetFlagString(cmd, "selector")).
+		FieldSelectorParam(cmdutil.GetFlagString(cmd, "field-selector")).
+		SelectAllParam(true).
+		ResourceTypeOrNameArgs(false, args...).
+		Flatten().
+		Do()
+	count := 0
+	err = result.Visit(func(_ *resource.Info, err error) error {
+		if err != nil {
+			return err
+		}
+		count++
+		return nil
+	})
+	return count, err
+}
+
+// managedObjects returns the selected objects that are propagated by Karmada,
+// which the control plane will recreate right after they are deleted.
+func managedObjects(f cmdutil.Factory, cmd *cobra.Command, args []string) ([]string, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	namespace, _, err := f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return nil, err
+	}
+	result := f.NewBuilder().
+		Unstructured().
+		ContinueOnError().
+		NamespaceParam(namespace).DefaultNamespace().
+		LabelSelectorParam(cmdutil.GetFlagString(cmd, "selector")).
+		FieldSelectorParam(cmdutil.GetFlagString(cmd, "field-selector")).
+		SelectAllParam(cmdutil.GetFlagBool(cmd, "all")).
+		ResourceTypeOrNameArgs(false, args...).
+		Flatten().
+		Do()
+	var managed []string
+	err = result.Visit(func(info *resource.Info, err error) error {
+		if err != nil {
+			return err
+		}
+		accessor, err := meta.Accessor(info.Object)
+		if err != nil {
+			return err
+		}
+		if _, ok := accessor.GetLabels()[karmadautil.ManagedByKarmadaLabel]; ok {
+			managed = append(managed, info.ObjectName())
+		}
+		return nil
+	})
+	return managed, err
+}
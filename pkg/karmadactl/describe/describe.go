@@ -48,6 +48,10 @@ var (
 		# Describe a deployment in Karmada control plane
 		%[1]s describe deployment/nginx
 
+		# Describe a work in an execution namespace, the status shows the last
+		# change applied to the member cluster, see .status.lastAppliedChanges
+		%[1]s describe work/nginx-687f7fb96f -n karmada-es-member1
+
 		# Describe a pod in cluster(member1)
 		%[1]s describe pods/nginx --operation-scope=members --cluster=member1
 
@@ -99,6 +103,7 @@ func NewCmdDescribe(f util.Factory, parentCommand string, streams genericiooptio
 	kubedescribeFlags.AddFlags(cmd)
 
 	options.AddKubeConfigFlags(flags)
+	options.AddImpersonationFlags(flags)
 	options.AddNamespaceFlag(flags)
 	o.OperationScope = options.KarmadaControlPlane
 	flags.VarP(&o.OperationScope, "operation-scope", "s", "Used to control the operation scope of the command. The optional values are karmada and members. Defaults to karmada.")
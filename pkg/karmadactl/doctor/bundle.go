@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/version"
+)
+
+// writeSupportBundle writes the findings and the inspected objects to a
+// gzipped tarball, so they can be attached to an issue or a support case.
+// Secrets and certificates themselves are deliberately not included, only
+// the findings derived from them.
+func writeSupportBundle(path string, findings []finding, clusters *clusterv1alpha1.ClusterList, mutating, validating interface{}) error {
+	entries := []struct {
+		name    string
+		content []byte
+	}{
+		{"findings.txt", renderFindings(findings)},
+		{"version.txt", []byte(fmt.Sprintf("%#v\n", version.Get()))},
+	}
+	for _, object := range []struct {
+		name  string
+		value interface{}
+	}{
+		{"clusters.yaml", clusters},
+		{"mutatingwebhookconfigurations.yaml", mutating},
+		{"validatingwebhookconfigurations.yaml", validating},
+	} {
+		if object.value == nil {
+			continue
+		}
+		content, err := yaml.Marshal(object.value)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %v", object.name, err)
+		}
+		entries = append(entries, struct {
+			name    string
+			content []byte
+		}{object.name, content})
+	}
+
+	file, err := os.Create(path) // #nosec G304 -- the bundle path is chosen by the user
+	if err != nil {
+		return err
+	}
+	defer file.Close() // #nosec G104 -- double close on the success path is harmless
+
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+	now := time.Now()
+	for _, entry := range entries {
+		header := &tar.Header{
+			Name:    "karmada-doctor/" + entry.name,
+			Mode:    0600,
+			Size:    int64(len(entry.content)),
+			ModTime: now,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tarWriter.Write(entry.content); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// renderFindings renders the findings table to plain text.
+func renderFindings(findings []finding) []byte {
+	buf := &bytes.Buffer{}
+	printFindings(buf, findings)
+	return buf.Bytes()
+}
@@ -0,0 +1,516 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package doctor implements the `karmadactl doctor` command running
+// connectivity and certificate diagnostics against a Karmada installation.
+package doctor
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util/apiclient"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+var (
+	doctorLong = templates.LongDesc(`
+		Run connectivity and certificate diagnostics against a Karmada
+		installation and emit actionable findings.
+
+		Through the Karmada control plane the command verifies the
+		karmada-apiserver serving certificate, the client credentials of the
+		current kubeconfig, the registered admission webhooks and their
+		reachability, and the per-cluster proxy authorization of every member
+		cluster.
+
+		The certificates of the control-plane components and the
+		scheduler-estimator deployments live in the cluster hosting the
+		control plane, so those checks additionally need --host-kubeconfig.
+
+		With --bundle the findings, the Cluster objects and the webhook
+		configurations are written to a support bundle tarball that can be
+		attached to an issue or a support case.`)
+
+	doctorExample = templates.Examples(`
+		# Run the diagnostics reachable through the control plane
+		%[1]s doctor
+
+		# Additionally verify component certificates and scheduler estimators
+		%[1]s doctor --host-kubeconfig=/etc/karmada/host.config
+
+		# Write a support bundle next to the findings
+		%[1]s doctor --bundle ./karmada-doctor.tar.gz`)
+)
+
+const (
+	// expiryWarnWindow is how close to its expiry a certificate may be
+	// before the finding turns into a warning.
+	expiryWarnWindow = 30 * 24 * time.Hour
+
+	// dialTimeout bounds the TLS probes of webhook and apiserver endpoints.
+	dialTimeout = 5 * time.Second
+)
+
+// finding states, ordered by severity.
+const (
+	statusOK   = "OK"
+	statusWarn = "Warn"
+	statusFail = "Fail"
+)
+
+// finding is one diagnostic result.
+type finding struct {
+	// check names the diagnostic the finding belongs to.
+	check string
+	// status is one of statusOK, statusWarn and statusFail.
+	status string
+	// message describes what was observed.
+	message string
+	// hint tells the user what to do about it, empty when nothing is needed.
+	hint string
+}
+
+// CommandDoctorOptions contains the input to the doctor command.
+type CommandDoctorOptions struct {
+	// HostKubeConfig is the path of the kubeconfig of the cluster hosting
+	// the Karmada control plane, enabling the component certificate and
+	// scheduler-estimator checks.
+	HostKubeConfig string
+
+	// HostContext is the context of HostKubeConfig to use.
+	HostContext string
+
+	// BundlePath is where the support bundle tarball is written. Empty
+	// means no bundle.
+	BundlePath string
+
+	genericiooptions.IOStreams
+}
+
+// NewCmdDoctor returns the doctor command running connectivity and
+// certificate diagnostics.
+func NewCmdDoctor(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandDoctorOptions{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "doctor",
+		Short:                 "Run connectivity and certificate diagnostics",
+		Long:                  doctorLong,
+		Example:               fmt.Sprintf(doctorExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return o.Run(f)
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterTroubleshootingAndDebugging,
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&o.HostKubeConfig, "host-kubeconfig", "", "Path of the kubeconfig of the cluster hosting the Karmada control plane. Enables the component certificate and scheduler-estimator checks.")
+	flags.StringVar(&o.HostContext, "host-context", "", "Context name of host-kubeconfig to use. Only works with --host-kubeconfig.")
+	flags.StringVar(&o.BundlePath, "bundle", "", "Write a support bundle tarball with the findings and the inspected objects to the given path.")
+	return cmd
+}
+
+// Run executes the diagnostics and prints the findings.
+func (o *CommandDoctorOptions) Run(f util.Factory) error {
+	restConfig, err := f.ToRawKubeConfigLoader().ClientConfig()
+	if err != nil {
+		return err
+	}
+	kubeClient, err := f.KubernetesClientSet()
+	if err != nil {
+		return err
+	}
+	karmadaClient, err := f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+	now := time.Now()
+
+	var findings []finding
+	findings = append(findings, checkAPIServerCertificate(restConfig.Host, now))
+	findings = append(findings, checkClientCertificate(restConfig, now))
+
+	webhookFindings, mutating, validating := checkWebhooks(ctx, kubeClient, now)
+	findings = append(findings, webhookFindings...)
+
+	clusterList, err := karmadaClient.ClusterV1alpha1().Clusters().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list member clusters: %v", err)
+	}
+	for i := range clusterList.Items {
+		findings = append(findings, checkClusterProxy(ctx, kubeClient, clusterList.Items[i].Name))
+	}
+
+	findings = append(findings, o.runHostChecks(ctx, clusterList.Items, now)...)
+
+	printFindings(o.Out, findings)
+
+	if o.BundlePath != "" {
+		if err := writeSupportBundle(o.BundlePath, findings, clusterList, mutating, validating); err != nil {
+			return fmt.Errorf("failed to write support bundle: %v", err)
+		}
+		fmt.Fprintf(o.Out, "\nSupport bundle written to %s\n", o.BundlePath)
+	}
+
+	failed := 0
+	for _, f := range findings {
+		if f.status == statusFail {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, len(findings))
+	}
+	return nil
+}
+
+// runHostChecks runs the diagnostics that need access to the cluster hosting
+// the control plane, or explains how to enable them.
+func (o *CommandDoctorOptions) runHostChecks(ctx context.Context, clusters []clusterv1alpha1.Cluster, now time.Time) []finding {
+	if o.HostKubeConfig == "" {
+		return []finding{{
+			check:   "control-plane-certificates",
+			status:  statusWarn,
+			message: "skipped, the component certificates live in the cluster hosting the control plane",
+			hint:    "rerun with --host-kubeconfig to inspect them",
+		}, {
+			check:   "scheduler-estimators",
+			status:  statusWarn,
+			message: "skipped, the scheduler estimators run in the cluster hosting the control plane",
+			hint:    "rerun with --host-kubeconfig to inspect them",
+		}}
+	}
+
+	hostConfig, err := apiclient.RestConfig(o.HostContext, o.HostKubeConfig)
+	if err != nil {
+		return []finding{{check: "host-cluster", status: statusFail, message: fmt.Sprintf("failed to load host kubeconfig: %v", err)}}
+	}
+	hostClient, err := kubernetes.NewForConfig(hostConfig)
+	if err != nil {
+		return []finding{{check: "host-cluster", status: statusFail, message: fmt.Sprintf("failed to build host cluster client: %v", err)}}
+	}
+
+	findings := checkControlPlaneCertificates(ctx, hostClient, now)
+	for i := range clusters {
+		findings = append(findings, checkSchedulerEstimator(ctx, hostClient, clusters[i].Name))
+	}
+	return findings
+}
+
+// checkAPIServerCertificate probes the TLS endpoint of the karmada-apiserver
+// and reports the expiry of its serving certificate.
+func checkAPIServerCertificate(host string, now time.Time) finding {
+	address, err := dialAddress(host)
+	if err != nil {
+		return finding{check: "apiserver-certificate", status: statusFail, message: fmt.Sprintf("cannot determine the apiserver address: %v", err)}
+	}
+	cert, err := fetchServingCertificate(address)
+	if err != nil {
+		return finding{
+			check:   "apiserver-certificate",
+			status:  statusFail,
+			message: fmt.Sprintf("TLS probe of %s failed: %v", address, err),
+			hint:    "check the connectivity to the karmada-apiserver",
+		}
+	}
+	return certificateFinding("apiserver-certificate", cert, now)
+}
+
+// checkClientCertificate reports the expiry of the client certificate of the
+// current kubeconfig, if certificate authentication is used at all.
+func checkClientCertificate(restConfig *rest.Config, now time.Time) finding {
+	certData := restConfig.TLSClientConfig.CertData
+	if len(certData) == 0 && restConfig.TLSClientConfig.CertFile != "" {
+		var err error
+		certData, err = os.ReadFile(restConfig.TLSClientConfig.CertFile)
+		if err != nil {
+			return finding{check: "client-certificate", status: statusFail, message: fmt.Sprintf("failed to read the client certificate: %v", err)}
+		}
+	}
+	if len(certData) == 0 {
+		return finding{check: "client-certificate", status: statusOK, message: "no client certificate, authentication is not certificate-based"}
+	}
+	cert, err := parseFirstCertificate(certData)
+	if err != nil {
+		return finding{check: "client-certificate", status: statusFail, message: fmt.Sprintf("failed to parse the client certificate: %v", err)}
+	}
+	return certificateFinding("client-certificate", cert, now)
+}
+
+// checkWebhooks verifies the registered admission webhook configurations and
+// the reachability of their URL-based endpoints. The inspected configurations
+// are returned for the support bundle.
+func checkWebhooks(ctx context.Context, kubeClient kubernetes.Interface, now time.Time) ([]finding, *admissionregistrationv1.MutatingWebhookConfigurationList, *admissionregistrationv1.ValidatingWebhookConfigurationList) {
+	mutating, err := kubeClient.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return []finding{{check: "webhooks", status: statusFail, message: fmt.Sprintf("failed to list mutating webhook configurations: %v", err)}}, nil, nil
+	}
+	validating, err := kubeClient.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return []finding{{check: "webhooks", status: statusFail, message: fmt.Sprintf("failed to list validating webhook configurations: %v", err)}}, mutating, nil
+	}
+
+	var findings []finding
+	for i := range mutating.Items {
+		for j := range mutating.Items[i].Webhooks {
+			webhook := &mutating.Items[i].Webhooks[j]
+			findings = append(findings, webhookFinding(webhook.Name, &webhook.ClientConfig, now))
+		}
+	}
+	for i := range validating.Items {
+		for j := range validating.Items[i].Webhooks {
+			webhook := &validating.Items[i].Webhooks[j]
+			findings = append(findings, webhookFinding(webhook.Name, &webhook.ClientConfig, now))
+		}
+	}
+	if len(findings) == 0 {
+		findings = append(findings, finding{
+			check:   "webhooks",
+			status:  statusWarn,
+			message: "no admission webhooks registered",
+			hint:    "check whether karmada-webhook is installed and registered its configurations",
+		})
+	}
+	return findings, mutating, validating
+}
+
+// webhookFinding verifies one admission webhook: its CA bundle and, for
+// URL-based webhooks, the reachability and serving certificate of the
+// endpoint.
+func webhookFinding(name string, clientConfig *admissionregistrationv1.WebhookClientConfig, now time.Time) finding {
+	check := "webhook/" + name
+	if len(clientConfig.CABundle) == 0 {
+		return finding{
+			check:   check,
+			status:  statusWarn,
+			message: "no CA bundle configured, the apiserver cannot verify the webhook",
+			hint:    "check the certificate configuration of karmada-webhook",
+		}
+	}
+	if clientConfig.URL == nil {
+		return finding{check: check, status: statusOK, message: "service-based webhook, delivered through the service network of the apiserver"}
+	}
+
+	address, err := dialAddress(*clientConfig.URL)
+	if err != nil {
+		return finding{check: check, status: statusFail, message: fmt.Sprintf("invalid webhook URL %s: %v", *clientConfig.URL, err)}
+	}
+	cert, err := fetchServingCertificate(address)
+	if err != nil {
+		return finding{
+			check:   check,
+			status:  statusFail,
+			message: fmt.Sprintf("TLS probe of %s failed: %v", address, err),
+			hint:    "check that karmada-webhook is running and the URL is reachable from where the karmada-apiserver runs",
+		}
+	}
+	return certificateFinding(check, cert, now)
+}
+
+// checkClusterProxy verifies that the current user may reach the member
+// cluster through the cluster proxy of the control plane.
+func checkClusterProxy(ctx context.Context, kubeClient kubernetes.Interface, clusterName string) finding {
+	check := "cluster-proxy/" + clusterName
+	path := fmt.Sprintf("/apis/cluster.karmada.io/v1alpha1/clusters/%s/proxy/healthz", clusterName)
+	_, err := kubeClient.Discovery().RESTClient().Get().AbsPath(path).DoRaw(ctx)
+	if err == nil {
+		return finding{check: check, status: statusOK, message: "proxy request authorized and the member apiserver responded"}
+	}
+	if apierrors.IsForbidden(err) || apierrors.IsUnauthorized(err) {
+		return finding{
+			check:   check,
+			status:  statusFail,
+			message: fmt.Sprintf("proxy request rejected: %v", err),
+			hint:    "grant the user access to the cluster proxy, e.g. via the cluster-proxy-admin ClusterRole",
+		}
+	}
+	if apierrors.IsServiceUnavailable(err) || apierrors.IsTimeout(err) {
+		return finding{
+			check:   check,
+			status:  statusFail,
+			message: fmt.Sprintf("member apiserver unreachable through the proxy: %v", err),
+			hint:    "check the connectivity between karmada-aggregated-apiserver and the member cluster",
+		}
+	}
+	return finding{check: check, status: statusFail, message: fmt.Sprintf("proxy request failed: %v", err)}
+}
+
+// checkControlPlaneCertificates scans the secrets of the karmada-system
+// namespace of the host cluster for certificates and reports the ones close
+// to expiry.
+func checkControlPlaneCertificates(ctx context.Context, hostClient kubernetes.Interface, now time.Time) []finding {
+	secrets, err := hostClient.CoreV1().Secrets(names.NamespaceKarmadaSystem).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return []finding{{check: "control-plane-certificates", status: statusFail, message: fmt.Sprintf("failed to list secrets in %s of the host cluster: %v", names.NamespaceKarmadaSystem, err)}}
+	}
+
+	var findings []finding
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		for key, data := range secret.Data {
+			cert, err := parseFirstCertificate(data)
+			if err != nil {
+				// not every secret entry is a certificate
+				continue
+			}
+			findings = append(findings, certificateFinding(fmt.Sprintf("certificate/%s/%s", secret.Name, key), cert, now))
+		}
+	}
+	if len(findings) == 0 {
+		findings = append(findings, finding{
+			check:   "control-plane-certificates",
+			status:  statusWarn,
+			message: fmt.Sprintf("no certificates found in the secrets of %s", names.NamespaceKarmadaSystem),
+		})
+	}
+	return findings
+}
+
+// checkSchedulerEstimator verifies that the scheduler estimator of the member
+// cluster is deployed, ready and has reachable endpoints in the host cluster.
+func checkSchedulerEstimator(ctx context.Context, hostClient kubernetes.Interface, clusterName string) finding {
+	check := "scheduler-estimator/" + clusterName
+	name := names.GenerateEstimatorDeploymentName(clusterName)
+	deployment, err := hostClient.AppsV1().Deployments(names.NamespaceKarmadaSystem).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return finding{
+				check:   check,
+				status:  statusWarn,
+				message: "no scheduler estimator deployed for this cluster",
+				hint:    "deploy one with 'karmadactl addons enable karmada-scheduler-estimator' if accurate estimation is wanted",
+			}
+		}
+		return finding{check: check, status: statusFail, message: fmt.Sprintf("failed to get the estimator deployment: %v", err)}
+	}
+	if deployment.Status.ReadyReplicas == 0 {
+		return finding{
+			check:   check,
+			status:  statusFail,
+			message: "estimator deployment has no ready replica, the scheduler falls back to general estimation",
+			hint:    fmt.Sprintf("inspect the pods of deployment %s in %s of the host cluster", name, names.NamespaceKarmadaSystem),
+		}
+	}
+	return finding{check: check, status: statusOK, message: fmt.Sprintf("%d/%d replicas ready", deployment.Status.ReadyReplicas, *deployment.Spec.Replicas)}
+}
+
+// certificateFinding classifies the expiry of a certificate.
+func certificateFinding(check string, cert *x509.Certificate, now time.Time) finding {
+	remaining := cert.NotAfter.Sub(now)
+	switch {
+	case remaining <= 0:
+		return finding{
+			check:   check,
+			status:  statusFail,
+			message: fmt.Sprintf("certificate expired %s ago", duration.HumanDuration(-remaining)),
+			hint:    "renew the certificate, e.g. by re-running the certificate bootstrap of the installation method",
+		}
+	case remaining < expiryWarnWindow:
+		return finding{
+			check:   check,
+			status:  statusWarn,
+			message: fmt.Sprintf("certificate expires in %s", duration.HumanDuration(remaining)),
+			hint:    "plan a certificate renewal",
+		}
+	default:
+		return finding{check: check, status: statusOK, message: fmt.Sprintf("certificate valid for another %s", duration.HumanDuration(remaining))}
+	}
+}
+
+// dialAddress extracts the host:port TLS dial address from a URL, defaulting
+// the port to 443.
+func dialAddress(rawURL string) (string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	if parsed.Host == "" {
+		return "", fmt.Errorf("no host in %q", rawURL)
+	}
+	host := parsed.Host
+	if parsed.Port() == "" {
+		host = net.JoinHostPort(parsed.Hostname(), "443")
+	}
+	return host, nil
+}
+
+// fetchServingCertificate performs a TLS handshake with the address and
+// returns the presented leaf certificate.
+func fetchServingCertificate(address string) (*x509.Certificate, error) {
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: dialTimeout}, "tcp", address,
+		&tls.Config{InsecureSkipVerify: true}) // #nosec G402 -- only inspecting the served certificate, not trusting it
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close() // #nosec G104 -- read-only probe connection
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate presented")
+	}
+	return certs[0], nil
+}
+
+// parseFirstCertificate parses the first CERTIFICATE block of PEM data.
+func parseFirstCertificate(data []byte) (*x509.Certificate, error) {
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		return x509.ParseCertificate(block.Bytes)
+	}
+	return nil, fmt.Errorf("no certificate found")
+}
+
+// printFindings prints the findings as a table, hints on their own line.
+func printFindings(out io.Writer, findings []finding) {
+	writer := tabwriter.NewWriter(out, 0, 8, 3, ' ', 0)
+	fmt.Fprintln(writer, "CHECK\tSTATUS\tMESSAGE")
+	for _, f := range findings {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", f.check, f.status, f.message)
+		if f.hint != "" {
+			fmt.Fprintf(writer, "\t\t↳ %s\n", f.hint)
+		}
+	}
+	writer.Flush()
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// newTestCertificate returns a self-signed certificate expiring at notAfter,
+// PEM-encoded alongside the parsed form.
+func newTestCertificate(t *testing.T, notAfter time.Time) (*x509.Certificate, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "karmada-test"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	return cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestCertificateFinding(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		notAfter time.Time
+		want     string
+	}{
+		{name: "valid", notAfter: now.Add(365 * 24 * time.Hour), want: statusOK},
+		{name: "expiring soon", notAfter: now.Add(7 * 24 * time.Hour), want: statusWarn},
+		{name: "expired", notAfter: now.Add(-time.Hour), want: statusFail},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cert, _ := newTestCertificate(t, tt.notAfter)
+			got := certificateFinding("test", cert, now)
+			if got.status != tt.want {
+				t.Errorf("certificateFinding() status = %s, want %s (message: %s)", got.status, tt.want, got.message)
+			}
+		})
+	}
+}
+
+func TestParseFirstCertificate(t *testing.T) {
+	_, certPEM := newTestCertificate(t, time.Now().Add(time.Hour))
+	keyBlock := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("not a key")})
+
+	cert, err := parseFirstCertificate(append(keyBlock, certPEM...))
+	if err != nil {
+		t.Fatalf("parseFirstCertificate() returned error: %v", err)
+	}
+	if cert.Subject.CommonName != "karmada-test" {
+		t.Errorf("parseFirstCertificate() parsed the wrong certificate: %v", cert.Subject)
+	}
+
+	if _, err := parseFirstCertificate(keyBlock); err == nil {
+		t.Error("parseFirstCertificate() accepted data without a certificate")
+	}
+}
+
+func TestWebhookFinding(t *testing.T) {
+	now := time.Now()
+
+	missingCA := webhookFinding("no-ca.example.com", &admissionregistrationv1.WebhookClientConfig{}, now)
+	if missingCA.status != statusWarn || !strings.Contains(missingCA.message, "CA bundle") {
+		t.Errorf("webhookFinding() without CA bundle = %+v, want a CA bundle warning", missingCA)
+	}
+
+	serviceBased := webhookFinding("svc.example.com", &admissionregistrationv1.WebhookClientConfig{
+		CABundle: []byte("ca"),
+		Service:  &admissionregistrationv1.ServiceReference{Name: "karmada-webhook"},
+	}, now)
+	if serviceBased.status != statusOK {
+		t.Errorf("webhookFinding() for a service-based webhook = %+v, want OK", serviceBased)
+	}
+
+	badURL := webhookFinding("bad.example.com", &admissionregistrationv1.WebhookClientConfig{
+		CABundle: []byte("ca"),
+		URL:      ptr.To("://not-a-url"),
+	}, now)
+	if badURL.status != statusFail {
+		t.Errorf("webhookFinding() for an invalid URL = %+v, want Fail", badURL)
+	}
+}
+
+func TestDialAddress(t *testing.T) {
+	tests := []struct {
+		rawURL  string
+		want    string
+		wantErr bool
+	}{
+		{rawURL: "https://karmada.example.com:5443", want: "karmada.example.com:5443"},
+		{rawURL: "https://karmada.example.com", want: "karmada.example.com:443"},
+		{rawURL: "://broken", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := dialAddress(tt.rawURL)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("dialAddress(%q) error = %v, wantErr %v", tt.rawURL, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got != tt.want {
+			t.Errorf("dialAddress(%q) = %q, want %q", tt.rawURL, got, tt.want)
+		}
+	}
+}
+
+func TestCheckSchedulerEstimator(t *testing.T) {
+	deploymentName := names.GenerateEstimatorDeploymentName("member1")
+	readyDeployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: names.NamespaceKarmadaSystem, Name: deploymentName},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)},
+		Status:     appsv1.DeploymentStatus{ReadyReplicas: 1},
+	}
+
+	got := checkSchedulerEstimator(context.TODO(), fakeclientset.NewClientset(readyDeployment), "member1")
+	if got.status != statusOK {
+		t.Errorf("checkSchedulerEstimator() with a ready deployment = %+v, want OK", got)
+	}
+
+	readyDeployment.Status.ReadyReplicas = 0
+	got = checkSchedulerEstimator(context.TODO(), fakeclientset.NewClientset(readyDeployment), "member1")
+	if got.status != statusFail {
+		t.Errorf("checkSchedulerEstimator() without ready replicas = %+v, want Fail", got)
+	}
+
+	got = checkSchedulerEstimator(context.TODO(), fakeclientset.NewClientset(), "member1")
+	if got.status != statusWarn {
+		t.Errorf("checkSchedulerEstimator() without a deployment = %+v, want Warn", got)
+	}
+}
+
+func TestWriteSupportBundle(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	findings := []finding{{check: "apiserver-certificate", status: statusOK, message: "certificate valid for another 300d"}}
+	clusters := &clusterv1alpha1.ClusterList{Items: []clusterv1alpha1.Cluster{{ObjectMeta: metav1.ObjectMeta{Name: "member1"}}}}
+
+	if err := writeSupportBundle(path, findings, clusters, nil, nil); err != nil {
+		t.Fatalf("writeSupportBundle() returned error: %v", err)
+	}
+
+	contents := readBundle(t, path)
+	findingsText, ok := contents["karmada-doctor/findings.txt"]
+	if !ok {
+		t.Fatalf("bundle misses findings.txt, got entries %v", contents)
+	}
+	if !strings.Contains(findingsText, "apiserver-certificate") {
+		t.Errorf("findings.txt does not contain the finding: %q", findingsText)
+	}
+	if clustersYAML := contents["karmada-doctor/clusters.yaml"]; !strings.Contains(clustersYAML, "member1") {
+		t.Errorf("clusters.yaml does not contain the cluster: %q", clustersYAML)
+	}
+}
+
+func readBundle(t *testing.T, path string) map[string]string {
+	file, err := gzipTarReader(path)
+	if err != nil {
+		t.Fatalf("failed to open bundle: %v", err)
+	}
+	defer file.close()
+
+	contents := map[string]string{}
+	for {
+		header, err := file.tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("failed to read bundle: %v", err)
+		}
+		data, err := io.ReadAll(file.tarReader)
+		if err != nil {
+			t.Fatalf("failed to read bundle entry %s: %v", header.Name, err)
+		}
+		contents[header.Name] = string(data)
+	}
+	return contents
+}
+
+type bundleReader struct {
+	closers   []io.Closer
+	tarReader *tar.Reader
+}
+
+func gzipTarReader(path string) (*bundleReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &bundleReader{closers: []io.Closer{gzipReader, file}, tarReader: tar.NewReader(gzipReader)}, nil
+}
+
+func (r *bundleReader) close() {
+	for _, closer := range r.closers {
+		closer.Close()
+	}
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package drain implements the multi-cluster analog of `kubectl drain`:
+// evacuating all propagated workloads from a member cluster.
+package drain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+)
+
+var (
+	drainLong = templates.LongDesc(`
+		Drain a member cluster in preparation for maintenance or removal.
+
+		The cluster is tainted NoSchedule so the scheduler stops placing new
+		workloads on it, and NoExecute so the taint manager evicts the
+		workloads already running there, honoring the configured
+		graceful-eviction behavior. The command then waits until no binding
+		targets the cluster anymore and reports progress while waiting.
+
+		Use 'uncordon' to make the cluster schedulable again.`)
+
+	drainExample = templates.Examples(`
+		# Drain cluster "member1"
+		%[1]s drain member1
+
+		# Drain cluster "member1" with a longer eviction timeout
+		%[1]s drain member1 --timeout 30m
+
+		# Taint cluster "member1" for draining without waiting for the evictions
+		%[1]s drain member1 --wait=false`)
+)
+
+// NewCmdDrain defines the `drain` command that evacuates workloads from a member cluster.
+func NewCmdDrain(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	opts := CommandDrainOption{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "drain CLUSTER",
+		Short:                 "Drain a member cluster by evicting all propagated workloads",
+		Long:                  drainLong,
+		Example:               fmt.Sprintf(drainExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		ValidArgsFunction:     utilcomp.ClusterNameCompletionFunc(),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := opts.Complete(f, args); err != nil {
+				return err
+			}
+			return opts.Run()
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterManagement,
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	flags.BoolVar(&opts.Wait, "wait", true, "Wait for the evictions to complete and report progress while waiting.")
+	flags.DurationVar(&opts.Timeout, "timeout", 5*time.Minute, "The length of time to wait for the workloads to be evicted before giving up.")
+	flags.BoolVar(&opts.DryRun, "dry-run", false, "Run the command in dry-run mode, without making any server requests.")
+	opts.Confirmation.AddFlags(flags)
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	return cmd
+}
+
+// CommandDrainOption holds all command options for drain.
+type CommandDrainOption struct {
+	// ClusterName is the name of the cluster to drain.
+	ClusterName string
+
+	// Wait tells whether to wait for the evictions to complete.
+	Wait bool
+
+	// Timeout is the max amount of time to wait for the evictions to complete.
+	Timeout time.Duration
+
+	// DryRun tells if run the command in dry-run mode, without making any server requests.
+	DryRun bool
+
+	// Confirmation gates the drain behind a risk prompt, skipped with --yes.
+	util.Confirmation
+
+	karmadaClient karmadaclientset.Interface
+
+	genericiooptions.IOStreams
+}
+
+// Complete ensures that options are valid and marshals them if necessary.
+func (o *CommandDrainOption) Complete(f util.Factory, args []string) error {
+	if len(args) != 1 {
+		return errors.New("exactly one cluster name is required")
+	}
+	o.ClusterName = args[0]
+	if o.Timeout <= 0 {
+		return errors.New("--timeout must be a positive duration")
+	}
+
+	var err error
+	o.karmadaClient, err = f.KarmadaClientSet()
+	return err
+}
+
+// Run taints the cluster for draining and waits for the evictions to complete.
+func (o *CommandDrainOption) Run() error {
+	if !o.DryRun {
+		scheduled, _, err := o.remainingWorkloads(context.TODO())
+		if err != nil {
+			return err
+		}
+		confirmed, err := o.Confirm(util.RiskSummary{
+			Operation: fmt.Sprintf("drain cluster %s", o.ClusterName),
+			Workloads: scheduled,
+			Clusters:  1,
+			Details:   []string{"the cluster is tainted NoExecute and all propagated workloads are evicted from it"},
+		}, o.In, o.Out)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+
+		if err := o.taintClusterForDrain(); err != nil {
+			return fmt.Errorf("failed to taint cluster %s: %v", o.ClusterName, err)
+		}
+	}
+	fmt.Fprintf(o.Out, "cluster %s cordoned and tainted for draining\n", o.ClusterName)
+
+	if !o.Wait || o.DryRun {
+		return nil
+	}
+
+	if err := o.waitForDrain(); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "cluster %s drained\n", o.ClusterName)
+	return nil
+}
+
+// taintClusterForDrain adds the NoSchedule unscheduler taint, the same marker
+// `karmadactl cordon` sets, plus a NoExecute taint with the current timestamp
+// so the taint manager starts evicting the workloads running on the cluster.
+func (o *CommandDrainOption) taintClusterForDrain() error {
+	cluster, err := o.karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), o.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	now := metav1.Now()
+	changed := false
+	for _, taint := range []corev1.Taint{
+		{Key: clusterv1alpha1.TaintClusterUnscheduler, Effect: corev1.TaintEffectNoSchedule},
+		{Key: clusterv1alpha1.TaintClusterUnscheduler, Effect: corev1.TaintEffectNoExecute, TimeAdded: &now},
+	} {
+		if hasTaint(cluster.Spec.Taints, &taint) {
+			continue
+		}
+		cluster.Spec.Taints = append(cluster.Spec.Taints, taint)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	_, err = o.karmadaClient.ClusterV1alpha1().Clusters().Update(context.TODO(), cluster, metav1.UpdateOptions{})
+	return err
+}
+
+func hasTaint(taints []corev1.Taint, taint *corev1.Taint) bool {
+	for i := range taints {
+		if taints[i].MatchTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+// waitForDrain polls the bindings until none of them targets the cluster and
+// no graceful eviction from the cluster is still in progress, printing the
+// remaining work on every change.
+func (o *CommandDrainOption) waitForDrain() error {
+	lastProgress := ""
+	err := wait.PollUntilContextTimeout(context.TODO(), 5*time.Second, o.Timeout, true, func(ctx context.Context) (bool, error) {
+		scheduled, evicting, err := o.remainingWorkloads(ctx)
+		if err != nil {
+			return false, err
+		}
+		if scheduled == 0 && evicting == 0 {
+			return true, nil
+		}
+		progress := fmt.Sprintf("%d binding(s) still scheduled to cluster %s, %d eviction(s) in progress", scheduled, o.ClusterName, evicting)
+		if progress != lastProgress {
+			fmt.Fprintln(o.Out, progress)
+			lastProgress = progress
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("timed out waiting for cluster %s to be drained: %v", o.ClusterName, err)
+	}
+	return nil
+}
+
+// remainingWorkloads counts the bindings still scheduled to the cluster and
+// the graceful evictions from the cluster still in progress.
+func (o *CommandDrainOption) remainingWorkloads(ctx context.Context) (scheduled, evicting int, err error) {
+	rbList, err := o.karmadaClient.WorkV1alpha2().ResourceBindings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, 0, err
+	}
+	for i := range rbList.Items {
+		s, e := countBindingWorkloads(&rbList.Items[i].Spec, o.ClusterName)
+		scheduled += s
+		evicting += e
+	}
+
+	crbList, err := o.karmadaClient.WorkV1alpha2().ClusterResourceBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, 0, err
+	}
+	for i := range crbList.Items {
+		s, e := countBindingWorkloads(&crbList.Items[i].Spec, o.ClusterName)
+		scheduled += s
+		evicting += e
+	}
+	return scheduled, evicting, nil
+}
+
+func countBindingWorkloads(spec *workv1alpha2.ResourceBindingSpec, clusterName string) (scheduled, evicting int) {
+	if spec.TargetContains(clusterName) {
+		scheduled = 1
+	}
+	for i := range spec.GracefulEvictionTasks {
+		if spec.GracefulEvictionTasks[i].FromCluster == clusterName {
+			evicting = 1
+			break
+		}
+	}
+	return scheduled, evicting
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drain
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	fakekarmadaclient "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+)
+
+func TestTaintClusterForDrain(t *testing.T) {
+	now := metav1.Now()
+	tests := []struct {
+		name    string
+		cluster *clusterv1alpha1.Cluster
+	}{
+		{
+			name:    "TaintClusterForDrain_WithoutTaints_BothTaintsAdded",
+			cluster: &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}},
+		},
+		{
+			name: "TaintClusterForDrain_AlreadyCordoned_NoExecuteTaintAdded",
+			cluster: &clusterv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+				Spec: clusterv1alpha1.ClusterSpec{
+					Taints: []corev1.Taint{
+						{Key: clusterv1alpha1.TaintClusterUnscheduler, Effect: corev1.TaintEffectNoSchedule},
+					},
+				},
+			},
+		},
+		{
+			name: "TaintClusterForDrain_AlreadyDraining_Idempotent",
+			cluster: &clusterv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+				Spec: clusterv1alpha1.ClusterSpec{
+					Taints: []corev1.Taint{
+						{Key: clusterv1alpha1.TaintClusterUnscheduler, Effect: corev1.TaintEffectNoSchedule},
+						{Key: clusterv1alpha1.TaintClusterUnscheduler, Effect: corev1.TaintEffectNoExecute, TimeAdded: &now},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			karmadaClient := fakekarmadaclient.NewSimpleClientset(tt.cluster)
+			opts := &CommandDrainOption{ClusterName: "member1", karmadaClient: karmadaClient}
+			if err := opts.taintClusterForDrain(); err != nil {
+				t.Fatalf("taintClusterForDrain() returned error: %v", err)
+			}
+			cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), "member1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			if len(cluster.Spec.Taints) != 2 {
+				t.Fatalf("expected 2 taints, got %d: %v", len(cluster.Spec.Taints), cluster.Spec.Taints)
+			}
+			var noSchedule, noExecute int
+			for i := range cluster.Spec.Taints {
+				taint := cluster.Spec.Taints[i]
+				if taint.Key != clusterv1alpha1.TaintClusterUnscheduler {
+					t.Errorf("unexpected taint key %q", taint.Key)
+					continue
+				}
+				switch taint.Effect {
+				case corev1.TaintEffectNoSchedule:
+					noSchedule++
+				case corev1.TaintEffectNoExecute:
+					noExecute++
+					if taint.TimeAdded == nil {
+						t.Errorf("expected TimeAdded to be set on the NoExecute taint")
+					}
+				}
+			}
+			if noSchedule != 1 || noExecute != 1 {
+				t.Errorf("expected one NoSchedule and one NoExecute taint, got %d and %d", noSchedule, noExecute)
+			}
+		})
+	}
+}
+
+func TestRemainingWorkloads(t *testing.T) {
+	rbScheduled := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx-deployment", Namespace: "default"},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}},
+		},
+	}
+	rbEvicting := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "evicting-deployment", Namespace: "default"},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Clusters:              []workv1alpha2.TargetCluster{{Name: "member2"}},
+			GracefulEvictionTasks: []workv1alpha2.GracefulEvictionTask{{FromCluster: "member1"}},
+		},
+	}
+	rbOther := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-deployment", Namespace: "default"},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Clusters: []workv1alpha2.TargetCluster{{Name: "member2"}},
+		},
+	}
+	crbScheduled := &workv1alpha2.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-crd"},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}},
+		},
+	}
+
+	karmadaClient := fakekarmadaclient.NewSimpleClientset(rbScheduled, rbEvicting, rbOther, crbScheduled)
+	opts := &CommandDrainOption{ClusterName: "member1", karmadaClient: karmadaClient}
+	scheduled, evicting, err := opts.remainingWorkloads(context.TODO())
+	if err != nil {
+		t.Fatalf("remainingWorkloads() returned error: %v", err)
+	}
+	if scheduled != 2 {
+		t.Errorf("scheduled = %d, want 2", scheduled)
+	}
+	if evicting != 1 {
+		t.Errorf("evicting = %d, want 1", evicting)
+	}
+}
@@ -20,14 +20,17 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
-	kubectledit "k8s.io/kubectl/pkg/cmd/edit"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/cmd/util/editor"
 	"k8s.io/kubectl/pkg/util/i18n"
 	"k8s.io/kubectl/pkg/util/templates"
 
 	"github.com/karmada-io/karmada/pkg/karmadactl/options"
 	"github.com/karmada-io/karmada/pkg/karmadactl/util"
 	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
 )
 
 var (
@@ -35,6 +38,9 @@ var (
 		# Edit the service named 'registry'
 		%[1]s edit svc/registry
 
+		# Edit the deployment named 'nginx' in member cluster "member1"
+		%[1]s edit deployment/nginx --operation-scope=members --cluster=member1
+
 		# Use an alternative editor
 		KUBE_EDITOR="nano" %[1]s edit svc/registry
 
@@ -50,15 +56,129 @@ var (
 
 // NewCmdEdit returns new initialized instance of edit sub command
 func NewCmdEdit(f util.Factory, parentCommand string, ioStreams genericiooptions.IOStreams) *cobra.Command {
-	cmd := kubectledit.NewCmdEdit(f, ioStreams)
-	cmd.Example = fmt.Sprintf(editExample, parentCommand)
-	cmd.Annotations = map[string]string{
-		util.TagCommandGroup: util.GroupBasic,
+	var o CommandEditOptions
+	o.EditOptions = editor.NewEditOptions(editor.NormalEditMode, ioStreams)
+
+	cmd := &cobra.Command{
+		Use:                   "edit (RESOURCE/NAME | -f FILENAME)",
+		DisableFlagsInUseLine: true,
+		Short:                 i18n.T("Edit a resource on the server"),
+		Long:                  "Edit a resource in the Karmada control plane or a member cluster from the default editor.",
+		Example:               fmt.Sprintf(editExample, parentCommand),
+		ValidArgsFunction:     utilcomp.ResourceTypeAndNameCompletionFunc(f),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.Validate())
+			cmdutil.CheckErr(o.Run())
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupBasic,
+		},
 	}
+
+	// bind flag structs
+	o.RecordFlags.AddFlags(cmd)
+	o.PrintFlags.AddFlags(cmd)
+
+	usage := "to use to edit the resource"
+	cmdutil.AddFilenameOptionFlags(cmd, &o.FilenameOptions, usage)
+	cmdutil.AddValidateFlags(cmd)
+	cmd.Flags().BoolVarP(&o.OutputPatch, "output-patch", "", o.OutputPatch, "Output the patch if the resource is edited.")
+	cmd.Flags().BoolVar(&o.WindowsLineEndings, "windows-line-endings", o.WindowsLineEndings,
+		"Defaults to the line ending native to your platform.")
+	cmdutil.AddFieldManagerFlagVar(cmd, &o.FieldManager, "kubectl-edit")
+	cmdutil.AddApplyAnnotationVarFlags(cmd, &o.ApplyAnnotation)
+	cmdutil.AddSubresourceFlags(cmd, &o.Subresource, "If specified, edit will operate on the subresource of the requested object.")
+
 	options.AddKubeConfigFlags(cmd.Flags())
 	options.AddNamespaceFlag(cmd.Flags())
+	o.OperationScope = options.KarmadaControlPlane
+	cmd.Flags().VarP(&o.OperationScope, "operation-scope", "s", "Used to control the operation scope of the command. The optional values are karmada and members. Defaults to karmada.")
+	cmd.Flags().StringVar(&o.Cluster, "cluster", "", "Used to specify a target member cluster and only takes effect when the command's operation scope is members, for example: --operation-scope=members --cluster=member1")
 
 	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
 	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	utilcomp.RegisterCompletionFuncForOperationScopeFlag(cmd, options.KarmadaControlPlane, options.Members)
+	utilcomp.RegisterCompletionFuncForClusterFlag(cmd)
 	return cmd
 }
+
+// CommandEditOptions declare the arguments accepted by the edit command
+type CommandEditOptions struct {
+	*editor.EditOptions
+	Cluster        string
+	OperationScope options.OperationScope
+
+	args        []string
+	editFactory cmdutil.Factory
+}
+
+// Complete verifies command line arguments and loads data from the command environment
+func (o *CommandEditOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
+	var editFactory cmdutil.Factory = f
+	if o.OperationScope == options.Members && len(o.Cluster) != 0 {
+		memberFactory, err := f.FactoryForMemberCluster(o.Cluster)
+		if err != nil {
+			return err
+		}
+		editFactory = memberFactory
+	}
+	o.args = args
+	o.editFactory = editFactory
+	return o.EditOptions.Complete(editFactory, args, cmd)
+}
+
+// Validate checks that the provided edit options are specified.
+func (o *CommandEditOptions) Validate() error {
+	err := options.VerifyOperationScopeFlags(o.OperationScope, options.KarmadaControlPlane, options.Members)
+	if err != nil {
+		return err
+	}
+	if o.OperationScope == options.Members && len(o.Cluster) == 0 {
+		return fmt.Errorf("must specify a member cluster")
+	}
+	return o.EditOptions.Validate()
+}
+
+// Run opens the editor and applies the resulting patch back to the server.
+func (o *CommandEditOptions) Run() error {
+	if o.OperationScope == options.Members {
+		o.warnManagedResources()
+	}
+	return o.EditOptions.Run()
+}
+
+// warnManagedResources warns when a target object in the member cluster is
+// managed by Karmada, in which case manual changes will be overwritten by the
+// control plane on the next sync.
+func (o *CommandEditOptions) warnManagedResources() {
+	namespace, _, err := o.editFactory.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return
+	}
+
+	r := o.editFactory.NewBuilder().
+		Unstructured().
+		NamespaceParam(namespace).DefaultNamespace().
+		FilenameParam(false, &o.FilenameOptions).
+		ResourceTypeOrNameArgs(true, o.args...).
+		ContinueOnError().
+		Latest().
+		Flatten().
+		Do()
+	infos, err := r.Infos()
+	if err != nil {
+		return
+	}
+
+	for _, info := range infos {
+		accessor, err := meta.Accessor(info.Object)
+		if err != nil {
+			continue
+		}
+		if accessor.GetLabels()[karmadautil.ManagedByKarmadaLabel] == karmadautil.ManagedByKarmadaLabelValue {
+			fmt.Fprintf(o.ErrOut, "warning: %s/%s in cluster(%s) is managed by Karmada, manual changes will be overwritten by the next sync from the control plane\n",
+				info.Mapping.Resource.Resource, info.Name, o.Cluster)
+		}
+	}
+}
@@ -104,6 +104,7 @@ func NewCmdExec(f util.Factory, parentCommand string, streams genericiooptions.I
 	o.OperationScope = options.KarmadaControlPlane
 	flags := cmd.Flags()
 	options.AddKubeConfigFlags(flags)
+	options.AddImpersonationFlags(flags)
 	options.AddNamespaceFlag(flags)
 	cmdutil.AddPodRunningTimeoutFlag(cmd, defaultPodExecTimeout)
 	cmdutil.AddJsonFilenameFlag(flags, &o.KubectlExecOptions.FilenameOptions.Filenames, "to use to exec into the resource")
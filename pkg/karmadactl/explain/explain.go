@@ -34,7 +34,10 @@ var (
 	explainLong = templates.LongDesc(`
 		Describe fields and structure of various resources in Karmada control plane or a member cluster.
 
-		This command describes the fields associated with each supported API resource.
+		This command describes the fields associated with each supported API resource,
+		including the Karmada CRDs such as PropagationPolicy, OverridePolicy,
+		ResourceBinding and Work. CRDs that only exist in a given member cluster can
+		be resolved through its aggregated OpenAPI by specifying --cluster.
 		Fields are identified via a simple JSONPath identifier:
 
 			<type>.<fieldName>[.<fieldName>]
@@ -53,7 +56,10 @@ var (
 
 		# Get the documentation of a specific field of a resource in member cluster member1
 		%[1]s explain pods.spec.containers --operation-scope=members --cluster=member1
-		
+
+		# Get the documentation of a CRD that only exists in member cluster member1
+		%[1]s explain prometheuses.monitoring.coreos.com --cluster=member1
+
 		# Get the documentation of resources in different format in Karmada control plane
 		%[1]s explain clusterpropagationpolicies --output=plaintext-openapiv2`)
 	plaintextTemplateName = "plaintext"
@@ -90,7 +96,7 @@ func NewCmdExplain(f util.Factory, parentCommand string, streams genericiooption
 
 	// Only enable --output as a valid flag if the feature is enabled
 	flags.StringVar(&o.OutputFormat, "output", plaintextTemplateName, "Format in which to render the schema. Valid values are: (plaintext, plaintext-openapiv2).")
-	flags.StringVar(&o.Cluster, "cluster", "", "Used to specify a target member cluster and only takes effect when the command's operation scope is member clusters, for example: --operation-scope=all --cluster=member1")
+	flags.StringVar(&o.Cluster, "cluster", "", "Used to specify a target member cluster whose aggregated OpenAPI serves the documentation, for example: --cluster=member1. Implies --operation-scope=members.")
 
 	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
 	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
@@ -109,6 +115,11 @@ type CommandExplainOptions struct {
 
 // Complete ensures that options are valid and marshals them if necessary
 func (o *CommandExplainOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
+	// Naming a member cluster is enough to resolve its CRDs, the scope flag
+	// does not have to be spelled out.
+	if len(o.Cluster) != 0 {
+		o.OperationScope = options.Members
+	}
 	var explainFactory cmdutil.Factory = f
 	if o.OperationScope == options.Members && len(o.Cluster) != 0 {
 		memberFactory, err := f.FactoryForMemberCluster(o.Cluster)
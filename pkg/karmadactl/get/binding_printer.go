@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+// bindingResourceArgs are the argument spellings that refer to
+// ResourceBindings or ClusterResourceBindings.
+var bindingResourceArgs = sets.New(
+	"rb", "resourcebinding", "resourcebindings",
+	"resourcebinding.work.karmada.io", "resourcebindings.work.karmada.io",
+	"crb", "clusterresourcebinding", "clusterresourcebindings",
+	"clusterresourcebinding.work.karmada.io", "clusterresourcebindings.work.karmada.io",
+)
+
+// bindingColumns are the rich columns rendered for bindings. CLUSTERS shows
+// the scheduled clusters with their replica division, APPLIED and HEALTHY
+// aggregate the per-cluster status.
+var bindingColumns = []metav1.TableColumnDefinition{
+	{Name: "NAME", Type: "string", Format: "name"},
+	{Name: "SCHEDULED-CLUSTERS", Type: "string"},
+	{Name: "REPLICAS", Type: "string"},
+	{Name: "CONFLICT-RESOLUTION", Type: "string"},
+	{Name: "APPLIED", Type: "string"},
+	{Name: "HEALTHY", Type: "string"},
+	{Name: "AGE", Type: "string"},
+}
+
+// bindingArgsOnly reports whether every requested resource type refers to
+// ResourceBindings or ClusterResourceBindings, in which case the rich
+// client-side binding printer takes over.
+func bindingArgsOnly(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	for ix, arg := range args {
+		resourceType := arg
+		if slash := strings.Index(arg, "/"); slash >= 0 {
+			resourceType = arg[:slash]
+		} else if ix > 0 {
+			// a bare argument after the type is a resource name
+			continue
+		}
+		for _, part := range strings.Split(resourceType, ",") {
+			if !bindingResourceArgs.Has(strings.ToLower(part)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// printBindingDetails renders bindings with the rich column set from the full
+// objects collected from the control plane.
+func (g *CommandGetOptions) printBindingDetails(objs []Obj, w io.Writer) error {
+	table := &metav1.Table{ColumnDefinitions: bindingColumns}
+	if g.AllNamespaces {
+		table.ColumnDefinitions = append([]metav1.TableColumnDefinition{{Name: "NAMESPACE", Type: "string"}}, bindingColumns...)
+	}
+
+	for ix := range objs {
+		unstr, ok := objs[ix].Info.Object.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("attempt to decode non-Unstructured object")
+		}
+		row, err := bindingTableRow(unstr, g.AllNamespaces)
+		if err != nil {
+			return err
+		}
+		table.Rows = append(table.Rows, row)
+	}
+
+	printer := printers.NewTablePrinter(printers.PrintOptions{NoHeaders: g.NoHeaders})
+	return printer.PrintObj(table, w)
+}
+
+// bindingTableRow builds one table row from a binding object. The spec and
+// status layouts of ResourceBinding and ClusterResourceBinding are identical,
+// so both decode through ResourceBinding.
+func bindingTableRow(unstr *unstructured.Unstructured, withNamespace bool) (metav1.TableRow, error) {
+	binding := &workv1alpha2.ResourceBinding{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstr.Object, binding); err != nil {
+		return metav1.TableRow{}, err
+	}
+
+	var clusterNames []string
+	var replicaDivision []string
+	for _, cluster := range binding.Spec.Clusters {
+		clusterNames = append(clusterNames, cluster.Name)
+		replicaDivision = append(replicaDivision, fmt.Sprintf("%s:%d", cluster.Name, cluster.Replicas))
+	}
+	scheduled := strings.Join(clusterNames, ",")
+	if len(scheduled) == 0 {
+		scheduled = "<none>"
+	}
+	replicas := strings.Join(replicaDivision, ",")
+	if binding.Spec.Replicas == 0 {
+		replicas = "-"
+	}
+
+	conflictResolution := string(binding.Spec.ConflictResolution)
+	if len(conflictResolution) == 0 {
+		conflictResolution = "-"
+	}
+
+	applied, healthy := 0, 0
+	for _, status := range binding.Status.AggregatedStatus {
+		if status.Applied {
+			applied++
+		}
+		if status.Health == workv1alpha2.ResourceHealthy {
+			healthy++
+		}
+	}
+	total := len(binding.Status.AggregatedStatus)
+
+	cells := []interface{}{
+		binding.Name,
+		scheduled,
+		replicas,
+		conflictResolution,
+		fmt.Sprintf("%d/%d", applied, total),
+		fmt.Sprintf("%d/%d", healthy, total),
+		duration.HumanDuration(time.Since(binding.CreationTimestamp.Time)),
+	}
+	if withNamespace {
+		cells = append([]interface{}{binding.Namespace}, cells...)
+	}
+	return metav1.TableRow{Cells: cells}, nil
+}
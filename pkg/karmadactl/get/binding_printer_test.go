@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+func TestBindingArgsOnly(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want bool
+	}{
+		{name: "no arguments", args: []string{}, want: false},
+		{name: "binding plural", args: []string{"resourcebindings"}, want: true},
+		{name: "binding short name with object name", args: []string{"rb", "nginx"}, want: true},
+		{name: "binding slash name", args: []string{"rb/nginx", "crb/cluster-wide"}, want: true},
+		{name: "mixed with other resources", args: []string{"rb,pods"}, want: false},
+		{name: "non-binding resource", args: []string{"deployments"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bindingArgsOnly(tt.args); got != tt.want {
+				t.Errorf("bindingArgsOnly(%v) = %v, want %v", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBindingTableRow(t *testing.T) {
+	binding := &workv1alpha2.ResourceBinding{
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Replicas: 3,
+			Clusters: []workv1alpha2.TargetCluster{
+				{Name: "member1", Replicas: 2},
+				{Name: "member2", Replicas: 1},
+			},
+		},
+		Status: workv1alpha2.ResourceBindingStatus{
+			AggregatedStatus: []workv1alpha2.AggregatedStatusItem{
+				{ClusterName: "member1", Applied: true, Health: workv1alpha2.ResourceHealthy},
+				{ClusterName: "member2", Applied: true, Health: workv1alpha2.ResourceUnhealthy},
+			},
+		},
+	}
+	binding.Name = "nginx-deployment"
+	binding.Namespace = "default"
+
+	unstrMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(binding)
+	if err != nil {
+		t.Fatalf("failed to convert binding: %v", err)
+	}
+
+	row, err := bindingTableRow(&unstructured.Unstructured{Object: unstrMap}, false)
+	if err != nil {
+		t.Fatalf("bindingTableRow() unexpected error: %v", err)
+	}
+
+	wantCells := []string{"nginx-deployment", "member1,member2", "member1:2,member2:1", "-", "2/2", "1/2"}
+	for i, want := range wantCells {
+		if got := fmt.Sprintf("%v", row.Cells[i]); got != want {
+			t.Errorf("cell[%d] = %s, want %s", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func newTestCacheMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{
+		{Version: "v1"},
+		{Group: "apps", Version: "v1"},
+	})
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Node"}, meta.RESTScopeRoot)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	return mapper
+}
+
+func TestParseCacheQueries(t *testing.T) {
+	mapper := newTestCacheMapper()
+	tests := []struct {
+		name        string
+		args        []string
+		wantQueries int
+		wantErr     bool
+	}{
+		{
+			name:    "no arguments",
+			args:    []string{},
+			wantErr: true,
+		},
+		{
+			name:        "single resource type",
+			args:        []string{"pods"},
+			wantQueries: 1,
+		},
+		{
+			name:        "comma separated resource types",
+			args:        []string{"pods,deployments"},
+			wantQueries: 2,
+		},
+		{
+			name:        "resource type with names",
+			args:        []string{"pods", "nginx-1", "nginx-2"},
+			wantQueries: 2,
+		},
+		{
+			name:        "resource slash name pairs",
+			args:        []string{"pods/nginx", "deployments/nginx"},
+			wantQueries: 2,
+		},
+		{
+			name:    "names with multiple resource types",
+			args:    []string{"pods,deployments", "nginx"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown resource type",
+			args:    []string{"doesnotexist"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries, err := parseCacheQueries(mapper, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCacheQueries() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(queries) != tt.wantQueries {
+				t.Errorf("parseCacheQueries() returned %d queries, want %d", len(queries), tt.wantQueries)
+			}
+		})
+	}
+}
+
+func TestCacheRequestURI(t *testing.T) {
+	mapper := newTestCacheMapper()
+	tests := []struct {
+		name    string
+		options CommandGetOptions
+		args    []string
+		want    string
+	}{
+		{
+			name:    "namespaced core resource",
+			options: CommandGetOptions{Namespace: "default"},
+			args:    []string{"pods"},
+			want:    searchCacheURL + "/api/v1/namespaces/default/pods",
+		},
+		{
+			name:    "namespaced grouped resource by name",
+			options: CommandGetOptions{Namespace: "default"},
+			args:    []string{"deployments/nginx"},
+			want:    searchCacheURL + "/apis/apps/v1/namespaces/default/deployments/nginx",
+		},
+		{
+			name:    "all namespaces",
+			options: CommandGetOptions{Namespace: "default", AllNamespaces: true},
+			args:    []string{"pods"},
+			want:    searchCacheURL + "/api/v1/pods",
+		},
+		{
+			name:    "cluster scoped resource with label selector",
+			options: CommandGetOptions{LabelSelector: "app=nginx"},
+			args:    []string{"nodes"},
+			want:    searchCacheURL + "/api/v1/nodes?labelSelector=app%3Dnginx",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries, err := parseCacheQueries(mapper, tt.args)
+			if err != nil {
+				t.Fatalf("parseCacheQueries() unexpected error: %v", err)
+			}
+			if got := tt.options.cacheRequestURI(queries[0]); got != tt.want {
+				t.Errorf("cacheRequestURI() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/client-go/rest"
+)
+
+// memberClusterHostRegexp extracts the member cluster name from the host of a
+// rest config pointing at the aggregated proxy.
+var memberClusterHostRegexp = regexp.MustCompile(`/apis/cluster\.karmada\.io/v1alpha1/clusters/([^/]+)/proxy`)
+
+// deprecationCollector records the API deprecation warnings returned by the
+// apiservers of the queried clusters, so a consolidated summary can be
+// printed once listing is done. API servers announce deprecated APIs through
+// warning headers, e.g. "batch/v1beta1 CronJob is deprecated in v1.21+,
+// unavailable in v1.25+", which helps platform teams plan member upgrades.
+type deprecationCollector struct {
+	mu sync.Mutex
+	// warnings maps cluster name to the deduplicated deprecation messages.
+	warnings map[string]map[string]struct{}
+}
+
+func newDeprecationCollector() *deprecationCollector {
+	return &deprecationCollector{warnings: map[string]map[string]struct{}{}}
+}
+
+// WrapConfig installs a warning handler recording deprecation warnings under
+// the cluster the config points at, suppressing the default client-go
+// behavior of printing every warning as it arrives.
+func (c *deprecationCollector) WrapConfig(config *rest.Config) *rest.Config {
+	cluster := "Karmada"
+	if match := memberClusterHostRegexp.FindStringSubmatch(config.Host); match != nil {
+		cluster = match[1]
+	}
+	config.WarningHandler = &deprecationHandler{collector: c, cluster: cluster}
+	return config
+}
+
+// record stores a deprecation warning reported by the given cluster.
+func (c *deprecationCollector) record(cluster, message string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.warnings[cluster] == nil {
+		c.warnings[cluster] = map[string]struct{}{}
+	}
+	c.warnings[cluster][message] = struct{}{}
+}
+
+// PrintSummary emits one consolidated warning block listing the deprecated
+// APIs served to this command by each queried cluster. It prints nothing when
+// no deprecated API was used.
+func (c *deprecationCollector) PrintSummary(w io.Writer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.warnings) == 0 {
+		return
+	}
+
+	clusters := make([]string, 0, len(c.warnings))
+	for cluster := range c.warnings {
+		clusters = append(clusters, cluster)
+	}
+	sort.Strings(clusters)
+
+	fmt.Fprintln(w, "Warning: deprecated APIs in use:")
+	for _, cluster := range clusters {
+		messages := make([]string, 0, len(c.warnings[cluster]))
+		for message := range c.warnings[cluster] {
+			messages = append(messages, message)
+		}
+		sort.Strings(messages)
+		for _, message := range messages {
+			fmt.Fprintf(w, "  - cluster %s: %s\n", cluster, message)
+		}
+	}
+}
+
+// deprecationHandler implements rest.WarningHandler and forwards deprecation
+// warnings to the collector. Other warnings are dropped to keep the listing
+// output clean.
+type deprecationHandler struct {
+	collector *deprecationCollector
+	cluster   string
+}
+
+// HandleWarningHeader implements rest.WarningHandler.
+func (h *deprecationHandler) HandleWarningHeader(code int, _ string, message string) {
+	if code != 299 || !strings.Contains(message, "deprecated") {
+		return
+	}
+	h.collector.record(h.cluster, message)
+}
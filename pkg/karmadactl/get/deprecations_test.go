@@ -0,0 +1,61 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"k8s.io/client-go/rest"
+)
+
+func TestDeprecationCollector(t *testing.T) {
+	collector := newDeprecationCollector()
+
+	karmadaConfig := collector.WrapConfig(&rest.Config{Host: "https://karmada.example.com:5443"})
+	memberConfig := collector.WrapConfig(&rest.Config{Host: "https://karmada.example.com:5443/apis/cluster.karmada.io/v1alpha1/clusters/member1/proxy"})
+
+	memberConfig.WarningHandler.HandleWarningHeader(299, "", "batch/v1beta1 CronJob is deprecated in v1.21+, unavailable in v1.25+")
+	// duplicates collapse
+	memberConfig.WarningHandler.HandleWarningHeader(299, "", "batch/v1beta1 CronJob is deprecated in v1.21+, unavailable in v1.25+")
+	karmadaConfig.WarningHandler.HandleWarningHeader(299, "", "policy/v1beta1 PodSecurityPolicy is deprecated in v1.21+, unavailable in v1.25+")
+	// non-deprecation warnings are dropped
+	memberConfig.WarningHandler.HandleWarningHeader(299, "", "unknown field \"spec.foo\"")
+	memberConfig.WarningHandler.HandleWarningHeader(200, "", "something deprecated but not a warning header")
+
+	var out bytes.Buffer
+	collector.PrintSummary(&out)
+
+	got := out.String()
+	wantLines := []string{
+		"Warning: deprecated APIs in use:",
+		"  - cluster Karmada: policy/v1beta1 PodSecurityPolicy is deprecated in v1.21+, unavailable in v1.25+",
+		"  - cluster member1: batch/v1beta1 CronJob is deprecated in v1.21+, unavailable in v1.25+",
+	}
+	if got != strings.Join(wantLines, "\n")+"\n" {
+		t.Errorf("unexpected summary:\n%s", got)
+	}
+}
+
+func TestDeprecationCollectorPrintsNothingWithoutWarnings(t *testing.T) {
+	var out bytes.Buffer
+	newDeprecationCollector().PrintSummary(&out)
+	if out.Len() != 0 {
+		t.Errorf("expected empty output, got %q", out.String())
+	}
+}
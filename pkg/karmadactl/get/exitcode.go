@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+// Exit codes returned by `karmadactl get` so that scripts can distinguish
+// between total failures, partial failures with printed rows, and empty
+// results. Code 1 remains the generic failure and codes below 3 are left to
+// the standard command machinery.
+const (
+	// ExitCodeAllClustersFailed indicates every targeted cluster failed and
+	// no rows were printed.
+	ExitCodeAllClustersFailed = 3
+	// ExitCodePartialFailure indicates some clusters failed while rows from
+	// the remaining clusters were printed.
+	ExitCodePartialFailure = 4
+	// ExitCodeNoResourcesFound indicates all clusters responded but no
+	// resources matched the query and --ignore-not-found was not set.
+	ExitCodeNoResourcesFound = 5
+)
+
+// ExitCodeError decorates an aggregated command error with a differentiated
+// exit code. The caller owning the process(e.g. the karmadactl main) unwraps
+// it to pick the exit code.
+type ExitCodeError struct {
+	// Code is the process exit code to use.
+	Code int
+	// Err is the underlying aggregated error, may be nil for the
+	// no-resources-found case where the notice is already printed.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ExitCodeError) Error() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return ""
+}
+
+// Unwrap makes the wrapped error visible to errors.Is/As.
+func (e *ExitCodeError) Unwrap() error {
+	return e.Err
+}
+
+// classifyRunError attaches the differentiated exit code matching how many of
+// the queried clusters failed and whether any resources were found.
+func classifyRunError(aggErr error, queriedClusters, failedClusters int, foundResources bool) error {
+	switch {
+	case failedClusters > 0 && failedClusters == queriedClusters:
+		return &ExitCodeError{Code: ExitCodeAllClustersFailed, Err: aggErr}
+	case failedClusters > 0:
+		return &ExitCodeError{Code: ExitCodePartialFailure, Err: aggErr}
+	case aggErr != nil:
+		return aggErr
+	case !foundResources:
+		return &ExitCodeError{Code: ExitCodeNoResourcesFound}
+	default:
+		return nil
+	}
+}
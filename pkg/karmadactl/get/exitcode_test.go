@@ -0,0 +1,96 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestClassifyRunError(t *testing.T) {
+	aggErr := errors.New("cluster(member1) is inaccessible, please check authorization or network")
+	tests := []struct {
+		name            string
+		aggErr          error
+		queriedClusters int
+		failedClusters  int
+		foundResources  bool
+		wantCode        int
+		wantNil         bool
+	}{
+		{
+			name:            "all clusters failed",
+			aggErr:          aggErr,
+			queriedClusters: 2,
+			failedClusters:  2,
+			wantCode:        ExitCodeAllClustersFailed,
+		},
+		{
+			name:            "some clusters failed",
+			aggErr:          aggErr,
+			queriedClusters: 3,
+			failedClusters:  1,
+			foundResources:  true,
+			wantCode:        ExitCodePartialFailure,
+		},
+		{
+			name:            "no resources found",
+			queriedClusters: 2,
+			foundResources:  false,
+			wantCode:        ExitCodeNoResourcesFound,
+		},
+		{
+			name:            "success",
+			queriedClusters: 2,
+			foundResources:  true,
+			wantNil:         true,
+		},
+		{
+			name:            "non cluster error keeps the generic exit",
+			aggErr:          errors.New("failed to print"),
+			queriedClusters: 2,
+			foundResources:  true,
+			wantNil:         false,
+			wantCode:        0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := classifyRunError(tt.aggErr, tt.queriedClusters, tt.failedClusters, tt.foundResources)
+			if tt.wantNil {
+				if err != nil {
+					t.Errorf("classifyRunError() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("classifyRunError() = nil, want error")
+			}
+			var codeErr *ExitCodeError
+			hasCode := errors.As(err, &codeErr)
+			if tt.wantCode == 0 {
+				if hasCode {
+					t.Errorf("classifyRunError() carries code %d, want generic error", codeErr.Code)
+				}
+				return
+			}
+			if !hasCode || codeErr.Code != tt.wantCode {
+				t.Errorf("classifyRunError() code = %v, want %d", codeErr, tt.wantCode)
+			}
+		})
+	}
+}
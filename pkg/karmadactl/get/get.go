@@ -24,7 +24,9 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/liggitt/tabwriter"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -35,6 +37,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 	"k8s.io/cli-runtime/pkg/printers"
@@ -51,6 +54,7 @@ import (
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
 	"github.com/karmada-io/karmada/pkg/karmadactl/options"
 	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util/clustercache"
 	karmadautil "github.com/karmada-io/karmada/pkg/util"
 	"github.com/karmada-io/karmada/pkg/util/gclient"
 	"github.com/karmada-io/karmada/pkg/util/helper"
@@ -116,7 +120,13 @@ var (
 		%[1]s get rs,services
 
 		# List one or more resources in Karmada control plane by their type and names
-		%[1]s get rs/nginx-cb87b6d88 service/kubernetes`)
+		%[1]s get rs/nginx-cb87b6d88 service/kubernetes
+
+		# List deployments together with their pods in the member clusters they are scheduled to
+		%[1]s get deployments --with-pods
+
+		# List only the deployments in member clusters that are not managed by Karmada
+		%[1]s get deployments --operation-scope=members --managed=false`)
 )
 
 // NewCmdGet New get command
@@ -149,6 +159,7 @@ func NewCmdGet(f util.Factory, parentCommand string, streams genericiooptions.IO
 	o.PrintFlags.AddFlags(cmd)
 	flags := cmd.Flags()
 	options.AddKubeConfigFlags(flags)
+	options.AddImpersonationFlags(flags)
 	options.AddNamespaceFlag(flags)
 	o.OperationScope = options.KarmadaControlPlane
 	flags.VarP(&o.OperationScope, "operation-scope", "s", "Used to control the operation scope of the command. The optional values are karmada, members, and all. Defaults to karmada.")
@@ -156,9 +167,17 @@ func NewCmdGet(f util.Factory, parentCommand string, streams genericiooptions.IO
 	flags.StringSliceVarP(&o.Clusters, "clusters", "C", []string{}, "Used to specify target member clusters and only takes effect when the command's operation scope is members or all, for example: --operation-scope=all --clusters=member1,member2")
 	flags.BoolVarP(&o.AllNamespaces, "all-namespaces", "A", o.AllNamespaces, "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
 	flags.BoolVar(&o.IgnoreNotFound, "ignore-not-found", o.IgnoreNotFound, "If the requested object does not exist the command will return exit code 0.")
+	flags.Int64Var(&o.ChunkSize, "chunk-size", o.ChunkSize, "Return large lists in chunks rather than all at once. Pass 0 to disable. This flag is beta and may change in the future.")
 	flags.BoolVarP(&o.Watch, "watch", "w", o.Watch, "After listing/getting the requested object, watch for changes. Uninitialized objects are excluded if no object name is provided.")
 	flags.BoolVar(&o.WatchOnly, "watch-only", o.WatchOnly, "Watch for changes to the requested object(s), without listing/getting first.")
 	flags.BoolVar(&o.OutputWatchEvents, "output-watch-events", o.OutputWatchEvents, "Output watch event objects when --watch or --watch-only is used. Existing objects are output as initial ADDED events.")
+	flags.BoolVar(&o.OpenMetrics, "openmetrics", o.OpenMetrics, "Emit resource counts and statuses as OpenMetrics families(per cluster, per kind) instead of a table, suitable for scraping fleet inventory into Prometheus.")
+	flags.BoolVar(&o.FailFast, "fail-fast", o.FailFast, "Stop querying the remaining clusters after the first cluster-level failure instead of aggregating partial results.")
+	flags.BoolVar(&o.Merge, "merge", o.Merge, "Merge rows describing the same object in the control plane and multiple member clusters into a single row with a CLUSTERS column and aggregated READY counts. Only takes effect when the command's operation scope covers member clusters.")
+	flags.BoolVar(&o.FromCache, "from-cache", o.FromCache, "Serve the request from the karmada-search ResourceRegistry cache with a single request instead of proxying to every member cluster, trading freshness for latency. Requires the karmada-search component and a ResourceRegistry covering the resource.")
+	flags.BoolVar(&o.ShowSchedule, "show-schedule", o.ShowSchedule, "Add a SCHEDULE column for Deployments and StatefulSets surfacing the scheduler's placement decision and the last reschedule reason from the associated ResourceBinding. Only takes effect with -o wide.")
+	flags.BoolVar(&o.WithPods, "with-pods", o.WithPods, "For each listed workload, also list its pods in the member clusters it is scheduled to, indented under the parent row. The pods are resolved via the workload's label selector through the cluster proxy.")
+	flags.StringVar(&o.Managed, "managed", o.Managed, "Filter member cluster resources by whether they are managed by Karmada: 'true' keeps only managed resources, 'false' keeps only unmanaged ones, useful for spotting drift and orphaned objects. Control plane resources are not affected. Only takes effect when the command's operation scope covers member clusters.")
 
 	return cmd
 }
@@ -195,10 +214,24 @@ type CommandGetOptions struct {
 	Sort           bool
 	IgnoreNotFound bool
 	Export         bool
+	OpenMetrics    bool
+	FailFast       bool
+	FromCache      bool
+	Merge          bool
+	ShowSchedule   bool
+	WithPods       bool
+
+	// Managed filters member cluster resources by Karmada adoption, valid
+	// values are 'true' and 'false'. Empty disables the filter.
+	Managed string
 
 	genericiooptions.IOStreams
 
 	KarmadaClient karmadaclientset.Interface
+
+	// deprecations collects the API deprecation warnings returned by the
+	// queried clusters during the run.
+	deprecations *deprecationCollector
 }
 
 // NewCommandGetOptions returns a CommandGetOptions with default chunk size 500.
@@ -233,6 +266,31 @@ func (g *CommandGetOptions) Complete(f util.Factory, cmd *cobra.Command) error {
 		g.IsHumanReadablePrinter = true
 	}
 
+	if g.OpenMetrics {
+		// The inventory is aggregated from complete objects on the client side,
+		// so disable server-side table printing and collect objects as-is.
+		g.ServerPrint = false
+		g.IsHumanReadablePrinter = true
+	}
+
+	if g.WithPods {
+		// pod expansion needs the workload's label selector, so collect
+		// complete objects instead of server-side printed tables.
+		g.ServerPrint = false
+	}
+
+	// Route the apiserver warning headers of every queried cluster into the
+	// collector, so deprecated API usage is reported once as a consolidated
+	// summary instead of being interleaved with the listing.
+	g.deprecations = newDeprecationCollector()
+	previousWrapConfigFn := options.DefaultConfigFlags.WrapConfigFn
+	options.DefaultConfigFlags.WrapConfigFn = func(config *rest.Config) *rest.Config {
+		if previousWrapConfigFn != nil {
+			config = previousWrapConfigFn(config)
+		}
+		return g.deprecations.WrapConfig(config)
+	}
+
 	g.ToPrinter = g.getResourcePrinter()
 	karmadaClient, err := f.KarmadaClientSet()
 	if err != nil {
@@ -253,6 +311,80 @@ func (g *CommandGetOptions) Validate(cmd *cobra.Command) error {
 	if g.OutputWatchEvents && !g.Watch && !g.WatchOnly {
 		return fmt.Errorf("--output-watch-events option can only be used with --watch or --watch-only")
 	}
+	if g.OpenMetrics {
+		if g.Watch || g.WatchOnly {
+			return fmt.Errorf("--openmetrics option cannot be used with --watch or --watch-only")
+		}
+		if len(*g.PrintFlags.OutputFormat) > 0 {
+			return fmt.Errorf("--openmetrics option cannot be used with %s printer", *g.PrintFlags.OutputFormat)
+		}
+	}
+
+	if g.Merge {
+		if g.Watch || g.WatchOnly {
+			return fmt.Errorf("--merge option cannot be used with --watch or --watch-only")
+		}
+		if g.FromCache {
+			return fmt.Errorf("--merge option cannot be used with --from-cache")
+		}
+		if !g.IsHumanReadablePrinter || g.OpenMetrics {
+			return fmt.Errorf("--merge option can only be used with table output")
+		}
+	}
+
+	if g.WithPods {
+		if g.Watch || g.WatchOnly {
+			return fmt.Errorf("--with-pods option cannot be used with --watch or --watch-only")
+		}
+		if g.FromCache {
+			return fmt.Errorf("--with-pods option cannot be used with --from-cache")
+		}
+		if g.Merge {
+			return fmt.Errorf("--with-pods option cannot be used with --merge")
+		}
+		if !g.IsHumanReadablePrinter || g.OpenMetrics {
+			return fmt.Errorf("--with-pods option can only be used with table output")
+		}
+	}
+
+	if g.FromCache {
+		if !options.ContainMembersScope(g.OperationScope) {
+			return fmt.Errorf("--from-cache option requires an operation scope covering member clusters, e.g. --operation-scope=members")
+		}
+		if g.Watch || g.WatchOnly {
+			return fmt.Errorf("--from-cache option cannot be used with --watch or --watch-only, the cache does not serve watches")
+		}
+		if g.OpenMetrics {
+			return fmt.Errorf("--from-cache option cannot be used with --openmetrics")
+		}
+	}
+
+	if g.ShowSchedule {
+		if *g.PrintFlags.OutputFormat != "wide" {
+			return fmt.Errorf("--show-schedule option can only be used with -o wide")
+		}
+		if g.Watch || g.WatchOnly {
+			return fmt.Errorf("--show-schedule option cannot be used with --watch or --watch-only")
+		}
+		if g.FromCache {
+			return fmt.Errorf("--show-schedule option cannot be used with --from-cache")
+		}
+	}
+
+	if len(g.Managed) > 0 {
+		if g.Managed != managedOnly && g.Managed != unmanagedOnly {
+			return fmt.Errorf("--managed option only accepts 'true' or 'false', got %q", g.Managed)
+		}
+		if !options.ContainMembersScope(g.OperationScope) {
+			return fmt.Errorf("--managed option requires an operation scope covering member clusters, e.g. --operation-scope=members")
+		}
+		if g.Watch || g.WatchOnly {
+			return fmt.Errorf("--managed option cannot be used with --watch or --watch-only")
+		}
+		if g.FromCache {
+			return fmt.Errorf("--managed option cannot be used with --from-cache")
+		}
+	}
 
 	if err := options.VerifyOperationScopeFlags(g.OperationScope); err != nil {
 		return err
@@ -349,39 +481,92 @@ type WatchObj struct {
 
 // Run performs the get operation.
 func (g *CommandGetOptions) Run(f util.Factory, args []string) error {
+	defer g.deprecations.PrintSummary(g.ErrOut)
+
+	if g.FromCache {
+		return g.runFromCache(f, args)
+	}
+
+	// bindings carry their interesting details in spec and status rather than
+	// server-side printed columns, so they get a rich client-side printer fed
+	// with the full objects
+	bindingDetails := g.IsHumanReadablePrinter && !g.Watch && !g.WatchOnly && !g.OpenMetrics && !g.WithPods && bindingArgsOnly(args)
+	if bindingDetails {
+		g.ServerPrint = false
+	}
+
 	mux := sync.Mutex{}
 	var wg sync.WaitGroup
 
 	var objs []Obj
 	var watchObjs []WatchObj
 	var allErrs []error
+	queriedClusters, failedClusters := 0, 0
 
 	if options.ContainKarmadaScope(g.OperationScope) {
-		g.getObjInfo(&mux, f, "Karmada", true, &objs, &watchObjs, &allErrs, args)
+		queriedClusters++
+		if !g.getObjInfo(&mux, f, "Karmada", true, &objs, &watchObjs, &allErrs, args) {
+			failedClusters++
+		}
 	}
 
-	if len(g.TargetMemberClusters) != 0 {
-		wg.Add(len(g.TargetMemberClusters))
-		for idx := range g.TargetMemberClusters {
-			memberFactory, err := f.FactoryForMemberCluster(g.TargetMemberClusters[idx])
-			if err != nil {
-				return err
+	if len(g.TargetMemberClusters) != 0 && !(g.FailFast && failedClusters > 0) {
+		if g.FailFast {
+			// query clusters one by one so the remaining ones can be skipped
+			// once a cluster-level failure shows up
+			for idx := range g.TargetMemberClusters {
+				memberFactory, err := f.FactoryForMemberCluster(g.TargetMemberClusters[idx])
+				if err != nil {
+					return err
+				}
+				queriedClusters++
+				if !g.getObjInfo(&mux, memberFactory, g.TargetMemberClusters[idx], false, &objs, &watchObjs, &allErrs, args) {
+					failedClusters++
+					break
+				}
+			}
+		} else {
+			clusterSucceeded := make([]bool, len(g.TargetMemberClusters))
+			wg.Add(len(g.TargetMemberClusters))
+			for idx := range g.TargetMemberClusters {
+				memberFactory, err := f.FactoryForMemberCluster(g.TargetMemberClusters[idx])
+				if err != nil {
+					return err
+				}
+				go func(idx int) {
+					clusterSucceeded[idx] = g.getObjInfo(&mux, memberFactory, g.TargetMemberClusters[idx], false, &objs, &watchObjs, &allErrs, args)
+					wg.Done()
+				}(idx)
+			}
+			wg.Wait()
+			queriedClusters += len(g.TargetMemberClusters)
+			for _, succeeded := range clusterSucceeded {
+				if !succeeded {
+					failedClusters++
+				}
 			}
-			go func() {
-				g.getObjInfo(&mux, memberFactory, g.TargetMemberClusters[idx], false, &objs, &watchObjs, &allErrs, args)
-				wg.Done()
-			}()
 		}
-		wg.Wait()
+	}
+
+	if g.FailFast && failedClusters > 0 {
+		return classifyRunError(utilerrors.NewAggregate(allErrs), queriedClusters, failedClusters, false)
 	}
 
 	if g.Watch || g.WatchOnly {
-		return g.watch(watchObjs)
+		return g.watch(f, watchObjs, args)
 	}
 
 	if !g.IsHumanReadablePrinter {
-		// have printed objects in yaml or json format above
-		return nil
+		// objects have been printed in yaml or json format along the way, only
+		// the cluster-level failures are left to classify
+		return classifyRunError(utilerrors.NewAggregate(allErrs), queriedClusters, failedClusters, true)
+	}
+
+	if g.OpenMetrics {
+		if err := g.printOpenMetrics(objs); err != nil {
+			allErrs = append(allErrs, err)
+		}
+		return classifyRunError(utilerrors.NewAggregate(allErrs), queriedClusters, failedClusters, true)
 	}
 
 	// sort objects by resource kind to classify them
@@ -389,9 +574,30 @@ func (g *CommandGetOptions) Run(f util.Factory, args []string) error {
 		return objs[i].Info.Mapping.Resource.String() < objs[j].Info.Mapping.Resource.String()
 	})
 
+	if bindingDetails {
+		if len(objs) == 0 {
+			g.printIfNotFindResource(0, &allErrs, !g.AllNamespaces)
+		} else if err := g.printBindingDetails(objs, g.Out); err != nil {
+			allErrs = append(allErrs, err)
+		}
+		foundResources := len(objs) != 0 || g.IgnoreNotFound
+		return classifyRunError(utilerrors.NewAggregate(allErrs), queriedClusters, failedClusters, foundResources)
+	}
+
+	if g.WithPods {
+		if len(objs) == 0 {
+			g.printIfNotFindResource(0, &allErrs, !g.AllNamespaces)
+		} else if err := g.printObjsWithPods(f, objs); err != nil {
+			allErrs = append(allErrs, err)
+		}
+		foundResources := len(objs) != 0 || g.IgnoreNotFound
+		return classifyRunError(utilerrors.NewAggregate(allErrs), queriedClusters, failedClusters, foundResources)
+	}
+
 	g.printObjs(objs, &allErrs, args)
 
-	return utilerrors.NewAggregate(allErrs)
+	foundResources := len(objs) != 0 || g.IgnoreNotFound
+	return classifyRunError(utilerrors.NewAggregate(allErrs), queriedClusters, failedClusters, foundResources)
 }
 
 // printObjs print objects in multi clusters
@@ -444,25 +650,7 @@ func (g *CommandGetOptions) printObjs(objs []Obj, allErrs *[]error, _ []string)
 		}
 
 		if ix == len(objs)-1 || objs[ix].Info.Mapping.Resource != objs[ix+1].Info.Mapping.Resource {
-			table := &metav1.Table{}
-			allTableRows, mapping, err := g.reconstructionRow(sameKind, table)
-			if err != nil {
-				*allErrs = append(*allErrs, err)
-				return
-			}
-			table.Rows = allTableRows
-
-			setNoAdoption(mapping)
-			g.setColumnDefinition(table)
-
-			printObj, err := helper.ToUnstructured(table)
-			if err != nil {
-				*allErrs = append(*allErrs, err)
-				return
-			}
-
-			err = printer.PrintObj(printObj, w)
-			if err != nil {
+			if err := g.printSameKindObjs(sameKind, printer, w); err != nil {
 				*allErrs = append(*allErrs, err)
 				return
 			}
@@ -475,6 +663,64 @@ func (g *CommandGetOptions) printObjs(objs []Obj, allErrs *[]error, _ []string)
 	g.printIfNotFindResource(trackingWriter.Written, allErrs, allResourcesNamespaced)
 }
 
+// printSameKindObjs prints objects of the same resource kind, emitting the
+// rows in chunks of ChunkSize so the buffered row count stays bounded no
+// matter how large the aggregated result is. Repeated headers are suppressed
+// by the printer. Events are the exception and are emitted in one piece, as
+// sorting them by time needs the complete row set.
+func (g *CommandGetOptions) printSameKindObjs(sameKind []Obj, printer printers.ResourcePrinter, w io.Writer) error {
+	flushRows := func(table *metav1.Table, mapping *meta.RESTMapping, rows []metav1.TableRow) error {
+		table.Rows = rows
+		sortEventTableRows(table, mapping)
+
+		setNoAdoption(mapping)
+		g.setColumnDefinition(table)
+		if g.Merge {
+			mergeDuplicateRows(table)
+		}
+		if g.ShowSchedule && supportsScheduleColumn(mapping) {
+			g.appendScheduleColumn(table, mapping)
+		}
+
+		printObj, err := helper.ToUnstructured(table)
+		if err != nil {
+			return err
+		}
+		return printer.PrintObj(printObj, w)
+	}
+
+	isEvents := len(sameKind) > 0 && sameKind[0].Info.Mapping.Resource.Resource == "events"
+
+	var pendingRows []metav1.TableRow
+	var mapping *meta.RESTMapping
+	var lastTable *metav1.Table
+	flushed := false
+	for ix := range sameKind {
+		table := &metav1.Table{}
+		rows, m, err := g.reconstructionRow(sameKind[ix:ix+1], table)
+		if err != nil {
+			return err
+		}
+		mapping = m
+		lastTable = table
+		pendingRows = append(pendingRows, rows...)
+
+		// merging needs the complete row set as the duplicates may come
+		// from different clusters
+		if !isEvents && !g.Merge && g.ChunkSize > 0 && int64(len(pendingRows)) >= g.ChunkSize {
+			if err := flushRows(lastTable, mapping, pendingRows); err != nil {
+				return err
+			}
+			pendingRows = nil
+			flushed = true
+		}
+	}
+	if lastTable != nil && (len(pendingRows) > 0 || !flushed) {
+		return flushRows(lastTable, mapping, pendingRows)
+	}
+	return nil
+}
+
 // printIfNotFindResource is sure we output something if we wrote no output, and had no errors, and are not ignoring NotFound
 func (g *CommandGetOptions) printIfNotFindResource(written int, allErrs *[]error, allResourcesNamespaced bool) {
 	if written != 0 || g.IgnoreNotFound || len(*allErrs) != 0 {
@@ -499,14 +745,15 @@ func (g *CommandGetOptions) checkPrintWithNamespace(mapping *meta.RESTMapping) b
 	return g.AllNamespaces
 }
 
-// getObjInfo get obj info in member cluster
+// getObjInfo get obj info in member cluster, and reports whether the cluster
+// was queried successfully.
 func (g *CommandGetOptions) getObjInfo(mux *sync.Mutex, f cmdutil.Factory,
 	cluster string, isControlPlane bool, objs *[]Obj, watchObjs *[]WatchObj, allErrs *[]error, args []string,
-) {
+) bool {
 	restClient, err := f.RESTClient()
 	if err != nil {
 		*allErrs = append(*allErrs, err)
-		return
+		return false
 	}
 
 	if !isControlPlane {
@@ -514,7 +761,7 @@ func (g *CommandGetOptions) getObjInfo(mux *sync.Mutex, f cmdutil.Factory,
 		request := restClient.Get().RequestURI(fmt.Sprintf(proxyURL, cluster) + "api")
 		if _, err := request.DoRaw(context.TODO()); err != nil {
 			*allErrs = append(*allErrs, fmt.Errorf("cluster(%s) is inaccessible, please check authorization or network", cluster))
-			return
+			return false
 		}
 	}
 
@@ -522,7 +769,7 @@ func (g *CommandGetOptions) getObjInfo(mux *sync.Mutex, f cmdutil.Factory,
 		Unstructured().
 		NamespaceParam(g.Namespace).DefaultNamespace().AllNamespaces(g.AllNamespaces).
 		FilenameParam(g.ExplicitNamespace, &g.FilenameOptions).
-		LabelSelectorParam(g.LabelSelector).
+		LabelSelectorParam(g.labelSelectorForCluster(isControlPlane)).
 		FieldSelectorParam(g.FieldSelector).
 		RequestChunksOf(g.ChunkSize).
 		ResourceTypeOrNameArgs(true, args...).
@@ -538,7 +785,7 @@ func (g *CommandGetOptions) getObjInfo(mux *sync.Mutex, f cmdutil.Factory,
 
 	if err := r.Err(); err != nil {
 		*allErrs = append(*allErrs, fmt.Errorf("cluster(%s): %s", cluster, err))
-		return
+		return false
 	}
 
 	if g.Watch || g.WatchOnly {
@@ -549,21 +796,23 @@ func (g *CommandGetOptions) getObjInfo(mux *sync.Mutex, f cmdutil.Factory,
 		}
 		*watchObjs = append(*watchObjs, watchObjsInfo)
 		mux.Unlock()
-		return
+		return true
 	}
 
 	if !g.IsHumanReadablePrinter {
-		if err := g.printGeneric(r); err != nil {
+		if err := g.printGeneric(r, isControlPlane); err != nil {
 			*allErrs = append(*allErrs, fmt.Errorf("cluster(%s): %s", cluster, err))
+			return false
 		}
-		return
+		return true
 	}
 
 	infos, err := r.Infos()
 	if err != nil {
 		*allErrs = append(*allErrs, fmt.Errorf("cluster(%s): %s", cluster, err))
-		return
+		return false
 	}
+	infos = g.filterManagedInfos(infos, isControlPlane)
 
 	mux.Lock()
 	var objInfo Obj
@@ -576,6 +825,7 @@ func (g *CommandGetOptions) getObjInfo(mux *sync.Mutex, f cmdutil.Factory,
 		*objs = append(*objs, objInfo)
 	}
 	mux.Unlock()
+	return true
 }
 
 // reconstructionRow reconstruction tableRow
@@ -591,6 +841,7 @@ func (g *CommandGetOptions) reconstructionRow(objs []Obj, table *metav1.Table) (
 		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstr.Object, table); err != nil {
 			return nil, nil, err
 		}
+		keptRows := make([]metav1.TableRow, 0, len(table.Rows))
 		for rowIdx := range table.Rows {
 			var cells []interface{}
 			cells = append(cells, table.Rows[rowIdx].Cells[0])
@@ -602,11 +853,16 @@ func (g *CommandGetOptions) reconstructionRow(objs []Obj, table *metav1.Table) (
 			err := unObj.UnmarshalJSON(table.Rows[rowIdx].Object.Raw)
 			if err != nil {
 				klog.Errorf("Failed to unmarshal unObj, error is: %v", err)
+				keptRows = append(keptRows, table.Rows[rowIdx])
 				continue
 			}
 
 			if objs[ix].IsControlPlane {
 				table.Rows[rowIdx].Cells = append(table.Rows[rowIdx].Cells, notApplicable)
+				keptRows = append(keptRows, table.Rows[rowIdx])
+				continue
+			}
+			if g.dropUnmanaged(unObj, false) {
 				continue
 			}
 			v, exist := unObj.GetLabels()[karmadautil.ManagedByKarmadaLabel]
@@ -615,8 +871,9 @@ func (g *CommandGetOptions) reconstructionRow(objs []Obj, table *metav1.Table) (
 			} else {
 				table.Rows[rowIdx].Cells = append(table.Rows[rowIdx].Cells, notManagedByKaramda)
 			}
+			keptRows = append(keptRows, table.Rows[rowIdx])
 		}
-		allTableRows = append(allTableRows, table.Rows...)
+		allTableRows = append(allTableRows, keptRows...)
 	}
 	return allTableRows, mapping, nil
 }
@@ -667,7 +924,7 @@ func (g *CommandGetOptions) reconstructObj(obj runtime.Object, mapping *meta.RES
 }
 
 // watch starts a client-side watch of one or more resources.
-func (g *CommandGetOptions) watch(watchObjs []WatchObj) error {
+func (g *CommandGetOptions) watch(f util.Factory, watchObjs []WatchObj, args []string) error {
 	if len(watchObjs) <= 0 {
 		return fmt.Errorf("not to find obj that is watched")
 	}
@@ -735,13 +992,13 @@ func (g *CommandGetOptions) watch(watchObjs []WatchObj) error {
 	}
 	writer.Flush()
 
-	g.watchMultiClusterObj(watchObjs, mapping, outputObjects, printer)
+	g.watchMultiClusterObj(f, args, watchObjs, mapping, outputObjects, printer)
 
 	return nil
 }
 
 // watchMultiClusterObj watch objects in multi clusters by goroutines
-func (g *CommandGetOptions) watchMultiClusterObj(watchObjs []WatchObj, mapping *meta.RESTMapping, outputObjects *bool, printer printers.ResourcePrinterFunc) {
+func (g *CommandGetOptions) watchMultiClusterObj(f util.Factory, args []string, watchObjs []WatchObj, mapping *meta.RESTMapping, outputObjects *bool, printer printers.ResourcePrinterFunc) {
 	var wg sync.WaitGroup
 
 	writer := printers.GetNewTabWriter(g.Out)
@@ -749,68 +1006,97 @@ func (g *CommandGetOptions) watchMultiClusterObj(watchObjs []WatchObj, mapping *
 	wg.Add(len(watchObjs))
 	for _, watchObj := range watchObjs {
 		go func(watchObj WatchObj) {
-			obj, err := watchObj.r.Object()
-			if err != nil {
+			defer wg.Done()
+			err := g.watchClusterObj(watchObj, mapping, outputObjects, printer, writer)
+			if err == nil || wait.Interrupted(err) {
+				return
+			}
+			if !g.isTargetMemberCluster(watchObj.Cluster) {
 				panic(err)
 			}
+			// The member stream broke, keep probing the cluster and resume
+			// streaming once it heals.
+			g.rewatchClusterObj(f, watchObj.Cluster, args, mapping, outputObjects, printer, writer)
+		}(watchObj)
+	}
 
-			rv := "0"
-			isList := meta.IsListType(obj)
-			if isList {
-				// the resourceVersion of list objects is ~now but won't return
-				// an initial watch event
-				rv, err = meta.NewAccessor().ResourceVersion(obj)
-				if err != nil {
-					panic(err)
-				}
-				// we can start outputting objects now, watches started from lists don't emit synthetic added events
-				*outputObjects = true
-			} else {
-				// suppress output, since watches started for individual items emit a synthetic ADDED event first
-				*outputObjects = false
+	// Member clusters that failed the initial access check join the watch
+	// session as soon as they become reachable.
+	dropped := g.droppedMemberClusters(watchObjs)
+	wg.Add(len(dropped))
+	for _, cluster := range dropped {
+		fmt.Fprintf(g.ErrOut, "cluster(%s) is inaccessible, retrying every %s until it recovers\n", cluster, reconnectInterval)
+		go func(cluster string) {
+			defer wg.Done()
+			g.rewatchClusterObj(f, cluster, args, mapping, outputObjects, printer, writer)
+		}(cluster)
+	}
+
+	wg.Wait()
+}
+
+// watchClusterObj streams the watch events of a single cluster until the
+// stream ends, returning the error that ended it.
+func (g *CommandGetOptions) watchClusterObj(watchObj WatchObj, mapping *meta.RESTMapping, outputObjects *bool, printer printers.ResourcePrinterFunc, writer *tabwriter.Writer) error {
+	obj, err := watchObj.r.Object()
+	if err != nil {
+		return err
+	}
+
+	rv := "0"
+	isList := meta.IsListType(obj)
+	if isList {
+		// the resourceVersion of list objects is ~now but won't return
+		// an initial watch event
+		rv, err = meta.NewAccessor().ResourceVersion(obj)
+		if err != nil {
+			return err
+		}
+		// we can start outputting objects now, watches started from lists don't emit synthetic added events
+		*outputObjects = true
+	} else {
+		// suppress output, since watches started for individual items emit a synthetic ADDED event first
+		*outputObjects = false
+	}
+
+	// print watched changes
+	w, err := watchObj.r.Watch(rv)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	intr := interrupt.New(nil, cancel)
+	return intr.Run(func() error {
+		_, err := watchtools.UntilWithoutRetry(ctx, w, func(e watch.Event) (bool, error) {
+			objToPrint := e.Object
+
+			objrow, err := g.reconstructObj(objToPrint, mapping, watchObj.Cluster, string(e.Type))
+			if err != nil {
+				return false, err
 			}
+			// not need to print ColumnDefinitions
+			objrow.ColumnDefinitions = nil
 
-			// print watched changes
-			w, err := watchObj.r.Watch(rv)
+			printObj, err := helper.ToUnstructured(objrow)
 			if err != nil {
-				panic(err)
+				return false, err
 			}
 
-			ctx, cancel := context.WithCancel(context.Background())
-			defer cancel()
-			intr := interrupt.New(nil, cancel)
-			_ = intr.Run(func() error {
-				_, err := watchtools.UntilWithoutRetry(ctx, w, func(e watch.Event) (bool, error) {
-					objToPrint := e.Object
-
-					objrow, err := g.reconstructObj(objToPrint, mapping, watchObj.Cluster, string(e.Type))
-					if err != nil {
-						return false, err
-					}
-					// not need to print ColumnDefinitions
-					objrow.ColumnDefinitions = nil
-
-					printObj, err := helper.ToUnstructured(objrow)
-					if err != nil {
-						return false, err
-					}
-
-					if err := printer.PrintObj(printObj, writer); err != nil {
-						return false, err
-					}
-					writer.Flush()
-					// after processing at least one event, start outputting objects
-					*outputObjects = true
-					return false, nil
-				})
-				return err
-			})
-		}(watchObj)
-	}
-	wg.Wait()
+			if err := printer.PrintObj(printObj, writer); err != nil {
+				return false, err
+			}
+			writer.Flush()
+			// after processing at least one event, start outputting objects
+			*outputObjects = true
+			return false, nil
+		})
+		return err
+	})
 }
 
-func (g *CommandGetOptions) printGeneric(r *resource.Result) error {
+func (g *CommandGetOptions) printGeneric(r *resource.Result, isControlPlane bool) error {
 	// we flattened the data from the builder, so we have individual items, but now we'd like to either:
 	// 1. if there is more than one item, combine them all into a single list
 	// 2. if there is a single item and that item is a list, leave it as its specific list
@@ -822,6 +1108,7 @@ func (g *CommandGetOptions) printGeneric(r *resource.Result) error {
 	if err != nil {
 		return err
 	}
+	infos = g.filterManagedInfos(infos, isControlPlane)
 
 	printer, err := g.ToPrinter(nil, nil, false, false)
 	if err != nil {
@@ -972,6 +1259,40 @@ func multipleGVKsRequested(objs []Obj) bool {
 	return false
 }
 
+// sortEventTableRows sorts event rows collected from the control plane and member
+// clusters by their last observed time, so that the merged output reads as a
+// single timeline instead of per-cluster blocks.
+func sortEventTableRows(table *metav1.Table, mapping *meta.RESTMapping) {
+	if mapping == nil || mapping.Resource.Resource != "events" {
+		return
+	}
+	sort.SliceStable(table.Rows, func(i, j int) bool {
+		return eventRowTime(table.Rows[i]).Before(eventRowTime(table.Rows[j]))
+	})
+}
+
+// eventRowTime extracts the last observed time of an event row, falling back to
+// the creation timestamp when the event details are not embedded in the row.
+func eventRowTime(row metav1.TableRow) time.Time {
+	unObj := &unstructured.Unstructured{}
+	if err := unObj.UnmarshalJSON(row.Object.Raw); err != nil {
+		return time.Time{}
+	}
+	for _, fields := range [][]string{
+		{"series", "lastObservedTime"},
+		{"lastTimestamp"},
+		{"eventTime"},
+		{"metadata", "creationTimestamp"},
+	} {
+		if value, exist, _ := unstructured.NestedString(unObj.Object, fields...); exist && value != "" {
+			if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+				return parsed
+			}
+		}
+	}
+	return time.Time{}
+}
+
 // setNoAdoption set pod no print adoption
 func setNoAdoption(mapping *meta.RESTMapping) {
 	if mapping != nil && mapping.Resource.Resource == "pods" {
@@ -1015,16 +1336,12 @@ func (p *skipPrinter) PrintObj(obj runtime.Object, writer io.Writer) error {
 	return p.delegate.PrintObj(table, writer)
 }
 
-// LoadRegisteredClusters gets a list of register clusters.
+// LoadRegisteredClusters gets a list of register clusters, served from the
+// short-lived on-disk cache when it is fresh.
 func LoadRegisteredClusters(clientSet karmadaclientset.Interface) ([]string, error) {
-	var clusters []string
-	clusterList, err := clientSet.ClusterV1alpha1().Clusters().List(context.TODO(), metav1.ListOptions{})
+	clusters, err := clustercache.ListClusters(clientSet)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list all member clusters in control plane, err: %w", err)
 	}
-
-	for i := range clusterList.Items {
-		clusters = append(clusters, clusterList.Items[i].Name)
-	}
 	return clusters, nil
 }
@@ -0,0 +1,77 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/resource"
+
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+)
+
+const (
+	// managedOnly keeps only the member cluster resources managed by Karmada.
+	managedOnly = "true"
+	// unmanagedOnly keeps only the member cluster resources not managed by Karmada.
+	unmanagedOnly = "false"
+)
+
+// labelSelectorForCluster returns the label selector the cluster is queried
+// with. Listing only managed resources is pushed down to the member cluster
+// apiservers by injecting the marker label Karmada puts on every propagated
+// object. The unmanaged side of the filter has no label to select on and is
+// applied on the client instead, see dropUnmanaged.
+func (g *CommandGetOptions) labelSelectorForCluster(isControlPlane bool) string {
+	if isControlPlane || g.Managed != managedOnly {
+		return g.LabelSelector
+	}
+	managedSelector := fmt.Sprintf("%s=%s", karmadautil.ManagedByKarmadaLabel, karmadautil.ManagedByKarmadaLabelValue)
+	if len(g.LabelSelector) == 0 {
+		return managedSelector
+	}
+	return g.LabelSelector + "," + managedSelector
+}
+
+// dropUnmanaged reports whether the --managed=false filter drops the member
+// cluster object, mirroring the logic behind the ADOPTION column. Control
+// plane resources are never dropped, they are neither managed nor unmanaged.
+func (g *CommandGetOptions) dropUnmanaged(obj metav1.Object, isControlPlane bool) bool {
+	if isControlPlane || g.Managed != unmanagedOnly {
+		return false
+	}
+	v, exist := obj.GetLabels()[karmadautil.ManagedByKarmadaLabel]
+	return exist && v == karmadautil.ManagedByKarmadaLabelValue
+}
+
+// filterManagedInfos applies the --managed=false filter on the objects
+// collected from a member cluster.
+func (g *CommandGetOptions) filterManagedInfos(infos []*resource.Info, isControlPlane bool) []*resource.Info {
+	if isControlPlane || g.Managed != unmanagedOnly {
+		return infos
+	}
+	kept := make([]*resource.Info, 0, len(infos))
+	for _, info := range infos {
+		if accessor, err := meta.Accessor(info.Object); err == nil && g.dropUnmanaged(accessor, isControlPlane) {
+			continue
+		}
+		kept = append(kept, info)
+	}
+	return kept
+}
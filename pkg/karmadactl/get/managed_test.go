@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/resource"
+
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+)
+
+func newManagedTestObject(name string, managed bool) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"namespace": "default",
+				"name":      name,
+			},
+		},
+	}
+	if managed {
+		obj.SetLabels(map[string]string{karmadautil.ManagedByKarmadaLabel: karmadautil.ManagedByKarmadaLabelValue})
+	}
+	return obj
+}
+
+func TestLabelSelectorForCluster(t *testing.T) {
+	managedSelector := karmadautil.ManagedByKarmadaLabel + "=" + karmadautil.ManagedByKarmadaLabelValue
+	tests := []struct {
+		name           string
+		opts           CommandGetOptions
+		isControlPlane bool
+		want           string
+	}{
+		{
+			name: "no managed filter keeps the user selector",
+			opts: CommandGetOptions{LabelSelector: "app=nginx"},
+			want: "app=nginx",
+		},
+		{
+			name: "managed filter injects the marker label",
+			opts: CommandGetOptions{Managed: managedOnly},
+			want: managedSelector,
+		},
+		{
+			name: "managed filter joins the user selector",
+			opts: CommandGetOptions{Managed: managedOnly, LabelSelector: "app=nginx"},
+			want: "app=nginx," + managedSelector,
+		},
+		{
+			name:           "control plane query is left untouched",
+			opts:           CommandGetOptions{Managed: managedOnly, LabelSelector: "app=nginx"},
+			isControlPlane: true,
+			want:           "app=nginx",
+		},
+		{
+			name: "unmanaged filter is not expressed as a selector",
+			opts: CommandGetOptions{Managed: unmanagedOnly, LabelSelector: "app=nginx"},
+			want: "app=nginx",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.opts.labelSelectorForCluster(tt.isControlPlane); got != tt.want {
+				t.Errorf("labelSelectorForCluster() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterManagedInfos(t *testing.T) {
+	infos := []*resource.Info{
+		{Object: newManagedTestObject("managed", true)},
+		{Object: newManagedTestObject("orphan", false)},
+	}
+
+	opts := CommandGetOptions{Managed: unmanagedOnly}
+	kept := opts.filterManagedInfos(infos, false)
+	if len(kept) != 1 || kept[0].Object.(*unstructured.Unstructured).GetName() != "orphan" {
+		t.Errorf("filterManagedInfos() kept %d info(s), want only the unmanaged one", len(kept))
+	}
+
+	if kept := opts.filterManagedInfos(infos, true); len(kept) != len(infos) {
+		t.Errorf("filterManagedInfos() dropped control plane objects")
+	}
+
+	opts = CommandGetOptions{}
+	if kept := opts.filterManagedInfos(infos, false); len(kept) != len(infos) {
+		t.Errorf("filterManagedInfos() filtered without --managed being set")
+	}
+}
+
+func TestDropUnmanaged(t *testing.T) {
+	opts := CommandGetOptions{Managed: unmanagedOnly}
+	if !opts.dropUnmanaged(newManagedTestObject("managed", true), false) {
+		t.Errorf("dropUnmanaged() kept a managed object with --managed=false")
+	}
+	if opts.dropUnmanaged(newManagedTestObject("orphan", false), false) {
+		t.Errorf("dropUnmanaged() dropped an unmanaged object with --managed=false")
+	}
+	if opts.dropUnmanaged(newManagedTestObject("managed", true), true) {
+		t.Errorf("dropUnmanaged() dropped a control plane object")
+	}
+
+	opts = CommandGetOptions{Managed: managedOnly}
+	if opts.dropUnmanaged(newManagedTestObject("orphan", false), false) {
+		t.Errorf("dropUnmanaged() dropped an object with --managed=true, the selector handles that side")
+	}
+}
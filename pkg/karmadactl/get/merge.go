@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// readyCellPattern matches fraction-style cells such as the pod READY column,
+// which are summed up when rows are merged.
+var readyCellPattern = regexp.MustCompile(`^(\d+)/(\d+)$`)
+
+// mergeDuplicateRows collapses rows describing the same logical object, i.e.
+// the same namespace and name, into a single row whose CLUSTERS cell lists
+// every cluster the object was found in. Fraction-style cells like READY are
+// summed up, other differing cells keep the value of the first row.
+func mergeDuplicateRows(table *metav1.Table) {
+	for i := range table.ColumnDefinitions {
+		if table.ColumnDefinitions[i].Name == podColumns[0].Name {
+			table.ColumnDefinitions[i].Name = "CLUSTERS"
+		}
+	}
+
+	type rowKey struct {
+		namespace string
+		name      string
+	}
+	var mergedRows []metav1.TableRow
+	rowIndex := map[rowKey]int{}
+	for _, row := range table.Rows {
+		// cells are [name, cluster, original cells..., adoption]
+		if len(row.Cells) < 3 {
+			mergedRows = append(mergedRows, row)
+			continue
+		}
+		key := rowKey{namespace: rowObjectNamespace(row), name: fmt.Sprintf("%v", row.Cells[0])}
+		if idx, exist := rowIndex[key]; exist {
+			mergeRowInto(&mergedRows[idx], row)
+			continue
+		}
+		rowIndex[key] = len(mergedRows)
+		mergedRows = append(mergedRows, row)
+	}
+	table.Rows = mergedRows
+}
+
+// mergeRowInto folds src into dst, extending the cluster list and aggregating
+// the remaining cells.
+func mergeRowInto(dst *metav1.TableRow, src metav1.TableRow) {
+	srcCluster := fmt.Sprintf("%v", src.Cells[printColumnClusterNum])
+	if len(srcCluster) > 0 {
+		dst.Cells[printColumnClusterNum] = fmt.Sprintf("%v,%s", dst.Cells[printColumnClusterNum], srcCluster)
+	}
+
+	lastCell := len(dst.Cells) - 1
+	for i := printColumnClusterNum + 1; i < lastCell && i < len(src.Cells)-1; i++ {
+		dst.Cells[i] = mergeCellValue(dst.Cells[i], src.Cells[i])
+	}
+
+	// the merged row counts as managed once any of its member rows is
+	if fmt.Sprintf("%v", src.Cells[len(src.Cells)-1]) == string(managedByKarmada) {
+		dst.Cells[lastCell] = managedByKarmada
+	}
+}
+
+// mergeCellValue aggregates two cells of the same column: identical values
+// collapse, fraction-style values such as READY are summed, anything else
+// keeps the first value.
+func mergeCellValue(a, b interface{}) interface{} {
+	aStr, bStr := fmt.Sprintf("%v", a), fmt.Sprintf("%v", b)
+	if aStr == bStr {
+		return a
+	}
+	aMatch, bMatch := readyCellPattern.FindStringSubmatch(aStr), readyCellPattern.FindStringSubmatch(bStr)
+	if aMatch == nil || bMatch == nil {
+		return a
+	}
+
+	aReady, _ := strconv.Atoi(aMatch[1])
+	aTotal, _ := strconv.Atoi(aMatch[2])
+	bReady, _ := strconv.Atoi(bMatch[1])
+	bTotal, _ := strconv.Atoi(bMatch[2])
+	return fmt.Sprintf("%d/%d", aReady+bReady, aTotal+bTotal)
+}
+
+// rowObjectNamespace extracts the namespace from the partial object metadata
+// attached to the row.
+func rowObjectNamespace(row metav1.TableRow) string {
+	if len(row.Object.Raw) == 0 {
+		return ""
+	}
+	unObj := &unstructured.Unstructured{}
+	if err := unObj.UnmarshalJSON(row.Object.Raw); err != nil {
+		return ""
+	}
+	return unObj.GetNamespace()
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func newMergeTestRow(namespace, name, cluster, ready string, adopted adoption) metav1.TableRow {
+	return metav1.TableRow{
+		Cells: []interface{}{name, cluster, ready, adopted},
+		Object: runtime.RawExtension{
+			Raw: []byte(fmt.Sprintf(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":%q,"name":%q}}`, namespace, name)),
+		},
+	}
+}
+
+func TestMergeDuplicateRows(t *testing.T) {
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "NAME"}, {Name: "CLUSTER"}, {Name: "READY"}, {Name: "ADOPTION"},
+		},
+		Rows: []metav1.TableRow{
+			newMergeTestRow("default", "nginx", "Karmada", "2/2", notApplicable),
+			newMergeTestRow("default", "nginx", "member1", "1/1", managedByKarmada),
+			newMergeTestRow("default", "nginx", "member2", "1/1", managedByKarmada),
+			newMergeTestRow("other", "nginx", "member1", "1/1", notManagedByKaramda),
+		},
+	}
+
+	mergeDuplicateRows(table)
+
+	if table.ColumnDefinitions[1].Name != "CLUSTERS" {
+		t.Errorf("cluster column name = %s, want CLUSTERS", table.ColumnDefinitions[1].Name)
+	}
+	if len(table.Rows) != 2 {
+		t.Fatalf("merged rows = %d, want 2", len(table.Rows))
+	}
+	merged := table.Rows[0]
+	if clusters := fmt.Sprintf("%v", merged.Cells[1]); clusters != "Karmada,member1,member2" {
+		t.Errorf("clusters cell = %s, want Karmada,member1,member2", clusters)
+	}
+	if ready := fmt.Sprintf("%v", merged.Cells[2]); ready != "4/4" {
+		t.Errorf("ready cell = %s, want 4/4", ready)
+	}
+	if adopted := fmt.Sprintf("%v", merged.Cells[3]); adopted != string(managedByKarmada) {
+		t.Errorf("adoption cell = %s, want %s", adopted, managedByKarmada)
+	}
+}
+
+func TestMergeCellValue(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b interface{}
+		want string
+	}{
+		{name: "identical values collapse", a: "Running", b: "Running", want: "Running"},
+		{name: "ready fractions are summed", a: "1/2", b: "3/3", want: "4/5"},
+		{name: "differing plain values keep the first", a: "Running", b: "Pending", want: "Running"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf("%v", mergeCellValue(tt.a, tt.b)); got != tt.want {
+				t.Errorf("mergeCellValue(%v, %v) = %s, want %s", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+)
+
+// metricsSeriesKey identifies one OpenMetrics series of the fleet inventory.
+type metricsSeriesKey struct {
+	cluster string
+	group   string
+	kind    string
+	phase   string
+}
+
+// fleetInventory aggregates fetched objects into per-cluster, per-kind counters
+// that can be rendered as OpenMetrics families.
+type fleetInventory struct {
+	count   map[metricsSeriesKey]int
+	managed map[metricsSeriesKey]int
+	phase   map[metricsSeriesKey]int
+}
+
+func newFleetInventory() *fleetInventory {
+	return &fleetInventory{
+		count:   map[metricsSeriesKey]int{},
+		managed: map[metricsSeriesKey]int{},
+		phase:   map[metricsSeriesKey]int{},
+	}
+}
+
+// add records a single object into the inventory.
+func (inv *fleetInventory) add(obj Obj) {
+	gvk := obj.Info.Mapping.GroupVersionKind
+	key := metricsSeriesKey{cluster: obj.Cluster, group: gvk.Group, kind: gvk.Kind}
+	inv.count[key]++
+
+	unstr, ok := obj.Info.Object.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	if !obj.IsControlPlane {
+		v, exist := unstr.GetLabels()[karmadautil.ManagedByKarmadaLabel]
+		if exist && v == karmadautil.ManagedByKarmadaLabelValue {
+			inv.managed[key]++
+		}
+	}
+
+	// Not every kind exposes status.phase, record it only when present so that
+	// workloads like pods get a per-phase breakdown for free.
+	if phase, found, _ := unstructured.NestedString(unstr.Object, "status", "phase"); found && phase != "" {
+		phaseKey := key
+		phaseKey.phase = phase
+		inv.phase[phaseKey]++
+	}
+}
+
+// render writes the inventory as OpenMetrics text exposition format, terminated
+// by the mandatory "# EOF" marker so the output can be scraped as-is.
+func (inv *fleetInventory) render(w io.Writer) error {
+	families := []struct {
+		name   string
+		help   string
+		series map[metricsSeriesKey]int
+	}{
+		{
+			name:   "karmada_fleet_resource",
+			help:   "Number of resources observed per cluster and kind.",
+			series: inv.count,
+		},
+		{
+			name:   "karmada_fleet_resource_managed",
+			help:   "Number of member cluster resources managed by the Karmada control plane, per cluster and kind.",
+			series: inv.managed,
+		},
+		{
+			name:   "karmada_fleet_resource_phase",
+			help:   "Number of resources per cluster, kind and status phase, for kinds that report status.phase.",
+			series: inv.phase,
+		},
+	}
+
+	for _, family := range families {
+		if len(family.series) == 0 {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "# TYPE %s gauge\n# HELP %s %s\n", family.name, family.name, family.help); err != nil {
+			return err
+		}
+		for _, key := range sortedSeriesKeys(family.series) {
+			if _, err := fmt.Fprintf(w, "%s{%s} %d\n", family.name, renderLabels(key), family.series[key]); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := fmt.Fprintln(w, "# EOF")
+	return err
+}
+
+func sortedSeriesKeys(series map[metricsSeriesKey]int) []metricsSeriesKey {
+	keys := make([]metricsSeriesKey, 0, len(series))
+	for key := range series {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].cluster != keys[j].cluster {
+			return keys[i].cluster < keys[j].cluster
+		}
+		if keys[i].group != keys[j].group {
+			return keys[i].group < keys[j].group
+		}
+		if keys[i].kind != keys[j].kind {
+			return keys[i].kind < keys[j].kind
+		}
+		return keys[i].phase < keys[j].phase
+	})
+	return keys
+}
+
+func renderLabels(key metricsSeriesKey) string {
+	labels := []string{
+		fmt.Sprintf("cluster=%q", key.cluster),
+		fmt.Sprintf("group=%q", key.group),
+		fmt.Sprintf("kind=%q", key.kind),
+	}
+	if key.phase != "" {
+		labels = append(labels, fmt.Sprintf("phase=%q", key.phase))
+	}
+	return strings.Join(labels, ",")
+}
+
+// printOpenMetrics renders all fetched objects as OpenMetrics families.
+func (g *CommandGetOptions) printOpenMetrics(objs []Obj) error {
+	inventory := newFleetInventory()
+	for ix := range objs {
+		inventory.add(objs[ix])
+	}
+	return inventory.render(g.Out)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/resource"
+
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+)
+
+func makeObj(cluster string, isControlPlane bool, gvk schema.GroupVersionKind, name, phase string, managed bool) Obj {
+	unstr := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": gvk.GroupVersion().String(),
+			"kind":       gvk.Kind,
+			"metadata": map[string]interface{}{
+				"name": name,
+			},
+		},
+	}
+	if phase != "" {
+		unstr.Object["status"] = map[string]interface{}{"phase": phase}
+	}
+	if managed {
+		unstr.SetLabels(map[string]string{karmadautil.ManagedByKarmadaLabel: karmadautil.ManagedByKarmadaLabelValue})
+	}
+	return Obj{
+		Cluster:        cluster,
+		IsControlPlane: isControlPlane,
+		Info: &resource.Info{
+			Object: unstr,
+			Mapping: &meta.RESTMapping{
+				GroupVersionKind: gvk,
+			},
+		},
+	}
+}
+
+func TestFleetInventoryRender(t *testing.T) {
+	podGVK := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	deployGVK := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+
+	inventory := newFleetInventory()
+	inventory.add(makeObj("member1", false, podGVK, "nginx-1", "Running", true))
+	inventory.add(makeObj("member1", false, podGVK, "nginx-2", "Pending", true))
+	inventory.add(makeObj("member2", false, podGVK, "nginx-3", "Running", false))
+	inventory.add(makeObj("Karmada", true, deployGVK, "nginx", "", false))
+
+	var builder strings.Builder
+	if err := inventory.render(&builder); err != nil {
+		t.Fatalf("unexpected error rendering inventory: %v", err)
+	}
+	got := builder.String()
+
+	expectedLines := []string{
+		`# TYPE karmada_fleet_resource gauge`,
+		`karmada_fleet_resource{cluster="Karmada",group="apps",kind="Deployment"} 1`,
+		`karmada_fleet_resource{cluster="member1",group="",kind="Pod"} 2`,
+		`karmada_fleet_resource{cluster="member2",group="",kind="Pod"} 1`,
+		`karmada_fleet_resource_managed{cluster="member1",group="",kind="Pod"} 2`,
+		`karmada_fleet_resource_phase{cluster="member1",group="",kind="Pod",phase="Pending"} 1`,
+		`karmada_fleet_resource_phase{cluster="member1",group="",kind="Pod",phase="Running"} 1`,
+		`karmada_fleet_resource_phase{cluster="member2",group="",kind="Pod",phase="Running"} 1`,
+	}
+	for _, line := range expectedLines {
+		if !strings.Contains(got, line) {
+			t.Errorf("expected output to contain %q, got:\n%s", line, got)
+		}
+	}
+
+	if !strings.HasSuffix(got, "# EOF\n") {
+		t.Errorf("expected output to end with the OpenMetrics EOF marker, got:\n%s", got)
+	}
+
+	if strings.Contains(got, `karmada_fleet_resource_managed{cluster="member2"`) {
+		t.Errorf("unmanaged resources must not be counted as managed, got:\n%s", got)
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/liggitt/tabwriter"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+// reconnectInterval is how often an unreachable member cluster is probed while
+// a watch session is active. It is a variable to keep tests fast.
+var reconnectInterval = 30 * time.Second
+
+// isTargetMemberCluster reports whether the given cluster is one of the member
+// clusters targeted by the command, as opposed to the control plane.
+func (g *CommandGetOptions) isTargetMemberCluster(cluster string) bool {
+	for _, target := range g.TargetMemberClusters {
+		if target == cluster {
+			return true
+		}
+	}
+	return false
+}
+
+// droppedMemberClusters returns the target member clusters that did not make it
+// into the watch session because they failed the initial access check.
+func (g *CommandGetOptions) droppedMemberClusters(watchObjs []WatchObj) []string {
+	watched := sets.New[string]()
+	for _, watchObj := range watchObjs {
+		watched.Insert(watchObj.Cluster)
+	}
+
+	var dropped []string
+	for _, cluster := range g.TargetMemberClusters {
+		if !watched.Has(cluster) {
+			dropped = append(dropped, cluster)
+		}
+	}
+	return dropped
+}
+
+// printReconnectMarker emits a synthetic marker line so that the event stream
+// shows when the watch stream of a member cluster was (re-)established.
+func (g *CommandGetOptions) printReconnectMarker(cluster string) {
+	fmt.Fprintf(g.Out, "---- cluster(%s): watch stream established, resuming events ----\n", cluster)
+}
+
+// reconnectCluster probes the given member cluster until it passes the access
+// check again, then returns a fresh watch result for it.
+func (g *CommandGetOptions) reconnectCluster(f util.Factory, cluster string, args []string) (WatchObj, error) {
+	for {
+		time.Sleep(reconnectInterval)
+
+		memberFactory, err := f.FactoryForMemberCluster(cluster)
+		if err != nil {
+			// not a connectivity problem, the member cluster config itself is broken
+			return WatchObj{}, err
+		}
+
+		var (
+			mux       sync.Mutex
+			objs      []Obj
+			watchObjs []WatchObj
+			errs      []error
+		)
+		// getObjInfo repeats the access check, a false return means the
+		// cluster is still unreachable and worth another attempt.
+		if !g.getObjInfo(&mux, memberFactory, cluster, false, &objs, &watchObjs, &errs, args) {
+			continue
+		}
+		return watchObjs[0], nil
+	}
+}
+
+// rewatchClusterObj keeps a member cluster in the watch session: it waits for
+// the cluster to become reachable, announces the recovery with a synthetic
+// marker line and resumes streaming until the stream breaks again.
+func (g *CommandGetOptions) rewatchClusterObj(f util.Factory, cluster string, args []string, mapping *meta.RESTMapping, outputObjects *bool, printer printers.ResourcePrinterFunc, writer *tabwriter.Writer) {
+	for {
+		watchObj, err := g.reconnectCluster(f, cluster, args)
+		if err != nil {
+			fmt.Fprintf(g.ErrOut, "stopped watching cluster(%s): %v\n", cluster, err)
+			return
+		}
+		g.printReconnectMarker(cluster)
+
+		err = g.watchClusterObj(watchObj, mapping, outputObjects, printer, writer)
+		if err == nil || wait.Interrupted(err) {
+			return
+		}
+	}
+}
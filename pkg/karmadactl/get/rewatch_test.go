@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+)
+
+func TestIsTargetMemberCluster(t *testing.T) {
+	g := NewCommandGetOptions(genericiooptions.NewTestIOStreamsDiscard())
+	g.TargetMemberClusters = []string{"member1", "member2"}
+
+	if !g.isTargetMemberCluster("member1") {
+		t.Error("isTargetMemberCluster(member1) = false, want true")
+	}
+	if g.isTargetMemberCluster("Karmada") {
+		t.Error("isTargetMemberCluster(Karmada) = true, want false")
+	}
+}
+
+func TestDroppedMemberClusters(t *testing.T) {
+	g := NewCommandGetOptions(genericiooptions.NewTestIOStreamsDiscard())
+	g.TargetMemberClusters = []string{"member1", "member2", "member3"}
+
+	// member2 failed the access check, the control plane and the other member
+	// clusters made it into the session
+	watchObjs := []WatchObj{{Cluster: "Karmada"}, {Cluster: "member1"}, {Cluster: "member3"}}
+	if got, want := g.droppedMemberClusters(watchObjs), []string{"member2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("droppedMemberClusters() = %v, want %v", got, want)
+	}
+
+	if got := g.droppedMemberClusters([]WatchObj{{Cluster: "member1"}, {Cluster: "member2"}, {Cluster: "member3"}}); got != nil {
+		t.Errorf("droppedMemberClusters() = %v, want nil when all clusters are watched", got)
+	}
+}
+
+func TestPrintReconnectMarker(t *testing.T) {
+	streams, _, out, _ := genericiooptions.NewTestIOStreams()
+	g := NewCommandGetOptions(streams)
+
+	g.printReconnectMarker("member1")
+	if marker := out.String(); !strings.Contains(marker, "cluster(member1)") || !strings.Contains(marker, "established") {
+		t.Errorf("printReconnectMarker() printed %q, want a marker line naming the cluster", marker)
+	}
+}
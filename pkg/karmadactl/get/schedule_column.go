@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// scheduleColumn is appended to workload tables when --show-schedule is set.
+var scheduleColumn = metav1.TableColumnDefinition{Name: "SCHEDULE", Type: "string", Priority: 1}
+
+// scheduledWorkloadResources are the workload resources the schedule column
+// is rendered for, they follow the binding naming convention.
+var scheduledWorkloadResources = sets.New("deployments", "statefulsets")
+
+// supportsScheduleColumn reports whether the schedule column applies to the
+// resource being printed.
+func supportsScheduleColumn(mapping *meta.RESTMapping) bool {
+	return mapping != nil && scheduledWorkloadResources.Has(mapping.Resource.Resource)
+}
+
+// appendScheduleColumn adds a SCHEDULE cell to every row, surfacing the
+// placement decision and the last reschedule reason from the associated
+// ResourceBinding in the control plane.
+func (g *CommandGetOptions) appendScheduleColumn(table *metav1.Table, mapping *meta.RESTMapping) {
+	table.ColumnDefinitions = append(table.ColumnDefinitions, scheduleColumn)
+
+	// the same workload shows up once per cluster, resolve each binding once
+	cache := map[string]string{}
+	for rowIdx := range table.Rows {
+		unObj := &unstructured.Unstructured{}
+		if err := unObj.UnmarshalJSON(table.Rows[rowIdx].Object.Raw); err != nil {
+			table.Rows[rowIdx].Cells = append(table.Rows[rowIdx].Cells, "<unknown>")
+			continue
+		}
+		bindingName := names.GenerateBindingName(mapping.GroupVersionKind.Kind, unObj.GetName())
+		cacheKey := unObj.GetNamespace() + "/" + bindingName
+		if _, exist := cache[cacheKey]; !exist {
+			cache[cacheKey] = g.scheduleCellValue(unObj.GetNamespace(), bindingName)
+		}
+		table.Rows[rowIdx].Cells = append(table.Rows[rowIdx].Cells, cache[cacheKey])
+	}
+}
+
+// scheduleCellValue summarizes the scheduler's decision for the binding, e.g.
+// "member1:2,member2:1 (Scheduled 5m ago)".
+func (g *CommandGetOptions) scheduleCellValue(namespace, bindingName string) string {
+	binding, err := g.KarmadaClient.WorkV1alpha2().ResourceBindings(namespace).Get(context.TODO(), bindingName, metav1.GetOptions{})
+	if err != nil {
+		return "<none>"
+	}
+	return formatScheduleCell(binding)
+}
+
+func formatScheduleCell(binding *workv1alpha2.ResourceBinding) string {
+	var placement []string
+	for _, cluster := range binding.Spec.Clusters {
+		if cluster.Replicas > 0 {
+			placement = append(placement, fmt.Sprintf("%s:%d", cluster.Name, cluster.Replicas))
+			continue
+		}
+		placement = append(placement, cluster.Name)
+	}
+	if len(placement) == 0 {
+		return "<pending>"
+	}
+
+	cell := strings.Join(placement, ",")
+	if history := binding.Status.SchedulingHistory; len(history) > 0 {
+		last := history[len(history)-1]
+		cell += fmt.Sprintf(" (%s %s ago)", last.Reason, duration.HumanDuration(time.Since(last.Timestamp.Time)))
+	}
+	return cell
+}
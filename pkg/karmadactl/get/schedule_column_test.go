@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+func TestFormatScheduleCell(t *testing.T) {
+	tests := []struct {
+		name    string
+		binding *workv1alpha2.ResourceBinding
+		want    string
+	}{
+		{
+			name:    "not scheduled yet",
+			binding: &workv1alpha2.ResourceBinding{},
+			want:    "<pending>",
+		},
+		{
+			name: "scheduled with replica assignment",
+			binding: &workv1alpha2.ResourceBinding{
+				Spec: workv1alpha2.ResourceBindingSpec{
+					Clusters: []workv1alpha2.TargetCluster{
+						{Name: "member1", Replicas: 2},
+						{Name: "member2", Replicas: 1},
+					},
+				},
+			},
+			want: "member1:2,member2:1",
+		},
+		{
+			name: "duplicated mode without replicas",
+			binding: &workv1alpha2.ResourceBinding{
+				Spec: workv1alpha2.ResourceBindingSpec{
+					Clusters: []workv1alpha2.TargetCluster{
+						{Name: "member1"},
+						{Name: "member2"},
+					},
+				},
+			},
+			want: "member1,member2",
+		},
+		{
+			name: "with reschedule reason",
+			binding: &workv1alpha2.ResourceBinding{
+				Spec: workv1alpha2.ResourceBindingSpec{
+					Clusters: []workv1alpha2.TargetCluster{
+						{Name: "member1", Replicas: 3},
+					},
+				},
+				Status: workv1alpha2.ResourceBindingStatus{
+					SchedulingHistory: []workv1alpha2.ScheduleRecord{
+						{Reason: "Descheduled", Timestamp: metav1.NewTime(time.Now().Add(-time.Hour))},
+						{Reason: "Scheduled", Timestamp: metav1.NewTime(time.Now().Add(-5 * time.Minute))},
+					},
+				},
+			},
+			want: "member1:3 (Scheduled 5m ago)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatScheduleCell(tt.binding); got != tt.want {
+				t.Errorf("formatScheduleCell() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSupportsScheduleColumn(t *testing.T) {
+	for resource, want := range map[string]bool{"deployments": true, "statefulsets": true, "pods": false} {
+		mapping := &meta.RESTMapping{Resource: schema.GroupVersionResource{Resource: resource}}
+		if got := supportsScheduleColumn(mapping); got != want {
+			t.Errorf("supportsScheduleColumn(%s) = %v, want %v", resource, got, want)
+		}
+	}
+	if supportsScheduleColumn(nil) {
+		t.Errorf("supportsScheduleColumn(nil) should be false")
+	}
+}
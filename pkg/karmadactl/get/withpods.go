@@ -0,0 +1,213 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// podIndent prefixes the rows of pods listed under their parent workload.
+const podIndent = "  "
+
+// withPodsColumns are the columns of the listing rendered when --with-pods is set.
+var withPodsColumns = []metav1.TableColumnDefinition{
+	{Name: "CLUSTER", Type: "string"},
+	{Name: "NAME", Type: "string"},
+	{Name: "READY", Type: "string"},
+	{Name: "STATUS", Type: "string"},
+	{Name: "AGE", Type: "string"},
+}
+
+// withPodsWorkloadResources are the workload resources whose pods are expanded
+// when --with-pods is set, they declare a pod label selector and follow the
+// binding naming convention.
+var withPodsWorkloadResources = sets.New("deployments", "statefulsets", "daemonsets", "replicasets", "jobs")
+
+// printObjsWithPods renders every listed workload followed by its pods in the
+// member clusters it is scheduled to, indented under the parent row.
+func (g *CommandGetOptions) printObjsWithPods(f util.Factory, objs []Obj) error {
+	table := &metav1.Table{ColumnDefinitions: withPodsColumns}
+	if g.AllNamespaces {
+		table.ColumnDefinitions = append([]metav1.TableColumnDefinition{{Name: "NAMESPACE", Type: "string"}}, withPodsColumns...)
+	}
+
+	var errs []error
+	memberClients := map[string]kubernetes.Interface{}
+	for ix := range objs {
+		unObj, ok := objs[ix].Info.Object.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("attempt to decode non-Unstructured object")
+		}
+		table.Rows = append(table.Rows, g.workloadTableRow(objs[ix], unObj))
+
+		if objs[ix].Info.Mapping == nil || !withPodsWorkloadResources.Has(objs[ix].Info.Mapping.Resource.Resource) {
+			continue
+		}
+		selector, err := workloadPodSelector(unObj)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to resolve the pod selector of %s: %v", unObj.GetName(), err))
+			continue
+		}
+		if selector == nil || selector.Empty() {
+			continue
+		}
+
+		for _, cluster := range g.podClusters(objs[ix], unObj) {
+			client, err := g.memberClient(f, memberClients, cluster)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("cluster(%s): %v", cluster, err))
+				continue
+			}
+			pods, err := client.CoreV1().Pods(unObj.GetNamespace()).List(context.TODO(), metav1.ListOptions{LabelSelector: selector.String()})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("cluster(%s): failed to list pods of %s: %v", cluster, unObj.GetName(), err))
+				continue
+			}
+			for podIx := range pods.Items {
+				table.Rows = append(table.Rows, g.podTableRow(cluster, &pods.Items[podIx]))
+			}
+		}
+	}
+
+	printer := printers.NewTablePrinter(printers.PrintOptions{NoHeaders: g.NoHeaders})
+	if err := printer.PrintObj(table, g.Out); err != nil {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// podClusters returns the clusters the pods of the workload live in: the
+// member cluster itself for a member scope object, and the clusters recorded
+// in the associated ResourceBinding for a control plane object.
+func (g *CommandGetOptions) podClusters(obj Obj, unObj *unstructured.Unstructured) []string {
+	if !obj.IsControlPlane {
+		return []string{obj.Cluster}
+	}
+
+	bindingName := names.GenerateBindingName(unObj.GetKind(), unObj.GetName())
+	binding, err := g.KarmadaClient.WorkV1alpha2().ResourceBindings(unObj.GetNamespace()).Get(context.TODO(), bindingName, metav1.GetOptions{})
+	if err != nil {
+		return nil
+	}
+	var clusters []string
+	for _, cluster := range binding.Spec.Clusters {
+		clusters = append(clusters, cluster.Name)
+	}
+	return clusters
+}
+
+// memberClient returns a client for the member cluster going through the
+// proxy, clients are cached since several workloads often share a cluster.
+func (g *CommandGetOptions) memberClient(f util.Factory, cache map[string]kubernetes.Interface, cluster string) (kubernetes.Interface, error) {
+	if client, exist := cache[cluster]; exist {
+		return client, nil
+	}
+	memberFactory, err := f.FactoryForMemberCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	client, err := memberFactory.KubernetesClientSet()
+	if err != nil {
+		return nil, err
+	}
+	cache[cluster] = client
+	return client, nil
+}
+
+// workloadTableRow builds the parent row of a listed workload.
+func (g *CommandGetOptions) workloadTableRow(obj Obj, unObj *unstructured.Unstructured) metav1.TableRow {
+	name := strings.ToLower(unObj.GetKind()) + "/" + unObj.GetName()
+	cells := []interface{}{obj.Cluster, name, workloadReadyCell(unObj), "-", ageCell(unObj.GetCreationTimestamp())}
+	if g.AllNamespaces {
+		cells = append([]interface{}{unObj.GetNamespace()}, cells...)
+	}
+	return metav1.TableRow{Cells: cells}
+}
+
+// podTableRow builds the indented row of a pod belonging to the workload
+// printed right above it.
+func (g *CommandGetOptions) podTableRow(cluster string, pod *corev1.Pod) metav1.TableRow {
+	ready := 0
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.Ready {
+			ready++
+		}
+	}
+	status := string(pod.Status.Phase)
+	if pod.DeletionTimestamp != nil {
+		status = "Terminating"
+	}
+	cells := []interface{}{cluster, podIndent + "pod/" + pod.Name, fmt.Sprintf("%d/%d", ready, len(pod.Spec.Containers)), status, ageCell(pod.CreationTimestamp)}
+	if g.AllNamespaces {
+		cells = append([]interface{}{pod.Namespace}, cells...)
+	}
+	return metav1.TableRow{Cells: cells}
+}
+
+// workloadPodSelector extracts the pod label selector declared by the
+// workload.
+func workloadPodSelector(unObj *unstructured.Unstructured) (labels.Selector, error) {
+	selectorMap, found, err := unstructured.NestedMap(unObj.Object, "spec", "selector")
+	if err != nil || !found {
+		return nil, err
+	}
+	labelSelector := &metav1.LabelSelector{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(selectorMap, labelSelector); err != nil {
+		return nil, err
+	}
+	return metav1.LabelSelectorAsSelector(labelSelector)
+}
+
+// workloadReadyCell summarizes the replica readiness of the workload,
+// "<ready>/<desired>" when both are declared.
+func workloadReadyCell(unObj *unstructured.Unstructured) string {
+	desired, foundDesired, _ := unstructured.NestedInt64(unObj.Object, "spec", "replicas")
+	ready, _, _ := unstructured.NestedInt64(unObj.Object, "status", "readyReplicas")
+	if !foundDesired {
+		// DaemonSets declare their numbers in status only.
+		desired, foundDesired, _ = unstructured.NestedInt64(unObj.Object, "status", "desiredNumberScheduled")
+		ready, _, _ = unstructured.NestedInt64(unObj.Object, "status", "numberReady")
+	}
+	if !foundDesired {
+		return "-"
+	}
+	return fmt.Sprintf("%d/%d", ready, desired)
+}
+
+func ageCell(timestamp metav1.Time) string {
+	if timestamp.IsZero() {
+		return "<unknown>"
+	}
+	return duration.HumanDuration(time.Since(timestamp.Time))
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestWorkloadPodSelector(t *testing.T) {
+	tests := []struct {
+		name         string
+		obj          map[string]interface{}
+		wantSelector string
+		wantNil      bool
+	}{
+		{
+			name: "match labels",
+			obj: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"selector": map[string]interface{}{
+						"matchLabels": map[string]interface{}{"app": "nginx"},
+					},
+				},
+			},
+			wantSelector: "app=nginx",
+		},
+		{
+			name: "match expressions",
+			obj: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"selector": map[string]interface{}{
+						"matchExpressions": []interface{}{
+							map[string]interface{}{
+								"key":      "app",
+								"operator": "In",
+								"values":   []interface{}{"nginx"},
+							},
+						},
+					},
+				},
+			},
+			wantSelector: "app in (nginx)",
+		},
+		{
+			name:    "no selector declared",
+			obj:     map[string]interface{}{"spec": map[string]interface{}{}},
+			wantNil: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := workloadPodSelector(&unstructured.Unstructured{Object: tt.obj})
+			if err != nil {
+				t.Fatalf("workloadPodSelector() unexpected error: %v", err)
+			}
+			if tt.wantNil {
+				if selector != nil {
+					t.Fatalf("workloadPodSelector() = %v, want nil", selector)
+				}
+				return
+			}
+			if selector.String() != tt.wantSelector {
+				t.Errorf("workloadPodSelector() = %q, want %q", selector.String(), tt.wantSelector)
+			}
+		})
+	}
+}
+
+func TestWorkloadReadyCell(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  map[string]interface{}
+		want string
+	}{
+		{
+			name: "replicas declared",
+			obj: map[string]interface{}{
+				"spec":   map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{"readyReplicas": int64(2)},
+			},
+			want: "2/3",
+		},
+		{
+			name: "daemonset numbers",
+			obj: map[string]interface{}{
+				"status": map[string]interface{}{
+					"desiredNumberScheduled": int64(4),
+					"numberReady":            int64(4),
+				},
+			},
+			want: "4/4",
+		},
+		{
+			name: "no replicas declared",
+			obj:  map[string]interface{}{"spec": map[string]interface{}{}},
+			want: "-",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := workloadReadyCell(&unstructured.Unstructured{Object: tt.obj}); got != tt.want {
+				t.Errorf("workloadReadyCell() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPodTableRow(t *testing.T) {
+	now := metav1.Now()
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx-0"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "nginx"}, {Name: "sidecar"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "nginx", Ready: true},
+				{Name: "sidecar", Ready: false},
+			},
+		},
+	}
+
+	g := &CommandGetOptions{}
+	row := g.podTableRow("member1", pod)
+	if len(row.Cells) != len(withPodsColumns) {
+		t.Fatalf("podTableRow() returned %d cells, want %d", len(row.Cells), len(withPodsColumns))
+	}
+	if row.Cells[0] != "member1" {
+		t.Errorf("cluster cell = %v, want member1", row.Cells[0])
+	}
+	if row.Cells[1] != podIndent+"pod/nginx-0" {
+		t.Errorf("name cell = %v, want indented pod/nginx-0", row.Cells[1])
+	}
+	if row.Cells[2] != "1/2" {
+		t.Errorf("ready cell = %v, want 1/2", row.Cells[2])
+	}
+	if row.Cells[3] != "Running" {
+		t.Errorf("status cell = %v, want Running", row.Cells[3])
+	}
+
+	pod.DeletionTimestamp = &now
+	row = g.podTableRow("member1", pod)
+	if row.Cells[3] != "Terminating" {
+		t.Errorf("status cell = %v, want Terminating", row.Cells[3])
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interpret
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/cli-runtime/pkg/printers"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative"
+)
+
+// operationAll selects every interpreter operation instead of a single one.
+const operationAll = "all"
+
+// Coverage statuses per interpreter operation.
+const (
+	coverageImplemented = "IMPLEMENTED"
+	coverageDefaulted   = "DEFAULTED"
+	coverageError       = "ERROR"
+)
+
+// runCoverage executes every interpreter operation against the given resources
+// and reports which ones the customization implements, which fall back to the
+// default interpreter and which error.
+func (o *Options) runCoverage() error {
+	customizations, err := o.getCustomizationObject()
+	if err != nil {
+		return fmt.Errorf("fail to get customization object: %v", err)
+	}
+
+	args, err := o.getRuleArgs()
+	if err != nil {
+		return err
+	}
+
+	configurableInterpreter := declarative.NewConfigurableInterpreter(nil)
+	configurableInterpreter.LoadConfig(customizations)
+
+	w := printers.GetNewTabWriter(o.Out)
+	fmt.Fprintln(w, "OPERATION\tSTATUS\tDETAIL")
+
+	var implemented, defaulted, errored int
+	for _, r := range o.Rules {
+		if !ruleEnabled(r, customizations) {
+			defaulted++
+			fmt.Fprintf(w, "%s\t%s\t%s\n", r.Name(), coverageDefaulted, "no script declared, the default interpreter applies")
+			continue
+		}
+
+		result := r.Run(configurableInterpreter, ruleRunArgs(r, args))
+		if result.Err != nil {
+			errored++
+			fmt.Fprintf(w, "%s\t%s\t%s\n", r.Name(), coverageError, strings.TrimSpace(result.Err.Error()))
+			continue
+		}
+		implemented++
+		fmt.Fprintf(w, "%s\t%s\t\n", r.Name(), coverageImplemented)
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "\n%d implemented, %d defaulted, %d errored\n", implemented, defaulted, errored)
+	if errored > 0 {
+		// The failing operations are listed above. So don't print them again.
+		return cmdutil.ErrExit
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interpret
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/resource"
+
+	cmdtesting "github.com/karmada-io/karmada/pkg/karmadactl/util/testing"
+	"github.com/karmada-io/karmada/pkg/util/interpreter"
+)
+
+const partialCustomization = `apiVersion: config.karmada.io/v1alpha1
+kind: ResourceInterpreterCustomization
+metadata:
+  name: partial
+spec:
+  target:
+    apiVersion: apps/v1
+    kind: Deployment
+  customizations:
+    retention:
+      luaScript: >
+        function Retain(desiredObj, runtimeObj)
+          return desiredObj
+        end
+`
+
+func TestOptions_runCoverage(t *testing.T) {
+	partialFile := filepath.Join(t.TempDir(), "partial.yml")
+	if err := os.WriteFile(partialFile, []byte(partialCustomization), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		options      *Options
+		wantErr      bool
+		wantContains []string
+	}{
+		{
+			name: "all operations implemented",
+			options: &Options{
+				FilenameOptions: resource.FilenameOptions{Filenames: []string{"./testdata/customization.yml"}},
+				Operation:       operationAll,
+				Coverage:        true,
+				DesiredFile:     "./testdata/desired.yml",
+				ObservedFile:    "./testdata/observed.yml",
+				StatusFile:      "./testdata/status.yml",
+				Rules:           interpreter.AllResourceInterpreterCustomizationRules,
+			},
+			wantContains: []string{
+				"Retain", coverageImplemented,
+				"7 implemented, 0 defaulted, 0 errored",
+			},
+		},
+		{
+			name: "defaulted and erroring operations",
+			options: &Options{
+				FilenameOptions: resource.FilenameOptions{Filenames: []string{partialFile}},
+				Operation:       operationAll,
+				Coverage:        true,
+				// The retention rule needs the desired object as well, so it
+				// must be reported as erroring.
+				ObservedFile: "./testdata/observed.yml",
+				Rules:        interpreter.AllResourceInterpreterCustomizationRules,
+			},
+			wantErr: true,
+			wantContains: []string{
+				coverageError,
+				coverageDefaulted,
+				"0 implemented, 6 defaulted, 1 errored",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tf := cmdtesting.NewTestFactory()
+			defer tf.Cleanup()
+
+			streams, _, buf, _ := genericiooptions.NewTestIOStreams()
+			tt.options.IOStreams = streams
+			if err := tt.options.Complete(tf, nil, nil); err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.options.Validate(); err != nil {
+				t.Fatal(err)
+			}
+
+			err := tt.options.Run()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runCoverage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("runCoverage() output misses %q:\n%s", want, buf.String())
+				}
+			}
+		})
+	}
+}
@@ -74,39 +74,46 @@ func (o *Options) runExecute() error {
 		return fmt.Errorf("fail to get customization object: %v", err)
 	}
 
+	args, err := o.getRuleArgs()
+	if err != nil {
+		return err
+	}
+
+	configurableInterpreter := declarative.NewConfigurableInterpreter(nil)
+	configurableInterpreter.LoadConfig(customizations)
+
+	r := o.Rules.GetByOperation(o.Operation)
+	if r == nil {
+		// Shall never occur, because we validate it before.
+		return fmt.Errorf("operation %s is not supported. Use one of: %s", o.Operation, strings.Join(o.Rules.Names(), ", "))
+	}
+	result := r.Run(configurableInterpreter, args)
+	printExecuteResult(o.Out, o.ErrOut, r.Name(), result)
+	return nil
+}
+
+func (o *Options) getRuleArgs() (interpreter.RuleArgs, error) {
 	desired, err := getUnstructuredObjectFromResult(o.DesiredResult)
 	if err != nil {
-		return fmt.Errorf("fail to get desired object: %v", err)
+		return interpreter.RuleArgs{}, fmt.Errorf("fail to get desired object: %v", err)
 	}
 
 	observed, err := getUnstructuredObjectFromResult(o.ObservedResult)
 	if err != nil {
-		return fmt.Errorf("fail to get observed object: %v", err)
+		return interpreter.RuleArgs{}, fmt.Errorf("fail to get observed object: %v", err)
 	}
 
 	status, err := o.getAggregatedStatusItems()
 	if err != nil {
-		return fmt.Errorf("fail to get status items: %v", err)
+		return interpreter.RuleArgs{}, fmt.Errorf("fail to get status items: %v", err)
 	}
 
-	args := interpreter.RuleArgs{
+	return interpreter.RuleArgs{
 		Desired:  desired,
 		Observed: observed,
 		Status:   status,
 		Replica:  int64(o.DesiredReplica),
-	}
-
-	configurableInterpreter := declarative.NewConfigurableInterpreter(nil)
-	configurableInterpreter.LoadConfig(customizations)
-
-	r := o.Rules.GetByOperation(o.Operation)
-	if r == nil {
-		// Shall never occur, because we validate it before.
-		return fmt.Errorf("operation %s is not supported. Use one of: %s", o.Operation, strings.Join(o.Rules.Names(), ", "))
-	}
-	result := r.Run(configurableInterpreter, args)
-	printExecuteResult(o.Out, o.ErrOut, r.Name(), result)
-	return nil
+	}, nil
 }
 
 func printExecuteResult(w, errOut io.Writer, name string, result *interpreter.RuleResult) {
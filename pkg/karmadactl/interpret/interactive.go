@@ -0,0 +1,237 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interpret
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative"
+	"github.com/karmada-io/karmada/pkg/util/interpreter"
+)
+
+const interactivePrompt = "interpret> "
+
+// ANSI escapes used to color the output diff of re-executed rules.
+const (
+	ansiRed   = "\x1b[31m"
+	ansiGreen = "\x1b[32m"
+	ansiReset = "\x1b[0m"
+)
+
+const interactiveHelp = `Commands:
+  run, <enter>  reload the customization file and re-execute all enabled rules
+  show          print the full output of the last execution
+  help          show this help
+  exit, quit    leave the interactive mode
+`
+
+// runInteractive drives a REPL for developing interpreter customizations:
+// every time the user hits enter, the customization file is reloaded, all
+// enabled rules are re-executed against the given resources, and the diff of
+// the outputs since the last execution is printed.
+func (o *Options) runInteractive() error {
+	args, err := o.getRuleArgs()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(o.Out, "Entering interactive mode. Edit the customization in another window, then press enter to re-execute all enabled rules. Type 'help' for commands.")
+
+	outputs, err := o.executeAllRules(args)
+	if err != nil {
+		fmt.Fprintf(o.ErrOut, "%v\n", err)
+	} else {
+		o.printAllOutputs(outputs)
+	}
+
+	scanner := bufio.NewScanner(o.In)
+	for {
+		fmt.Fprint(o.Out, interactivePrompt)
+		if !scanner.Scan() {
+			// The input is closed, e.g. by ctrl-d.
+			fmt.Fprintln(o.Out)
+			break
+		}
+
+		switch command := strings.TrimSpace(scanner.Text()); command {
+		case "", "run":
+			current, err := o.executeAllRules(args)
+			if err != nil {
+				fmt.Fprintf(o.ErrOut, "%v\n", err)
+				continue
+			}
+			o.printChangedOutputs(outputs, current)
+			outputs = current
+		case "show":
+			o.printAllOutputs(outputs)
+		case "help":
+			fmt.Fprint(o.Out, interactiveHelp)
+		case "exit", "quit":
+			return nil
+		default:
+			fmt.Fprintf(o.ErrOut, "unknown command %q, type 'help' for available commands\n", command)
+		}
+	}
+	return scanner.Err()
+}
+
+// executeAllRules reloads the customizations from their source and executes
+// every rule enabled by them, returning the rendered output per rule name.
+func (o *Options) executeAllRules(args interpreter.RuleArgs) (map[string]string, error) {
+	if o.rebuildCustomizationResult != nil {
+		o.CustomizationResult = o.rebuildCustomizationResult()
+	}
+	customizations, err := o.getCustomizationObject()
+	if err != nil {
+		return nil, fmt.Errorf("fail to get customization object: %v", err)
+	}
+
+	configurableInterpreter := declarative.NewConfigurableInterpreter(nil)
+	configurableInterpreter.LoadConfig(customizations)
+
+	outputs := make(map[string]string, len(o.Rules))
+	for _, r := range o.Rules {
+		if !ruleEnabled(r, customizations) {
+			continue
+		}
+		buf := &bytes.Buffer{}
+		printExecuteResult(buf, buf, r.Name(), r.Run(configurableInterpreter, ruleRunArgs(r, args)))
+		outputs[r.Name()] = buf.String()
+	}
+	return outputs, nil
+}
+
+// ruleRunArgs adapts the shared args for a single rule. Only the retention
+// rule consumes both the desired and the observed object. The other rules
+// interpret a single object and refuse ambiguous input, so hand them just the
+// observed object when both are given.
+func ruleRunArgs(r interpreter.Rule, args interpreter.RuleArgs) interpreter.RuleArgs {
+	if r.Name() != string(configv1alpha1.InterpreterOperationRetain) && args.Desired != nil && args.Observed != nil {
+		args.Desired = nil
+	}
+	return args
+}
+
+func ruleEnabled(r interpreter.Rule, customizations []*configv1alpha1.ResourceInterpreterCustomization) bool {
+	for _, c := range customizations {
+		if r.GetScript(c) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *Options) printAllOutputs(outputs map[string]string) {
+	if len(outputs) == 0 {
+		fmt.Fprintln(o.Out, "No rule is enabled in the customization.")
+		return
+	}
+	for _, r := range o.Rules {
+		output, ok := outputs[r.Name()]
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(o.Out, "RULE %s:\n%s", r.Name(), output)
+	}
+}
+
+func (o *Options) printChangedOutputs(previous, current map[string]string) {
+	changed := false
+	for _, r := range o.Rules {
+		name := r.Name()
+		before := previous[name]
+		after := current[name]
+		if before == after {
+			continue
+		}
+		changed = true
+		fmt.Fprintf(o.Out, "RULE %s:\n", name)
+		for _, line := range diffLines(before, after, true) {
+			fmt.Fprintln(o.Out, line)
+		}
+	}
+	if !changed {
+		fmt.Fprintln(o.Out, "No output changed.")
+	}
+}
+
+// diffLines returns a line based diff between before and after. Removed lines
+// are prefixed with "-", added lines with "+" and common lines with a space.
+// When color is set, removed and added lines are colored red and green.
+func diffLines(before, after string, color bool) []string {
+	a := splitLines(before)
+	b := splitLines(after)
+
+	// The longest common subsequence of the two line sets.
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var lines []string
+	emit := func(prefix, line, ansiColor string) {
+		if color && ansiColor != "" {
+			lines = append(lines, ansiColor+prefix+line+ansiReset)
+			return
+		}
+		lines = append(lines, prefix+line)
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			emit(" ", a[i], "")
+			i, j = i+1, j+1
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			emit("-", a[i], ansiRed)
+			i++
+		default:
+			emit("+", b[j], ansiGreen)
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		emit("-", a[i], ansiRed)
+	}
+	for ; j < len(b); j++ {
+		emit("+", b[j], ansiGreen)
+	}
+	return lines
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interpret
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/resource"
+
+	cmdtesting "github.com/karmada-io/karmada/pkg/karmadactl/util/testing"
+	"github.com/karmada-io/karmada/pkg/util/interpreter"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name   string
+		before string
+		after  string
+		color  bool
+		want   []string
+	}{
+		{
+			name:   "identical outputs",
+			before: "a\nb\n",
+			after:  "a\nb\n",
+			want:   []string{" a", " b"},
+		},
+		{
+			name:   "changed line",
+			before: "a\nb\nc\n",
+			after:  "a\nx\nc\n",
+			want:   []string{" a", "-b", "+x", " c"},
+		},
+		{
+			name:  "newly enabled rule",
+			after: "a\n",
+			want:  []string{"+a"},
+		},
+		{
+			name:   "colored diff",
+			before: "a\n",
+			after:  "b\n",
+			color:  true,
+			want:   []string{ansiRed + "-a" + ansiReset, ansiGreen + "+b" + ansiReset},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffLines(tt.before, tt.after, tt.color)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// scriptedInput delivers one command per read and runs the associated side
+// effect (such as editing the customization file) just before doing so.
+type scriptedInput struct {
+	steps   []func() string
+	pending []byte
+}
+
+func (s *scriptedInput) Read(p []byte) (int, error) {
+	if len(s.pending) == 0 {
+		if len(s.steps) == 0 {
+			return 0, io.EOF
+		}
+		s.pending = []byte(s.steps[0]())
+		s.steps = s.steps[1:]
+	}
+	n := copy(p, s.pending)
+	s.pending = s.pending[n:]
+	return n, nil
+}
+
+func TestOptions_runInteractive(t *testing.T) {
+	original, err := os.ReadFile("./testdata/customization.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	customizationFile := filepath.Join(t.TempDir(), "customization.yml")
+	if err = os.WriteFile(customizationFile, original, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	input := &scriptedInput{steps: []func() string{
+		// Re-execute without editing the file: nothing may change.
+		func() string { return "run\n" },
+		// Edit the retention script, then re-execute: only the retain rule
+		// may be reported, with a colored diff of its output.
+		func() string {
+			edited := strings.Replace(string(original),
+				"desiredObj.metadata.annotations.cluster = runtimeObj.metadata.annotations.cluster",
+				`desiredObj.metadata.annotations.cluster = "edited"`, 1)
+			if err := os.WriteFile(customizationFile, []byte(edited), 0600); err != nil {
+				t.Error(err)
+			}
+			return "run\n"
+		},
+		func() string { return "exit\n" },
+	}}
+
+	streams, _, buf, _ := genericiooptions.NewTestIOStreams()
+	streams.In = input
+	options := &Options{
+		FilenameOptions: resource.FilenameOptions{Filenames: []string{customizationFile}},
+		Interactive:     true,
+		DesiredFile:     "./testdata/desired.yml",
+		ObservedFile:    "./testdata/observed.yml",
+		StatusFile:      "./testdata/status.yml",
+		Rules:           interpreter.AllResourceInterpreterCustomizationRules,
+		IOStreams:       streams,
+	}
+
+	tf := cmdtesting.NewTestFactory()
+	defer tf.Cleanup()
+	if err = options.Complete(tf, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = options.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if err = options.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	got := buf.String()
+	if !strings.Contains(got, "RULE Retain:") {
+		t.Errorf("initial execution must print the retain rule output:\n%s", got)
+	}
+	if !strings.Contains(got, "No output changed.") {
+		t.Errorf("re-execution without edits must report no change:\n%s", got)
+	}
+	if !strings.Contains(got, ansiRed+"-        cluster: cluster1"+ansiReset) ||
+		!strings.Contains(got, ansiGreen+"+        cluster: edited"+ansiReset) {
+		t.Errorf("edited retention script must yield a colored diff:\n%s", got)
+	}
+}
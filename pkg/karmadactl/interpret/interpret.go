@@ -83,6 +83,14 @@ var (
 
 		# Edit customization
 		%[1]s interpret -f customization.yml --edit
+
+		# Develop interactively: re-execute all enabled rules and show a diff of
+		# the outputs every time the customization file is edited
+		%[1]s interpret -f customization.yml --interactive --desired-file desired.yml --observed-file observed.yml
+
+		# Report which operations the customization implements, which fall back
+		# to the default interpreter and which error
+		%[1]s interpret -f customization.yml --operation all --coverage --desired-file desired.yml --observed-file observed.yml --status-file status.yml
 	`)
 )
 
@@ -124,6 +132,8 @@ func NewCmdInterpret(f util.Factory, parentCommand string, streams genericioopti
 	flags.StringVar(&o.Operation, "operation", o.Operation, "The interpret operation to use. One of: ("+strings.Join(o.Rules.Names(), ",")+")")
 	flags.BoolVar(&o.Check, "check", false, "Validates the given ResourceInterpreterCustomization configuration(s)")
 	flags.BoolVar(&o.Edit, "edit", false, "Edit customizations")
+	flags.BoolVar(&o.Interactive, "interactive", false, "Start a REPL that re-executes all enabled rules and shows a diff of the outputs after each edit of the customization file")
+	flags.BoolVar(&o.Coverage, "coverage", false, "Report for every interpreter operation whether the customization implements it, falls back to the default interpreter, or errors. Requires --operation all")
 	flags.BoolVar(&o.ShowDoc, "show-doc", false, "Show document of rules when editing")
 	flags.StringVar(&o.DesiredFile, "desired-file", o.DesiredFile, "Filename, directory, or URL to files identifying the resource to use as desiredObj argument in rule script.")
 	flags.StringVar(&o.ObservedFile, "observed-file", o.ObservedFile, "Filename, directory, or URL to files identifying the resource to use as observedObj argument in rule script.")
@@ -141,10 +151,12 @@ type Options struct {
 	resource.FilenameOptions
 	*editor.EditOptions
 
-	Operation string
-	Check     bool
-	Edit      bool
-	ShowDoc   bool
+	Operation   string
+	Check       bool
+	Edit        bool
+	Interactive bool
+	Coverage    bool
+	ShowDoc     bool
 
 	// args
 	DesiredFile    string
@@ -153,9 +165,13 @@ type Options struct {
 	DesiredReplica int32
 
 	CustomizationResult *resource.Result
-	DesiredResult       *resource.Result
-	ObservedResult      *resource.Result
-	StatusResult        *genericresource.Result
+	// rebuildCustomizationResult reloads the customizations from their source,
+	// allowing interactive mode to pick up edits of the file.
+	rebuildCustomizationResult func() *resource.Result
+
+	DesiredResult  *resource.Result
+	ObservedResult *resource.Result
+	StatusResult   *genericresource.Result
 
 	Rules interpreter.Rules
 
@@ -164,18 +180,28 @@ type Options struct {
 
 // Complete ensures that options are valid and marshals them if necessary
 func (o *Options) Complete(f util.Factory, _ *cobra.Command, args []string) error {
-	if o.Check && o.Edit {
-		return fmt.Errorf("you can't set both --check and --edit options")
+	modes := 0
+	for _, mode := range []bool{o.Check, o.Edit, o.Interactive, o.Coverage} {
+		if mode {
+			modes++
+		}
+	}
+	if modes > 1 {
+		return fmt.Errorf("you can only set one of --check, --edit, --interactive and --coverage options")
 	}
 
 	scheme := gclient.NewSchema()
-	o.CustomizationResult = f.NewBuilder().
-		WithScheme(scheme, scheme.PrioritizedVersionsAllGroups()...).
-		FilenameParam(false, &o.FilenameOptions).
-		ResourceNames(customizationResourceName, args...).
-		RequireObject(true).
-		Local().
-		Do()
+	buildCustomizationResult := func() *resource.Result {
+		return f.NewBuilder().
+			WithScheme(scheme, scheme.PrioritizedVersionsAllGroups()...).
+			FilenameParam(false, &o.FilenameOptions).
+			ResourceNames(customizationResourceName, args...).
+			RequireObject(true).
+			Local().
+			Do()
+	}
+	o.CustomizationResult = buildCustomizationResult()
+	o.rebuildCustomizationResult = buildCustomizationResult
 
 	var errs []error
 	errs = append(errs, o.CustomizationResult.Err())
@@ -186,7 +212,10 @@ func (o *Options) Complete(f util.Factory, _ *cobra.Command, args []string) erro
 
 // Validate validates Options.
 func (o *Options) Validate() error {
-	if o.Operation != "" {
+	if o.Coverage && !strings.EqualFold(o.Operation, operationAll) {
+		return fmt.Errorf("--coverage requires --operation %s", operationAll)
+	}
+	if o.Operation != "" && !o.Coverage {
 		r := o.Rules.GetByOperation(o.Operation)
 		if r == nil {
 			return fmt.Errorf("operation %s is not supported. Use one of: %s", o.Operation, strings.Join(o.Rules.Names(), ", "))
@@ -208,6 +237,10 @@ func (o *Options) Run() error {
 		return o.runCheck()
 	case o.Edit:
 		return o.runEdit()
+	case o.Interactive:
+		return o.runInteractive()
+	case o.Coverage:
+		return o.runCoverage()
 	default:
 		return o.runExecute()
 	}
@@ -34,8 +34,11 @@ import (
 	"github.com/karmada-io/karmada/pkg/karmadactl/options"
 	cmdutil "github.com/karmada-io/karmada/pkg/karmadactl/util"
 	"github.com/karmada-io/karmada/pkg/karmadactl/util/apiclient"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util/clustercache"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util/cmdresult"
 	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
 	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/lifted"
 	"github.com/karmada-io/karmada/pkg/util/names"
 )
 
@@ -45,7 +48,10 @@ var (
 
 	joinExample = templates.Examples(`
 		# Join cluster into karmada control plane, if '--cluster-context' not specified, take the cluster name as the context
-		%[1]s join CLUSTER_NAME --cluster-kubeconfig=<KUBECONFIG>`)
+		%[1]s join CLUSTER_NAME --cluster-kubeconfig=<KUBECONFIG>
+
+		# Join a cluster behind NAT, deploying a reverse tunnel agent and registering the tunnel URL
+		%[1]s join CLUSTER_NAME --cluster-kubeconfig=<KUBECONFIG> --tunnel-server-address=tunnel.example.com:8091 --tunnel-url=https://tunnel.example.com:8443`)
 )
 
 // NewCmdJoin defines the `join` command that registers a cluster.
@@ -59,17 +65,21 @@ func NewCmdJoin(f cmdutil.Factory, parentCommand string) *cobra.Command {
 		Example:               fmt.Sprintf(joinExample, parentCommand),
 		SilenceUsage:          true,
 		DisableFlagsInUseLine: true,
-		RunE: func(_ *cobra.Command, args []string) error {
-			if err := opts.Complete(args); err != nil {
-				return err
-			}
-			if err := opts.Validate(args); err != nil {
-				return err
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Output == cmdresult.OutputFormatJSON {
+				// the JSON envelope already carries the error detail
+				cmd.SilenceErrors = true
 			}
-			if err := opts.Run(f); err != nil {
-				return err
-			}
-			return nil
+			runErr := func() error {
+				if err := opts.Complete(args); err != nil {
+					return err
+				}
+				if err := opts.Validate(args); err != nil {
+					return cmdresult.NewError(cmdresult.ValidationFailed, err)
+				}
+				return opts.Run(f)
+			}()
+			return cmdresult.Print(cmd.OutOrStdout(), opts.Output, "join", runErr)
 		},
 		Annotations: map[string]string{
 			cmdutil.TagCommandGroup: cmdutil.GroupClusterRegistration,
@@ -111,6 +121,30 @@ type CommandJoinOption struct {
 	// more details about running Kubernetes in multiple zones.
 	ClusterZones []string
 
+	// ClusterLabels is the labels to be written to the Cluster object.
+	ClusterLabels map[string]string
+
+	// ClusterTaints is the taints to be written to the Cluster object, in the
+	// form 'key=value:Effect'.
+	ClusterTaints []string
+
+	// TunnelServerAddress is the "host:port" of the tunnel(konnectivity) server
+	// the reverse tunnel agent in the member cluster dials out to. When set,
+	// the agent is deployed during join so clusters behind NAT can be
+	// push-managed without a routable apiserver endpoint.
+	TunnelServerAddress string
+
+	// TunnelURL is the proxy URL the control plane uses to reach the member
+	// apiserver through the tunnel, registered as the cluster's proxy URL.
+	TunnelURL string
+
+	// TunnelAgentImage is the image of the reverse tunnel agent.
+	TunnelAgentImage string
+
+	// TunnelNamespace is the namespace in the member cluster where the reverse
+	// tunnel agent is deployed.
+	TunnelNamespace string
+
 	// KarmadaAs represents the username to impersonate for the operation in karmada control plane. User could be a regular user or a service account in a namespace
 	KarmadaAs string
 
@@ -122,6 +156,10 @@ type CommandJoinOption struct {
 
 	// DryRun tells if run the command in dry-run mode, without making any server requests.
 	DryRun bool
+
+	// Output is the output format of the command result, either empty for
+	// human-readable output or "json" for a machine-readable result envelope.
+	Output string
 }
 
 // Complete ensures that options are valid and marshals them if necessary.
@@ -145,6 +183,13 @@ func (j *CommandJoinOption) Validate(args []string) error {
 	if errMsgs := validation.ValidateClusterName(j.ClusterName); len(errMsgs) != 0 {
 		return fmt.Errorf("invalid cluster name(%s): %s", j.ClusterName, strings.Join(errMsgs, ";"))
 	}
+	if len(j.Output) != 0 && j.Output != cmdresult.OutputFormatJSON {
+		return fmt.Errorf("invalid output format %q, only %q is supported", j.Output, cmdresult.OutputFormatJSON)
+	}
+
+	if j.TunnelServerAddress != "" && j.TunnelURL == "" {
+		return errors.New("--tunnel-url is required when --tunnel-server-address is set, otherwise the control plane cannot reach the cluster")
+	}
 
 	if j.ClusterNamespace == names.NamespaceKarmadaSystem {
 		klog.Warningf("karmada-system is always reserved for Karmada control plane. We do not recommend using karmada-system to store secrets of member clusters. It may cause mistaken cleanup of resources.")
@@ -163,6 +208,12 @@ func (j *CommandJoinOption) AddFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&j.ClusterProvider, "cluster-provider", "", "Provider of the joining cluster. The Karmada scheduler can use this information to spread workloads across providers for higher availability.")
 	flags.StringVar(&j.ClusterRegion, "cluster-region", "", "The region of the joining cluster. The Karmada scheduler can use this information to spread workloads across regions for higher availability.")
 	flags.StringSliceVar(&j.ClusterZones, "cluster-zones", nil, "The zones of the joining cluster. The Karmada scheduler can use this information to spread workloads across zones for higher availability.")
+	flags.StringToStringVar(&j.ClusterLabels, "cluster-labels", nil, "Labels to be written to the Cluster object, e.g. 'env=prod,tier=frontend'.")
+	flags.StringSliceVar(&j.ClusterTaints, "cluster-taints", nil, "Taints to be written to the Cluster object, in the form 'key=value:Effect', e.g. 'dedicated=db:NoSchedule'.")
+	flags.StringVar(&j.TunnelServerAddress, "tunnel-server-address", "", "The 'host:port' of the tunnel server the reverse tunnel agent dials out to. When set, a tunnel agent is deployed in the joining cluster so clusters behind NAT can be push-managed.")
+	flags.StringVar(&j.TunnelURL, "tunnel-url", "", "The proxy URL the control plane uses to reach the joining cluster's apiserver through the tunnel, e.g. https://tunnel.example.com:8443. Registered as the cluster's proxy URL.")
+	flags.StringVar(&j.TunnelAgentImage, "tunnel-agent-image", DefaultTunnelAgentImage, "Image of the reverse tunnel agent. Only works with --tunnel-server-address.")
+	flags.StringVar(&j.TunnelNamespace, "tunnel-namespace", DefaultTunnelNamespace, "Namespace in the joining cluster where the reverse tunnel agent is deployed. Only works with --tunnel-server-address.")
 	flags.StringVar(&j.KarmadaAs, "karmada-as", "",
 		"Username to impersonate for the operation in karmada control plane. User could be a regular user or a service account in a namespace.")
 	flags.StringArrayVar(&j.KarmadaAsGroups, "karmada-as-group", []string{},
@@ -170,6 +221,7 @@ func (j *CommandJoinOption) AddFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&j.KarmadaAsUID, "karmada-as-uid", "",
 		"UID to impersonate for the operation in karmada control plane.")
 	flags.BoolVar(&j.DryRun, "dry-run", false, "Run the command in dry-run mode, without making any server requests.")
+	flags.StringVarP(&j.Output, "output", "o", "", "Output format. One of: json.")
 }
 
 // Run is the implementation of the 'join' command.
@@ -192,7 +244,7 @@ func (j *CommandJoinOption) Run(f cmdutil.Factory) error {
 	// Get cluster config
 	clusterConfig, err := apiclient.RestConfig(j.ClusterContext, j.ClusterKubeConfig)
 	if err != nil {
-		return fmt.Errorf("failed to get joining cluster config. error: %v", err)
+		return cmdresult.NewError(cmdresult.ClusterUnreachable, fmt.Errorf("failed to get joining cluster config. error: %v", err))
 	}
 
 	return j.RunJoinCluster(controlPlaneRestConfig, clusterConfig)
@@ -216,6 +268,25 @@ func (j *CommandJoinOption) RunJoinCluster(controlPlaneRestConfig, clusterConfig
 
 	klog.V(1).Infof("Joining cluster config. endpoint: %s", clusterConfig.Host)
 
+	clusterTaints, taintsToRemove, err := lifted.ParseTaints(j.ClusterTaints)
+	if err != nil {
+		return fmt.Errorf("failed to parse cluster taints: %v", err)
+	}
+	if len(taintsToRemove) > 0 {
+		return fmt.Errorf("--cluster-taints does not support removing taints")
+	}
+
+	// Derive region and zones from the node topology labels unless specified explicitly.
+	if j.ClusterRegion == "" && len(j.ClusterZones) == 0 {
+		region, zones, err := util.DiscoverRegionAndZones(clusterKubeClient)
+		if err != nil {
+			klog.Warningf("Failed to discover region and zones of the joining cluster: %v", err)
+		} else {
+			j.ClusterRegion = region
+			j.ClusterZones = zones
+		}
+	}
+
 	registerOption := util.ClusterRegisterOption{
 		ClusterNamespace:   j.ClusterNamespace,
 		ClusterName:        j.ClusterName,
@@ -223,7 +294,10 @@ func (j *CommandJoinOption) RunJoinCluster(controlPlaneRestConfig, clusterConfig
 		ClusterProvider:    j.ClusterProvider,
 		ClusterRegion:      j.ClusterRegion,
 		ClusterZones:       j.ClusterZones,
+		ClusterLabels:      j.ClusterLabels,
+		ClusterTaints:      clusterTaints,
 		DryRun:             j.DryRun,
+		ProxyServerAddress: j.TunnelURL,
 		ControlPlaneConfig: controlPlaneRestConfig,
 		ClusterConfig:      clusterConfig,
 	}
@@ -245,12 +319,21 @@ func (j *CommandJoinOption) RunJoinCluster(controlPlaneRestConfig, clusterConfig
 	if j.DryRun {
 		return nil
 	}
+
+	if j.TunnelServerAddress != "" {
+		if err = j.deployTunnelAgent(clusterKubeClient); err != nil {
+			return err
+		}
+	}
+
 	registerOption.Secret = *clusterSecret
 	registerOption.ImpersonatorSecret = *impersonatorSecret
 	err = util.RegisterClusterInControllerPlane(registerOption, controlPlaneKubeClient, generateClusterInControllerPlane)
 	if err != nil {
 		return err
 	}
+	// the membership changed, cached cluster lists are stale now
+	clustercache.Invalidate()
 
 	fmt.Printf("cluster(%s) is joined successfully\n", j.ClusterName)
 	return nil
@@ -283,6 +366,14 @@ func generateClusterInControllerPlane(opts util.ClusterRegisterOption) (*cluster
 		clusterObj.Spec.Region = opts.ClusterRegion
 	}
 
+	if len(opts.ClusterLabels) > 0 {
+		clusterObj.Labels = opts.ClusterLabels
+	}
+
+	if len(opts.ClusterTaints) > 0 {
+		clusterObj.Spec.Taints = opts.ClusterTaints
+	}
+
 	clusterObj.Spec.InsecureSkipTLSVerification = opts.ClusterConfig.TLSClientConfig.Insecure
 
 	if opts.ClusterConfig.Proxy != nil {
@@ -293,6 +384,12 @@ func generateClusterInControllerPlane(opts util.ClusterRegisterOption) (*cluster
 		clusterObj.Spec.ProxyURL = url.String()
 	}
 
+	// The tunnel URL takes precedence: for clusters behind NAT the kubeconfig
+	// endpoint is not reachable from the control plane at all.
+	if opts.ProxyServerAddress != "" {
+		clusterObj.Spec.ProxyURL = opts.ProxyServerAddress
+	}
+
 	controlPlaneKarmadaClient := karmadaClientBuilder(opts.ControlPlaneConfig)
 	cluster, err := util.CreateClusterObject(controlPlaneKarmadaClient, clusterObj)
 	if err != nil {
@@ -67,6 +67,16 @@ func TestValidate(t *testing.T) {
 			wantErr:  true,
 			errMsg:   "invalid cluster name",
 		},
+		{
+			name: "Validate_TunnelServerAddressWithoutTunnelURL_TunnelURLIsRequired",
+			joinOpts: &CommandJoinOption{
+				ClusterName:         "cluster1",
+				TunnelServerAddress: "tunnel.example.com:8091",
+			},
+			args:    []string{"cluster1"},
+			wantErr: true,
+			errMsg:  "--tunnel-url is required",
+		},
 		{
 			name: "Validate_WithNameSpaceKarmadaSystem_WarningIssuedAndValidated",
 			joinOpts: &CommandJoinOption{
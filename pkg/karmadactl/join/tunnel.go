@@ -0,0 +1,140 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package join
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+const (
+	// TunnelAgentName is the name of the reverse tunnel agent Deployment
+	// created in the member cluster.
+	TunnelAgentName = "karmada-tunnel-agent"
+
+	// DefaultTunnelAgentImage is the default image of the reverse tunnel agent,
+	// a standard apiserver-network-proxy(konnectivity) agent.
+	DefaultTunnelAgentImage = "registry.k8s.io/kas-network-proxy/proxy-agent:v0.30.3"
+
+	// DefaultTunnelNamespace is the default namespace in the member cluster
+	// where the reverse tunnel agent is deployed.
+	DefaultTunnelNamespace = "karmada-system"
+)
+
+var tunnelAgentLabels = map[string]string{"app": TunnelAgentName}
+
+// deployTunnelAgent deploys an apiserver-network-proxy style agent in the
+// member cluster that dials out to the tunnel server, so the control plane can
+// reach the member apiserver of a cluster behind NAT through the tunnel.
+func (j *CommandJoinOption) deployTunnelAgent(clusterKubeClient kubeclient.Interface) error {
+	host, port, err := net.SplitHostPort(j.TunnelServerAddress)
+	if err != nil {
+		return fmt.Errorf("invalid tunnel server address %q: %v", j.TunnelServerAddress, err)
+	}
+
+	// It's necessary to set the label of namespace to make sure that the namespace is created by Karmada.
+	labels := map[string]string{
+		util.KarmadaSystemLabel: util.KarmadaSystemLabelValue,
+	}
+	if _, err = util.EnsureNamespaceExistWithLabels(clusterKubeClient, j.TunnelNamespace, false, labels); err != nil {
+		return err
+	}
+
+	tunnelAgent := j.makeTunnelAgentDeployment(host, port)
+	if _, err = clusterKubeClient.AppsV1().Deployments(j.TunnelNamespace).Create(context.TODO(), tunnelAgent, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create tunnel agent deployment in cluster(%s): %v", j.ClusterName, err)
+		}
+		if _, err = clusterKubeClient.AppsV1().Deployments(j.TunnelNamespace).Update(context.TODO(), tunnelAgent, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update tunnel agent deployment in cluster(%s): %v", j.ClusterName, err)
+		}
+	}
+	klog.V(1).Infof("Deployed tunnel agent in cluster(%s), dialing out to %s", j.ClusterName, j.TunnelServerAddress)
+	return nil
+}
+
+// makeTunnelAgentDeployment generates the reverse tunnel agent Deployment.
+func (j *CommandJoinOption) makeTunnelAgentDeployment(serverHost, serverPort string) *appsv1.Deployment {
+	podSpec := corev1.PodSpec{
+		Containers: []corev1.Container{
+			{
+				Name:  TunnelAgentName,
+				Image: j.TunnelAgentImage,
+				Command: []string{
+					"/proxy-agent",
+					"--logtostderr=true",
+					fmt.Sprintf("--proxy-server-host=%s", serverHost),
+					fmt.Sprintf("--proxy-server-port=%s", serverPort),
+					fmt.Sprintf("--agent-identifiers=host=%s", j.ClusterName),
+					"--health-server-port=8134",
+				},
+				LivenessProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{
+						HTTPGet: &corev1.HTTPGetAction{
+							Path: "/healthz",
+							Port: intstr.FromInt32(8134),
+						},
+					},
+					InitialDelaySeconds: 15,
+					TimeoutSeconds:      15,
+				},
+			},
+		},
+		Tolerations: []corev1.Toleration{
+			{
+				Key:      "node-role.kubernetes.io/master",
+				Operator: corev1.TolerationOpExists,
+			},
+		},
+	}
+
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      TunnelAgentName,
+			Namespace: j.TunnelNamespace,
+			Labels:    tunnelAgentLabels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: tunnelAgentLabels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: tunnelAgentLabels,
+				},
+				Spec: podSpec,
+			},
+		},
+	}
+}
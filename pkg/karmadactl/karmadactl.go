@@ -29,17 +29,24 @@ import (
 	"k8s.io/kubectl/pkg/util/templates"
 
 	"github.com/karmada-io/karmada/pkg/karmadactl/addons"
+	"github.com/karmada-io/karmada/pkg/karmadactl/adopt"
 	"github.com/karmada-io/karmada/pkg/karmadactl/annotate"
 	"github.com/karmada-io/karmada/pkg/karmadactl/apiresources"
 	"github.com/karmada-io/karmada/pkg/karmadactl/apply"
 	"github.com/karmada-io/karmada/pkg/karmadactl/attach"
+	"github.com/karmada-io/karmada/pkg/karmadactl/auth"
+	"github.com/karmada-io/karmada/pkg/karmadactl/backup"
 	"github.com/karmada-io/karmada/pkg/karmadactl/cmdinit"
 	"github.com/karmada-io/karmada/pkg/karmadactl/completion"
+	"github.com/karmada-io/karmada/pkg/karmadactl/compliance"
+	karmadactlconfig "github.com/karmada-io/karmada/pkg/karmadactl/config"
 	"github.com/karmada-io/karmada/pkg/karmadactl/cordon"
 	"github.com/karmada-io/karmada/pkg/karmadactl/create"
 	"github.com/karmada-io/karmada/pkg/karmadactl/deinit"
 	karmadactldelete "github.com/karmada-io/karmada/pkg/karmadactl/delete"
 	"github.com/karmada-io/karmada/pkg/karmadactl/describe"
+	"github.com/karmada-io/karmada/pkg/karmadactl/doctor"
+	"github.com/karmada-io/karmada/pkg/karmadactl/drain"
 	"github.com/karmada-io/karmada/pkg/karmadactl/edit"
 	"github.com/karmada-io/karmada/pkg/karmadactl/exec"
 	"github.com/karmada-io/karmada/pkg/karmadactl/explain"
@@ -49,17 +56,30 @@ import (
 	"github.com/karmada-io/karmada/pkg/karmadactl/label"
 	"github.com/karmada-io/karmada/pkg/karmadactl/logs"
 	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/orphandependencies"
+	"github.com/karmada-io/karmada/pkg/karmadactl/override"
 	"github.com/karmada-io/karmada/pkg/karmadactl/patch"
+	"github.com/karmada-io/karmada/pkg/karmadactl/plugin"
+	"github.com/karmada-io/karmada/pkg/karmadactl/policy"
+	"github.com/karmada-io/karmada/pkg/karmadactl/portforward"
 	"github.com/karmada-io/karmada/pkg/karmadactl/promote"
+	"github.com/karmada-io/karmada/pkg/karmadactl/raw"
 	"github.com/karmada-io/karmada/pkg/karmadactl/register"
+	"github.com/karmada-io/karmada/pkg/karmadactl/reschedule"
+	"github.com/karmada-io/karmada/pkg/karmadactl/rollout"
+	"github.com/karmada-io/karmada/pkg/karmadactl/scale"
+	"github.com/karmada-io/karmada/pkg/karmadactl/status"
 	"github.com/karmada-io/karmada/pkg/karmadactl/taint"
 	"github.com/karmada-io/karmada/pkg/karmadactl/token"
 	"github.com/karmada-io/karmada/pkg/karmadactl/top"
 	"github.com/karmada-io/karmada/pkg/karmadactl/unjoin"
 	"github.com/karmada-io/karmada/pkg/karmadactl/unregister"
+	"github.com/karmada-io/karmada/pkg/karmadactl/upgrade"
 	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util/clustercache"
 	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
-	"github.com/karmada-io/karmada/pkg/version/sharedcommand"
+	versioncmd "github.com/karmada-io/karmada/pkg/karmadactl/version"
+	"github.com/karmada-io/karmada/pkg/karmadactl/wait"
 )
 
 var (
@@ -76,6 +96,11 @@ func NewKarmadaCtlCommand(cmdUse, parentCommand string) *cobra.Command {
 		Long:  fmt.Sprintf(rootCmdLong, parentCommand),
 
 		RunE: runHelp,
+		// Merge the per-user default flag values of the karmadactl
+		// configuration file under the parsed flags, explicit flags win.
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			return karmadactlconfig.ApplyDefaults(cmd)
+		},
 	}
 
 	// Init log flags
@@ -86,12 +111,17 @@ func NewKarmadaCtlCommand(cmdUse, parentCommand string) *cobra.Command {
 	pflag.CommandLine.SetNormalizeFunc(apiserverflag.WordSepNormalizeFunc)
 	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
 	rootCmd.PersistentFlags().AddFlagSet(pflag.CommandLine)
+	clustercache.AddFlags(rootCmd.PersistentFlags())
 
 	// From this point and forward we get warnings on flags that contain "_" separators
 	rootCmd.SetGlobalNormalizationFunc(apiserverflag.WarnWordSepNormalizeFunc)
 
 	// Prevent klog errors about logging before parsing.
 	_ = flag.CommandLine.Parse(nil)
+
+	// Default the kubeconfig to the current context of the karmadactl
+	// configuration file, an explicit --kubeconfig flag still wins.
+	karmadactlconfig.ApplyCurrentContext(options.DefaultConfigFlags)
 	f := util.NewFactory(options.DefaultConfigFlags)
 	ioStreams := genericiooptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr}
 
@@ -125,6 +155,8 @@ func NewKarmadaCtlCommand(cmdUse, parentCommand string) *cobra.Command {
 				token.NewCmdToken(f, parentCommand, ioStreams),
 				register.NewCmdRegister(parentCommand),
 				unregister.NewCmdUnregister(parentCommand),
+				upgrade.NewCmdUpgrade(parentCommand),
+				backup.NewCmdBackup(f, parentCommand, ioStreams),
 			},
 		},
 		{
@@ -132,6 +164,7 @@ func NewKarmadaCtlCommand(cmdUse, parentCommand string) *cobra.Command {
 			Commands: []*cobra.Command{
 				cordon.NewCmdCordon(f, parentCommand),
 				cordon.NewCmdUncordon(f, parentCommand),
+				drain.NewCmdDrain(f, parentCommand, ioStreams),
 				taint.NewCmdTaint(f, parentCommand),
 			},
 		},
@@ -139,19 +172,32 @@ func NewKarmadaCtlCommand(cmdUse, parentCommand string) *cobra.Command {
 			Message: "Troubleshooting and Debugging Commands:",
 			Commands: []*cobra.Command{
 				attach.NewCmdAttach(f, parentCommand, ioStreams),
+				auth.NewCmdAuth(f, parentCommand, ioStreams),
 				logs.NewCmdLogs(f, parentCommand, ioStreams),
 				exec.NewCmdExec(f, parentCommand, ioStreams),
+				portforward.NewCmdPortForward(f, parentCommand, ioStreams),
 				describe.NewCmdDescribe(f, parentCommand, ioStreams),
+				raw.NewCmdRaw(f, parentCommand, ioStreams),
+				status.NewCmdStatus(f, parentCommand, ioStreams),
+				doctor.NewCmdDoctor(f, parentCommand, ioStreams),
+				compliance.NewCmdCompliance(f, parentCommand, ioStreams),
 				interpret.NewCmdInterpret(f, parentCommand, ioStreams),
+				override.NewCmdOverride(f, parentCommand, ioStreams),
 			},
 		},
 		{
 			Message: "Advanced Commands:",
 			Commands: []*cobra.Command{
+				adopt.NewCmdAdopt(f, parentCommand, ioStreams),
 				apply.NewCmdApply(f, parentCommand, ioStreams),
+				policy.NewCmdPolicy(f, parentCommand, ioStreams),
 				promote.NewCmdPromote(f, parentCommand),
+				reschedule.NewCmdReschedule(f, parentCommand, ioStreams),
+				rollout.NewCmdRollout(f, parentCommand, ioStreams),
+				scale.NewCmdScale(f, parentCommand, ioStreams),
 				top.NewCmdTop(f, parentCommand, ioStreams),
 				patch.NewCmdPatch(f, parentCommand, ioStreams),
+				wait.NewCmdWait(f, parentCommand, ioStreams),
 			},
 		},
 		{
@@ -159,6 +205,7 @@ func NewKarmadaCtlCommand(cmdUse, parentCommand string) *cobra.Command {
 			Commands: []*cobra.Command{
 				label.NewCmdLabel(f, parentCommand, ioStreams),
 				annotate.NewCmdAnnotate(f, parentCommand, ioStreams),
+				karmadactlconfig.NewCmdConfig(parentCommand, ioStreams),
 				completion.NewCmdCompletion(parentCommand, ioStreams.Out, ""),
 			},
 		},
@@ -167,6 +214,8 @@ func NewKarmadaCtlCommand(cmdUse, parentCommand string) *cobra.Command {
 			Commands: []*cobra.Command{
 				apiresources.NewCmdAPIResources(f, parentCommand, ioStreams),
 				apiresources.NewCmdAPIVersions(f, parentCommand, ioStreams),
+				orphandependencies.NewCmdOrphanedDependencies(f, parentCommand, ioStreams),
+				plugin.NewCmdPlugin(parentCommand, ioStreams),
 			},
 		},
 	}
@@ -174,7 +223,7 @@ func NewKarmadaCtlCommand(cmdUse, parentCommand string) *cobra.Command {
 
 	filters := []string{"options"}
 
-	rootCmd.AddCommand(sharedcommand.NewCmdVersion(parentCommand))
+	rootCmd.AddCommand(versioncmd.NewCmdVersion(f, parentCommand, ioStreams))
 	rootCmd.AddCommand(options.NewCmdOptions(parentCommand, ioStreams.Out))
 
 	templates.ActsAsRootCommand(rootCmd, filters, groups...)
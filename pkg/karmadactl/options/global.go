@@ -49,6 +49,16 @@ func AddKubeConfigFlags(flags *pflag.FlagSet) {
 	flags.StringVar(DefaultConfigFlags.Context, "karmada-context", *DefaultConfigFlags.Context, "The name of the kubeconfig context to use")
 }
 
+// AddImpersonationFlags adds the user impersonation flags to the specified FlagSet.
+// The impersonation headers are sent to the karmada control plane and are forwarded
+// through the aggregated proxy to member clusters, so that administrators can inspect
+// what another user would see.
+func AddImpersonationFlags(flags *pflag.FlagSet) {
+	flags.StringVar(DefaultConfigFlags.Impersonate, "as", *DefaultConfigFlags.Impersonate, "Username to impersonate for the operation. User could be a regular user or a service account in a namespace.")
+	flags.StringArrayVar(DefaultConfigFlags.ImpersonateGroup, "as-group", *DefaultConfigFlags.ImpersonateGroup, "Group to impersonate for the operation, this flag can be repeated to specify multiple groups.")
+	flags.StringVar(DefaultConfigFlags.ImpersonateUID, "as-uid", *DefaultConfigFlags.ImpersonateUID, "UID to impersonate for the operation.")
+}
+
 // AddNamespaceFlag add namespace flag to the specified FlagSet.
 func AddNamespaceFlag(flags *pflag.FlagSet) {
 	flags.StringVarP(DefaultConfigFlags.Namespace, "namespace", "n", *DefaultConfigFlags.Namespace, "If present, the namespace scope for this CLI request.")
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphandependencies
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+var (
+	orphanDependenciesExample = templates.Examples(`
+		# List dependency objects eligible for garbage collection in all namespaces
+		%[1]s orphaned-dependencies
+
+		# List dependency objects eligible for garbage collection in namespace(default)
+		%[1]s orphaned-dependencies -n default`)
+)
+
+// NewCmdOrphanedDependencies creates the orphaned-dependencies command
+func NewCmdOrphanedDependencies(f util.Factory, parentCommand string, ioStreams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandOrphanedDependenciesOptions{IOStreams: ioStreams}
+	cmd := &cobra.Command{
+		Use:                   "orphaned-dependencies",
+		Short:                 "List dependency objects which are no longer referenced and eligible for garbage collection",
+		Long:                  "List dependency objects propagated by PropagateDeps which are no longer referenced by any workload and will be removed from member clusters once the garbage collection grace period elapses. The command is a dry-run report, it does not delete anything.",
+		Example:               fmt.Sprintf(orphanDependenciesExample, parentCommand),
+		DisableFlagsInUseLine: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := o.Complete(f); err != nil {
+				return err
+			}
+			return o.Run()
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupOtherCommands,
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	flags.StringVarP(&o.Namespace, "namespace", "n", "", "If present, the namespace scope for this CLI request.")
+
+	return cmd
+}
+
+// CommandOrphanedDependenciesOptions contains the input to the orphaned-dependencies command.
+type CommandOrphanedDependenciesOptions struct {
+	genericiooptions.IOStreams
+
+	// Namespace limits the report to a single namespace, empty means all namespaces.
+	Namespace string
+
+	karmadaClient karmadaclientset.Interface
+}
+
+// Complete ensures that options are valid and marshals them if necessary
+func (o *CommandOrphanedDependenciesOptions) Complete(f util.Factory) error {
+	karmadaClient, err := f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+	o.karmadaClient = karmadaClient
+	return nil
+}
+
+// Run does the work
+func (o *CommandOrphanedDependenciesOptions) Run() error {
+	bindingList, err := o.karmadaClient.WorkV1alpha2().ResourceBindings(o.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list resource bindings: %v", err)
+	}
+
+	var orphaned []workv1alpha2.ResourceBinding
+	for _, binding := range bindingList.Items {
+		if _, exist := binding.Annotations[workv1alpha2.DependencyGCEligibleSinceAnnotation]; exist {
+			orphaned = append(orphaned, binding)
+		}
+	}
+	if len(orphaned) == 0 {
+		fmt.Fprintln(o.Out, "No orphaned dependency objects found.")
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(o.Out, 0, 8, 2, ' ', 0)
+	defer writer.Flush()
+	fmt.Fprintln(writer, "NAMESPACE\tKIND\tNAME\tORPHANED-SINCE\tREQUIRED-BY")
+	for _, binding := range orphaned {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%d\n",
+			binding.Spec.Resource.Namespace,
+			binding.Spec.Resource.Kind,
+			binding.Spec.Resource.Name,
+			binding.Annotations[workv1alpha2.DependencyGCEligibleSinceAnnotation],
+			len(binding.Spec.RequiredBy))
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package override
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+	"github.com/karmada-io/karmada/pkg/util/overridemanager"
+)
+
+var (
+	traceLong = templates.LongDesc(`
+		Show which OverridePolicies and ClusterOverridePolicies would rewrite a
+		resource when it is propagated to a given member cluster.
+
+		The matching policies are applied in the same order the controller
+		applies them, ClusterOverridePolicies first and then OverridePolicies
+		from the resource's namespace, and the JSON merge diff of every step
+		is printed. Nothing is written, the evaluation is a pure dry-run
+		against the resource currently stored in the Karmada control plane.`)
+
+	traceExample = templates.Examples(`
+		# Trace the overrides a deployment receives on its way to cluster member1
+		%[1]s override trace deployment/nginx --cluster member1
+
+		# Trace a resource in a specific namespace
+		%[1]s override trace deployment nginx -n test --cluster member1`)
+)
+
+// CommandTraceOptions contains the input to the override trace command.
+type CommandTraceOptions struct {
+	// Cluster is the member cluster the resource would be propagated to.
+	Cluster string
+	// Namespace is the namespace of the resource.
+	Namespace string
+
+	resourceObj   *unstructured.Unstructured
+	controlPlane  client.Client
+	resourceLabel string
+
+	genericiooptions.IOStreams
+}
+
+func newCmdOverrideTrace(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandTraceOptions{IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:                   "trace (TYPE[.VERSION][.GROUP] NAME | TYPE[.VERSION][.GROUP]/NAME) --cluster CLUSTER",
+		Short:                 "Show which override policies match a resource for a cluster and the diff each one produces",
+		Long:                  traceLong,
+		Example:               fmt.Sprintf(traceExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(f, args); err != nil {
+				return err
+			}
+			return o.Run()
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVarP(&o.Cluster, "cluster", "C", "", "The member cluster the resource would be propagated to.")
+	flags.StringVarP(&o.Namespace, "namespace", "n", "", "The namespace of the resource.")
+	return cmd
+}
+
+// Complete fetches the resource to trace and builds a control plane client.
+func (o *CommandTraceOptions) Complete(f util.Factory, args []string) error {
+	if len(o.Cluster) == 0 {
+		return fmt.Errorf("the --cluster flag is required")
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("the resource to trace is required, e.g. deployment/nginx")
+	}
+
+	if len(o.Namespace) == 0 {
+		namespace, _, err := f.ToRawKubeConfigLoader().Namespace()
+		if err != nil {
+			return err
+		}
+		o.Namespace = namespace
+	}
+
+	r := f.NewBuilder().
+		Unstructured().
+		NamespaceParam(o.Namespace).DefaultNamespace().
+		ResourceTypeOrNameArgs(false, args...).
+		SingleResourceType().
+		RequireObject(true).
+		Do()
+	infos, err := r.Infos()
+	if err != nil {
+		return err
+	}
+	if len(infos) != 1 {
+		return fmt.Errorf("expected exactly one resource, got %d", len(infos))
+	}
+	obj, ok := infos[0].Object.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("failed to decode resource %s as unstructured object", infos[0].Name)
+	}
+	o.resourceObj = obj
+	o.resourceLabel = fmt.Sprintf("%s/%s", infos[0].Mapping.Resource.Resource, infos[0].Name)
+
+	restConfig, err := f.ToRESTConfig()
+	if err != nil {
+		return err
+	}
+	o.controlPlane, err = client.New(restConfig, client.Options{Scheme: gclient.NewSchema()})
+	return err
+}
+
+// Run traces the override policies and prints one diff per applied rule.
+func (o *CommandTraceOptions) Run() error {
+	steps, err := overridemanager.TraceOverridePolicies(context.TODO(), o.controlPlane, o.resourceObj, o.Cluster)
+	if err != nil {
+		return err
+	}
+
+	if len(steps) == 0 {
+		fmt.Fprintf(o.Out, "No override policy matches %s for cluster %s.\n", o.resourceLabel, o.Cluster)
+		return nil
+	}
+
+	fmt.Fprintf(o.Out, "Tracing overrides of %s for cluster %s:\n", o.resourceLabel, o.Cluster)
+	for i, step := range steps {
+		fmt.Fprintf(o.Out, "\nStep %d: %s\n", i+1, policyLabel(step))
+		diff, err := stepDiff(step)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(o.Out, "%s\n", diff)
+	}
+	fmt.Fprintf(o.Out, "\n%d override rule(s) applied.\n", len(steps))
+	return nil
+}
+
+// policyLabel renders the policy reference of a step, distinguishing
+// ClusterOverridePolicy from namespaced OverridePolicy.
+func policyLabel(step overridemanager.TraceStep) string {
+	if len(step.PolicyNamespace) == 0 {
+		return fmt.Sprintf("ClusterOverridePolicy/%s", step.PolicyName)
+	}
+	return fmt.Sprintf("OverridePolicy/%s/%s", step.PolicyNamespace, step.PolicyName)
+}
+
+// stepDiff renders the JSON merge diff a step produced on the resource.
+func stepDiff(step overridemanager.TraceStep) (string, error) {
+	if bytes.Equal(step.Before, step.After) {
+		return "(no effective change)", nil
+	}
+	patch, err := jsonpatch.CreateMergePatch(step.Before, step.After)
+	if err != nil {
+		return "", fmt.Errorf("failed to diff step of policy %s: %v", step.PolicyName, err)
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, patch, "", "  "); err != nil {
+		return "", err
+	}
+	return indented.String(), nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package plugin provides kubectl-style plugin support: unknown subcommands
+// resolve to karmadactl-<name> binaries on PATH, so the ecosystem can ship
+// extensions without forking the CLI.
+package plugin
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+var (
+	pluginLong = templates.LongDesc(`
+		Provides utilities for interacting with plugins.
+
+		Plugins provide extended functionality that is not part of the major command-line distribution.
+		Please refer to the documentation and examples for more information about how write your own plugins.
+
+		The easiest way to discover and install plugins is via the kubernetes sub-project krew.
+		To install krew, visit [krew.sigs.k8s.io](https://krew.sigs.k8s.io/docs/user-guide/setup/install/)`)
+
+	pluginListLong = templates.LongDesc(`
+		List all available plugin files on a user's PATH.
+
+		Available plugin files are those that are:
+		- executable
+		- anywhere on the user's PATH
+		- begin with "karmadactl-"`)
+)
+
+// NewCmdPlugin creates the command that lists available plugins.
+func NewCmdPlugin(parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "plugin [flags]",
+		DisableFlagsInUseLine: true,
+		Short:                 "Provides utilities for interacting with plugins",
+		Long:                  pluginLong,
+		Run:                   cmdutil.DefaultSubCommandRun(streams.ErrOut),
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupOtherCommands,
+		},
+	}
+	cmd.AddCommand(newCmdPluginList(parentCommand, streams))
+	return cmd
+}
+
+// pluginListOptions holds the input to the plugin list command.
+type pluginListOptions struct {
+	nameOnly bool
+
+	pluginPaths []string
+
+	genericiooptions.IOStreams
+}
+
+func newCmdPluginList(parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &pluginListOptions{IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List all visible plugin executables on a user's PATH",
+		Long:    pluginListLong,
+		Example: fmt.Sprintf("  # List all visible plugin executables on a user's PATH\n  %s plugin list", parentCommand),
+		RunE: func(*cobra.Command, []string) error {
+			o.complete()
+			return o.run()
+		},
+	}
+	cmd.Flags().BoolVar(&o.nameOnly, "name-only", o.nameOnly, "If true, display only the binary name of each plugin, rather than its full path.")
+	return cmd
+}
+
+func (o *pluginListOptions) complete() {
+	o.pluginPaths = filepath.SplitList(os.Getenv("PATH"))
+}
+
+func (o *pluginListOptions) run() error {
+	var plugins []string
+	var warnings []string
+	seenPlugins := map[string]string{}
+
+	for _, dir := range uniquePathEntries(o.pluginPaths) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !hasPluginPrefix(entry.Name()) {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			if !isExecutable(entry) {
+				warnings = append(warnings, fmt.Sprintf("warning: %s identified as a plugin, but it is not executable", path))
+			}
+			if existing, shadowed := seenPlugins[entry.Name()]; shadowed {
+				warnings = append(warnings, fmt.Sprintf("warning: %s is overshadowed by a similarly named plugin: %s", path, existing))
+			} else {
+				seenPlugins[entry.Name()] = path
+			}
+			if o.nameOnly {
+				plugins = append(plugins, entry.Name())
+			} else {
+				plugins = append(plugins, path)
+			}
+		}
+	}
+
+	if len(plugins) == 0 {
+		return fmt.Errorf("unable to find any karmadactl plugins in your PATH")
+	}
+	fmt.Fprintf(o.Out, "The following compatible plugins are available:\n\n")
+	for _, plugin := range plugins {
+		fmt.Fprintf(o.Out, "%s\n", plugin)
+	}
+	for _, warning := range warnings {
+		fmt.Fprintf(o.ErrOut, "%s\n", warning)
+	}
+	return nil
+}
+
+// uniquePathEntries deduplicates the PATH entries while keeping their order.
+func uniquePathEntries(paths []string) []string {
+	seen := map[string]bool{}
+	var unique []string
+	for _, dir := range paths {
+		if len(dir) == 0 || seen[dir] {
+			continue
+		}
+		seen[dir] = true
+		unique = append(unique, dir)
+	}
+	return unique
+}
+
+// hasPluginPrefix reports whether the file name identifies a karmadactl
+// plugin, taking the Windows executable extensions into account.
+func hasPluginPrefix(name string) bool {
+	if runtime.GOOS == "windows" {
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	return strings.HasPrefix(name, pluginFilenamePrefix+"-")
+}
+
+func isExecutable(entry fs.DirEntry) bool {
+	if runtime.GOOS == "windows" {
+		fileExt := strings.ToLower(filepath.Ext(entry.Name()))
+		switch fileExt {
+		case ".bat", ".cmd", ".com", ".exe", ".ps1":
+			return true
+		}
+		return false
+	}
+	info, err := entry.Info()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&0111 != 0
+}
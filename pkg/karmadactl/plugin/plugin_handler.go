@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"syscall"
+)
+
+// pluginFilenamePrefix is the prefix plugin binaries must carry to be
+// discovered, e.g. `karmadactl-cost` serves `karmadactl cost`.
+const pluginFilenamePrefix = "karmadactl"
+
+// Handler parses command line arguments and performs executable filename
+// lookups to search for valid plugin files, and execute found plugins.
+type Handler interface {
+	// Lookup receives a potential filename and returns a full or relative
+	// path to an executable, if one exists.
+	Lookup(filename string) (string, bool)
+	// Execute receives an executable's filepath, a slice of arguments,
+	// and a slice of environment variables to relay to the executable.
+	Execute(executablePath string, cmdArgs, environment []string) error
+}
+
+// DefaultHandler implements Handler.
+type DefaultHandler struct {
+	prefix string
+}
+
+// NewDefaultHandler instantiates the DefaultHandler with the karmadactl
+// plugin filename prefix.
+func NewDefaultHandler() *DefaultHandler {
+	return &DefaultHandler{prefix: pluginFilenamePrefix}
+}
+
+// Lookup implements Handler.
+func (h *DefaultHandler) Lookup(filename string) (string, bool) {
+	path, err := exec.LookPath(fmt.Sprintf("%s-%s", h.prefix, filename))
+	if err != nil || len(path) == 0 {
+		return "", false
+	}
+	return path, true
+}
+
+// Execute implements Handler.
+func (h *DefaultHandler) Execute(executablePath string, cmdArgs, environment []string) error {
+	// Windows does not support exec syscall.
+	if runtime.GOOS == "windows" {
+		cmd := exec.Command(executablePath, cmdArgs...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		cmd.Stdin = os.Stdin
+		cmd.Env = environment
+		err := cmd.Run()
+		if err == nil {
+			os.Exit(0)
+		}
+		return err
+	}
+	return syscall.Exec(executablePath, append([]string{executablePath}, cmdArgs...), environment)
+}
+
+// HandleCommand receives a Handler and command-line arguments and attempts to
+// find a plugin executable on the PATH that satisfies the given arguments.
+func HandleCommand(handler Handler, cmdArgs []string, minArgs int) error {
+	var remainingArgs []string // all "non-flag" arguments
+	for _, arg := range cmdArgs {
+		if strings.HasPrefix(arg, "-") {
+			break
+		}
+		remainingArgs = append(remainingArgs, strings.ReplaceAll(arg, "-", "_"))
+	}
+
+	if len(remainingArgs) == 0 {
+		// the length of cmdArgs is at least 1
+		return fmt.Errorf("flags cannot be placed before plugin name: %s", cmdArgs[0])
+	}
+
+	foundBinaryPath := ""
+	// attempt to find binary, starting at longest possible name with given cmdArgs
+	for len(remainingArgs) > 0 {
+		path, found := handler.Lookup(strings.Join(remainingArgs, "-"))
+		if !found {
+			remainingArgs = remainingArgs[:len(remainingArgs)-1]
+			if len(remainingArgs) < minArgs {
+				// we shouldn't continue searching with less than
+				// the minimum number of arguments
+				break
+			}
+			continue
+		}
+		foundBinaryPath = path
+		break
+	}
+
+	if len(foundBinaryPath) == 0 {
+		return nil
+	}
+
+	// invoke cmd binary relaying the current environment and args given
+	return handler.Execute(foundBinaryPath, cmdArgs[len(remainingArgs):], os.Environ())
+}
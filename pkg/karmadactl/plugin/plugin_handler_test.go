@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"testing"
+)
+
+// fakeHandler records lookups and executions instead of touching the PATH.
+type fakeHandler struct {
+	known    map[string]string
+	executed string
+	args     []string
+}
+
+func (h *fakeHandler) Lookup(filename string) (string, bool) {
+	path, found := h.known[filename]
+	return path, found
+}
+
+func (h *fakeHandler) Execute(executablePath string, cmdArgs, _ []string) error {
+	h.executed = executablePath
+	h.args = cmdArgs
+	return nil
+}
+
+func TestHandleCommand(t *testing.T) {
+	tests := []struct {
+		name         string
+		known        map[string]string
+		args         []string
+		wantErr      bool
+		wantExecuted string
+		wantArgs     []string
+	}{
+		{
+			name:         "simple plugin found",
+			known:        map[string]string{"cost": "/usr/local/bin/karmadactl-cost"},
+			args:         []string{"cost", "--cluster", "member1"},
+			wantExecuted: "/usr/local/bin/karmadactl-cost",
+			wantArgs:     []string{"--cluster", "member1"},
+		},
+		{
+			name:         "longest matching name wins",
+			known:        map[string]string{"cost": "/usr/local/bin/karmadactl-cost", "cost-report": "/usr/local/bin/karmadactl-cost-report"},
+			args:         []string{"cost", "report", "member1"},
+			wantExecuted: "/usr/local/bin/karmadactl-cost-report",
+			wantArgs:     []string{"member1"},
+		},
+		{
+			name:         "dashes in subcommand translated to underscores",
+			known:        map[string]string{"cost_report": "/usr/local/bin/karmadactl-cost_report"},
+			args:         []string{"cost-report"},
+			wantExecuted: "/usr/local/bin/karmadactl-cost_report",
+			wantArgs:     []string{},
+		},
+		{
+			name:  "no plugin found falls through silently",
+			known: map[string]string{},
+			args:  []string{"nonexistent"},
+		},
+		{
+			name:    "flags before plugin name rejected",
+			known:   map[string]string{"cost": "/usr/local/bin/karmadactl-cost"},
+			args:    []string{"--cluster", "member1", "cost"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := &fakeHandler{known: tt.known}
+			err := HandleCommand(handler, tt.args, 1)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HandleCommand() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if handler.executed != tt.wantExecuted {
+				t.Errorf("executed %q, want %q", handler.executed, tt.wantExecuted)
+			}
+			if fmt.Sprint(handler.args) != fmt.Sprint(tt.wantArgs) {
+				t.Errorf("relayed args %v, want %v", handler.args, tt.wantArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,461 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+var (
+	convertLong = templates.LongDesc(`
+		Convert a PropagationPolicy into a ClusterPropagationPolicy or vice
+		versa.
+
+		The command creates the converted policy first and removes the
+		original afterwards, so the resources stay claimed by a policy during
+		the whole switchover and no binding is orphaned. Resource selectors
+		are adjusted to the new scope and user labels and annotations are
+		preserved, only the Karmada bookkeeping of the original policy is
+		dropped. After the switchover the command verifies that every
+		previously claimed ResourceBinding is claimed by the converted policy
+		and still scheduled to the same clusters.`)
+
+	convertExample = templates.Examples(`
+		# Convert the PropagationPolicy 'nginx' of the default namespace into a ClusterPropagationPolicy
+		%[1]s policy convert propagationpolicy/nginx
+
+		# Convert the ClusterPropagationPolicy 'nginx' into a PropagationPolicy of namespace 'prod'
+		%[1]s policy convert clusterpropagationpolicy/nginx --target-namespace=prod
+
+		# Print the converted policy without performing the switchover
+		%[1]s policy convert pp/nginx --dry-run`)
+)
+
+// CommandConvertOptions contains the input to the policy convert command.
+type CommandConvertOptions struct {
+	// Namespace is the namespace of the source PropagationPolicy.
+	Namespace string
+	// TargetNamespace is the namespace of the resulting PropagationPolicy
+	// when converting a ClusterPropagationPolicy.
+	TargetNamespace string
+	// DryRun prints the converted policy without performing the switchover.
+	DryRun bool
+	// KeepOriginal keeps the source policy instead of removing it after the
+	// converted policy is created.
+	KeepOriginal bool
+	// Timeout is the maximum duration to wait for the claims to move over.
+	Timeout time.Duration
+
+	genericiooptions.IOStreams
+}
+
+// NewCmdPolicyConvert returns the policy convert command.
+func NewCmdPolicyConvert(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandConvertOptions{IOStreams: streams, Timeout: 60 * time.Second}
+
+	cmd := &cobra.Command{
+		Use:                   "convert (propagationpolicy | clusterpropagationpolicy) NAME",
+		Short:                 "Convert between PropagationPolicy and ClusterPropagationPolicy",
+		Long:                  convertLong,
+		Example:               fmt.Sprintf(convertExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		RunE: func(_ *cobra.Command, args []string) error {
+			kind, name, err := parsePolicyArgs(args)
+			if err != nil {
+				return err
+			}
+			if err = o.Complete(f); err != nil {
+				return err
+			}
+			return o.Run(f, kind, name)
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+	flags.StringVar(&o.TargetNamespace, "target-namespace", "", "Namespace of the resulting PropagationPolicy when converting a ClusterPropagationPolicy. Defaults to the common namespace of the resource selectors.")
+	flags.BoolVar(&o.DryRun, "dry-run", false, "Only print the converted policy, without performing the switchover.")
+	flags.BoolVar(&o.KeepOriginal, "keep-original", false, "Keep the source policy instead of removing it after the converted policy is created. Note that a kept PropagationPolicy continues to take precedence over the converted ClusterPropagationPolicy.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "The maximum duration to wait for the bindings to be claimed by the converted policy.")
+	return cmd
+}
+
+// policyKind is the scope of the source policy.
+type policyKind string
+
+const (
+	propagationPolicyKind        policyKind = "PropagationPolicy"
+	clusterPropagationPolicyKind policyKind = "ClusterPropagationPolicy"
+)
+
+// parsePolicyArgs extracts the source policy kind and name from the
+// arguments, accepting both the "TYPE NAME" and the "TYPE/NAME" form.
+func parsePolicyArgs(args []string) (policyKind, string, error) {
+	var kindArg, name string
+	switch len(args) {
+	case 1:
+		parts := strings.SplitN(args[0], "/", 2)
+		if len(parts) != 2 {
+			return "", "", errors.New("the policy must be specified as TYPE/NAME or TYPE NAME")
+		}
+		kindArg, name = parts[0], parts[1]
+	case 2:
+		kindArg, name = args[0], args[1]
+	default:
+		return "", "", errors.New("the policy must be specified as TYPE/NAME or TYPE NAME")
+	}
+	if name == "" {
+		return "", "", errors.New("the policy name must not be empty")
+	}
+	switch strings.ToLower(kindArg) {
+	case "propagationpolicy", "propagationpolicies", "pp":
+		return propagationPolicyKind, name, nil
+	case "clusterpropagationpolicy", "clusterpropagationpolicies", "cpp":
+		return clusterPropagationPolicyKind, name, nil
+	default:
+		return "", "", fmt.Errorf("unsupported policy type %q, expect propagationpolicy or clusterpropagationpolicy", kindArg)
+	}
+}
+
+// Complete resolves the namespace of the source PropagationPolicy.
+func (o *CommandConvertOptions) Complete(f util.Factory) error {
+	namespace, _, err := f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+	o.Namespace = namespace
+	return nil
+}
+
+// Run performs the conversion and the switchover.
+func (o *CommandConvertOptions) Run(f util.Factory, kind policyKind, name string) error {
+	karmadaClient, err := f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+	if kind == propagationPolicyKind {
+		return o.convertToClusterScope(karmadaClient, name)
+	}
+	return o.convertToNamespaceScope(karmadaClient, name)
+}
+
+// convertToClusterScope converts a PropagationPolicy into a
+// ClusterPropagationPolicy.
+func (o *CommandConvertOptions) convertToClusterScope(karmadaClient karmadaclientset.Interface, name string) error {
+	ctx := context.TODO()
+	pp, err := karmadaClient.PolicyV1alpha1().PropagationPolicies(o.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	cpp := convertPropagationPolicyToClustered(pp)
+	if o.DryRun {
+		return printPolicy(o.Out, cpp)
+	}
+
+	bindings, err := snapshotResourceBindings(ctx, karmadaClient, o.Namespace,
+		policyv1alpha1.PropagationPolicyPermanentIDLabel, pp.Labels[policyv1alpha1.PropagationPolicyPermanentIDLabel])
+	if err != nil {
+		return err
+	}
+
+	if _, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Create(ctx, cpp, metav1.CreateOptions{}); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("ClusterPropagationPolicy %q already exists, remove it first or pick another name", cpp.Name)
+		}
+		return err
+	}
+	fmt.Fprintf(o.Out, "ClusterPropagationPolicy %q created.\n", cpp.Name)
+
+	if !o.KeepOriginal {
+		if err = karmadaClient.PolicyV1alpha1().PropagationPolicies(o.Namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+			return fmt.Errorf("failed to remove the source PropagationPolicy, the switchover is incomplete: %v", err)
+		}
+		fmt.Fprintf(o.Out, "PropagationPolicy %s/%s removed.\n", o.Namespace, name)
+	}
+
+	if err = o.verifyClaims(ctx, karmadaClient, bindings, policyv1alpha1.ClusterPropagationPolicyPermanentIDLabel); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "Verified %d binding(s): claimed by the converted policy with unchanged placement.\n", len(bindings))
+	return nil
+}
+
+// convertToNamespaceScope converts a ClusterPropagationPolicy into a
+// PropagationPolicy.
+func (o *CommandConvertOptions) convertToNamespaceScope(karmadaClient karmadaclientset.Interface, name string) error {
+	ctx := context.TODO()
+	cpp, err := karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	pp, err := convertClusteredToPropagationPolicy(cpp, o.TargetNamespace)
+	if err != nil {
+		return err
+	}
+	if o.DryRun {
+		return printPolicy(o.Out, pp)
+	}
+
+	permanentID := cpp.Labels[policyv1alpha1.ClusterPropagationPolicyPermanentIDLabel]
+	clusterBindings, err := karmadaClient.WorkV1alpha2().ClusterResourceBindings().List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{policyv1alpha1.ClusterPropagationPolicyPermanentIDLabel: permanentID}.String(),
+	})
+	if err != nil {
+		return err
+	}
+	if len(clusterBindings.Items) > 0 {
+		return fmt.Errorf("the ClusterPropagationPolicy claims %d cluster-scoped resource(s) which a PropagationPolicy cannot propagate", len(clusterBindings.Items))
+	}
+
+	bindings, err := snapshotResourceBindings(ctx, karmadaClient, pp.Namespace,
+		policyv1alpha1.ClusterPropagationPolicyPermanentIDLabel, permanentID)
+	if err != nil {
+		return err
+	}
+
+	if _, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(pp.Namespace).Create(ctx, pp, metav1.CreateOptions{}); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("PropagationPolicy %s/%s already exists, remove it first or pick another name", pp.Namespace, pp.Name)
+		}
+		return err
+	}
+	fmt.Fprintf(o.Out, "PropagationPolicy %s/%s created.\n", pp.Namespace, pp.Name)
+
+	if !o.KeepOriginal {
+		if err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+			return fmt.Errorf("failed to remove the source ClusterPropagationPolicy, the switchover is incomplete: %v", err)
+		}
+		fmt.Fprintf(o.Out, "ClusterPropagationPolicy %q removed.\n", name)
+	}
+
+	if err = o.verifyClaims(ctx, karmadaClient, bindings, policyv1alpha1.PropagationPolicyPermanentIDLabel); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "Verified %d binding(s): claimed by the converted policy with unchanged placement.\n", len(bindings))
+	return nil
+}
+
+// convertPropagationPolicyToClustered builds the cluster-scoped counterpart
+// of the policy. The resource selectors are pinned to the namespace of the
+// source policy, which they implicitly targeted before.
+func convertPropagationPolicyToClustered(pp *policyv1alpha1.PropagationPolicy) *policyv1alpha1.ClusterPropagationPolicy {
+	cpp := &policyv1alpha1.ClusterPropagationPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: policyv1alpha1.GroupVersion.String(),
+			Kind:       "ClusterPropagationPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        pp.Name,
+			Labels:      withoutPolicyBookkeeping(pp.Labels),
+			Annotations: withoutPolicyBookkeeping(pp.Annotations),
+		},
+		Spec: *pp.Spec.DeepCopy(),
+	}
+	for i := range cpp.Spec.ResourceSelectors {
+		if cpp.Spec.ResourceSelectors[i].Namespace == "" {
+			cpp.Spec.ResourceSelectors[i].Namespace = pp.Namespace
+		}
+	}
+	return cpp
+}
+
+// convertClusteredToPropagationPolicy builds the namespaced counterpart of
+// the policy. All resource selectors must target the namespace of the
+// resulting policy, which is inferred from the selectors when not specified.
+func convertClusteredToPropagationPolicy(cpp *policyv1alpha1.ClusterPropagationPolicy, targetNamespace string) (*policyv1alpha1.PropagationPolicy, error) {
+	namespace := targetNamespace
+	for _, selector := range cpp.Spec.ResourceSelectors {
+		if selector.Namespace == "" {
+			continue
+		}
+		if namespace == "" {
+			namespace = selector.Namespace
+			continue
+		}
+		if selector.Namespace != namespace {
+			return nil, fmt.Errorf("the resource selectors target multiple namespaces(%s, %s), a PropagationPolicy is restricted to one", namespace, selector.Namespace)
+		}
+	}
+	if namespace == "" {
+		return nil, errors.New("cannot infer the target namespace from the resource selectors, specify it by --target-namespace")
+	}
+	for _, selector := range cpp.Spec.ResourceSelectors {
+		if selector.Namespace != "" && selector.Namespace != namespace {
+			return nil, fmt.Errorf("the resource selector of %s targets namespace %q, not the target namespace %q", selector.Kind, selector.Namespace, namespace)
+		}
+	}
+
+	pp := &policyv1alpha1.PropagationPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: policyv1alpha1.GroupVersion.String(),
+			Kind:       "PropagationPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        cpp.Name,
+			Namespace:   namespace,
+			Labels:      withoutPolicyBookkeeping(cpp.Labels),
+			Annotations: withoutPolicyBookkeeping(cpp.Annotations),
+		},
+		Spec: *cpp.Spec.DeepCopy(),
+	}
+	for i := range pp.Spec.ResourceSelectors {
+		pp.Spec.ResourceSelectors[i].Namespace = namespace
+	}
+	return pp, nil
+}
+
+// withoutPolicyBookkeeping copies the labels or annotations, dropping the
+// Karmada bookkeeping of the source policy which must not be inherited by the
+// converted one.
+func withoutPolicyBookkeeping(values map[string]string) map[string]string {
+	if values == nil {
+		return nil
+	}
+	copied := make(map[string]string, len(values))
+	for key, value := range values {
+		if key == policyv1alpha1.PropagationPolicyPermanentIDLabel ||
+			key == policyv1alpha1.ClusterPropagationPolicyPermanentIDLabel {
+			continue
+		}
+		copied[key] = value
+	}
+	if len(copied) == 0 {
+		return nil
+	}
+	return copied
+}
+
+// bindingSnapshot records the placement of a claimed ResourceBinding before
+// the switchover.
+type bindingSnapshot struct {
+	namespace string
+	name      string
+	clusters  []workv1alpha2.TargetCluster
+}
+
+// snapshotResourceBindings records the bindings claimed by the source policy
+// and their current placement.
+func snapshotResourceBindings(ctx context.Context, karmadaClient karmadaclientset.Interface, namespace, labelKey, permanentID string) ([]bindingSnapshot, error) {
+	bindingList, err := karmadaClient.WorkV1alpha2().ResourceBindings(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{labelKey: permanentID}.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the bindings claimed by the policy: %v", err)
+	}
+	snapshots := make([]bindingSnapshot, 0, len(bindingList.Items))
+	for i := range bindingList.Items {
+		binding := &bindingList.Items[i]
+		snapshots = append(snapshots, bindingSnapshot{
+			namespace: binding.Namespace,
+			name:      binding.Name,
+			clusters:  binding.Spec.Clusters,
+		})
+	}
+	return snapshots, nil
+}
+
+// verifyClaims waits until every recorded binding is claimed by the converted
+// policy and verifies its placement did not change during the switchover.
+func (o *CommandConvertOptions) verifyClaims(ctx context.Context, karmadaClient karmadaclientset.Interface, bindings []bindingSnapshot, claimLabelKey string) error {
+	for _, snapshot := range bindings {
+		snapshot := snapshot
+		err := wait.PollUntilContextTimeout(ctx, time.Second, o.Timeout, true, func(ctx context.Context) (bool, error) {
+			binding, err := karmadaClient.WorkV1alpha2().ResourceBindings(snapshot.namespace).Get(ctx, snapshot.name, metav1.GetOptions{})
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					return false, nil
+				}
+				return false, err
+			}
+			if _, claimed := binding.Labels[claimLabelKey]; !claimed {
+				return false, nil
+			}
+			if !targetClustersEqual(snapshot.clusters, binding.Spec.Clusters) {
+				return false, fmt.Errorf("the placement of ResourceBinding(%s/%s) changed from %s to %s during the switchover",
+					snapshot.namespace, snapshot.name, formatTargetClusters(snapshot.clusters), formatTargetClusters(binding.Spec.Clusters))
+			}
+			return true, nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to verify ResourceBinding(%s/%s): %v", snapshot.namespace, snapshot.name, err)
+		}
+	}
+	return nil
+}
+
+// targetClustersEqual reports whether both placements assign the same
+// replicas to the same clusters, regardless of order.
+func targetClustersEqual(a, b []workv1alpha2.TargetCluster) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	replicas := make(map[string]int32, len(a))
+	for _, cluster := range a {
+		replicas[cluster.Name] = cluster.Replicas
+	}
+	for _, cluster := range b {
+		expected, exist := replicas[cluster.Name]
+		if !exist || expected != cluster.Replicas {
+			return false
+		}
+	}
+	return true
+}
+
+// formatTargetClusters renders the placement for error messages.
+func formatTargetClusters(clusters []workv1alpha2.TargetCluster) string {
+	if len(clusters) == 0 {
+		return "<none>"
+	}
+	names := make([]string, 0, len(clusters))
+	for _, cluster := range clusters {
+		names = append(names, fmt.Sprintf("%s(%d)", cluster.Name, cluster.Replicas))
+	}
+	return strings.Join(names, ",")
+}
+
+// printPolicy prints the converted policy as YAML.
+func printPolicy(out io.Writer, obj interface{}) error {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(data)
+	return err
+}
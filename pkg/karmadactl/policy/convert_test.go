@@ -0,0 +1,177 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+func TestParsePolicyArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantKind policyKind
+		wantName string
+		wantErr  bool
+	}{
+		{name: "slash form", args: []string{"propagationpolicy/nginx"}, wantKind: propagationPolicyKind, wantName: "nginx"},
+		{name: "short alias", args: []string{"cpp/nginx"}, wantKind: clusterPropagationPolicyKind, wantName: "nginx"},
+		{name: "two args", args: []string{"pp", "nginx"}, wantKind: propagationPolicyKind, wantName: "nginx"},
+		{name: "unknown type", args: []string{"deployment/nginx"}, wantErr: true},
+		{name: "missing name", args: []string{"pp"}, wantErr: true},
+		{name: "no args", args: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, name, err := parsePolicyArgs(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parsePolicyArgs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if kind != tt.wantKind || name != tt.wantName {
+				t.Errorf("parsePolicyArgs() = (%q, %q), want (%q, %q)", kind, name, tt.wantKind, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestConvertPropagationPolicyToClustered(t *testing.T) {
+	pp := &policyv1alpha1.PropagationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "nginx",
+			Namespace: "prod",
+			Labels: map[string]string{
+				"team": "web",
+				policyv1alpha1.PropagationPolicyPermanentIDLabel: "some-id",
+			},
+		},
+		Spec: policyv1alpha1.PropagationSpec{
+			ResourceSelectors: []policyv1alpha1.ResourceSelector{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx"},
+				{APIVersion: "v1", Kind: "ConfigMap", Namespace: "prod", Name: "nginx-conf"},
+			},
+		},
+	}
+
+	cpp := convertPropagationPolicyToClustered(pp)
+	if cpp.Name != "nginx" {
+		t.Errorf("name = %q, want %q", cpp.Name, "nginx")
+	}
+	if cpp.Labels["team"] != "web" {
+		t.Error("user label was not preserved")
+	}
+	if _, exist := cpp.Labels[policyv1alpha1.PropagationPolicyPermanentIDLabel]; exist {
+		t.Error("permanent-id label of the source policy was inherited")
+	}
+	for i, selector := range cpp.Spec.ResourceSelectors {
+		if selector.Namespace != "prod" {
+			t.Errorf("selector[%d] namespace = %q, want %q", i, selector.Namespace, "prod")
+		}
+	}
+	// The source must stay untouched.
+	if pp.Spec.ResourceSelectors[0].Namespace != "" {
+		t.Error("conversion modified the source policy")
+	}
+}
+
+func TestConvertClusteredToPropagationPolicy(t *testing.T) {
+	newCPP := func(namespaces ...string) *policyv1alpha1.ClusterPropagationPolicy {
+		cpp := &policyv1alpha1.ClusterPropagationPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "nginx",
+				Labels: map[string]string{policyv1alpha1.ClusterPropagationPolicyPermanentIDLabel: "some-id"},
+			},
+		}
+		for _, namespace := range namespaces {
+			cpp.Spec.ResourceSelectors = append(cpp.Spec.ResourceSelectors, policyv1alpha1.ResourceSelector{
+				APIVersion: "apps/v1", Kind: "Deployment", Namespace: namespace,
+			})
+		}
+		return cpp
+	}
+
+	pp, err := convertClusteredToPropagationPolicy(newCPP("prod", "prod"), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pp.Namespace != "prod" {
+		t.Errorf("namespace = %q, want inferred %q", pp.Namespace, "prod")
+	}
+	if _, exist := pp.Labels[policyv1alpha1.ClusterPropagationPolicyPermanentIDLabel]; exist {
+		t.Error("permanent-id label of the source policy was inherited")
+	}
+
+	if _, err = convertClusteredToPropagationPolicy(newCPP("prod", "staging"), ""); err == nil {
+		t.Error("expected error for selectors targeting multiple namespaces, got nil")
+	}
+
+	if _, err = convertClusteredToPropagationPolicy(newCPP(""), ""); err == nil {
+		t.Error("expected error when the namespace cannot be inferred, got nil")
+	}
+
+	pp, err = convertClusteredToPropagationPolicy(newCPP(""), "staging")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pp.Namespace != "staging" || pp.Spec.ResourceSelectors[0].Namespace != "staging" {
+		t.Errorf("explicit target namespace not applied, got namespace %q and selector namespace %q",
+			pp.Namespace, pp.Spec.ResourceSelectors[0].Namespace)
+	}
+
+	if _, err = convertClusteredToPropagationPolicy(newCPP("prod"), "staging"); err == nil {
+		t.Error("expected error when a selector conflicts with --target-namespace, got nil")
+	}
+}
+
+func TestTargetClustersEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []workv1alpha2.TargetCluster
+		want bool
+	}{
+		{name: "both empty", want: true},
+		{
+			name: "same clusters different order",
+			a:    []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 1}, {Name: "member2", Replicas: 2}},
+			b:    []workv1alpha2.TargetCluster{{Name: "member2", Replicas: 2}, {Name: "member1", Replicas: 1}},
+			want: true,
+		},
+		{
+			name: "different replicas",
+			a:    []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 1}},
+			b:    []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 2}},
+			want: false,
+		},
+		{
+			name: "different clusters",
+			a:    []workv1alpha2.TargetCluster{{Name: "member1"}},
+			b:    []workv1alpha2.TargetCluster{{Name: "member2"}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := targetClustersEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("targetClustersEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
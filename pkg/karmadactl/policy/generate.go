@@ -0,0 +1,380 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+var (
+	generateLong = templates.LongDesc(`
+		Generate a ready-to-apply PropagationPolicy for a resource.
+
+		The command renders the policy from the given placement constraints and
+		replica scheduling strategy and prints it as YAML, it does not apply
+		anything. Placement constraints are cluster label equalities, a value
+		may end with a '*' wildcard which is resolved against the labels of the
+		registered member clusters and pinned as explicit cluster names.`)
+
+	generateExample = templates.Examples(`
+		# Generate a PropagationPolicy propagating deployment/nginx to the clusters labeled zone=us-east
+		%[1]s policy generate --resource deployment/nginx --placement zone=us-east
+
+		# Resolve a wildcard against the registered clusters and divide the replicas by static weights
+		%[1]s policy generate --resource deployment/nginx --placement zone=us-* --replica-scheduling divided:weighted=member1:2,member2:1
+
+		# Duplicate the full replicas to explicitly named clusters and add an OverridePolicy skeleton
+		%[1]s policy generate --resource statefulset/db --clusters member1,member2 --replica-scheduling duplicated --with-override`)
+)
+
+// CommandGenerateOptions contains the input to the policy generate command.
+type CommandGenerateOptions struct {
+	// Namespace is the namespace of the resource and the generated policy.
+	Namespace string
+	// Resource is the target resource in the KIND/NAME form.
+	Resource string
+	// APIVersion overrides the apiVersion of the resource selector for kinds
+	// the command does not know.
+	APIVersion string
+	// Name is the name of the generated policy, defaults to the resource name.
+	Name string
+	// Placement holds cluster label constraints in the key=value form, values
+	// may end with a '*' wildcard.
+	Placement []string
+	// Clusters pins the placement to explicitly named clusters.
+	Clusters []string
+	// ReplicaScheduling is the replica scheduling strategy, e.g. "duplicated",
+	// "divided:aggregated" or "divided:weighted=member1:2,member2:1".
+	ReplicaScheduling string
+	// WithOverride additionally emits an OverridePolicy skeleton.
+	WithOverride bool
+
+	genericiooptions.IOStreams
+}
+
+// NewCmdPolicyGenerate returns the policy generate command.
+func NewCmdPolicyGenerate(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandGenerateOptions{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "generate --resource KIND/NAME [--placement key=value] [--replica-scheduling STRATEGY]",
+		Short:                 "Generate a ready-to-apply PropagationPolicy for a resource",
+		Long:                  generateLong,
+		Example:               fmt.Sprintf(generateExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := o.Complete(f); err != nil {
+				return err
+			}
+			return o.Run(f)
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+	flags.StringVar(&o.Resource, "resource", "", "The resource the policy propagates, in the KIND/NAME form, e.g. deployment/nginx.")
+	flags.StringVar(&o.APIVersion, "api-version", "", "The apiVersion of the resource, only needed for kinds the command does not know.")
+	flags.StringVar(&o.Name, "name", "", "The name of the generated policy. Defaults to the resource name.")
+	flags.StringSliceVar(&o.Placement, "placement", nil, "Cluster label constraints in the key=value form, a value may end with a '*' wildcard which is resolved against the registered clusters, for example: --placement zone=us-*")
+	flags.StringSliceVar(&o.Clusters, "clusters", nil, "Pin the placement to explicitly named member clusters, for example: --clusters member1,member2")
+	flags.StringVar(&o.ReplicaScheduling, "replica-scheduling", "", "The replica scheduling strategy: duplicated, divided, divided:aggregated, divided:weighted or divided:weighted=CLUSTER:WEIGHT[,CLUSTER:WEIGHT].")
+	flags.BoolVar(&o.WithOverride, "with-override", false, "Additionally emit an OverridePolicy skeleton targeting the same resource.")
+	return cmd
+}
+
+// Complete resolves the namespace of the generated policy.
+func (o *CommandGenerateOptions) Complete(f util.Factory) error {
+	namespace, _, err := f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+	o.Namespace = namespace
+	return nil
+}
+
+// Run renders the policy and prints it.
+func (o *CommandGenerateOptions) Run(f util.Factory) error {
+	selector, err := o.resourceSelector()
+	if err != nil {
+		return err
+	}
+
+	placement, err := o.buildPlacement(func(pattern placementConstraint) ([]string, error) {
+		// Wildcards are pinned against the live cluster list, everything else
+		// stays offline.
+		karmadaClient, err := f.KarmadaClientSet()
+		if err != nil {
+			return nil, err
+		}
+		clusterList, err := karmadaClient.ClusterV1alpha1().Clusters().List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list clusters to resolve the placement wildcard %s=%s: %v", pattern.key, pattern.value, err)
+		}
+		var matched []string
+		for _, cluster := range clusterList.Items {
+			if wildcardMatch(pattern.value, cluster.Labels[pattern.key]) {
+				matched = append(matched, cluster.Name)
+			}
+		}
+		sort.Strings(matched)
+		return matched, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	pp := &policyv1alpha1.PropagationPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: policyv1alpha1.GroupVersion.String(),
+			Kind:       "PropagationPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      o.policyName(selector),
+			Namespace: o.Namespace,
+		},
+		Spec: policyv1alpha1.PropagationSpec{
+			ResourceSelectors: []policyv1alpha1.ResourceSelector{selector},
+			Placement:         *placement,
+		},
+	}
+	if err := printPolicy(o.Out, pp); err != nil {
+		return err
+	}
+
+	if !o.WithOverride {
+		return nil
+	}
+	op := &policyv1alpha1.OverridePolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: policyv1alpha1.GroupVersion.String(),
+			Kind:       "OverridePolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      o.policyName(selector),
+			Namespace: o.Namespace,
+		},
+		Spec: policyv1alpha1.OverrideSpec{
+			ResourceSelectors: []policyv1alpha1.ResourceSelector{selector},
+			OverrideRules: []policyv1alpha1.RuleWithCluster{
+				{
+					TargetCluster: pp.Spec.Placement.ClusterAffinity.DeepCopy(),
+					// left empty on purpose, fill in the overriders to apply
+					Overriders: policyv1alpha1.Overriders{},
+				},
+			},
+		},
+	}
+	fmt.Fprintln(o.Out, "---")
+	return printPolicy(o.Out, op)
+}
+
+// policyName returns the name of the generated policies.
+func (o *CommandGenerateOptions) policyName(selector policyv1alpha1.ResourceSelector) string {
+	if o.Name != "" {
+		return o.Name
+	}
+	return strings.ToLower(selector.Name)
+}
+
+// knownKinds maps the kind aliases the command understands to the resource
+// selector it should generate.
+var knownKinds = map[string]policyv1alpha1.ResourceSelector{
+	"deployment":  {APIVersion: "apps/v1", Kind: "Deployment"},
+	"statefulset": {APIVersion: "apps/v1", Kind: "StatefulSet"},
+	"daemonset":   {APIVersion: "apps/v1", Kind: "DaemonSet"},
+	"replicaset":  {APIVersion: "apps/v1", Kind: "ReplicaSet"},
+	"job":         {APIVersion: "batch/v1", Kind: "Job"},
+	"cronjob":     {APIVersion: "batch/v1", Kind: "CronJob"},
+	"pod":         {APIVersion: "v1", Kind: "Pod"},
+	"service":     {APIVersion: "v1", Kind: "Service"},
+	"configmap":   {APIVersion: "v1", Kind: "ConfigMap"},
+	"secret":      {APIVersion: "v1", Kind: "Secret"},
+	"ingress":     {APIVersion: "networking.k8s.io/v1", Kind: "Ingress"},
+}
+
+// resourceSelector parses the --resource argument into a resource selector.
+func (o *CommandGenerateOptions) resourceSelector() (policyv1alpha1.ResourceSelector, error) {
+	parts := strings.SplitN(o.Resource, "/", 2)
+	if o.Resource == "" || len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return policyv1alpha1.ResourceSelector{}, errors.New("the resource must be specified as KIND/NAME by --resource, e.g. --resource deployment/nginx")
+	}
+
+	kindArg := strings.ToLower(strings.TrimSuffix(parts[0], "s"))
+	selector, known := knownKinds[kindArg]
+	if known && o.APIVersion != "" {
+		selector.APIVersion = o.APIVersion
+	}
+	if !known {
+		if o.APIVersion == "" {
+			return policyv1alpha1.ResourceSelector{}, fmt.Errorf("unknown resource kind %q, specify its apiVersion by --api-version", parts[0])
+		}
+		selector = policyv1alpha1.ResourceSelector{APIVersion: o.APIVersion, Kind: parts[0]}
+	}
+	selector.Name = parts[1]
+	selector.Namespace = o.Namespace
+	return selector, nil
+}
+
+// placementConstraint is a single parsed --placement entry.
+type placementConstraint struct {
+	key   string
+	value string
+}
+
+// buildPlacement renders the placement from the placement constraints, the
+// pinned clusters and the replica scheduling strategy. Wildcard constraints
+// are resolved to cluster names via the given resolver.
+func (o *CommandGenerateOptions) buildPlacement(resolveWildcard func(placementConstraint) ([]string, error)) (*policyv1alpha1.Placement, error) {
+	affinity := &policyv1alpha1.ClusterAffinity{ClusterNames: o.Clusters}
+	for _, entry := range o.Placement {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid placement constraint %q, expect the key=value form", entry)
+		}
+		constraint := placementConstraint{key: parts[0], value: parts[1]}
+
+		if !strings.Contains(constraint.value, "*") {
+			if affinity.LabelSelector == nil {
+				affinity.LabelSelector = &metav1.LabelSelector{MatchLabels: map[string]string{}}
+			}
+			affinity.LabelSelector.MatchLabels[constraint.key] = constraint.value
+			continue
+		}
+
+		matched, err := resolveWildcard(constraint)
+		if err != nil {
+			return nil, err
+		}
+		if len(matched) == 0 {
+			return nil, fmt.Errorf("no registered cluster matches the placement constraint %s=%s", constraint.key, constraint.value)
+		}
+		affinity.ClusterNames = append(affinity.ClusterNames, matched...)
+	}
+
+	replicaScheduling, err := parseReplicaScheduling(o.ReplicaScheduling)
+	if err != nil {
+		return nil, err
+	}
+	return &policyv1alpha1.Placement{
+		ClusterAffinity:   affinity,
+		ReplicaScheduling: replicaScheduling,
+	}, nil
+}
+
+// parseReplicaScheduling parses the --replica-scheduling argument, e.g.
+// "duplicated", "divided:aggregated" or "divided:weighted=member1:2,member2:1".
+func parseReplicaScheduling(arg string) (*policyv1alpha1.ReplicaSchedulingStrategy, error) {
+	if arg == "" {
+		return nil, nil
+	}
+
+	schedulingType, preference, _ := strings.Cut(arg, ":")
+	switch strings.ToLower(schedulingType) {
+	case "duplicated":
+		if preference != "" {
+			return nil, fmt.Errorf("the duplicated strategy takes no division preference, got %q", preference)
+		}
+		return &policyv1alpha1.ReplicaSchedulingStrategy{
+			ReplicaSchedulingType: policyv1alpha1.ReplicaSchedulingTypeDuplicated,
+		}, nil
+	case "divided":
+		strategy := &policyv1alpha1.ReplicaSchedulingStrategy{
+			ReplicaSchedulingType: policyv1alpha1.ReplicaSchedulingTypeDivided,
+		}
+		preferenceName, weights, _ := strings.Cut(preference, "=")
+		switch strings.ToLower(preferenceName) {
+		case "":
+			return strategy, nil
+		case "aggregated":
+			strategy.ReplicaDivisionPreference = policyv1alpha1.ReplicaDivisionPreferenceAggregated
+			return strategy, nil
+		case "weighted":
+			strategy.ReplicaDivisionPreference = policyv1alpha1.ReplicaDivisionPreferenceWeighted
+			if weights == "" {
+				// without a weight list the scheduler weights all clusters the same
+				return strategy, nil
+			}
+			weightList, err := parseStaticWeightList(weights)
+			if err != nil {
+				return nil, err
+			}
+			strategy.WeightPreference = &policyv1alpha1.ClusterPreferences{StaticWeightList: weightList}
+			return strategy, nil
+		default:
+			return nil, fmt.Errorf("unsupported replica division preference %q, expect aggregated or weighted", preferenceName)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported replica scheduling strategy %q, expect duplicated or divided", schedulingType)
+	}
+}
+
+// parseStaticWeightList parses a CLUSTER:WEIGHT[,CLUSTER:WEIGHT] list.
+func parseStaticWeightList(weights string) ([]policyv1alpha1.StaticClusterWeight, error) {
+	var weightList []policyv1alpha1.StaticClusterWeight
+	for _, entry := range strings.Split(weights, ",") {
+		cluster, weightArg, found := strings.Cut(entry, ":")
+		if !found || cluster == "" {
+			return nil, fmt.Errorf("invalid static weight %q, expect the CLUSTER:WEIGHT form", entry)
+		}
+		weight, err := strconv.ParseInt(weightArg, 10, 64)
+		if err != nil || weight <= 0 {
+			return nil, fmt.Errorf("invalid weight %q for cluster %s, expect a positive integer", weightArg, cluster)
+		}
+		weightList = append(weightList, policyv1alpha1.StaticClusterWeight{
+			TargetCluster: policyv1alpha1.ClusterAffinity{ClusterNames: []string{cluster}},
+			Weight:        weight,
+		})
+	}
+	return weightList, nil
+}
+
+// wildcardMatch reports whether the value matches the pattern, which may use
+// '*' to match any, possibly empty, sequence of characters.
+func wildcardMatch(pattern, value string) bool {
+	segments := strings.Split(pattern, "*")
+	if len(segments) == 1 {
+		return pattern == value
+	}
+	if !strings.HasPrefix(value, segments[0]) {
+		return false
+	}
+	value = value[len(segments[0]):]
+	for _, segment := range segments[1 : len(segments)-1] {
+		index := strings.Index(value, segment)
+		if index < 0 {
+			return false
+		}
+		value = value[index+len(segment):]
+	}
+	return strings.HasSuffix(value, segments[len(segments)-1])
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+)
+
+func TestResourceSelector(t *testing.T) {
+	tests := []struct {
+		name       string
+		resource   string
+		apiVersion string
+		want       policyv1alpha1.ResourceSelector
+		wantErr    bool
+	}{
+		{
+			name:     "known kind",
+			resource: "deployment/nginx",
+			want:     policyv1alpha1.ResourceSelector{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx", Namespace: "default"},
+		},
+		{
+			name:     "plural alias",
+			resource: "services/frontend",
+			want:     policyv1alpha1.ResourceSelector{APIVersion: "v1", Kind: "Service", Name: "frontend", Namespace: "default"},
+		},
+		{
+			name:       "unknown kind with apiVersion",
+			resource:   "FlinkDeployment/stream",
+			apiVersion: "flink.apache.org/v1beta1",
+			want:       policyv1alpha1.ResourceSelector{APIVersion: "flink.apache.org/v1beta1", Kind: "FlinkDeployment", Name: "stream", Namespace: "default"},
+		},
+		{
+			name:     "unknown kind without apiVersion",
+			resource: "flinkdeployment/stream",
+			wantErr:  true,
+		},
+		{
+			name:     "missing name",
+			resource: "deployment",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &CommandGenerateOptions{Resource: tt.resource, APIVersion: tt.apiVersion, Namespace: "default"}
+			got, err := o.resourceSelector()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resourceSelector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("resourceSelector() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseReplicaScheduling(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     string
+		want    *policyv1alpha1.ReplicaSchedulingStrategy
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			arg:  "",
+			want: nil,
+		},
+		{
+			name: "duplicated",
+			arg:  "duplicated",
+			want: &policyv1alpha1.ReplicaSchedulingStrategy{ReplicaSchedulingType: policyv1alpha1.ReplicaSchedulingTypeDuplicated},
+		},
+		{
+			name: "divided aggregated",
+			arg:  "divided:aggregated",
+			want: &policyv1alpha1.ReplicaSchedulingStrategy{
+				ReplicaSchedulingType:     policyv1alpha1.ReplicaSchedulingTypeDivided,
+				ReplicaDivisionPreference: policyv1alpha1.ReplicaDivisionPreferenceAggregated,
+			},
+		},
+		{
+			name: "divided weighted with static weights",
+			arg:  "divided:weighted=member1:2,member2:1",
+			want: &policyv1alpha1.ReplicaSchedulingStrategy{
+				ReplicaSchedulingType:     policyv1alpha1.ReplicaSchedulingTypeDivided,
+				ReplicaDivisionPreference: policyv1alpha1.ReplicaDivisionPreferenceWeighted,
+				WeightPreference: &policyv1alpha1.ClusterPreferences{
+					StaticWeightList: []policyv1alpha1.StaticClusterWeight{
+						{TargetCluster: policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}}, Weight: 2},
+						{TargetCluster: policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member2"}}, Weight: 1},
+					},
+				},
+			},
+		},
+		{
+			name: "divided weighted without weights",
+			arg:  "divided:weighted",
+			want: &policyv1alpha1.ReplicaSchedulingStrategy{
+				ReplicaSchedulingType:     policyv1alpha1.ReplicaSchedulingTypeDivided,
+				ReplicaDivisionPreference: policyv1alpha1.ReplicaDivisionPreferenceWeighted,
+			},
+		},
+		{
+			name:    "duplicated with preference",
+			arg:     "duplicated:weighted",
+			wantErr: true,
+		},
+		{
+			name:    "negative weight",
+			arg:     "divided:weighted=member1:-1",
+			wantErr: true,
+		},
+		{
+			name:    "unknown strategy",
+			arg:     "spread",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseReplicaScheduling(tt.arg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseReplicaScheduling() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseReplicaScheduling() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildPlacement(t *testing.T) {
+	resolver := func(constraint placementConstraint) ([]string, error) {
+		if constraint.key == "zone" && constraint.value == "us-*" {
+			return []string{"member1", "member2"}, nil
+		}
+		return nil, fmt.Errorf("unexpected wildcard %s=%s", constraint.key, constraint.value)
+	}
+
+	o := &CommandGenerateOptions{Placement: []string{"zone=us-*", "env=prod"}}
+	placement, err := o.buildPlacement(resolver)
+	if err != nil {
+		t.Fatalf("buildPlacement() returned error: %v", err)
+	}
+	if want := []string{"member1", "member2"}; !reflect.DeepEqual(placement.ClusterAffinity.ClusterNames, want) {
+		t.Errorf("buildPlacement() clusterNames = %v, want %v", placement.ClusterAffinity.ClusterNames, want)
+	}
+	if want := map[string]string{"env": "prod"}; !reflect.DeepEqual(placement.ClusterAffinity.LabelSelector.MatchLabels, want) {
+		t.Errorf("buildPlacement() matchLabels = %v, want %v", placement.ClusterAffinity.LabelSelector.MatchLabels, want)
+	}
+
+	o = &CommandGenerateOptions{Placement: []string{"zone"}}
+	if _, err := o.buildPlacement(resolver); err == nil {
+		t.Error("buildPlacement() accepted a constraint without a value")
+	}
+}
+
+func TestWildcardMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		value   string
+		want    bool
+	}{
+		{"us-east", "us-east", true},
+		{"us-east", "us-west", false},
+		{"us-*", "us-east", true},
+		{"us-*", "eu-west", false},
+		{"*-east", "us-east", true},
+		{"us-*-1", "us-east-1", true},
+		{"us-*-1", "us-east-2", false},
+		{"*", "anything", true},
+		{"us-*", "us-", true},
+	}
+	for _, tt := range tests {
+		if got := wildcardMatch(tt.pattern, tt.value); got != tt.want {
+			t.Errorf("wildcardMatch(%q, %q) = %v, want %v", tt.pattern, tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package policy implements the `karmadactl policy` command operating on
+// PropagationPolicies and ClusterPropagationPolicies.
+package policy
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+// NewCmdPolicy returns the policy command operating on propagation policies.
+func NewCmdPolicy(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy SUBCOMMAND",
+		Short: "Operate on PropagationPolicies and ClusterPropagationPolicies",
+		Long:  fmt.Sprintf("Operate on PropagationPolicies and ClusterPropagationPolicies. Run '%s policy SUBCOMMAND --help' for more information.", parentCommand),
+		Run:   cmdutil.DefaultSubCommandRun(streams.ErrOut),
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupAdvancedCommands,
+		},
+	}
+
+	cmd.AddCommand(NewCmdPolicyConvert(f, parentCommand, streams))
+	cmd.AddCommand(NewCmdPolicyGenerate(f, parentCommand, streams))
+	return cmd
+}
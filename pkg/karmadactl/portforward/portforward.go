@@ -0,0 +1,146 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	kubectlportforward "k8s.io/kubectl/pkg/cmd/portforward"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+)
+
+const (
+	// defaultPodPortForwardWaitTimeout is the amount of time to wait until at least one pod is running.
+	defaultPodPortForwardWaitTimeout = 60 * time.Second
+)
+
+var (
+	portForwardLong = templates.LongDesc(`
+		Forward one or more local ports to a pod.
+
+		When the operation scope is members, the connection is established
+		through the cluster proxy of the Karmada control plane, so no member
+		cluster kubeconfig is required. When the target is a service or a
+		workload, a running backing pod is selected automatically.
+
+		Use resource type/name such as deployment/mydeployment to select a pod.
+		Resource type defaults to 'pod' if omitted.`)
+
+	portForwardExample = templates.Examples(`
+		# Listen on port 8080 locally, forwarding to port 80 of the service's backing pod in member cluster 'member2'
+		%[1]s port-forward svc/frontend 8080:80 --operation-scope=members --cluster=member2
+
+		# Listen on port 8888 locally, forwarding to port 5000 of pod mypod in member cluster 'member1'
+		%[1]s port-forward pod/mypod 8888:5000 --operation-scope=members --cluster=member1
+
+		# Listen on a random port locally, forwarding to port 5000 of a pod selected by the deployment on the Karmada control plane
+		%[1]s port-forward deployment/mydeployment :5000`)
+)
+
+// NewCmdPortForward new port-forward command.
+func NewCmdPortForward(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandPortForwardOptions{
+		KubectlPortForwardOptions: kubectlportforward.NewDefaultPortForwardOptions(streams),
+	}
+
+	cmd := &cobra.Command{
+		Use:                   "port-forward TYPE/NAME [options] [LOCAL_PORT:]REMOTE_PORT [...[LOCAL_PORT_N:]REMOTE_PORT_N] (-C CLUSTER)",
+		Short:                 "Forward one or more local ports to a pod in a cluster",
+		Long:                  portForwardLong,
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		Example:               fmt.Sprintf(portForwardExample, parentCommand),
+		ValidArgsFunction:     utilcomp.PodResourceNameCompletionFunc(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(f, cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run()
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterTroubleshootingAndDebugging,
+		},
+	}
+
+	o.OperationScope = options.KarmadaControlPlane
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	options.AddImpersonationFlags(flags)
+	options.AddNamespaceFlag(flags)
+	cmdutil.AddPodRunningTimeoutFlag(cmd, defaultPodPortForwardWaitTimeout)
+
+	flags.StringSliceVar(&o.KubectlPortForwardOptions.Address, "address", []string{"localhost"}, "Addresses to listen on (comma separated). Only accepts IP addresses or localhost as a value. When localhost is supplied, kubectl will try to bind on both 127.0.0.1 and ::1 and will fail if neither of these addresses are available to bind.")
+	flags.VarP(&o.OperationScope, "operation-scope", "s", "Used to control the operation scope of the command. The optional values are karmada and members. Defaults to karmada.")
+	flags.StringVar(&o.Cluster, "cluster", "", "Used to specify a target member cluster and only takes effect when the command's operation scope is members, for example: --operation-scope=members --cluster=member1")
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	utilcomp.RegisterCompletionFuncForOperationScopeFlag(cmd, options.KarmadaControlPlane, options.Members)
+	utilcomp.RegisterCompletionFuncForClusterFlag(cmd)
+
+	return cmd
+}
+
+// CommandPortForwardOptions declare the arguments accepted by the port-forward command
+type CommandPortForwardOptions struct {
+	// flags specific to port-forward
+	KubectlPortForwardOptions *kubectlportforward.PortForwardOptions
+	Cluster                   string
+	OperationScope            options.OperationScope
+}
+
+// Complete verifies command line arguments and loads data from the command environment
+func (o *CommandPortForwardOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
+	var portForwardFactory cmdutil.Factory = f
+	if o.OperationScope == options.Members && len(o.Cluster) != 0 {
+		memberFactory, err := f.FactoryForMemberCluster(o.Cluster)
+		if err != nil {
+			return err
+		}
+		portForwardFactory = memberFactory
+	}
+	return o.KubectlPortForwardOptions.Complete(portForwardFactory, cmd, args)
+}
+
+// Validate checks that the provided port-forward options are specified.
+func (o *CommandPortForwardOptions) Validate() error {
+	err := options.VerifyOperationScopeFlags(o.OperationScope, options.KarmadaControlPlane, options.Members)
+	if err != nil {
+		return err
+	}
+	if o.OperationScope == options.Members && len(o.Cluster) == 0 {
+		return errors.New("must specify a member cluster")
+	}
+	return o.KubectlPortForwardOptions.Validate()
+}
+
+// Run forwards the local ports to the selected pod.
+func (o *CommandPortForwardOptions) Run() error {
+	return o.KubectlPortForwardOptions.RunPortForward()
+}
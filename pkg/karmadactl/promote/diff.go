@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promote
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/yaml"
+
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// printPromotionDiff renders everything promote would create in the control
+// plane, the resource template and the generated policy, and diffs it against
+// anything that already exists. No mutating request is made, so the output
+// can be reviewed, e.g. in a PR, before executing the promotion.
+func (o *CommandPromoteOption) printPromotionDiff(controlPlaneRestConfig *rest.Config, obj *unstructured.Unstructured, gvr schema.GroupVersionResource) error {
+	controlPlaneDynamicClient := dynamicClientBuilder(controlPlaneRestConfig)
+
+	var existing *unstructured.Unstructured
+	var err error
+	if len(obj.GetNamespace()) == 0 {
+		existing, err = controlPlaneDynamicClient.Resource(gvr).Get(context.TODO(), o.name, metav1.GetOptions{})
+	} else {
+		existing, err = controlPlaneDynamicClient.Resource(gvr).Namespace(o.Namespace).Get(context.TODO(), o.name, metav1.GetOptions{})
+	}
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get resource %q(%s/%s) in control plane: %v", gvr, o.Namespace, o.name, err)
+		}
+		existing = nil
+	}
+	var existingTemplate interface{}
+	if existing != nil {
+		// Normalize the stored object the same way the promoted one was, so
+		// only meaningful differences show up.
+		existing = existing.DeepCopy()
+		if err := preprocessResource(existing); err != nil {
+			return fmt.Errorf("failed to preprocess existing resource %q(%s/%s): %v", gvr, o.Namespace, o.name, err)
+		}
+		existingTemplate = existing.Object
+	}
+	if err := printDiffSection(os.Stdout, fmt.Sprintf("resource %q(%s/%s)", gvr, o.Namespace, o.name),
+		existing != nil, existingTemplate, obj.Object, obj.Object); err != nil {
+		return err
+	}
+
+	if !o.AutoCreatePolicy {
+		return nil
+	}
+
+	karmadaClient := karmadaClientBuilder(controlPlaneRestConfig)
+	if len(obj.GetNamespace()) == 0 {
+		policyName := o.PolicyName
+		if policyName == "" {
+			policyName = names.GeneratePolicyName("", o.name, o.gvk.String())
+		}
+		desired := buildClusterPropagationPolicy(o.name, policyName, o.Cluster, gvr, o.gvk, o.Deps)
+		existingPolicy, err := karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Get(context.TODO(), policyName, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get ClusterPropagationPolicy(%s) in control plane: %v", policyName, err)
+		}
+		var existingSpec interface{}
+		if err == nil {
+			existingSpec = existingPolicy.Spec
+		}
+		return printDiffSection(os.Stdout, fmt.Sprintf("ClusterPropagationPolicy(%s)", policyName), err == nil, existingSpec, desired.Spec, desired)
+	}
+
+	policyName := o.PolicyName
+	if policyName == "" {
+		policyName = names.GeneratePolicyName(o.Namespace, o.name, o.gvk.String())
+	}
+	desired := buildPropagationPolicy(o.name, policyName, o.Namespace, o.Cluster, gvr, o.gvk, o.Deps)
+	existingPolicy, err := karmadaClient.PolicyV1alpha1().PropagationPolicies(o.Namespace).Get(context.TODO(), policyName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get PropagationPolicy(%s/%s) in control plane: %v", o.Namespace, policyName, err)
+	}
+	var existingSpec interface{}
+	if err == nil {
+		existingSpec = existingPolicy.Spec
+	}
+	return printDiffSection(os.Stdout, fmt.Sprintf("PropagationPolicy(%s/%s)", o.Namespace, policyName), err == nil, existingSpec, desired.Spec, desired)
+}
+
+// printDiffSection prints one reviewable section: the full rendered manifest
+// when the object does not exist yet, or the difference between existing and
+// desired when it does. Policies are compared by spec since the stored ones
+// carry server-populated metadata.
+func printDiffSection(out io.Writer, title string, exists bool, existing, desired, rendered interface{}) error {
+	fmt.Fprintf(out, "--- %s\n", title)
+	if !exists {
+		renderedYAML, err := yaml.Marshal(rendered)
+		if err != nil {
+			return fmt.Errorf("failed to render %s: %v", title, err)
+		}
+		fmt.Fprintf(out, "(not found in the control plane, will be created)\n%s\n", renderedYAML)
+		return nil
+	}
+	if diff := cmp.Diff(existing, desired); len(diff) != 0 {
+		fmt.Fprintf(out, "%s\n", diff)
+		return nil
+	}
+	fmt.Fprintf(out, "(no changes)\n\n")
+	return nil
+}
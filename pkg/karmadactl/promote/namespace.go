@@ -0,0 +1,221 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promote
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/utils/ptr"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	u "github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// derivedResources are resources whose content is generated from other
+// objects and therefore must not be promoted as templates.
+var derivedResources = sets.New(
+	schema.GroupResource{Group: "", Resource: "events"},
+	schema.GroupResource{Group: "events.k8s.io", Resource: "events"},
+	schema.GroupResource{Group: "", Resource: "endpoints"},
+	schema.GroupResource{Group: "discovery.k8s.io", Resource: "endpointslices"},
+)
+
+// promotedObject records a resource created in the control plane so a failed
+// batch promotion can be reverted.
+type promotedObject struct {
+	gvr  schema.GroupVersionResource
+	kind string
+	name string
+}
+
+// promoteAllNamespaceResources enumerates every namespaced resource of the
+// promoting namespace in the member cluster, promotes them to the control
+// plane, and generates a single PropagationPolicy that keeps them placed on
+// the member cluster. Already promoted objects are deleted again if any later
+// step fails, so the batch either lands as a whole or not at all.
+func (o *CommandPromoteOption) promoteAllNamespaceResources(memberClusterFactory cmdutil.Factory, controlPlaneRestConfig *rest.Config) error {
+	if o.DryRun {
+		return nil
+	}
+
+	memberDiscovery, err := memberClusterFactory.ToDiscoveryClient()
+	if err != nil {
+		return fmt.Errorf("failed to get discovery client of member cluster: %v", err)
+	}
+	resourceLists, err := memberDiscovery.ServerPreferredNamespacedResources()
+	if err != nil {
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			return fmt.Errorf("failed to discover namespaced resources in cluster(%s): %v", o.Cluster, err)
+		}
+		klog.Warningf("Partial discovery failure in cluster(%s): %v", o.Cluster, err)
+	}
+	memberDynamicClient, err := memberClusterFactory.DynamicClient()
+	if err != nil {
+		return fmt.Errorf("failed to get dynamic client of member cluster: %v", err)
+	}
+	controlPlaneDynamicClient := dynamicClientBuilder(controlPlaneRestConfig)
+
+	var promoted []promotedObject
+	for _, resourceList := range resourceLists {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for i := range resourceList.APIResources {
+			apiResource := resourceList.APIResources[i]
+			if strings.Contains(apiResource.Name, "/") ||
+				!sets.New(apiResource.Verbs...).HasAll("list", "create") ||
+				derivedResources.Has(schema.GroupResource{Group: gv.Group, Resource: apiResource.Name}) {
+				continue
+			}
+			gvr := gv.WithResource(apiResource.Name)
+			objList, err := memberDynamicClient.Resource(gvr).Namespace(o.name).List(context.TODO(), metav1.ListOptions{})
+			if err != nil {
+				o.revertPromotedObjects(controlPlaneDynamicClient, promoted)
+				return fmt.Errorf("failed to list %q in namespace %s of cluster(%s): %v", gvr, o.name, o.Cluster, err)
+			}
+			for j := range objList.Items {
+				obj := &objList.Items[j]
+				// Objects owned by other objects will be recreated by their
+				// controllers, and objects already managed by Karmada must
+				// not be promoted twice.
+				if len(obj.GetOwnerReferences()) > 0 || obj.GetLabels()[u.ManagedByKarmadaLabel] == u.ManagedByKarmadaLabelValue {
+					continue
+				}
+				created, err := o.promoteNamespacedObject(controlPlaneDynamicClient, gvr, obj)
+				if err != nil {
+					o.revertPromotedObjects(controlPlaneDynamicClient, promoted)
+					return err
+				}
+				if created {
+					promoted = append(promoted, promotedObject{gvr: gvr, kind: apiResource.Kind, name: obj.GetName()})
+					fmt.Printf("Resource %q(%s/%s) is promoted successfully\n", gvr, o.name, obj.GetName())
+				}
+			}
+		}
+	}
+
+	if len(promoted) == 0 {
+		fmt.Printf("No resource of namespace %s needs to be promoted\n", o.name)
+		return nil
+	}
+
+	if o.AutoCreatePolicy {
+		policyName, err := o.createNamespacePropagationPolicy(controlPlaneRestConfig, promoted)
+		if err != nil {
+			o.revertPromotedObjects(controlPlaneDynamicClient, promoted)
+			return err
+		}
+		fmt.Printf("PropagationPolicy (%s/%s) covering %d promoted resource(s) is created successfully\n", o.name, policyName, len(promoted))
+	}
+
+	return nil
+}
+
+// promoteNamespacedObject creates the given object in the control plane,
+// reporting whether it was actually created. Objects that already exist in
+// the control plane are left untouched.
+func (o *CommandPromoteOption) promoteNamespacedObject(controlPlaneDynamicClient dynamic.Interface, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) (bool, error) {
+	_, err := controlPlaneDynamicClient.Resource(gvr).Namespace(o.name).Get(context.TODO(), obj.GetName(), metav1.GetOptions{})
+	if err == nil {
+		klog.Warningf("Resource %q(%s/%s) already exist in karmada control plane, skip promoting it\n", gvr, o.name, obj.GetName())
+		return false, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("failed to get resource %q(%s/%s) in control plane: %v", gvr, o.name, obj.GetName(), err)
+	}
+
+	if err := preprocessResource(obj); err != nil {
+		return false, fmt.Errorf("failed to preprocess resource %q(%s/%s): %v", gvr, o.name, obj.GetName(), err)
+	}
+	if _, err := controlPlaneDynamicClient.Resource(gvr).Namespace(o.name).Create(context.TODO(), obj, metav1.CreateOptions{}); err != nil {
+		return false, fmt.Errorf("failed to create resource %q(%s/%s) in control plane: %v", gvr, o.name, obj.GetName(), err)
+	}
+	return true, nil
+}
+
+// createNamespacePropagationPolicy creates one PropagationPolicy selecting
+// all promoted resources, placed on the member cluster they came from.
+func (o *CommandPromoteOption) createNamespacePropagationPolicy(controlPlaneRestConfig *rest.Config, promoted []promotedObject) (string, error) {
+	karmadaClient := karmadaClientBuilder(controlPlaneRestConfig)
+
+	policyName := o.PolicyName
+	if policyName == "" {
+		policyName = names.GeneratePolicyName(o.name, o.name, o.gvk.String())
+	}
+
+	selectors := make([]policyv1alpha1.ResourceSelector, 0, len(promoted))
+	for _, p := range promoted {
+		selectors = append(selectors, policyv1alpha1.ResourceSelector{
+			APIVersion: p.gvr.GroupVersion().String(),
+			Kind:       p.kind,
+			Namespace:  o.name,
+			Name:       p.name,
+		})
+	}
+	pp := &policyv1alpha1.PropagationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      policyName,
+			Namespace: o.name,
+		},
+		Spec: policyv1alpha1.PropagationSpec{
+			PropagateDeps:     o.Deps,
+			ResourceSelectors: selectors,
+			Placement: policyv1alpha1.Placement{
+				ClusterAffinity: &policyv1alpha1.ClusterAffinity{
+					ClusterNames: []string{o.Cluster},
+				},
+			},
+			ConflictResolution:          policyv1alpha1.ConflictOverwrite,
+			PreserveResourcesOnDeletion: ptr.To[bool](true),
+		},
+	}
+
+	_, err := karmadaClient.PolicyV1alpha1().PropagationPolicies(o.name).Create(context.TODO(), pp, metav1.CreateOptions{})
+	if err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return policyName, fmt.Errorf("the PropagationPolicy(%s/%s) already exist, please edit it to propagate the promoted resources", o.name, policyName)
+		}
+		return policyName, fmt.Errorf("failed to create PropagationPolicy(%s/%s) in control plane: %v", o.name, policyName, err)
+	}
+	return policyName, nil
+}
+
+// revertPromotedObjects deletes the resources a failed batch promotion has
+// already created in the control plane.
+func (o *CommandPromoteOption) revertPromotedObjects(controlPlaneDynamicClient dynamic.Interface, promoted []promotedObject) {
+	for _, p := range promoted {
+		if err := controlPlaneDynamicClient.Resource(p.gvr).Namespace(o.name).Delete(context.TODO(), p.name, metav1.DeleteOptions{}); err != nil {
+			klog.Errorf("Failed to revert promoted resource %q(%s/%s) in control plane: %v", p.gvr, o.name, p.name, err)
+			continue
+		}
+		fmt.Printf("Promoted resource %q(%s/%s) is reverted successfully\n", p.gvr, o.name, p.name)
+	}
+}
@@ -81,12 +81,23 @@ var (
 		# Dumps the artifacts but does not deploy them to Karmada, same as 'dry run'
 		%[1]s promote deployment nginx -n default -C cluster1 -o yaml|json
 
+		# Render the artifacts and the diff against anything already existing in
+		# the control plane, for review before executing the promotion
+		%[1]s promote deployment nginx -n default -C cluster1 --dry-run -o diff
+
 		# Promote secret(default/default-token) from cluster1 to Karmada
 		%[1]s promote secret default-token -n default -C cluster1
 
+		# Promote namespace(test) together with every namespaced resource it contains,
+		# generating a single PropagationPolicy that keeps them placed on cluster1
+		%[1]s promote namespace test -C cluster1 --all-resources
+
 		# Support to use '--dependencies=true' or '-d=true' to promote resource with its dependencies automatically, default to false
 		%[1]s promote deployment nginx -n default -C cluster1 -d=true
 
+		# Promote deployment(default/nginx) along with the ConfigMaps, Secrets and ServiceAccounts it references
+		%[1]s promote deployment nginx -n default -C cluster1 --with-dependencies
+
 		# Support to use '--cluster-kubeconfig' to specify the configuration of member cluster
 		%[1]s promote deployment nginx -n default -C cluster1 --cluster-kubeconfig=<CLUSTER_KUBECONFIG_PATH>
 
@@ -164,6 +175,14 @@ type CommandPromoteOption struct {
 	// Deps tells if promote resource with its dependencies automatically, default to false
 	Deps bool
 
+	// WithDeps is an alias of Deps, kept as a separate field so either flag
+	// spelling can enable dependency promotion.
+	WithDeps bool
+
+	// AllResources tells if promote every namespaced resource contained in
+	// the promoting namespace, only valid when promoting a Namespace.
+	AllResources bool
+
 	// DryRun tells if run the command in dry-run mode, without making any server requests.
 	DryRun bool
 
@@ -194,7 +213,7 @@ func (o *CommandPromoteOption) AddFlags(flags *pflag.FlagSet) {
 		"Automatically create a PropagationPolicy for namespace-scoped resources or create a ClusterPropagationPolicy for cluster-scoped resources.")
 	flags.StringVar(&o.PolicyName, "policy-name", "",
 		"The name of the PropagationPolicy(or ClusterPropagationPolicy) that is automatically created after promotion. If not specified, the name will be the resource name with a hash suffix that is generated by resource metadata.")
-	flags.StringVarP(&o.OutputFormat, "output", "o", "", "Output format. One of: json|yaml")
+	flags.StringVarP(&o.OutputFormat, "output", "o", "", "Output format. One of: json|yaml|diff")
 
 	flags.StringVarP(&o.Cluster, "cluster", "C", "", "the name of legacy cluster (eg -C=member1)")
 	flags.StringVar(&o.ClusterContext, "cluster-context", "",
@@ -202,7 +221,9 @@ func (o *CommandPromoteOption) AddFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.ClusterKubeConfig, "cluster-kubeconfig", "",
 		"Path of the legacy cluster's kubeconfig.")
 	flags.BoolVarP(&o.Deps, "dependencies", "d", false, "Promote resource with its dependencies automatically, default to false")
+	flags.BoolVar(&o.WithDeps, "with-dependencies", false, "Promote the resource together with the dependencies (e.g. ConfigMaps, Secrets, ServiceAccounts) discovered by the resource interpreter's InterpretDependency hook. Alias of --dependencies.")
 	flags.BoolVar(&o.DryRun, "dry-run", false, "Run the command in dry-run mode, without making any server requests.")
+	flags.BoolVar(&o.AllResources, "all-resources", false, "Promote every namespaced resource contained in the promoting namespace and generate a single PropagationPolicy preserving the current placement. Only valid when promoting a Namespace.")
 }
 
 // Complete ensures that options are valid and marshals them if necessary
@@ -242,6 +263,12 @@ func (o *CommandPromoteOption) Complete(f util.Factory, args []string) error {
 		o.ClusterContext = o.Cluster
 	}
 
+	// '--with-dependencies' is an alias of '--dependencies', either enables
+	// dependency promotion.
+	if o.WithDeps {
+		o.Deps = true
+	}
+
 	return nil
 }
 
@@ -251,8 +278,12 @@ func (o *CommandPromoteOption) Validate() error {
 		return errors.New("the cluster cannot be empty")
 	}
 
-	if o.OutputFormat != "" && o.OutputFormat != "yaml" && o.OutputFormat != "json" {
-		return errors.New("invalid output format: supported formats are json and yaml")
+	if o.OutputFormat != "" && o.OutputFormat != "yaml" && o.OutputFormat != "json" && o.OutputFormat != "diff" {
+		return errors.New("invalid output format: supported formats are json, yaml and diff")
+	}
+
+	if o.AllResources && o.OutputFormat != "" {
+		return errors.New("--all-resources can not be used together with --output")
 	}
 
 	return nil
@@ -300,6 +331,12 @@ func (o *CommandPromoteOption) Run(f util.Factory, args []string) error {
 		return fmt.Errorf("failed to get gvr from %q: %v", o.gvk, err)
 	}
 
+	if o.AllResources {
+		if o.gvk.Group != "" || o.gvk.Kind != "Namespace" {
+			return fmt.Errorf("--all-resources is only valid when promoting a Namespace, got %q", o.gvk)
+		}
+	}
+
 	if o.Deps {
 		err := o.promoteDeps(memberClusterFactory, obj, mapper, gvr, controlPlaneRestConfig)
 		if err != nil {
@@ -307,7 +344,17 @@ func (o *CommandPromoteOption) Run(f util.Factory, args []string) error {
 		}
 	}
 
-	return o.promote(controlPlaneRestConfig, obj, gvr)
+	if err := o.promote(controlPlaneRestConfig, obj, gvr); err != nil {
+		return err
+	}
+
+	if o.AllResources {
+		if err := o.promoteAllNamespaceResources(memberClusterFactory, controlPlaneRestConfig); err != nil {
+			return fmt.Errorf("failed to promote resources of namespace %s: %v", o.name, err)
+		}
+	}
+
+	return nil
 }
 
 // revertPromotedDeps reverts promoted dependencies of the resource
@@ -448,7 +495,7 @@ func (o *CommandPromoteOption) doPromoteDeps(memberClusterFactory cmdutil.Factor
 }
 
 func (o *CommandPromoteOption) promoteDeps(memberClusterFactory cmdutil.Factory, obj *unstructured.Unstructured, mapper meta.RESTMapper, gvr schema.GroupVersionResource, config *rest.Config) error {
-	if o.DryRun {
+	if o.DryRun || o.OutputFormat == "diff" {
 		return nil
 	}
 	// create resource interpreter
@@ -536,6 +583,12 @@ func (o *CommandPromoteOption) promote(controlPlaneRestConfig *rest.Config, obj
 		return fmt.Errorf("failed to preprocess resource %q(%s/%s) in control plane: %v", gvr, o.Namespace, o.name, err)
 	}
 
+	if o.OutputFormat == "diff" {
+		// render the artifacts and the diff against anything existing, without
+		// making any mutating request
+		return o.printPromotionDiff(controlPlaneRestConfig, obj, gvr)
+	}
+
 	if o.OutputFormat != "" {
 		// only print the resource template and Policy
 		err := o.printObjectAndPolicy(obj, gvr)
@@ -0,0 +1,173 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package raw
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/karmada-io/karmada/pkg/clientfanout"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+)
+
+const proxyURL = "/apis/cluster.karmada.io/v1alpha1/clusters/%s/proxy/"
+
+var (
+	rawLong = templates.LongDesc(`
+		Issue an arbitrary GET against the Karmada apiserver, or against every
+		given member cluster apiserver through the cluster proxy with the
+		responses keyed by cluster.
+
+		This is useful for hitting non-resource endpoints such as /metrics,
+		/healthz or custom aggregated APIs fleet-wide without juggling the
+		member cluster kubeconfigs.`)
+
+	rawExample = templates.Examples(`
+		# Get the readiness of the Karmada apiserver
+		%[1]s raw /readyz
+
+		# Get the metrics of member clusters member1 and member2
+		%[1]s raw /metrics --operation-scope=members --clusters=member1,member2
+
+		# Get the health of the kubelet on a node of member cluster member1
+		%[1]s raw /api/v1/nodes/node1/proxy/healthz --operation-scope=members --clusters=member1`)
+)
+
+// CommandRawOptions declares the arguments accepted by the raw command.
+type CommandRawOptions struct {
+	// OperationScope is the operation scope of the command.
+	OperationScope options.OperationScope
+	// Clusters is the names of the target member clusters, and only takes
+	// effect when the operation scope is members.
+	Clusters []string
+	// Path is the URI to GET, e.g. /metrics.
+	Path string
+
+	genericiooptions.IOStreams
+}
+
+// NewCmdRaw returns new initialized instance of raw sub command.
+func NewCmdRaw(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandRawOptions{
+		OperationScope: options.KarmadaControlPlane,
+		IOStreams:      streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:                   "raw URI (-C CLUSTER,...)",
+		Short:                 "Issue an arbitrary GET against the Karmada apiserver or member cluster apiservers",
+		Long:                  rawLong,
+		Example:               fmt.Sprintf(rawExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			o.Path = args[0]
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(f)
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterTroubleshootingAndDebugging,
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	flags.VarP(&o.OperationScope, "operation-scope", "s", "Used to control the operation scope of the command. The optional values are karmada and members. Defaults to karmada.")
+	flags.StringSliceVarP(&o.Clusters, "clusters", "C", []string{}, "Used to specify target member clusters and only takes effect when the command's operation scope is members, for example: --operation-scope=members --clusters=member1,member2")
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForOperationScopeFlag(cmd, options.KarmadaControlPlane, options.Members)
+	utilcomp.RegisterCompletionFuncForClustersFlag(cmd)
+	return cmd
+}
+
+// Validate checks that the provided raw options are specified.
+func (o *CommandRawOptions) Validate() error {
+	if !strings.HasPrefix(o.Path, "/") {
+		return fmt.Errorf("uri must begin with '/', got %q", o.Path)
+	}
+	err := options.VerifyOperationScopeFlags(o.OperationScope, options.KarmadaControlPlane, options.Members)
+	if err != nil {
+		return err
+	}
+	if o.OperationScope == options.Members && len(o.Clusters) == 0 {
+		return errors.New("must specify member clusters by --clusters")
+	}
+	return nil
+}
+
+// Run issues the GET against the Karmada apiserver or every target member
+// cluster apiserver through the cluster proxy.
+func (o *CommandRawOptions) Run(f util.Factory) error {
+	restClient, err := f.RESTClient()
+	if err != nil {
+		return err
+	}
+
+	if o.OperationScope == options.KarmadaControlPlane {
+		body, err := restClient.Get().RequestURI(o.Path).DoRaw(context.TODO())
+		if err != nil {
+			return err
+		}
+		writeBody(o.Out, body)
+		return nil
+	}
+
+	results := clientfanout.Invoke(context.TODO(), f, o.Clusters, clientfanout.Options{},
+		func(ctx context.Context, cluster string, _ cmdutil.Factory) ([]byte, error) {
+			return restClient.Get().RequestURI(proxyPath(cluster, o.Path)).DoRaw(ctx)
+		})
+	for _, result := range results {
+		if result.Err != nil {
+			continue
+		}
+		if len(o.Clusters) > 1 {
+			fmt.Fprintf(o.Out, "# cluster: %s\n", result.Cluster)
+		}
+		writeBody(o.Out, result.Value)
+	}
+	return clientfanout.AggregateErrors(results)
+}
+
+// proxyPath translates the URI into the cluster proxy path of the member
+// cluster apiserver.
+func proxyPath(cluster, path string) string {
+	return fmt.Sprintf(proxyURL, cluster) + strings.TrimPrefix(path, "/")
+}
+
+// writeBody prints the response body with a guaranteed trailing newline, so
+// the per-cluster sections stay separated.
+func writeBody(out io.Writer, body []byte) {
+	_, _ = out.Write(body)
+	if !bytes.HasSuffix(body, []byte("\n")) {
+		_, _ = out.Write([]byte("\n"))
+	}
+}
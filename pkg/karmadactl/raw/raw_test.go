@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package raw
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    CommandRawOptions
+		wantErr bool
+	}{
+		{
+			name: "control plane path",
+			opts: CommandRawOptions{OperationScope: options.KarmadaControlPlane, Path: "/readyz"},
+		},
+		{
+			name:    "path without leading slash",
+			opts:    CommandRawOptions{OperationScope: options.KarmadaControlPlane, Path: "readyz"},
+			wantErr: true,
+		},
+		{
+			name:    "members scope without clusters",
+			opts:    CommandRawOptions{OperationScope: options.Members, Path: "/metrics"},
+			wantErr: true,
+		},
+		{
+			name: "members scope with clusters",
+			opts: CommandRawOptions{OperationScope: options.Members, Path: "/metrics", Clusters: []string{"member1"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.opts.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProxyPath(t *testing.T) {
+	want := "/apis/cluster.karmada.io/v1alpha1/clusters/member1/proxy/metrics"
+	if got := proxyPath("member1", "/metrics"); got != want {
+		t.Errorf("proxyPath() = %q, want %q", got, want)
+	}
+}
+
+func TestWriteBody(t *testing.T) {
+	out := &bytes.Buffer{}
+	writeBody(out, []byte("ok"))
+	writeBody(out, []byte("done\n"))
+	if got := out.String(); got != "ok\ndone\n" {
+		t.Errorf("writeBody() output = %q, want %q", got, "ok\ndone\n")
+	}
+}
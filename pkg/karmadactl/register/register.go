@@ -36,6 +36,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	k8srand "k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/apimachinery/pkg/util/wait"
 	kubeclient "k8s.io/client-go/kubernetes"
@@ -55,6 +56,7 @@ import (
 	"github.com/karmada-io/karmada/pkg/karmadactl/util/apiclient"
 	tokenutil "github.com/karmada-io/karmada/pkg/karmadactl/util/bootstraptoken"
 	karmadautil "github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/lifted"
 	"github.com/karmada-io/karmada/pkg/util/lifted/pubkeypin"
 	"github.com/karmada-io/karmada/pkg/util/names"
 	"github.com/karmada-io/karmada/pkg/version"
@@ -183,6 +185,8 @@ func NewCmdRegister(parentCommand string) *cobra.Command {
 	flags.StringVar(&opts.ClusterProvider, "cluster-provider", "", "Provider of the joining cluster. The Karmada scheduler can use this information to spread workloads across providers for higher availability.")
 	flags.StringVar(&opts.ClusterRegion, "cluster-region", "", "The region of the joining cluster. The Karmada scheduler can use this information to spread workloads across regions for higher availability.")
 	flags.StringSliceVar(&opts.ClusterZones, "cluster-zones", []string{}, "The zones of the joining cluster. The Karmada scheduler can use this information to spread workloads across zones for higher availability.")
+	flags.StringToStringVar(&opts.ClusterLabels, "cluster-labels", nil, "Labels to be written to the Cluster object, e.g. 'env=prod,tier=frontend'.")
+	flags.StringSliceVar(&opts.ClusterTaints, "cluster-taints", nil, "Taints to be written to the Cluster object, in the form 'key=value:Effect', e.g. 'dedicated=db:NoSchedule'.")
 	flags.BoolVar(&opts.EnableCertRotation, "enable-cert-rotation", false, "Enable means controller would rotate certificate for karmada-agent when the certificate is about to expire.")
 	// nolint: errcheck
 	flags.StringVar(&opts.BootstrapToken.Token, "token", "", "For token-based discovery, the token used to validate cluster information fetched from the API server.")
@@ -225,6 +229,13 @@ type CommandRegisterOption struct {
 	// ClusterZones represents the zones of the cluster locate in.
 	ClusterZones []string
 
+	// ClusterLabels is the labels to be written to the Cluster object.
+	ClusterLabels map[string]string
+
+	// ClusterTaints is the taints to be written to the Cluster object, in the
+	// form 'key=value:Effect'.
+	ClusterTaints []string
+
 	// EnableCertRotation indicates if enable certificate rotation for karmada-agent.
 	EnableCertRotation bool
 
@@ -308,6 +319,14 @@ func (o *CommandRegisterOption) Validate() error {
 		return fmt.Errorf("need to verify CACertHashes, or set --discovery-token-unsafe-skip-ca-verification=true")
 	}
 
+	_, taintsToRemove, err := lifted.ParseTaints(o.ClusterTaints)
+	if err != nil {
+		return fmt.Errorf("failed to parse cluster taints: %v", err)
+	}
+	if len(taintsToRemove) > 0 {
+		return fmt.Errorf("--cluster-taints does not support removing taints")
+	}
+
 	return nil
 }
 
@@ -1026,6 +1045,8 @@ func (o *CommandRegisterOption) makeKarmadaAgentDeployment() *appsv1.Deployment
 					fmt.Sprintf("--cluster-provider=%s", o.ClusterProvider),
 					fmt.Sprintf("--cluster-region=%s", o.ClusterRegion),
 					fmt.Sprintf("--cluster-zones=%s", strings.Join(o.ClusterZones, ",")),
+					fmt.Sprintf("--cluster-labels=%s", labels.Set(o.ClusterLabels).String()),
+					fmt.Sprintf("--cluster-taints=%s", strings.Join(o.ClusterTaints, ",")),
 					fmt.Sprintf("--controllers=%s", strings.Join(controllers, ",")),
 					fmt.Sprintf("--proxy-server-address=%s", o.ProxyServerAddress),
 					fmt.Sprintf("--leader-elect-resource-namespace=%s", o.Namespace),
@@ -0,0 +1,278 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reschedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+)
+
+var (
+	rescheduleLong = templates.LongDesc(`
+		Trigger rescheduling of a specific ResourceBinding or ClusterResourceBinding.
+
+		The command sets the spec.rescheduleTriggeredAt field of the binding, which
+		instructs the scheduler to do a complete recalculation without referring to
+		the last scheduling results. It then waits for the scheduler to finish the
+		new placement decision and prints a before/after cluster diff.`)
+
+	rescheduleExample = templates.Examples(`
+		# Trigger rescheduling of resourcebinding "nginx-deployment" in namespace "default"
+		%[1]s reschedule binding nginx-deployment -n default
+
+		# Trigger rescheduling of clusterresourcebinding "example-crd"
+		%[1]s reschedule clusterbinding example-crd
+
+		# Trigger rescheduling without waiting for the new placement decision
+		%[1]s reschedule binding nginx-deployment --wait=false`)
+)
+
+var (
+	bindingAliases        = sets.New[string]("binding", "resourcebinding", "rb")
+	clusterBindingAliases = sets.New[string]("clusterbinding", "clusterresourcebinding", "crb")
+)
+
+// NewCmdReschedule defines the `reschedule` command that triggers rescheduling of a binding.
+func NewCmdReschedule(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	opts := CommandRescheduleOption{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "reschedule (binding | clusterbinding) NAME",
+		Short:                 "Trigger rescheduling of a ResourceBinding or ClusterResourceBinding",
+		Long:                  rescheduleLong,
+		Example:               fmt.Sprintf(rescheduleExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := opts.Complete(f, args); err != nil {
+				return err
+			}
+			return opts.Run()
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupAdvancedCommands,
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+	flags.BoolVar(&opts.Wait, "wait", true, "Wait for the scheduler to finish the new placement decision and print a before/after cluster diff.")
+	flags.DurationVar(&opts.Timeout, "timeout", 60*time.Second, "The length of time to wait for the new placement decision before giving up.")
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	return cmd
+}
+
+// CommandRescheduleOption holds all command options for reschedule.
+type CommandRescheduleOption struct {
+	// Name is the name of the target binding.
+	Name string
+
+	// Namespace is the namespace of the target ResourceBinding,
+	// ignored for ClusterResourceBinding.
+	Namespace string
+
+	// ClusterScoped indicates the target is a ClusterResourceBinding.
+	ClusterScoped bool
+
+	// Wait tells whether to wait for the new placement decision.
+	Wait bool
+
+	// Timeout is the max amount of time to wait for the new placement decision.
+	Timeout time.Duration
+
+	karmadaClient karmadaclientset.Interface
+
+	genericiooptions.IOStreams
+}
+
+// Complete ensures that options are valid and marshals them if necessary.
+func (o *CommandRescheduleOption) Complete(f util.Factory, args []string) error {
+	if len(args) != 2 {
+		return errors.New("binding kind and name are required, e.g. 'reschedule binding NAME'")
+	}
+
+	kind := strings.ToLower(args[0])
+	switch {
+	case bindingAliases.Has(kind):
+		o.ClusterScoped = false
+	case clusterBindingAliases.Has(kind):
+		o.ClusterScoped = true
+	default:
+		return fmt.Errorf("unsupported kind %q, only binding and clusterbinding are supported", args[0])
+	}
+	o.Name = args[1]
+
+	var err error
+	o.Namespace, _, err = f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+
+	o.karmadaClient, err = f.KarmadaClientSet()
+	return err
+}
+
+// Run triggers rescheduling of the target binding and optionally waits for the result.
+func (o *CommandRescheduleOption) Run() error {
+	beforeClusters, err := o.scheduledClusters()
+	if err != nil {
+		return err
+	}
+
+	triggeredAt := metav1.Now()
+	patchBytes := []byte(fmt.Sprintf(`{"spec":{"rescheduleTriggeredAt":%q}}`, triggeredAt.UTC().Format(time.RFC3339)))
+	if err = o.patchBinding(patchBytes); err != nil {
+		return fmt.Errorf("failed to trigger rescheduling of %s: %v", o.targetString(), err)
+	}
+	fmt.Fprintf(o.Out, "%s rescheduling triggered\n", o.targetString())
+
+	if !o.Wait {
+		return nil
+	}
+
+	afterClusters, err := o.waitForNewPlacement(triggeredAt)
+	if err != nil {
+		return err
+	}
+
+	o.printClusterDiff(beforeClusters, afterClusters)
+	return nil
+}
+
+// scheduledClusters returns the names of the clusters the binding is currently scheduled to.
+func (o *CommandRescheduleOption) scheduledClusters() ([]string, error) {
+	var targetClusters []workv1alpha2.TargetCluster
+	if o.ClusterScoped {
+		binding, err := o.karmadaClient.WorkV1alpha2().ClusterResourceBindings().Get(context.TODO(), o.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		targetClusters = binding.Spec.Clusters
+	} else {
+		binding, err := o.karmadaClient.WorkV1alpha2().ResourceBindings(o.Namespace).Get(context.TODO(), o.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		targetClusters = binding.Spec.Clusters
+	}
+
+	clusters := make([]string, 0, len(targetClusters))
+	for i := range targetClusters {
+		clusters = append(clusters, targetClusters[i].Name)
+	}
+	sort.Strings(clusters)
+	return clusters, nil
+}
+
+func (o *CommandRescheduleOption) patchBinding(patchBytes []byte) error {
+	var err error
+	if o.ClusterScoped {
+		_, err = o.karmadaClient.WorkV1alpha2().ClusterResourceBindings().Patch(context.TODO(), o.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	} else {
+		_, err = o.karmadaClient.WorkV1alpha2().ResourceBindings(o.Namespace).Patch(context.TODO(), o.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	}
+	return err
+}
+
+// waitForNewPlacement polls the binding until status.lastScheduledTime is not
+// earlier than the trigger timestamp, which indicates the scheduler finished a
+// complete scheduling afterwards.
+func (o *CommandRescheduleOption) waitForNewPlacement(triggeredAt metav1.Time) ([]string, error) {
+	var afterClusters []string
+	err := wait.PollUntilContextTimeout(context.TODO(), time.Second, o.Timeout, true, func(ctx context.Context) (bool, error) {
+		var lastScheduledTime *metav1.Time
+		if o.ClusterScoped {
+			binding, err := o.karmadaClient.WorkV1alpha2().ClusterResourceBindings().Get(ctx, o.Name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			lastScheduledTime = binding.Status.LastScheduledTime
+		} else {
+			binding, err := o.karmadaClient.WorkV1alpha2().ResourceBindings(o.Namespace).Get(ctx, o.Name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			lastScheduledTime = binding.Status.LastScheduledTime
+		}
+		return lastScheduledTime != nil && !lastScheduledTime.Time.Before(triggeredAt.Time.Truncate(time.Second)), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("timed out waiting for the scheduler to finish the new placement decision of %s: %v", o.targetString(), err)
+	}
+
+	afterClusters, err = o.scheduledClusters()
+	if err != nil {
+		return nil, err
+	}
+	return afterClusters, nil
+}
+
+// printClusterDiff prints the scheduled clusters before and after rescheduling.
+func (o *CommandRescheduleOption) printClusterDiff(before, after []string) {
+	fmt.Fprintf(o.Out, "clusters before rescheduling: %s\n", formatClusters(before))
+	fmt.Fprintf(o.Out, "clusters after rescheduling:  %s\n", formatClusters(after))
+
+	beforeSet := sets.New[string](before...)
+	afterSet := sets.New[string](after...)
+	added := sets.List(afterSet.Difference(beforeSet))
+	removed := sets.List(beforeSet.Difference(afterSet))
+	if len(added) == 0 && len(removed) == 0 {
+		fmt.Fprintln(o.Out, "placement unchanged")
+		return
+	}
+	if len(added) != 0 {
+		fmt.Fprintf(o.Out, "added:   %s\n", strings.Join(added, ","))
+	}
+	if len(removed) != 0 {
+		fmt.Fprintf(o.Out, "removed: %s\n", strings.Join(removed, ","))
+	}
+}
+
+func (o *CommandRescheduleOption) targetString() string {
+	if o.ClusterScoped {
+		return fmt.Sprintf("clusterresourcebinding(%s)", o.Name)
+	}
+	return fmt.Sprintf("resourcebinding(%s/%s)", o.Namespace, o.Name)
+}
+
+func formatClusters(clusters []string) string {
+	if len(clusters) == 0 {
+		return "<none>"
+	}
+	return strings.Join(clusters, ",")
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+var rolloutLong = templates.LongDesc(`
+		Manage the rollout of one or many resources in the Karmada control plane and member clusters.
+
+		Valid resource types include:
+
+		* deployments
+		* daemonsets
+		* statefulsets`)
+
+// NewCmdRollout returns new initialized instance of rollout sub command
+func NewCmdRollout(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "rollout SUBCOMMAND",
+		DisableFlagsInUseLine: true,
+		Short:                 "Manage the rollout of a resource across member clusters",
+		Long:                  rolloutLong,
+		Example:               fmt.Sprintf(rolloutStatusExample, parentCommand),
+		RunE:                  func(cmd *cobra.Command, _ []string) error { return cmd.Help() },
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupAdvancedCommands,
+		},
+	}
+
+	cmd.AddCommand(NewCmdRolloutStatus(f, parentCommand, streams))
+	return cmd
+}
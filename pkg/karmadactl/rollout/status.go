@@ -0,0 +1,233 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/polymorphichelpers"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/get"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+)
+
+var (
+	rolloutStatusLong = templates.LongDesc(`
+		Show the rollout status of a resource in the Karmada control plane and member clusters.
+
+		The status is evaluated per cluster, so a single workload propagated to several member
+		clusters yields one line per cluster. The command exits with a non-zero code when the
+		rollout has not completed in every targeted cluster.`)
+
+	rolloutStatusExample = templates.Examples(`
+		# Show the rollout status of deployment "nginx" in the Karmada control plane
+		%[1]s rollout status deployment/nginx
+
+		# Show the rollout status of deployment "nginx" in all member clusters
+		%[1]s rollout status deployment/nginx --operation-scope=members
+
+		# Show the rollout status of deployment "nginx" in member1 and member2
+		%[1]s rollout status deployment/nginx --operation-scope=members --clusters=member1,member2`)
+)
+
+// NewCmdRolloutStatus returns new initialized instance of rollout status sub command
+func NewCmdRolloutStatus(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := NewCommandRolloutStatusOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:                   "status (TYPE NAME | TYPE/NAME) [flags]",
+		DisableFlagsInUseLine: true,
+		Short:                 "Show the status of the rollout across clusters",
+		Long:                  rolloutStatusLong,
+		Example:               fmt.Sprintf(rolloutStatusExample, parentCommand),
+		SilenceUsage:          true,
+		ValidArgsFunction:     utilcomp.ResourceTypeAndNameCompletionFunc(f),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.Complete(f); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(f, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+	o.OperationScope = options.KarmadaControlPlane
+	flags.VarP(&o.OperationScope, "operation-scope", "s", "Used to control the operation scope of the command. The optional values are karmada, members, and all. Defaults to karmada.")
+	flags.StringSliceVarP(&o.Clusters, "clusters", "C", []string{}, "Used to specify target member clusters and only takes effect when the command's operation scope is members or all, for example: --operation-scope=all --clusters=member1,member2")
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	utilcomp.RegisterCompletionFuncForOperationScopeFlag(cmd)
+	utilcomp.RegisterCompletionFuncForClustersFlag(cmd)
+	return cmd
+}
+
+// CommandRolloutStatusOptions contains the input to the rollout status command.
+type CommandRolloutStatusOptions struct {
+	Clusters             []string
+	OperationScope       options.OperationScope
+	TargetMemberClusters []string
+
+	Namespace string
+
+	karmadaClient karmadaclientset.Interface
+
+	genericiooptions.IOStreams
+}
+
+// NewCommandRolloutStatusOptions returns a CommandRolloutStatusOptions.
+func NewCommandRolloutStatusOptions(streams genericiooptions.IOStreams) *CommandRolloutStatusOptions {
+	return &CommandRolloutStatusOptions{
+		IOStreams: streams,
+	}
+}
+
+// Complete takes the command arguments and infers any remaining options.
+func (o *CommandRolloutStatusOptions) Complete(f util.Factory) error {
+	var err error
+	o.Namespace, _, err = f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+
+	o.karmadaClient, err = f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+
+	if options.ContainMembersScope(o.OperationScope) {
+		if len(o.Clusters) != 0 {
+			o.TargetMemberClusters = o.Clusters
+		} else {
+			o.TargetMemberClusters, err = get.LoadRegisteredClusters(o.karmadaClient)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks the set of flags provided by the user.
+func (o *CommandRolloutStatusOptions) Validate() error {
+	if err := options.VerifyOperationScopeFlags(o.OperationScope); err != nil {
+		return err
+	}
+	if options.ContainMembersScope(o.OperationScope) && len(o.Clusters) > 0 {
+		clusters, err := o.karmadaClient.ClusterV1alpha1().Clusters().List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		return util.VerifyClustersExist(o.Clusters, clusters)
+	}
+	return nil
+}
+
+// Run performs the rollout status operation.
+func (o *CommandRolloutStatusOptions) Run(f util.Factory, args []string) error {
+	var allErrs []error
+	unfinished := 0
+
+	if options.ContainKarmadaScope(o.OperationScope) {
+		done, err := o.printRolloutStatus(f, "Karmada", args)
+		if err != nil {
+			allErrs = append(allErrs, err)
+		} else if !done {
+			unfinished++
+		}
+	}
+
+	for _, cluster := range o.TargetMemberClusters {
+		memberFactory, err := f.FactoryForMemberCluster(cluster)
+		if err != nil {
+			return err
+		}
+		done, err := o.printRolloutStatus(memberFactory, cluster, args)
+		if err != nil {
+			allErrs = append(allErrs, err)
+		} else if !done {
+			unfinished++
+		}
+	}
+
+	if err := utilerrors.NewAggregate(allErrs); err != nil {
+		return err
+	}
+	if unfinished != 0 {
+		return fmt.Errorf("rollout has not completed in %d cluster(s)", unfinished)
+	}
+	return nil
+}
+
+// printRolloutStatus evaluates and prints the rollout status of the requested
+// resources in the given cluster. It returns whether all rollouts are complete.
+func (o *CommandRolloutStatusOptions) printRolloutStatus(f cmdutil.Factory, cluster string, args []string) (bool, error) {
+	r := f.NewBuilder().
+		Unstructured().
+		NamespaceParam(o.Namespace).DefaultNamespace().
+		ResourceTypeOrNameArgs(true, args...).
+		ContinueOnError().
+		Latest().
+		Flatten().
+		Do()
+	if err := r.Err(); err != nil {
+		return false, fmt.Errorf("cluster(%s): %s", cluster, err)
+	}
+
+	infos, err := r.Infos()
+	if err != nil {
+		return false, fmt.Errorf("cluster(%s): %s", cluster, err)
+	}
+
+	allDone := true
+	for _, info := range infos {
+		statusViewer, err := polymorphichelpers.StatusViewerFn(info.Mapping)
+		if err != nil {
+			return false, fmt.Errorf("cluster(%s): %s", cluster, err)
+		}
+
+		unstr, ok := info.Object.(*unstructured.Unstructured)
+		if !ok {
+			return false, fmt.Errorf("cluster(%s): attempt to decode non-Unstructured object", cluster)
+		}
+		status, done, err := statusViewer.Status(unstr, 0)
+		if err != nil {
+			return false, fmt.Errorf("cluster(%s): %s", cluster, err)
+		}
+		fmt.Fprintf(o.Out, "%s: %s/%s: %s", cluster, info.Mapping.Resource.Resource, info.Name, status)
+		if !done {
+			allDone = false
+		}
+	}
+	return allDone, nil
+}
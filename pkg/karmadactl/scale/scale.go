@@ -0,0 +1,256 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/scale"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+var (
+	scaleLong = templates.LongDesc(`
+		Set a new size for a deployment, replica set, or other scalable resource
+		in the Karmada control plane.
+
+		The specified replicas are the total for the resource template. When the
+		resource is scheduled with divided replica scheduling, the scheduler splits
+		the total across the scheduled clusters, and the command prints the
+		resulting per-cluster replica division.`)
+
+	scaleExample = templates.Examples(`
+		# Scale deployment "nginx" to 6 total replicas and show the per-cluster division
+		%[1]s scale deployment/nginx --replicas=6
+
+		# Scale statefulset "web" in namespace "prod" to 3 replicas
+		%[1]s scale statefulset web -n prod --replicas=3`)
+)
+
+// NewCmdScale returns new initialized instance of scale sub command
+func NewCmdScale(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := NewCommandScaleOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:                   "scale (TYPE NAME | TYPE/NAME) --replicas=COUNT",
+		Short:                 "Set a new size for a resource in the Karmada control plane",
+		Long:                  scaleLong,
+		Example:               fmt.Sprintf(scaleExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		ValidArgsFunction:     utilcomp.ResourceTypeAndNameCompletionFunc(f),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.Complete(f); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(f, args)
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupAdvancedCommands,
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+	flags.Int32Var(&o.Replicas, "replicas", -1, "The new desired total number of replicas. Required.")
+	flags.DurationVar(&o.DivisionTimeout, "division-timeout", 15*time.Second, "The length of time to wait for the scheduler to recalculate the per-cluster replica division before giving up, zero means do not wait.")
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	return cmd
+}
+
+// CommandScaleOptions contains the input to the scale command.
+type CommandScaleOptions struct {
+	// Replicas is the new desired total number of replicas.
+	Replicas int32
+
+	// DivisionTimeout is the max amount of time to wait for the recalculated
+	// replica division.
+	DivisionTimeout time.Duration
+
+	Namespace string
+
+	karmadaClient karmadaclientset.Interface
+
+	genericiooptions.IOStreams
+}
+
+// NewCommandScaleOptions returns a CommandScaleOptions.
+func NewCommandScaleOptions(streams genericiooptions.IOStreams) *CommandScaleOptions {
+	return &CommandScaleOptions{
+		IOStreams: streams,
+	}
+}
+
+// Complete takes the command arguments and infers any remaining options.
+func (o *CommandScaleOptions) Complete(f util.Factory) error {
+	var err error
+	o.Namespace, _, err = f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+	o.karmadaClient, err = f.KarmadaClientSet()
+	return err
+}
+
+// Validate checks the set of flags provided by the user.
+func (o *CommandScaleOptions) Validate() error {
+	if o.Replicas < 0 {
+		return errors.New("--replicas is required and must not be negative")
+	}
+	return nil
+}
+
+// Run performs the scale operation.
+func (o *CommandScaleOptions) Run(f util.Factory, args []string) error {
+	r := f.NewBuilder().
+		Unstructured().
+		NamespaceParam(o.Namespace).DefaultNamespace().
+		ResourceTypeOrNameArgs(true, args...).
+		ContinueOnError().
+		Latest().
+		Flatten().
+		Do()
+	if err := r.Err(); err != nil {
+		return err
+	}
+	infos, err := r.Infos()
+	if err != nil {
+		return err
+	}
+	if len(infos) != 1 {
+		return fmt.Errorf("exactly one resource is expected, got %d", len(infos))
+	}
+	info := infos[0]
+
+	scalesGetter, err := scalesGetterFor(f)
+	if err != nil {
+		return err
+	}
+
+	groupResource := info.Mapping.Resource.GroupResource()
+	scaleObj, err := scalesGetter.Scales(info.Namespace).Get(context.TODO(), groupResource, info.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get scale subresource of %s/%s: %v", info.Mapping.Resource.Resource, info.Name, err)
+	}
+
+	scaleObj.Spec.Replicas = o.Replicas
+	if _, err = scalesGetter.Scales(info.Namespace).Update(context.TODO(), groupResource, scaleObj, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to scale %s/%s: %v", info.Mapping.Resource.Resource, info.Name, err)
+	}
+	fmt.Fprintf(o.Out, "%s/%s scaled to %d total replicas\n", info.Mapping.Resource.Resource, info.Name, o.Replicas)
+
+	return o.printReplicaDivision(info.Mapping.GroupVersionKind.Kind, info.Name)
+}
+
+func scalesGetterFor(f util.Factory) (scale.ScalesGetter, error) {
+	restConfig, err := f.ToRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	mapper, err := f.ToRESTMapper()
+	if err != nil {
+		return nil, err
+	}
+	discoveryClient, err := f.ToDiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+	return scale.NewForConfig(restConfig, mapper, dynamic.LegacyAPIPathResolverFunc, scale.NewDiscoveryScaleKindResolver(discoveryClient))
+}
+
+// printReplicaDivision prints how the scheduler divided the total replicas
+// across the scheduled clusters, as recorded in the ResourceBinding.
+func (o *CommandScaleOptions) printReplicaDivision(kind, name string) error {
+	bindingName := names.GenerateBindingName(kind, name)
+
+	var division []string
+	var duplicated bool
+	err := wait.PollUntilContextTimeout(context.TODO(), time.Second, nonZeroTimeout(o.DivisionTimeout), true, func(ctx context.Context) (bool, error) {
+		binding, err := o.karmadaClient.WorkV1alpha2().ResourceBindings(o.Namespace).Get(ctx, bindingName, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				// the resource is not propagated by Karmada, nothing to report
+				return true, nil
+			}
+			return false, err
+		}
+
+		duplicated = binding.Spec.Placement != nil &&
+			binding.Spec.Placement.ReplicaScheduling != nil &&
+			binding.Spec.Placement.ReplicaScheduling.ReplicaSchedulingType == policyv1alpha1.ReplicaSchedulingTypeDuplicated
+
+		division = division[:0]
+		scheduledTotal := int32(0)
+		for _, cluster := range binding.Spec.Clusters {
+			division = append(division, fmt.Sprintf("%s=%d", cluster.Name, cluster.Replicas))
+			scheduledTotal += cluster.Replicas
+		}
+		sort.Strings(division)
+
+		if duplicated || o.DivisionTimeout == 0 {
+			return true, nil
+		}
+		// with divided scheduling, wait until the division sums up to the new total
+		return scheduledTotal == o.Replicas, nil
+	})
+	if err != nil && !wait.Interrupted(err) {
+		return err
+	}
+
+	switch {
+	case len(division) == 0:
+	case duplicated:
+		fmt.Fprintf(o.Out, "replicas are duplicated to each scheduled cluster: %s\n", strings.Join(division, ", "))
+	default:
+		if err != nil {
+			fmt.Fprintf(o.ErrOut, "warning: the scheduler has not yet recalculated the replica division, the last observed division is printed\n")
+		}
+		fmt.Fprintf(o.Out, "replica division across clusters: %s\n", strings.Join(division, ", "))
+	}
+	return nil
+}
+
+func nonZeroTimeout(timeout time.Duration) time.Duration {
+	if timeout <= 0 {
+		return time.Second
+	}
+	return timeout
+}
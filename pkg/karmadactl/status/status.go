@@ -0,0 +1,263 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+var (
+	statusLong = templates.LongDesc(`
+		Display the health of the Karmada control plane and the member fleet.
+
+		The command checks the karmada-apiserver readiness, the leader
+		election leases of karmada-controller-manager and karmada-scheduler,
+		the registration of karmada-webhook, and the Ready condition plus the
+		last heartbeat of every member cluster, all through the Karmada
+		control plane. It exits non-zero when any component is degraded.`)
+
+	statusExample = templates.Examples(`
+		# Display the health of the control plane and all member clusters
+		%[1]s status`)
+)
+
+// leaseGraceFactor is the number of missed lease renew intervals after which
+// the lease holder counts as degraded.
+const leaseGraceFactor = 2
+
+// CommandStatusOptions contains the input to the status command.
+type CommandStatusOptions struct {
+	genericiooptions.IOStreams
+}
+
+// NewCmdStatus returns the status command summarizing control plane and fleet
+// health.
+func NewCmdStatus(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandStatusOptions{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "status",
+		Short:                 "Display the health of the Karmada control plane and the member fleet",
+		Long:                  statusLong,
+		Example:               fmt.Sprintf(statusExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return o.Run(f)
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterTroubleshootingAndDebugging,
+		},
+	}
+	return cmd
+}
+
+// componentStatus is one row of the dashboard.
+type componentStatus struct {
+	name    string
+	healthy bool
+	message string
+}
+
+// Run checks the control plane components and the member clusters and prints
+// the dashboard.
+func (o *CommandStatusOptions) Run(f util.Factory) error {
+	kubeClient, err := f.KubernetesClientSet()
+	if err != nil {
+		return err
+	}
+	karmadaClient, err := f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+	now := time.Now()
+
+	components := []componentStatus{
+		checkAPIServer(ctx, kubeClient),
+		checkLeaderLease(ctx, kubeClient, names.KarmadaControllerManagerComponentName, now),
+		checkLeaderLease(ctx, kubeClient, names.KarmadaSchedulerComponentName, now),
+		checkWebhook(ctx, kubeClient),
+	}
+
+	clusterList, err := karmadaClient.ClusterV1alpha1().Clusters().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list member clusters: %v", err)
+	}
+	clusters := make([]componentStatus, 0, len(clusterList.Items))
+	for i := range clusterList.Items {
+		clusters = append(clusters, checkCluster(ctx, kubeClient, &clusterList.Items[i], now))
+	}
+
+	printDashboard(o.Out, components, clusters)
+
+	degraded := 0
+	for _, status := range append(components, clusters...) {
+		if !status.healthy {
+			degraded++
+		}
+	}
+	if degraded > 0 {
+		return fmt.Errorf("%d of %d components degraded", degraded, len(components)+len(clusters))
+	}
+	return nil
+}
+
+// checkAPIServer probes the readiness endpoint of the karmada-apiserver.
+func checkAPIServer(ctx context.Context, kubeClient kubernetes.Interface) componentStatus {
+	status := componentStatus{name: "karmada-apiserver"}
+	body, err := kubeClient.Discovery().RESTClient().Get().AbsPath("/readyz").DoRaw(ctx)
+	if err != nil {
+		status.message = fmt.Sprintf("readiness probe failed: %v", err)
+		return status
+	}
+	status.healthy = true
+	status.message = string(body)
+	return status
+}
+
+// checkLeaderLease reports whether the leader election lease of the component
+// is renewed in time, which requires a live leader behind it.
+func checkLeaderLease(ctx context.Context, kubeClient kubernetes.Interface, component string, now time.Time) componentStatus {
+	status := componentStatus{name: component}
+	lease, err := kubeClient.CoordinationV1().Leases(names.NamespaceKarmadaSystem).Get(ctx, component, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			status.message = "leader election lease not found"
+		} else {
+			status.message = fmt.Sprintf("failed to get leader election lease: %v", err)
+		}
+		return status
+	}
+	status.healthy, status.message = leaseStatus(&lease.Spec, now)
+	return status
+}
+
+// checkWebhook reports whether karmada-webhook registered any admission
+// webhook configurations.
+func checkWebhook(ctx context.Context, kubeClient kubernetes.Interface) componentStatus {
+	status := componentStatus{name: names.KarmadaWebhookComponentName}
+	mutating, err := kubeClient.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		status.message = fmt.Sprintf("failed to list mutating webhook configurations: %v", err)
+		return status
+	}
+	validating, err := kubeClient.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		status.message = fmt.Sprintf("failed to list validating webhook configurations: %v", err)
+		return status
+	}
+	webhooks := 0
+	for i := range mutating.Items {
+		webhooks += len(mutating.Items[i].Webhooks)
+	}
+	for i := range validating.Items {
+		webhooks += len(validating.Items[i].Webhooks)
+	}
+	if webhooks == 0 {
+		status.message = "no admission webhooks registered"
+		return status
+	}
+	status.healthy = true
+	status.message = fmt.Sprintf("%d admission webhooks registered", webhooks)
+	return status
+}
+
+// checkCluster combines the Ready condition of the cluster with the age of
+// its heartbeat lease.
+func checkCluster(ctx context.Context, kubeClient kubernetes.Interface, cluster *clusterv1alpha1.Cluster, now time.Time) componentStatus {
+	status := componentStatus{name: fmt.Sprintf("cluster/%s", cluster.Name)}
+	ready := karmadautil.IsClusterReady(&cluster.Status)
+
+	heartbeat := "<unknown>"
+	lease, err := kubeClient.CoordinationV1().Leases(karmadautil.NamespaceClusterLease).Get(ctx, cluster.Name, metav1.GetOptions{})
+	if err == nil && lease.Spec.RenewTime != nil {
+		heartbeat = duration.HumanDuration(now.Sub(lease.Spec.RenewTime.Time))
+	}
+
+	if !ready {
+		message := "cluster not ready"
+		if condition := meta.FindStatusCondition(cluster.Status.Conditions, clusterv1alpha1.ClusterConditionReady); condition != nil && condition.Message != "" {
+			message = condition.Message
+		}
+		status.message = fmt.Sprintf("%s, last heartbeat %s ago", message, heartbeat)
+		return status
+	}
+	status.healthy = true
+	status.message = fmt.Sprintf("ready, last heartbeat %s ago", heartbeat)
+	return status
+}
+
+// leaseStatus reports whether the lease is renewed in time.
+func leaseStatus(spec *coordinationv1.LeaseSpec, now time.Time) (bool, string) {
+	if spec.RenewTime == nil {
+		return false, "leader election lease never renewed"
+	}
+	age := now.Sub(spec.RenewTime.Time)
+	grace := time.Duration(leaseGraceFactor) * 15 * time.Second
+	if spec.LeaseDurationSeconds != nil {
+		grace = time.Duration(leaseGraceFactor) * time.Duration(*spec.LeaseDurationSeconds) * time.Second
+	}
+	holder := ""
+	if spec.HolderIdentity != nil {
+		holder = *spec.HolderIdentity
+	}
+	if age > grace {
+		return false, fmt.Sprintf("leader election lease of %q not renewed for %s", holder, duration.HumanDuration(age))
+	}
+	return true, fmt.Sprintf("leader %q renewed the lease %s ago", holder, duration.HumanDuration(age))
+}
+
+// printDashboard prints the component and cluster statuses as a table.
+func printDashboard(out io.Writer, components, clusters []componentStatus) {
+	writer := tabwriter.NewWriter(out, 0, 8, 3, ' ', 0)
+	fmt.Fprintln(writer, "COMPONENT\tSTATUS\tMESSAGE")
+	for _, status := range components {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", status.name, healthText(status.healthy), status.message)
+	}
+	for _, status := range clusters {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", status.name, healthText(status.healthy), status.message)
+	}
+	writer.Flush()
+}
+
+func healthText(healthy bool) string {
+	if healthy {
+		return "Healthy"
+	}
+	return "Degraded"
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestLeaseStatus(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name        string
+		spec        *coordinationv1.LeaseSpec
+		wantHealthy bool
+	}{
+		{
+			name:        "never renewed",
+			spec:        &coordinationv1.LeaseSpec{},
+			wantHealthy: false,
+		},
+		{
+			name: "renewed in time",
+			spec: &coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To("host-1"),
+				LeaseDurationSeconds: ptr.To[int32](15),
+				RenewTime:            &metav1.MicroTime{Time: now.Add(-5 * time.Second)},
+			},
+			wantHealthy: true,
+		},
+		{
+			name: "renew overdue",
+			spec: &coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To("host-1"),
+				LeaseDurationSeconds: ptr.To[int32](15),
+				RenewTime:            &metav1.MicroTime{Time: now.Add(-5 * time.Minute)},
+			},
+			wantHealthy: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			healthy, message := leaseStatus(tt.spec, now)
+			if healthy != tt.wantHealthy {
+				t.Errorf("leaseStatus() healthy = %v (%q), want %v", healthy, message, tt.wantHealthy)
+			}
+			if message == "" {
+				t.Error("leaseStatus() returned an empty message")
+			}
+		})
+	}
+}
+
+func TestPrintDashboard(t *testing.T) {
+	components := []componentStatus{
+		{name: "karmada-apiserver", healthy: true, message: "ok"},
+		{name: "karmada-scheduler", healthy: false, message: "leader election lease not found"},
+	}
+	clusters := []componentStatus{
+		{name: "cluster/member1", healthy: true, message: "ready, last heartbeat 10s ago"},
+	}
+
+	out := &bytes.Buffer{}
+	printDashboard(out, components, clusters)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("printDashboard() printed %d lines, want 4:\n%s", len(lines), out.String())
+	}
+	if !strings.HasPrefix(lines[0], "COMPONENT") {
+		t.Errorf("header = %q, want COMPONENT prefix", lines[0])
+	}
+	if !strings.Contains(lines[1], "Healthy") {
+		t.Errorf("apiserver line = %q, want Healthy", lines[1])
+	}
+	if !strings.Contains(lines[2], "Degraded") {
+		t.Errorf("scheduler line = %q, want Degraded", lines[2])
+	}
+	if !strings.Contains(lines[3], "cluster/member1") {
+		t.Errorf("cluster line = %q, want cluster/member1", lines[3])
+	}
+}
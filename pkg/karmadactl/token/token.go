@@ -101,6 +101,8 @@ type CommandTokenOptions struct {
 
 	PrintRegisterCommand bool
 
+	BoundClusterName string
+
 	parentCommand string
 }
 
@@ -135,6 +137,7 @@ func NewCmdTokenCreate(f util.Factory, out io.Writer, tokenOpts *CommandTokenOpt
 	cmd.Flags().StringSliceVar(&tokenOpts.Usages, "usages", tokenutil.DefaultUsages, fmt.Sprintf("Describes the ways in which this token can be used. You can pass --usages multiple times or provide a comma separated list of options. Valid options: [%s]", strings.Join(bootstrapapi.KnownTokenUsages, ",")))
 	cmd.Flags().StringSliceVar(&tokenOpts.Groups, "groups", tokenutil.DefaultGroups, fmt.Sprintf("Extra groups that this token will authenticate as when used for authentication. Must match %q", bootstrapapi.BootstrapGroupPattern))
 	cmd.Flags().StringVar(&tokenOpts.Description, "description", tokenOpts.Description, "A human friendly description of how this token is used.")
+	cmd.Flags().StringVar(&tokenOpts.BoundClusterName, "bound-cluster-name", "", "Restrict the token to registering the member cluster with the given name. Bound tokens are deleted after their first successful registration and must have a non-zero --ttl.")
 
 	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
 	return cmd
@@ -200,10 +203,14 @@ func NewCmdTokenDelete(f util.Factory, out io.Writer, tokenOpts *CommandTokenOpt
 // runCreateToken generates a new bootstrap token and stores it as a secret on the server.
 func (o *CommandTokenOptions) runCreateToken(out io.Writer, client kubeclient.Interface) error {
 	klog.V(1).Infoln("[token] creating token")
+	if o.BoundClusterName != "" && o.TTL.Duration == 0 {
+		return fmt.Errorf("a token bound to a cluster name must have a limited lifetime, specify a non-zero --ttl")
+	}
 	bootstrapToken, err := tokenutil.GenerateRandomBootstrapToken(o.TTL, o.Description, o.Groups, o.Usages)
 	if err != nil {
 		return err
 	}
+	bootstrapToken.BoundClusterName = o.BoundClusterName
 
 	if err := tokenutil.CreateNewToken(client, bootstrapToken); err != nil {
 		return err
@@ -66,6 +66,7 @@ func NewCmdTop(f util.Factory, parentCommand string, streams genericiooptions.IO
 	// create subcommands
 	cmd.AddCommand(NewCmdTopPod(f, parentCommand, nil, streams))
 	cmd.AddCommand(NewCmdTopNode(f, parentCommand, nil, streams))
+	cmd.AddCommand(NewCmdTopClusters(f, parentCommand, streams))
 
 	return cmd
 }
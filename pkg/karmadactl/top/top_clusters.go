@@ -0,0 +1,276 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package top
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+)
+
+const (
+	clusterEgressMetricName   = "cluster_network_egress_bytes_total"
+	clusterIngressMetricName  = "cluster_network_ingress_bytes_total"
+	clusterThrottleMetricName = "cluster_network_throttle_duration_seconds_total"
+)
+
+var (
+	topClusterLong = templates.LongDesc(i18n.T(`
+		Display resource usage of member clusters.
+
+		The 'top clusters' command summarizes the node numbers and the
+		allocated/allocatable resources reported in the cluster status.
+
+		With --network, the command additionally shows the control plane
+		traffic towards every cluster (Work applies, status reports, proxy
+		requests) accounted by karmada-controller-manager, which requires
+		access to its metrics endpoint.`))
+
+	topClusterExample = templates.Examples(i18n.T(`
+		# Show resource usage of all member clusters
+		%[1]s top clusters
+
+		# Show resource usage of the given member cluster
+		%[1]s top clusters member1
+
+		# Also show the control plane traffic towards every cluster
+		%[1]s top clusters --network --metrics-endpoint=http://127.0.0.1:8080/metrics`))
+)
+
+// ClusterOptions contains the options to the top clusters command.
+type ClusterOptions struct {
+	ClusterNames    []string
+	Network         bool
+	MetricsEndpoint string
+
+	karmadaClient karmadaclientset.Interface
+
+	genericiooptions.IOStreams
+}
+
+// networkUsage is the accounted control plane traffic towards one cluster.
+type networkUsage struct {
+	EgressBytes      float64
+	IngressBytes     float64
+	ThrottledSeconds float64
+}
+
+// NewCmdTopClusters implements the top clusters command.
+func NewCmdTopClusters(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &ClusterOptions{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "clusters [NAME...]",
+		DisableFlagsInUseLine: true,
+		Short:                 i18n.T("Display resource usage of member clusters"),
+		Long:                  topClusterLong,
+		Example:               fmt.Sprintf(topClusterExample, parentCommand),
+		Aliases:               []string{"cluster"},
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, args))
+			cmdutil.CheckErr(o.RunTopClusters())
+		},
+	}
+	options.AddKubeConfigFlags(cmd.Flags())
+	cmd.Flags().BoolVar(&o.Network, "network", o.Network, "Also show the control plane traffic towards every cluster, accounted by karmada-controller-manager.")
+	cmd.Flags().StringVar(&o.MetricsEndpoint, "metrics-endpoint", "http://127.0.0.1:8080/metrics", "The metrics endpoint of karmada-controller-manager the network accounting is scraped from, e.g. via a port-forward. Only takes effect with --network.")
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *ClusterOptions) Complete(f util.Factory, args []string) error {
+	o.ClusterNames = args
+	karmadaClient, err := f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+	o.karmadaClient = karmadaClient
+	return nil
+}
+
+// RunTopClusters runs the top clusters command.
+func (o *ClusterOptions) RunTopClusters() error {
+	clusterList, err := o.karmadaClient.ClusterV1alpha1().Clusters().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list all member clusters in control plane, err: %w", err)
+	}
+
+	clusters := clusterList.Items
+	if len(o.ClusterNames) > 0 {
+		if err = util.VerifyClustersExist(o.ClusterNames, clusterList); err != nil {
+			return err
+		}
+		requested := make([]clusterv1alpha1.Cluster, 0, len(o.ClusterNames))
+		for i := range clusters {
+			for _, name := range o.ClusterNames {
+				if clusters[i].Name == name {
+					requested = append(requested, clusters[i])
+					break
+				}
+			}
+		}
+		clusters = requested
+	}
+
+	var network map[string]networkUsage
+	if o.Network {
+		network, err = o.scrapeNetworkUsage()
+		if err != nil {
+			return fmt.Errorf("failed to scrape network accounting from %s: %w", o.MetricsEndpoint, err)
+		}
+	}
+
+	w := printers.GetNewTabWriter(o.Out)
+	defer w.Flush()
+
+	header := "NAME\tREADY\tNODES\tCPU(allocated/allocatable)\tMEMORY(allocated/allocatable)"
+	if o.Network {
+		header += "\tEGRESS\tINGRESS\tTHROTTLED"
+	}
+	fmt.Fprintln(w, header)
+
+	for i := range clusters {
+		row := clusterRow(&clusters[i])
+		if o.Network {
+			row += "\t" + networkCells(network[clusters[i].Name])
+		}
+		fmt.Fprintln(w, row)
+	}
+	return nil
+}
+
+// clusterRow summarizes the status of one cluster into a table row.
+func clusterRow(cluster *clusterv1alpha1.Cluster) string {
+	ready := "Unknown"
+	if karmadautil.IsClusterReady(&cluster.Status) {
+		ready = "True"
+	} else if len(cluster.Status.Conditions) > 0 {
+		ready = "False"
+	}
+
+	nodes := "-"
+	if cluster.Status.NodeSummary != nil {
+		nodes = fmt.Sprintf("%d/%d", cluster.Status.NodeSummary.ReadyNum, cluster.Status.NodeSummary.TotalNum)
+	}
+
+	cpu, memory := "-", "-"
+	if summary := cluster.Status.ResourceSummary; summary != nil && summary.Allocatable != nil && summary.Allocated != nil {
+		cpu = fmt.Sprintf("%s/%s", summary.Allocated.Cpu(), summary.Allocatable.Cpu())
+		memory = fmt.Sprintf("%s/%s", summary.Allocated.Memory(), summary.Allocatable.Memory())
+	}
+
+	return fmt.Sprintf("%s\t%s\t%s\t%s\t%s", cluster.Name, ready, nodes, cpu, memory)
+}
+
+// networkCells renders the accounted traffic of one cluster.
+func networkCells(usage networkUsage) string {
+	egress := resource.NewQuantity(int64(usage.EgressBytes), resource.BinarySI)
+	ingress := resource.NewQuantity(int64(usage.IngressBytes), resource.BinarySI)
+	throttled := time.Duration(usage.ThrottledSeconds * float64(time.Second)).Round(time.Millisecond)
+	return fmt.Sprintf("%s\t%s\t%s", egress, ingress, throttled)
+}
+
+// scrapeNetworkUsage fetches the network accounting counters from the
+// karmada-controller-manager metrics endpoint.
+func (o *ClusterOptions) scrapeNetworkUsage() (map[string]networkUsage, error) {
+	resp, err := http.Get(o.MetricsEndpoint) //nolint:gosec // the endpoint is provided by the user on purpose
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return parseNetworkMetrics(resp.Body)
+}
+
+// parseNetworkMetrics extracts the per cluster network counters from the
+// Prometheus text exposition.
+func parseNetworkMetrics(r io.Reader) (map[string]networkUsage, error) {
+	usage := map[string]networkUsage{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		var assign func(u *networkUsage, value float64)
+		switch {
+		case strings.HasPrefix(line, clusterEgressMetricName):
+			assign = func(u *networkUsage, value float64) { u.EgressBytes = value }
+		case strings.HasPrefix(line, clusterIngressMetricName):
+			assign = func(u *networkUsage, value float64) { u.IngressBytes = value }
+		case strings.HasPrefix(line, clusterThrottleMetricName):
+			assign = func(u *networkUsage, value float64) { u.ThrottledSeconds = value }
+		default:
+			continue
+		}
+		cluster, value, err := parseMetricLine(line)
+		if err != nil {
+			return nil, err
+		}
+		if cluster == "" {
+			continue
+		}
+		clusterUsage := usage[cluster]
+		assign(&clusterUsage, value)
+		usage[cluster] = clusterUsage
+	}
+	return usage, scanner.Err()
+}
+
+// parseMetricLine extracts the cluster_name label and the sample value from
+// one line of the text exposition.
+func parseMetricLine(line string) (cluster string, value float64, err error) {
+	if labelStart := strings.Index(line, `cluster_name="`); labelStart >= 0 {
+		rest := line[labelStart+len(`cluster_name="`):]
+		labelEnd := strings.Index(rest, `"`)
+		if labelEnd < 0 {
+			return "", 0, fmt.Errorf("malformed metric line: %s", line)
+		}
+		cluster = rest[:labelEnd]
+	}
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return "", 0, fmt.Errorf("malformed metric line: %s", line)
+	}
+	value, err = strconv.ParseFloat(fields[len(fields)-1], 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("malformed metric line: %s", line)
+	}
+	return cluster, value, nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package top
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseNetworkMetrics(t *testing.T) {
+	exposition := `
+# HELP cluster_network_egress_bytes_total Number of bytes sent from the control plane to the cluster.
+# TYPE cluster_network_egress_bytes_total counter
+cluster_network_egress_bytes_total{cluster_name="member1"} 1024
+cluster_network_egress_bytes_total{cluster_name="member2"} 2048
+cluster_network_ingress_bytes_total{cluster_name="member1"} 4096
+cluster_network_throttle_duration_seconds_total{cluster_name="member1"} 1.5
+some_other_metric{cluster_name="member1"} 7
+`
+	got, err := parseNetworkMetrics(strings.NewReader(exposition))
+	if err != nil {
+		t.Fatalf("parseNetworkMetrics() unexpected error: %v", err)
+	}
+	want := map[string]networkUsage{
+		"member1": {EgressBytes: 1024, IngressBytes: 4096, ThrottledSeconds: 1.5},
+		"member2": {EgressBytes: 2048},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseNetworkMetrics() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseMetricLine(t *testing.T) {
+	tests := []struct {
+		name        string
+		line        string
+		wantCluster string
+		wantValue   float64
+		wantErr     bool
+	}{
+		{
+			name:        "labeled sample",
+			line:        `cluster_network_egress_bytes_total{cluster_name="member1"} 1024`,
+			wantCluster: "member1",
+			wantValue:   1024,
+		},
+		{
+			name:      "no cluster label",
+			line:      "cluster_network_egress_bytes_total 12",
+			wantValue: 12,
+		},
+		{
+			name:    "no value",
+			line:    `cluster_network_egress_bytes_total{cluster_name="member1"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster, value, err := parseMetricLine(tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseMetricLine() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if cluster != tt.wantCluster || value != tt.wantValue {
+				t.Errorf("parseMetricLine() = (%q, %v), want (%q, %v)", cluster, value, tt.wantCluster, tt.wantValue)
+			}
+		})
+	}
+}
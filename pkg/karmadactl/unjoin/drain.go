@@ -0,0 +1,206 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package unjoin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+)
+
+// drainedBinding identifies a binding that targeted the draining cluster and
+// had rescheduling triggered.
+type drainedBinding struct {
+	namespace string // empty for ClusterResourceBinding
+	name      string
+}
+
+func (b drainedBinding) String() string {
+	if len(b.namespace) == 0 {
+		return fmt.Sprintf("clusterresourcebinding(%s)", b.name)
+	}
+	return fmt.Sprintf("resourcebinding(%s/%s)", b.namespace, b.name)
+}
+
+// drainCluster evacuates workloads from the unjoining cluster before its
+// registration is removed: the cluster is cordoned so it no longer receives
+// new placements, rescheduling is triggered for every binding targeting it,
+// and the bindings are then awaited to be fully applied on other clusters.
+func (j *CommandUnjoinOption) drainCluster(karmadaClient karmadaclientset.Interface) error {
+	if err := cordonCluster(karmadaClient, j.ClusterName); err != nil {
+		return fmt.Errorf("failed to cordon cluster %s: %v", j.ClusterName, err)
+	}
+	fmt.Printf("cluster %s cordoned\n", j.ClusterName)
+
+	bindings, err := triggerRescheduling(karmadaClient, j.ClusterName)
+	if err != nil {
+		return fmt.Errorf("failed to trigger rescheduling of bindings targeting cluster %s: %v", j.ClusterName, err)
+	}
+	if len(bindings) == 0 {
+		fmt.Printf("no bindings target cluster %s, nothing to evacuate\n", j.ClusterName)
+		return nil
+	}
+	fmt.Printf("rescheduling triggered for %d binding(s) targeting cluster %s\n", len(bindings), j.ClusterName)
+
+	if err := waitForEvacuation(karmadaClient, j.ClusterName, bindings, j.DrainTimeout); err != nil {
+		return err
+	}
+	fmt.Printf("workloads evacuated from cluster %s\n", j.ClusterName)
+	return nil
+}
+
+// cordonCluster adds the unscheduler taint to the cluster so the scheduler
+// stops placing new workloads on it, the same marker `karmadactl cordon` sets.
+func cordonCluster(karmadaClient karmadaclientset.Interface, clusterName string) error {
+	cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), clusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	unschedulerTaint := corev1.Taint{
+		Key:    clusterv1alpha1.TaintClusterUnscheduler,
+		Effect: corev1.TaintEffectNoSchedule,
+	}
+	for i := range cluster.Spec.Taints {
+		if cluster.Spec.Taints[i].MatchTaint(&unschedulerTaint) {
+			return nil
+		}
+	}
+	cluster.Spec.Taints = append(cluster.Spec.Taints, unschedulerTaint)
+	_, err = karmadaClient.ClusterV1alpha1().Clusters().Update(context.TODO(), cluster, metav1.UpdateOptions{})
+	return err
+}
+
+// triggerRescheduling sets spec.rescheduleTriggeredAt on every ResourceBinding
+// and ClusterResourceBinding currently scheduled to the cluster and returns
+// the affected bindings.
+func triggerRescheduling(karmadaClient karmadaclientset.Interface, clusterName string) ([]drainedBinding, error) {
+	patchBytes := []byte(fmt.Sprintf(`{"spec":{"rescheduleTriggeredAt":%q}}`, metav1.Now().UTC().Format(time.RFC3339)))
+
+	var bindings []drainedBinding
+	rbList, err := karmadaClient.WorkV1alpha2().ResourceBindings(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range rbList.Items {
+		rb := &rbList.Items[i]
+		if !rb.Spec.TargetContains(clusterName) {
+			continue
+		}
+		if _, err := karmadaClient.WorkV1alpha2().ResourceBindings(rb.Namespace).Patch(context.TODO(), rb.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+			return nil, err
+		}
+		bindings = append(bindings, drainedBinding{namespace: rb.Namespace, name: rb.Name})
+	}
+
+	crbList, err := karmadaClient.WorkV1alpha2().ClusterResourceBindings().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range crbList.Items {
+		crb := &crbList.Items[i]
+		if !crb.Spec.TargetContains(clusterName) {
+			continue
+		}
+		if _, err := karmadaClient.WorkV1alpha2().ClusterResourceBindings().Patch(context.TODO(), crb.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+			return nil, err
+		}
+		bindings = append(bindings, drainedBinding{name: crb.Name})
+	}
+	return bindings, nil
+}
+
+// waitForEvacuation polls the drained bindings until none of them targets the
+// cluster anymore and each of them is fully applied on its new clusters, or
+// the timeout expires.
+func waitForEvacuation(karmadaClient karmadaclientset.Interface, clusterName string, bindings []drainedBinding, timeout time.Duration) error {
+	var pending drainedBinding
+	err := wait.PollUntilContextTimeout(context.TODO(), 2*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		for _, binding := range bindings {
+			evacuated, err := bindingEvacuated(ctx, karmadaClient, clusterName, binding)
+			if err != nil {
+				return false, err
+			}
+			if !evacuated {
+				pending = binding
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("timed out waiting for %s to be evacuated from cluster %s: %v", pending, clusterName, err)
+	}
+	return nil
+}
+
+// bindingEvacuated reports whether the binding no longer targets the cluster
+// and its workload is fully applied on the remaining clusters. Bindings
+// deleted during the drain count as evacuated.
+func bindingEvacuated(ctx context.Context, karmadaClient karmadaclientset.Interface, clusterName string, binding drainedBinding) (bool, error) {
+	var spec workv1alpha2.ResourceBindingSpec
+	var conditions []metav1.Condition
+	if len(binding.namespace) == 0 {
+		crb, err := karmadaClient.WorkV1alpha2().ClusterResourceBindings().Get(ctx, binding.name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		spec = crb.Spec
+		conditions = crb.Status.Conditions
+	} else {
+		rb, err := karmadaClient.WorkV1alpha2().ResourceBindings(binding.namespace).Get(ctx, binding.name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		spec = rb.Spec
+		conditions = rb.Status.Conditions
+	}
+
+	if spec.TargetContains(clusterName) {
+		klog.V(2).Infof("%s still targets cluster %s", binding, clusterName)
+		return false, nil
+	}
+	// A binding scheduled away to no cluster at all (e.g. no other cluster
+	// fits) is not treated as evacuated, its workload would be lost.
+	if len(spec.Clusters) == 0 {
+		klog.V(2).Infof("%s is not scheduled to any cluster yet", binding)
+		return false, nil
+	}
+	if !meta.IsStatusConditionTrue(conditions, workv1alpha2.FullyApplied) {
+		klog.V(2).Infof("%s is not fully applied on its new clusters yet", binding)
+		return false, nil
+	}
+	return true, nil
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package unjoin
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	fakekarmadaclient "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+)
+
+func TestCordonCluster(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster *clusterv1alpha1.Cluster
+	}{
+		{
+			name:    "CordonCluster_WithoutTaint_TaintAdded",
+			cluster: &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}},
+		},
+		{
+			name: "CordonCluster_AlreadyCordoned_Idempotent",
+			cluster: &clusterv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+				Spec: clusterv1alpha1.ClusterSpec{
+					Taints: []corev1.Taint{
+						{Key: clusterv1alpha1.TaintClusterUnscheduler, Effect: corev1.TaintEffectNoSchedule},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			karmadaClient := fakekarmadaclient.NewSimpleClientset(tt.cluster)
+			if err := cordonCluster(karmadaClient, "member1"); err != nil {
+				t.Fatalf("cordonCluster() returned error: %v", err)
+			}
+			cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), "member1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			taintCount := 0
+			for _, taint := range cluster.Spec.Taints {
+				if taint.Key == clusterv1alpha1.TaintClusterUnscheduler && taint.Effect == corev1.TaintEffectNoSchedule {
+					taintCount++
+				}
+			}
+			if taintCount != 1 {
+				t.Errorf("expected exactly one unscheduler taint, got %d", taintCount)
+			}
+		})
+	}
+}
+
+func TestTriggerRescheduling(t *testing.T) {
+	rbTargeting := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx-deployment", Namespace: "default"},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}},
+		},
+	}
+	rbOther := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-deployment", Namespace: "default"},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Clusters: []workv1alpha2.TargetCluster{{Name: "member2"}},
+		},
+	}
+	crbTargeting := &workv1alpha2.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-crd"},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}, {Name: "member2"}},
+		},
+	}
+
+	karmadaClient := fakekarmadaclient.NewSimpleClientset(rbTargeting, rbOther, crbTargeting)
+	bindings, err := triggerRescheduling(karmadaClient, "member1")
+	if err != nil {
+		t.Fatalf("triggerRescheduling() returned error: %v", err)
+	}
+	if len(bindings) != 2 {
+		t.Fatalf("expected 2 drained bindings, got %d: %v", len(bindings), bindings)
+	}
+
+	rb, err := karmadaClient.WorkV1alpha2().ResourceBindings("default").Get(context.TODO(), "nginx-deployment", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get resourcebinding: %v", err)
+	}
+	if rb.Spec.RescheduleTriggeredAt == nil {
+		t.Errorf("expected rescheduleTriggeredAt to be set on targeting resourcebinding")
+	}
+	other, err := karmadaClient.WorkV1alpha2().ResourceBindings("default").Get(context.TODO(), "other-deployment", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get resourcebinding: %v", err)
+	}
+	if other.Spec.RescheduleTriggeredAt != nil {
+		t.Errorf("expected rescheduleTriggeredAt to be unset on non-targeting resourcebinding")
+	}
+	crb, err := karmadaClient.WorkV1alpha2().ClusterResourceBindings().Get(context.TODO(), "example-crd", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get clusterresourcebinding: %v", err)
+	}
+	if crb.Spec.RescheduleTriggeredAt == nil {
+		t.Errorf("expected rescheduleTriggeredAt to be set on targeting clusterresourcebinding")
+	}
+}
+
+func TestBindingEvacuated(t *testing.T) {
+	tests := []struct {
+		name    string
+		binding *workv1alpha2.ResourceBinding
+		want    bool
+	}{
+		{
+			name: "BindingEvacuated_StillTargetsCluster_NotEvacuated",
+			binding: &workv1alpha2.ResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "nginx-deployment", Namespace: "default"},
+				Spec: workv1alpha2.ResourceBindingSpec{
+					Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "BindingEvacuated_ScheduledNowhere_NotEvacuated",
+			binding: &workv1alpha2.ResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "nginx-deployment", Namespace: "default"},
+			},
+			want: false,
+		},
+		{
+			name: "BindingEvacuated_RescheduledButNotApplied_NotEvacuated",
+			binding: &workv1alpha2.ResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "nginx-deployment", Namespace: "default"},
+				Spec: workv1alpha2.ResourceBindingSpec{
+					Clusters: []workv1alpha2.TargetCluster{{Name: "member2"}},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "BindingEvacuated_RescheduledAndFullyApplied_Evacuated",
+			binding: &workv1alpha2.ResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "nginx-deployment", Namespace: "default"},
+				Spec: workv1alpha2.ResourceBindingSpec{
+					Clusters: []workv1alpha2.TargetCluster{{Name: "member2"}},
+				},
+				Status: workv1alpha2.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{Type: workv1alpha2.FullyApplied, Status: metav1.ConditionTrue},
+					},
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			karmadaClient := fakekarmadaclient.NewSimpleClientset(tt.binding)
+			got, err := bindingEvacuated(context.TODO(), karmadaClient, "member1",
+				drainedBinding{namespace: tt.binding.Namespace, name: tt.binding.Name})
+			if err != nil {
+				t.Fatalf("bindingEvacuated() returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("bindingEvacuated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("BindingEvacuated_BindingDeleted_Evacuated", func(t *testing.T) {
+		karmadaClient := fakekarmadaclient.NewSimpleClientset()
+		got, err := bindingEvacuated(context.TODO(), karmadaClient, "member1",
+			drainedBinding{namespace: "default", name: "nginx-deployment"})
+		if err != nil {
+			t.Fatalf("bindingEvacuated() returned error: %v", err)
+		}
+		if !got {
+			t.Errorf("bindingEvacuated() = false, want true for a deleted binding")
+		}
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -36,6 +37,7 @@ import (
 	"github.com/karmada-io/karmada/pkg/karmadactl/options"
 	cmdutil "github.com/karmada-io/karmada/pkg/karmadactl/util"
 	"github.com/karmada-io/karmada/pkg/karmadactl/util/apiclient"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util/clustercache"
 	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
 	"github.com/karmada-io/karmada/pkg/util"
 	"github.com/karmada-io/karmada/pkg/util/names"
@@ -53,7 +55,10 @@ var (
 		%[1]s unjoin CLUSTER_NAME --cluster-kubeconfig=<KUBECONFIG>
 
 		# Unjoin cluster from karmada control plane with timeout
-		%[1]s unjoin CLUSTER_NAME --cluster-kubeconfig=<KUBECONFIG> --wait 2m`)
+		%[1]s unjoin CLUSTER_NAME --cluster-kubeconfig=<KUBECONFIG> --wait 2m
+
+		# Evacuate workloads to other clusters before unjoining
+		%[1]s unjoin CLUSTER_NAME --drain --timeout 10m`)
 )
 
 // NewCmdUnjoin defines the `unjoin` command that removes registration of a cluster from control plane.
@@ -67,6 +72,7 @@ func NewCmdUnjoin(f cmdutil.Factory, parentCommand string) *cobra.Command {
 		Example:               fmt.Sprintf(unjoinExample, parentCommand),
 		SilenceUsage:          true,
 		DisableFlagsInUseLine: true,
+		ValidArgsFunction:     utilcomp.ClusterNameCompletionFunc(),
 		RunE: func(_ *cobra.Command, args []string) error {
 			if err := opts.Complete(args); err != nil {
 				return err
@@ -109,10 +115,21 @@ type CommandUnjoinOption struct {
 	// DryRun tells if run the command in dry-run mode, without making any server requests.
 	DryRun bool
 
+	// Drain tells whether to evacuate workloads from the unjoining cluster
+	// before removing its registration.
+	Drain bool
+
+	// DrainTimeout is the maximum time to wait for the workloads to become
+	// healthy on other clusters during a drain.
+	DrainTimeout time.Duration
+
 	forceDeletion bool
 
 	// Wait tells maximum command execution time
 	Wait time.Duration
+
+	// Confirmation gates the unjoin behind a risk prompt, skipped with --yes.
+	cmdutil.Confirmation
 }
 
 // Complete ensures that options are valid and marshals them if necessary.
@@ -141,6 +158,9 @@ func (j *CommandUnjoinOption) Validate(args []string) error {
 	if j.Wait <= 0 {
 		return fmt.Errorf(" --wait %v  must be a positive duration, e.g. 1m0s ", j.Wait)
 	}
+	if j.Drain && j.DrainTimeout <= 0 {
+		return fmt.Errorf(" --timeout %v  must be a positive duration, e.g. 5m0s ", j.DrainTimeout)
+	}
 	return nil
 }
 
@@ -154,7 +174,11 @@ func (j *CommandUnjoinOption) AddFlags(flags *pflag.FlagSet) {
 	flags.BoolVar(&j.forceDeletion, "force", false,
 		"When set, the unjoin command will attempt to clean up resources in the member cluster before deleting the Cluster object. If the cleanup fails within the timeout period, the Cluster object will still be deleted, potentially leaving some resources behind in the member cluster.")
 	flags.DurationVar(&j.Wait, "wait", 60*time.Second, "wait for the unjoin command execution process(default 60s), if there is no success after this time, timeout will be returned.")
+	flags.BoolVar(&j.Drain, "drain", false,
+		"Cordon the cluster and wait for its workloads to be rescheduled and become healthy on other clusters before removing the registration.")
+	flags.DurationVar(&j.DrainTimeout, "timeout", 5*time.Minute, "The length of time to wait for the workloads to be evacuated during a drain before giving up. Only works with --drain.")
 	flags.BoolVar(&j.DryRun, "dry-run", false, "Run the command in dry-run mode, without making any server requests.")
+	j.Confirmation.AddFlags(flags)
 }
 
 // Run is the implementation of the 'unjoin' command.
@@ -183,6 +207,22 @@ func (j *CommandUnjoinOption) Run(f cmdutil.Factory) error {
 	return j.RunUnJoinCluster(controlPlaneRestConfig, clusterConfig)
 }
 
+// riskSummary counts the workloads Karmada currently propagates to the
+// unjoining cluster so that the confirmation prompt can report what the
+// unjoin affects.
+func (j *CommandUnjoinOption) riskSummary(karmadaClient karmadaclientset.Interface) (cmdutil.RiskSummary, error) {
+	executionSpace := names.GenerateExecutionSpaceName(j.ClusterName)
+	works, err := karmadaClient.WorkV1alpha1().Works(executionSpace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return cmdutil.RiskSummary{}, fmt.Errorf("failed to list works in execution space %s: %v", executionSpace, err)
+	}
+	return cmdutil.RiskSummary{
+		Operation: fmt.Sprintf("unjoin cluster %s from the Karmada control plane", j.ClusterName),
+		Workloads: len(works.Items),
+		Clusters:  1,
+	}, nil
+}
+
 var controlPlaneKarmadaClientBuilder = func(controlPlaneRestConfig *rest.Config) karmadaclientset.Interface {
 	return karmadaclientset.NewForConfigOrDie(controlPlaneRestConfig)
 }
@@ -210,12 +250,39 @@ func (j *CommandUnjoinOption) RunUnJoinCluster(controlPlaneRestConfig, clusterCo
 		return fmt.Errorf("cluster %s is a %s mode member cluster, please use command `unregister` if you want to continue unregistering the cluster", j.ClusterName, target.Spec.SyncMode)
 	}
 
+	// present the blast radius and ask the user to acknowledge it before
+	// touching anything
+	if !j.DryRun {
+		summary, err := j.riskSummary(controlPlaneKarmadaClient)
+		if err != nil {
+			return err
+		}
+		confirmed, err := j.Confirm(summary, os.Stdin, os.Stdout)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	// evacuate workloads from the unjoining cluster before removing its
+	// registration when a graceful drain was requested
+	if j.Drain && !j.DryRun {
+		if err := j.drainCluster(controlPlaneKarmadaClient); err != nil {
+			klog.Errorf("Failed to drain cluster %q: %v", j.ClusterName, err)
+			return err
+		}
+	}
+
 	// delete the cluster object in host cluster that associates the unjoining cluster
 	err = cmdutil.DeleteClusterObject(controlPlaneKubeClient, controlPlaneKarmadaClient, j.ClusterName, j.Wait, j.DryRun, j.forceDeletion)
 	if err != nil {
 		klog.Errorf("Failed to delete cluster object. cluster name: %s, error: %v", j.ClusterName, err)
 		return err
 	}
+	// the membership changed, cached cluster lists are stale now
+	clustercache.Invalidate()
 
 	// Attempt to delete the cluster role, cluster rolebindings and service account from the unjoining cluster
 	// if user provides the kubeconfig of cluster
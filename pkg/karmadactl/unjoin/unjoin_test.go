@@ -37,6 +37,7 @@ import (
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
 	fakekarmadaclient "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
 	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	cmdutil "github.com/karmada-io/karmada/pkg/karmadactl/util"
 	"github.com/karmada-io/karmada/pkg/util"
 	"github.com/karmada-io/karmada/pkg/util/names"
 )
@@ -141,7 +142,7 @@ func TestRunUnJoinCluster(t *testing.T) {
 		},
 		{
 			name:                   "RunUnJoinCluster_DeleteClusterObject_FailedToDeleteClusterObject",
-			unjoinOpts:             &CommandUnjoinOption{ClusterName: "member1"},
+			unjoinOpts:             &CommandUnjoinOption{ClusterName: "member1", Confirmation: cmdutil.Confirmation{Yes: true}},
 			controlPlaneRestConfig: &rest.Config{},
 			clusterConfig:          &rest.Config{},
 			karmadaClient:          fakekarmadaclient.NewSimpleClientset(),
@@ -177,6 +178,7 @@ func TestRunUnJoinCluster(t *testing.T) {
 				ClusterNamespace: options.DefaultKarmadaClusterNamespace,
 				forceDeletion:    false,
 				Wait:             time.Minute,
+				Confirmation:     cmdutil.Confirmation{Yes: true},
 			},
 			controlKubeClient: fakeclientset.NewClientset(),
 			karmadaClient:     fakekarmadaclient.NewSimpleClientset(),
@@ -0,0 +1,335 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util/apiclient"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	"github.com/karmada-io/karmada/pkg/version"
+)
+
+// upgradeOrder lists the Karmada components in the order they are safely
+// upgraded: API extension components first so newer controllers never talk to
+// older servers, the webhook last so admission keeps working for the versions
+// actually running. Components not installed are skipped.
+var upgradeOrder = []string{
+	names.KarmadaAggregatedAPIServerComponentName,
+	names.KarmadaSearchComponentName,
+	names.KarmadaMetricsAdapterComponentName,
+	names.KarmadaControllerManagerComponentName,
+	names.KarmadaSchedulerComponentName,
+	names.KarmadaDeschedulerComponentName,
+	names.KarmadaWebhookComponentName,
+}
+
+var (
+	upgradeLong = templates.LongDesc(`
+		Upgrade the Karmada control plane components installed by "init" to a newer version.
+
+		"upgrade plan" detects the installed version and prints the component-by-component
+		steps without changing anything. "upgrade apply" executes the image bumps in the safe
+		order, waits for every component to roll out, and rolls the already upgraded
+		components back when one of them fails.
+
+		The Karmada CRDs are not updated by this command, apply the crds.tar.gz of the target
+		release to the Karmada apiserver before upgrading the components.`)
+
+	upgradeExample = templates.Examples(`
+		# Show the upgrade steps to the version this karmadactl was built for
+		%[1]s upgrade plan
+
+		# Upgrade the control plane to v1.15.0
+		%[1]s upgrade apply --target-version v1.15.0`)
+)
+
+// CommandUpgradeOption options for upgrade.
+type CommandUpgradeOption struct {
+	// KubeConfig holds host cluster KUBECONFIG file path.
+	KubeConfig string
+	Context    string
+	Namespace  string
+
+	// TargetVersion is the Karmada release to upgrade to, e.g. v1.15.0.
+	TargetVersion string
+	// WaitComponentReadyTimeout is the seconds to wait for each upgraded component to roll out.
+	WaitComponentReadyTimeout int
+
+	KubeClientSet kubernetes.Interface
+}
+
+// upgradeStep is one image bump of the upgrade plan.
+type upgradeStep struct {
+	component string
+	oldImage  string
+	newImage  string
+}
+
+// NewCmdUpgrade upgrades the Karmada control plane components.
+func NewCmdUpgrade(parentCommand string) *cobra.Command {
+	opts := CommandUpgradeOption{}
+	cmd := &cobra.Command{
+		Use:                   "upgrade",
+		Short:                 "Upgrade the Karmada control plane to a newer version",
+		Long:                  upgradeLong,
+		Example:               fmt.Sprintf(upgradeExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmd.Help()
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterRegistration,
+		},
+	}
+
+	flags := cmd.PersistentFlags()
+	flags.StringVarP(&opts.Namespace, "namespace", "n", "karmada-system", "namespace where Karmada components are installed.")
+	flags.StringVar(&opts.KubeConfig, "kubeconfig", "", "Path to the host cluster kubeconfig file.")
+	flags.StringVar(&opts.Context, "context", "", "The name of the kubeconfig context to use")
+	flags.StringVar(&opts.TargetVersion, "target-version", "", "The Karmada release to upgrade to, e.g. v1.15.0. Defaults to the release this karmadactl was built for.")
+	flags.IntVar(&opts.WaitComponentReadyTimeout, "wait-component-ready-timeout", 120, "Seconds to wait for each upgraded component to roll out.")
+
+	cmd.AddCommand(newCmdUpgradePlan(&opts))
+	cmd.AddCommand(newCmdUpgradeApply(&opts))
+	return cmd
+}
+
+func newCmdUpgradePlan(opts *CommandUpgradeOption) *cobra.Command {
+	return &cobra.Command{
+		Use:          "plan",
+		Short:        "Show the steps to upgrade the Karmada control plane, without changing anything",
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+			return opts.RunPlan()
+		},
+	}
+}
+
+func newCmdUpgradeApply(opts *CommandUpgradeOption) *cobra.Command {
+	return &cobra.Command{
+		Use:          "apply",
+		Short:        "Upgrade the Karmada control plane components in the safe order",
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+			return opts.RunApply()
+		},
+	}
+}
+
+// Complete the conditions required to be able to run upgrade.
+func (o *CommandUpgradeOption) Complete() error {
+	restConfig, err := apiclient.RestConfig(o.Context, o.KubeConfig)
+	if err != nil {
+		return err
+	}
+	o.KubeClientSet, err = apiclient.NewClientSet(restConfig)
+	if err != nil {
+		return err
+	}
+
+	if o.TargetVersion == "" {
+		releaseVer, err := version.ParseGitVersion(version.Get().GitVersion)
+		if err != nil {
+			return fmt.Errorf("no target version specified and the build version %q holds no release, use --target-version", version.Get().GitVersion)
+		}
+		o.TargetVersion = releaseVer.ReleaseVersion()
+	}
+	return nil
+}
+
+// buildPlan inspects the installed components and returns the image bumps
+// needed to reach the target version, in the safe upgrade order.
+func (o *CommandUpgradeOption) buildPlan() ([]upgradeStep, error) {
+	var steps []upgradeStep
+	for _, component := range upgradeOrder {
+		deployment, err := o.KubeClientSet.AppsV1().Deployments(o.Namespace).Get(context.TODO(), component, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get deployment %s/%s: %v", o.Namespace, component, err)
+		}
+		image, exist := componentImage(deployment, component)
+		if !exist {
+			klog.Warningf("No container image matching component %s found in deployment %s/%s, skipping.", component, o.Namespace, component)
+			continue
+		}
+		steps = append(steps, upgradeStep{
+			component: component,
+			oldImage:  image,
+			newImage:  replaceImageTag(image, o.TargetVersion),
+		})
+	}
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("no Karmada components found in namespace %s", o.Namespace)
+	}
+	return steps, nil
+}
+
+// RunPlan prints the upgrade steps without changing anything.
+func (o *CommandUpgradeOption) RunPlan() error {
+	steps, err := o.buildPlan()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("The Karmada control plane in namespace %q is at %s, target version is %s.\n\n",
+		o.Namespace, imageTag(steps[0].oldImage), o.TargetVersion)
+	fmt.Println("Upgrade steps, in order:")
+	fmt.Printf("  1. Apply the CRDs of %s to the Karmada apiserver(manual, not performed by \"upgrade apply\").\n", o.TargetVersion)
+	for i, step := range steps {
+		if step.oldImage == step.newImage {
+			fmt.Printf("  %d. %s: already at %s, nothing to do.\n", i+2, step.component, step.newImage)
+			continue
+		}
+		fmt.Printf("  %d. %s: bump image %s -> %s and wait for rollout.\n", i+2, step.component, step.oldImage, step.newImage)
+	}
+	fmt.Printf("\nRun \"upgrade apply --target-version %s\" to execute steps 2-%d.\n", o.TargetVersion, len(steps)+1)
+	return nil
+}
+
+// RunApply executes the upgrade, rolling already upgraded components back
+// when one of them fails to roll out.
+func (o *CommandUpgradeOption) RunApply() error {
+	steps, err := o.buildPlan()
+	if err != nil {
+		return err
+	}
+
+	var applied []upgradeStep
+	for _, step := range steps {
+		if step.oldImage == step.newImage {
+			fmt.Printf("Component %s already at %s, skipping.\n", step.component, step.newImage)
+			continue
+		}
+		fmt.Printf("Upgrading %s: %s -> %s\n", step.component, step.oldImage, step.newImage)
+		if err := o.setComponentImage(step.component, step.newImage); err != nil {
+			o.rollback(applied)
+			return err
+		}
+		applied = append(applied, step)
+		if err := o.waitComponentReady(step.component); err != nil {
+			klog.Errorf("Component %s failed to roll out with image %s: %v", step.component, step.newImage, err)
+			o.rollback(applied)
+			return fmt.Errorf("upgrade of %s failed and the upgraded components were rolled back to %s: %v",
+				step.component, imageTag(step.oldImage), err)
+		}
+	}
+	fmt.Printf("Karmada control plane upgraded to %s successfully.\n", o.TargetVersion)
+	return nil
+}
+
+// rollback restores the images of the already upgraded components, newest
+// first, and waits for each of them to roll out again. Failures are logged
+// instead of returned, a half-finished rollback still benefits from restoring
+// the remaining components.
+func (o *CommandUpgradeOption) rollback(applied []upgradeStep) {
+	for i := len(applied) - 1; i >= 0; i-- {
+		step := applied[i]
+		fmt.Printf("Rolling %s back to %s\n", step.component, step.oldImage)
+		if err := o.setComponentImage(step.component, step.oldImage); err != nil {
+			klog.Errorf("Failed to roll component %s back to %s: %v", step.component, step.oldImage, err)
+			continue
+		}
+		if err := o.waitComponentReady(step.component); err != nil {
+			klog.Errorf("Component %s did not become ready after rollback: %v", step.component, err)
+		}
+	}
+}
+
+func (o *CommandUpgradeOption) setComponentImage(component, image string) error {
+	deployment, err := o.KubeClientSet.AppsV1().Deployments(o.Namespace).Get(context.TODO(), component, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get deployment %s/%s: %v", o.Namespace, component, err)
+	}
+	for i := range deployment.Spec.Template.Spec.Containers {
+		if imageName(deployment.Spec.Template.Spec.Containers[i].Image) == component {
+			deployment.Spec.Template.Spec.Containers[i].Image = image
+		}
+	}
+	if _, err = o.KubeClientSet.AppsV1().Deployments(o.Namespace).Update(context.TODO(), deployment, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update deployment %s/%s: %v", o.Namespace, component, err)
+	}
+	return nil
+}
+
+func (o *CommandUpgradeOption) waitComponentReady(component string) error {
+	deployment, err := o.KubeClientSet.AppsV1().Deployments(o.Namespace).Get(context.TODO(), component, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	return util.WaitForDeploymentRollout(o.KubeClientSet, deployment, time.Duration(o.WaitComponentReadyTimeout)*time.Second)
+}
+
+// componentImage returns the image of the container running the component.
+func componentImage(deployment *appsv1.Deployment, component string) (string, bool) {
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if imageName(container.Image) == component {
+			return container.Image, true
+		}
+	}
+	return "", false
+}
+
+// imageName extracts the repository-less name of an image reference, e.g.
+// "docker.io/karmada/karmada-webhook:v1.14.0" yields "karmada-webhook".
+func imageName(image string) string {
+	name := image
+	if index := strings.LastIndex(name, ":"); index >= 0 && !strings.Contains(name[index:], "/") {
+		name = name[:index]
+	}
+	if index := strings.LastIndex(name, "/"); index >= 0 {
+		name = name[index+1:]
+	}
+	return name
+}
+
+// imageTag extracts the tag of an image reference, defaulting to "latest".
+func imageTag(image string) string {
+	if index := strings.LastIndex(image, ":"); index >= 0 && !strings.Contains(image[index:], "/") {
+		return image[index+1:]
+	}
+	return "latest"
+}
+
+// replaceImageTag swaps the tag of an image reference for the given version.
+func replaceImageTag(image, version string) string {
+	if index := strings.LastIndex(image, ":"); index >= 0 && !strings.Contains(image[index:], "/") {
+		return image[:index] + ":" + version
+	}
+	return image + ":" + version
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+func newDeployment(namespace, name, image string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: name, Image: image}},
+				},
+			},
+		},
+	}
+}
+
+func TestBuildPlan(t *testing.T) {
+	client := fakeclientset.NewSimpleClientset(
+		newDeployment("karmada-system", names.KarmadaWebhookComponentName, "docker.io/karmada/karmada-webhook:v1.14.0"),
+		newDeployment("karmada-system", names.KarmadaControllerManagerComponentName, "docker.io/karmada/karmada-controller-manager:v1.14.0"),
+	)
+	o := &CommandUpgradeOption{
+		Namespace:     "karmada-system",
+		TargetVersion: "v1.15.0",
+		KubeClientSet: client,
+	}
+
+	steps, err := o.buildPlan()
+	if err != nil {
+		t.Fatalf("buildPlan() unexpected error: %v", err)
+	}
+	if len(steps) != 2 {
+		t.Fatalf("buildPlan() returned %d steps, want 2", len(steps))
+	}
+	// the webhook must be upgraded last
+	if steps[0].component != names.KarmadaControllerManagerComponentName ||
+		steps[1].component != names.KarmadaWebhookComponentName {
+		t.Errorf("buildPlan() unexpected order: %v", steps)
+	}
+	if steps[0].newImage != "docker.io/karmada/karmada-controller-manager:v1.15.0" {
+		t.Errorf("buildPlan() unexpected new image: %s", steps[0].newImage)
+	}
+}
+
+func TestBuildPlanWithoutComponents(t *testing.T) {
+	o := &CommandUpgradeOption{
+		Namespace:     "karmada-system",
+		TargetVersion: "v1.15.0",
+		KubeClientSet: fakeclientset.NewSimpleClientset(),
+	}
+	if _, err := o.buildPlan(); err == nil {
+		t.Errorf("buildPlan() expected error when no components are installed")
+	}
+}
+
+func TestImageHelpers(t *testing.T) {
+	tests := []struct {
+		image       string
+		wantName    string
+		wantTag     string
+		wantBumped  string
+		bumpVersion string
+	}{
+		{
+			image:       "docker.io/karmada/karmada-webhook:v1.14.0",
+			wantName:    "karmada-webhook",
+			wantTag:     "v1.14.0",
+			wantBumped:  "docker.io/karmada/karmada-webhook:v1.15.0",
+			bumpVersion: "v1.15.0",
+		},
+		{
+			image:       "karmada-scheduler",
+			wantName:    "karmada-scheduler",
+			wantTag:     "latest",
+			wantBumped:  "karmada-scheduler:v1.15.0",
+			bumpVersion: "v1.15.0",
+		},
+		{
+			image:       "localhost:5000/karmada/karmada-search:v1.14.0",
+			wantName:    "karmada-search",
+			wantTag:     "v1.14.0",
+			wantBumped:  "localhost:5000/karmada/karmada-search:v1.15.0",
+			bumpVersion: "v1.15.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			if got := imageName(tt.image); got != tt.wantName {
+				t.Errorf("imageName(%q) = %q, want %q", tt.image, got, tt.wantName)
+			}
+			if got := imageTag(tt.image); got != tt.wantTag {
+				t.Errorf("imageTag(%q) = %q, want %q", tt.image, got, tt.wantTag)
+			}
+			if got := replaceImageTag(tt.image, tt.bumpVersion); got != tt.wantBumped {
+				t.Errorf("replaceImageTag(%q) = %q, want %q", tt.image, got, tt.wantBumped)
+			}
+		})
+	}
+}
@@ -55,6 +55,11 @@ const (
 	// DefaultTokenDuration specifies the default amount of time that a bootstrap token will be valid
 	// Default behaviour is 24 hours
 	DefaultTokenDuration = 24 * time.Hour
+
+	// BootstrapTokenBoundClusterNameKey is a Karmada-specific extension of the bootstrap
+	// token Secret data that restricts the token to registering one specific named cluster.
+	// The constraint is enforced server-side by the agent CSR approving controller.
+	BootstrapTokenBoundClusterNameKey = "bound-cluster-name"
 )
 
 var (
@@ -89,6 +94,10 @@ type BootstrapToken struct {
 	// used for authentication
 	// +optional
 	Groups []string
+	// BoundClusterName restricts the token to registering the member cluster with
+	// the given name. An empty value means the token can register any cluster.
+	// +optional
+	BoundClusterName string
 }
 
 // Token is a token of the format abcdef.abcdef0123456789 that is used
@@ -218,6 +227,10 @@ func encodeTokenSecretData(token *BootstrapToken, now time.Time) map[string][]by
 		data[bootstrapapi.BootstrapTokenDescriptionKey] = []byte(token.Description)
 	}
 
+	if len(token.BoundClusterName) > 0 {
+		data[BootstrapTokenBoundClusterNameKey] = []byte(token.BoundClusterName)
+	}
+
 	// If for some strange reason both token.TTL and token.Expires would be set
 	// (they are mutually exclusive in validation so this shouldn't be the case),
 	// token.Expires has higher priority, as can be seen in the logic here.
@@ -318,11 +331,12 @@ func GetBootstrapTokenFromSecret(secret *corev1.Secret) (*BootstrapToken, error)
 	}
 
 	return &BootstrapToken{
-		Token:       bts,
-		Description: description,
-		Expires:     expires,
-		Usages:      usages,
-		Groups:      groups,
+		Token:            bts,
+		Description:      description,
+		Expires:          expires,
+		Usages:           usages,
+		Groups:           groups,
+		BoundClusterName: bootstrapsecretutil.GetData(secret, BootstrapTokenBoundClusterNameKey),
 	}, nil
 }
 
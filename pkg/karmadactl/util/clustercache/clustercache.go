@@ -0,0 +1,152 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clustercache caches the member cluster list on disk, so repeated
+// karmadactl invocations and shell completions do not pay a synchronous
+// Cluster list on every call. Discovery data is already cached by client-go
+// under ~/.kube/cache, this package covers the cluster inventory. Entries
+// are stored under ~/.karmada/cache/clusters, keyed by the karmada context
+// the command talks to, and expire after --cache-ttl.
+package clustercache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+)
+
+// ttl is the lifetime of a cache entry, bound to --cache-ttl.
+var ttl = time.Minute
+
+// AddFlags adds the cache flags to the specified FlagSet.
+func AddFlags(flags *pflag.FlagSet) {
+	flags.DurationVar(&ttl, "cache-ttl", ttl, "The lifetime of the on-disk member cluster list cache. Invocations within the lifetime reuse the cached list instead of listing the clusters on every call, join and unjoin invalidate it. Pass 0 to disable the cache.")
+}
+
+// entry is the on-disk format of a cached cluster list.
+type entry struct {
+	Clusters []string `json:"clusters,omitempty"`
+}
+
+// ListClusters returns the names of the clusters registered in the Karmada
+// control plane, serving them from the on-disk cache while it is fresh.
+func ListClusters(clientSet karmadaclientset.Interface) ([]string, error) {
+	key := cacheKey()
+	if clusters, ok := load(key); ok {
+		return clusters, nil
+	}
+
+	clusterList, err := clientSet.ClusterV1alpha1().Clusters().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var clusters []string
+	for i := range clusterList.Items {
+		clusters = append(clusters, clusterList.Items[i].Name)
+	}
+	store(key, clusters)
+	return clusters, nil
+}
+
+// Invalidate drops every cached cluster list. Join and unjoin call it so a
+// changed membership shows up immediately regardless of the TTL.
+func Invalidate() {
+	if dir, err := cacheDir(); err == nil {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+// cacheDir returns the directory the cached cluster lists are stored in.
+func cacheDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".karmada", "cache", "clusters"), nil
+}
+
+// cacheKey derives the cache key from the kubeconfig and context the command
+// talks to, so separate Karmada control planes never share an entry.
+func cacheKey() string {
+	kubeconfig := ""
+	if options.DefaultConfigFlags.KubeConfig != nil {
+		kubeconfig = *options.DefaultConfigFlags.KubeConfig
+	}
+	if len(kubeconfig) == 0 {
+		kubeconfig = os.Getenv("KUBECONFIG")
+	}
+	karmadaContext := ""
+	if options.DefaultConfigFlags.Context != nil {
+		karmadaContext = *options.DefaultConfigFlags.Context
+	}
+	sum := sha256.Sum256([]byte(kubeconfig + "\x00" + karmadaContext))
+	return hex.EncodeToString(sum[:8])
+}
+
+// load returns the cached cluster list of the key if it is still fresh.
+func load(key string) ([]string, bool) {
+	if ttl <= 0 {
+		return nil, false
+	}
+	dir, err := cacheDir()
+	if err != nil {
+		return nil, false
+	}
+	path := filepath.Join(dir, key+".json")
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > ttl {
+		return nil, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	cached := entry{}
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return nil, false
+	}
+	return cached.Clusters, true
+}
+
+// store writes the cluster list of the key to disk. Failures are ignored, the
+// cache only ever trades a saved round trip.
+func store(key string, clusters []string) {
+	if ttl <= 0 {
+		return
+	}
+	dir, err := cacheDir()
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return
+	}
+	data, err := json.Marshal(entry{Clusters: clusters})
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(filepath.Join(dir, key+".json"), data, 0600)
+}
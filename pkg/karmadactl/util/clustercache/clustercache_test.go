@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustercache
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	fakekarmadaclient "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+)
+
+func newClientSet(clusters ...string) *fakekarmadaclient.Clientset {
+	var objects []runtime.Object
+	for _, cluster := range clusters {
+		objects = append(objects, &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: cluster}})
+	}
+	return fakekarmadaclient.NewSimpleClientset(objects...)
+}
+
+func TestListClusters(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	originalTTL := ttl
+	ttl = time.Minute
+	defer func() { ttl = originalTTL }()
+
+	clusters, err := ListClusters(newClientSet("member1", "member2"))
+	if err != nil {
+		t.Fatalf("ListClusters() returned error: %v", err)
+	}
+	if want := []string{"member1", "member2"}; !reflect.DeepEqual(clusters, want) {
+		t.Fatalf("ListClusters() = %v, want %v", clusters, want)
+	}
+
+	// the membership changed, but the fresh cache entry still answers
+	clusters, err = ListClusters(newClientSet("member1", "member2", "member3"))
+	if err != nil {
+		t.Fatalf("ListClusters() returned error: %v", err)
+	}
+	if want := []string{"member1", "member2"}; !reflect.DeepEqual(clusters, want) {
+		t.Errorf("ListClusters() = %v, want the cached %v", clusters, want)
+	}
+
+	// invalidation drops the entry and the next call sees the new membership
+	Invalidate()
+	clusters, err = ListClusters(newClientSet("member1", "member2", "member3"))
+	if err != nil {
+		t.Fatalf("ListClusters() returned error: %v", err)
+	}
+	if want := []string{"member1", "member2", "member3"}; !reflect.DeepEqual(clusters, want) {
+		t.Errorf("ListClusters() = %v, want %v after invalidation", clusters, want)
+	}
+}
+
+func TestListClustersDisabled(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	originalTTL := ttl
+	ttl = 0
+	defer func() { ttl = originalTTL }()
+
+	if _, err := ListClusters(newClientSet("member1")); err != nil {
+		t.Fatalf("ListClusters() returned error: %v", err)
+	}
+	clusters, err := ListClusters(newClientSet("member1", "member2"))
+	if err != nil {
+		t.Fatalf("ListClusters() returned error: %v", err)
+	}
+	if want := []string{"member1", "member2"}; !reflect.DeepEqual(clusters, want) {
+		t.Errorf("ListClusters() = %v, want %v with the cache disabled", clusters, want)
+	}
+}
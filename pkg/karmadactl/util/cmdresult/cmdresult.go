@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cmdresult provides a machine-readable result envelope for karmadactl
+// commands, so that automation wrapping karmadactl can branch on typed error
+// codes instead of parsing human-oriented output.
+package cmdresult
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Code classifies why a karmadactl command failed.
+type Code string
+
+const (
+	// ClusterUnreachable indicates the control plane or a member cluster
+	// could not be connected.
+	ClusterUnreachable Code = "ClusterUnreachable"
+	// PolicyConflict indicates the requested change conflicts with an
+	// existing propagation or override policy.
+	PolicyConflict Code = "PolicyConflict"
+	// ValidationFailed indicates the command arguments or flags did not pass
+	// validation, or the server rejected the request as invalid.
+	ValidationFailed Code = "ValidationFailed"
+	// InternalError is the fallback code for failures that do not fit a more
+	// specific classification.
+	InternalError Code = "InternalError"
+)
+
+// OutputFormatJSON is the value of the --output flag that selects the JSON
+// result envelope.
+const OutputFormatJSON = "json"
+
+// Error associates a Code with an underlying error.
+type Error struct {
+	Code Code
+	Err  error
+}
+
+// NewError wraps err with the given code. A nil err yields a nil result.
+func NewError(code Code, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Code: code, Err: err}
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap makes the wrapped error visible to errors.Is/As.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// CodeOf returns the code attached to err, classifying untyped errors by
+// their cause where possible.
+func CodeOf(err error) Code {
+	var typed *Error
+	if errors.As(err, &typed) {
+		return typed.Code
+	}
+
+	var netErr net.Error
+	var urlErr *url.Error
+	switch {
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		return ValidationFailed
+	case apierrors.IsTimeout(err), apierrors.IsServerTimeout(err), apierrors.IsServiceUnavailable(err),
+		errors.As(err, &netErr), errors.As(err, &urlErr):
+		return ClusterUnreachable
+	default:
+		return InternalError
+	}
+}
+
+// Result is the JSON envelope emitted when --output=json is requested.
+type Result struct {
+	// Command is the name of the karmadactl sub-command that produced the result.
+	Command string `json:"command"`
+	// Success reports whether the command completed without error.
+	Success bool `json:"success"`
+	// Code is the typed error code, present only on failure.
+	Code Code `json:"code,omitempty"`
+	// Message is the human-readable error detail, present only on failure.
+	Message string `json:"message,omitempty"`
+}
+
+// Print writes the result envelope for the given command error to w when the
+// JSON output format is requested, and returns the error unchanged so that the
+// command still exits non-zero on failure. For any other output format it is
+// a no-op.
+func Print(w io.Writer, output, command string, runErr error) error {
+	if output != OutputFormatJSON {
+		return runErr
+	}
+
+	result := Result{Command: command, Success: runErr == nil}
+	if runErr != nil {
+		result.Code = CodeOf(runErr)
+		result.Message = runErr.Error()
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal command result: %v", err)
+	}
+	fmt.Fprintln(w, string(data))
+	return runErr
+}
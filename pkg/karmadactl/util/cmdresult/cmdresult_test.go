@@ -0,0 +1,128 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmdresult
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestCodeOf(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want Code
+	}{
+		{
+			name: "typed error keeps its code",
+			err:  NewError(PolicyConflict, errors.New("policy already selects the resource")),
+			want: PolicyConflict,
+		},
+		{
+			name: "wrapped typed error keeps its code",
+			err:  fmt.Errorf("failed to run: %w", NewError(ValidationFailed, errors.New("bad flag"))),
+			want: ValidationFailed,
+		},
+		{
+			name: "invalid api error is a validation failure",
+			err:  apierrors.NewBadRequest("spec is invalid"),
+			want: ValidationFailed,
+		},
+		{
+			name: "url error means the cluster is unreachable",
+			err:  &url.Error{Op: "Get", URL: "https://10.0.0.1:6443", Err: errors.New("connection refused")},
+			want: ClusterUnreachable,
+		},
+		{
+			name: "service unavailable means the cluster is unreachable",
+			err:  apierrors.NewServiceUnavailable("apiserver is down"),
+			want: ClusterUnreachable,
+		},
+		{
+			name: "untyped error falls back to internal error",
+			err:  apierrors.NewNotFound(schema.GroupResource{Resource: "clusters"}, "member1"),
+			want: InternalError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CodeOf(tt.err); got != tt.want {
+				t.Errorf("CodeOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrint(t *testing.T) {
+	tests := []struct {
+		name        string
+		output      string
+		runErr      error
+		wantWritten bool
+		wantResult  Result
+	}{
+		{
+			name:        "human output leaves the error untouched",
+			output:      "",
+			runErr:      errors.New("boom"),
+			wantWritten: false,
+		},
+		{
+			name:        "json output on success",
+			output:      OutputFormatJSON,
+			runErr:      nil,
+			wantWritten: true,
+			wantResult:  Result{Command: "join", Success: true},
+		},
+		{
+			name:        "json output on failure carries the code",
+			output:      OutputFormatJSON,
+			runErr:      NewError(ClusterUnreachable, errors.New("dial tcp: timeout")),
+			wantWritten: true,
+			wantResult:  Result{Command: "join", Success: false, Code: ClusterUnreachable, Message: "dial tcp: timeout"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := Print(buf, tt.output, "join", tt.runErr)
+			if !errors.Is(err, tt.runErr) {
+				t.Errorf("Print() error = %v, want %v", err, tt.runErr)
+			}
+			if !tt.wantWritten {
+				if buf.Len() != 0 {
+					t.Errorf("Print() wrote %q, want no output", buf.String())
+				}
+				return
+			}
+			got := Result{}
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("Print() wrote invalid JSON %q: %v", buf.String(), err)
+			}
+			if got != tt.wantResult {
+				t.Errorf("Print() result = %+v, want %+v", got, tt.wantResult)
+			}
+		})
+	}
+}
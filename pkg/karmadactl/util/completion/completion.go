@@ -39,6 +39,7 @@ import (
 	"github.com/karmada-io/karmada/pkg/karmadactl/get"
 	"github.com/karmada-io/karmada/pkg/karmadactl/options"
 	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util/clustercache"
 )
 
 var factory util.Factory
@@ -222,27 +223,77 @@ func ListContextsInConfig(toComplete string) []string {
 	return ret
 }
 
-// ListClustersInConfig returns a list of cluster names which begin with `toComplete`
+// ListClustersInConfig returns a list of cluster names which begin with `toComplete`.
+// The names are served from the short-lived on-disk cache when it is fresh, so
+// repeated completions do not hit the control plane every time.
 func ListClustersInConfig(toComplete string) []string {
 	set, err := factory.KarmadaClientSet()
 	if err != nil {
 		return nil
 	}
 
+	clusters, err := clustercache.ListClusters(set)
+	if err != nil {
+		return nil
+	}
+
+	var ret []string
+	for _, cluster := range clusters {
+		if strings.HasPrefix(cluster, toComplete) {
+			ret = append(ret, cluster)
+		}
+	}
+	return ret
+}
+
+// ListClusterLabelsInConfig returns `key=value` completions harvested from the
+// labels of the clusters registered in the Karmada control plane. It supports
+// comma-separated selector lists, completing only the element after the last
+// comma.
+func ListClusterLabelsInConfig(toComplete string) []string {
+	set, err := factory.KarmadaClientSet()
+	if err != nil {
+		return nil
+	}
+
 	list, err := set.ClusterV1alpha1().Clusters().List(context.Background(), metav1.ListOptions{})
 	if err != nil {
 		return nil
 	}
 
+	prefix := ""
+	suffix := toComplete
+	if lastIdx := strings.LastIndex(toComplete, ","); lastIdx != -1 {
+		prefix = toComplete[0 : lastIdx+1]
+		suffix = toComplete[lastIdx+1:]
+	}
+
+	seen := map[string]struct{}{}
 	var ret []string
-	for _, cluster := range list.Items {
-		if strings.HasPrefix(cluster.Name, toComplete) {
-			ret = append(ret, cluster.Name)
+	for i := range list.Items {
+		for key, value := range list.Items[i].Labels {
+			pair := fmt.Sprintf("%s=%s", key, value)
+			if _, dup := seen[pair]; dup || !strings.HasPrefix(pair, suffix) {
+				continue
+			}
+			seen[pair] = struct{}{}
+			ret = append(ret, prefix+pair)
 		}
 	}
 	return ret
 }
 
+// ClusterNameCompletionFunc returns a completion function that completes as
+// the first argument the cluster names registered in the Karmada control plane.
+func ClusterNameCompletionFunc() func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return ListClustersInConfig(toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
 // compGetResourceList returns the list of api resources which begin with `toComplete`.
 func compGetResourceList(restClientGetter genericclioptions.RESTClientGetter, cmd *cobra.Command, toComplete string) []string {
 	buf := new(bytes.Buffer)
@@ -476,6 +527,15 @@ func RegisterCompletionFuncForClustersFlag(cmd *cobra.Command) {
 		}))
 }
 
+// RegisterCompletionFuncForClusterSelectorFlag registers CompletionFunc for flag cluster-selector.
+func RegisterCompletionFuncForClusterSelectorFlag(cmd *cobra.Command) {
+	cmdutil.CheckErr(cmd.RegisterFlagCompletionFunc(
+		"cluster-selector",
+		func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return ListClusterLabelsInConfig(toComplete), cobra.ShellCompDirectiveNoFileComp
+		}))
+}
+
 // RegisterCompletionFuncForKarmadaContextFlag registers CompletionFunc for flag karmada-context.
 func RegisterCompletionFuncForKarmadaContextFlag(cmd *cobra.Command) {
 	cmdutil.CheckErr(cmd.RegisterFlagCompletionFunc(
@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// RiskSummary describes the blast radius of a destructive operation so that
+// the user can judge it before the operation proceeds.
+type RiskSummary struct {
+	// Operation is a short imperative description of what is about to happen,
+	// e.g. "unjoin cluster member1".
+	Operation string
+
+	// Workloads is the number of workloads affected by the operation. Zero
+	// means the number is unknown or not applicable.
+	Workloads int
+
+	// Clusters is the number of member clusters affected by the operation.
+	// Zero means the number is unknown or not applicable.
+	Clusters int
+
+	// Details holds extra context lines printed below the headline.
+	Details []string
+}
+
+// Confirmation gates a destructive command behind an explicit confirmation.
+// The interactive prompt is skipped with --yes; automation contexts can skip
+// it permanently with 'karmadactl config set yes true'.
+type Confirmation struct {
+	// Yes proceeds with the operation without asking for confirmation.
+	Yes bool
+}
+
+// AddFlags adds the confirmation flag to the specified FlagSet.
+func (c *Confirmation) AddFlags(flags *pflag.FlagSet) {
+	flags.BoolVarP(&c.Yes, "yes", "y", false,
+		"Proceed without asking for confirmation. Automation contexts can make this the default with 'karmadactl config set yes true'.")
+}
+
+// Confirm prints the risk summary of a destructive operation and asks the
+// user to acknowledge it. It returns true when --yes was given or the user
+// answered yes, and an error when no answer could be read from the input.
+func (c *Confirmation) Confirm(summary RiskSummary, in io.Reader, out io.Writer) (bool, error) {
+	fmt.Fprintf(out, "You are about to %s.\n", summary.Operation)
+	for _, detail := range summary.Details {
+		fmt.Fprintf(out, "  %s\n", detail)
+	}
+	if summary.Workloads > 0 || summary.Clusters > 0 {
+		fmt.Fprintf(out, "This affects %d workload(s) across %d cluster(s).\n", summary.Workloads, summary.Clusters)
+	}
+	if c.Yes {
+		return true, nil
+	}
+
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(out, "Please type (y)es or (n)o and then press enter:")
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return false, err
+			}
+			return false, fmt.Errorf("no confirmation received, specify --yes to proceed without a prompt")
+		}
+		switch strings.ToLower(strings.TrimSpace(scanner.Text())) {
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestConfirm(t *testing.T) {
+	summary := RiskSummary{
+		Operation: "drain cluster member1",
+		Workloads: 3,
+		Clusters:  1,
+		Details:   []string{"the cluster will be tainted NoExecute"},
+	}
+
+	tests := []struct {
+		name    string
+		yes     bool
+		input   string
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:  "yes flag skips the prompt",
+			yes:   true,
+			input: "",
+			want:  true,
+		},
+		{
+			name:  "user confirms",
+			input: "yes\n",
+			want:  true,
+		},
+		{
+			name:  "user declines",
+			input: "n\n",
+			want:  false,
+		},
+		{
+			name:  "invalid answer is asked again",
+			input: "maybe\ny\n",
+			want:  true,
+		},
+		{
+			name:    "no answer available",
+			input:   "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Confirmation{Yes: tt.yes}
+			out := &bytes.Buffer{}
+			got, err := c.Confirm(summary, strings.NewReader(tt.input), out)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Confirm() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Confirm() = %v, want %v", got, tt.want)
+			}
+			if !strings.Contains(out.String(), "drain cluster member1") {
+				t.Errorf("Confirm() output does not mention the operation: %q", out.String())
+			}
+			if !strings.Contains(out.String(), "3 workload(s) across 1 cluster(s)") {
+				t.Errorf("Confirm() output does not contain the risk summary: %q", out.String())
+			}
+		})
+	}
+}
+
+func TestConfirmOmitsUnknownCounts(t *testing.T) {
+	c := &Confirmation{Yes: true}
+	out := &bytes.Buffer{}
+	if _, err := c.Confirm(RiskSummary{Operation: "remove the Karmada control plane"}, strings.NewReader(""), out); err != nil {
+		t.Fatalf("Confirm() returned error: %v", err)
+	}
+	if strings.Contains(out.String(), "affects") {
+		t.Errorf("Confirm() printed a count line for unknown counts: %q", out.String())
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,6 +47,30 @@ func CreateService(client kubernetes.Interface, service *corev1.Service) error {
 	return nil
 }
 
+// CreateOrUpdatePodDisruptionBudget creates a PodDisruptionBudget if the target resource doesn't exist.
+// If the resource exists already, this function will update the resource instead.
+func CreateOrUpdatePodDisruptionBudget(client kubernetes.Interface, pdb *policyv1.PodDisruptionBudget) error {
+	if _, err := client.PolicyV1().PodDisruptionBudgets(pdb.Namespace).Create(context.TODO(), pdb, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("unable to create PodDisruptionBudget: %v", err)
+		}
+
+		existPDB, err := client.PolicyV1().PodDisruptionBudgets(pdb.Namespace).Get(context.TODO(), pdb.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		pdb.ResourceVersion = existPDB.ResourceVersion
+
+		if _, err := client.PolicyV1().PodDisruptionBudgets(pdb.Namespace).Update(context.TODO(), pdb, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("unable to update PodDisruptionBudget: %v", err)
+		}
+	}
+	klog.V(2).Infof("PodDisruptionBudget %s/%s has been created or updated.", pdb.Namespace, pdb.Name)
+
+	return nil
+}
+
 // CreateOrUpdateSecret creates a Secret if the target resource doesn't exist.
 // If the resource exists already, this function will update the resource instead.
 func CreateOrUpdateSecret(client kubernetes.Interface, secret *corev1.Secret) error {
@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package steprunner provides a small step runner for long-running karmadactl
+// commands. It prints per-step progress with timing, optionally relays verbose
+// step logs and can emit the step results in a machine-readable form.
+package steprunner
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	// StepSucceeded indicates the step completed without error.
+	StepSucceeded = "succeeded"
+	// StepFailed indicates the step returned an error.
+	StepFailed = "failed"
+	// StepSkipped indicates the step did not run because an earlier step failed.
+	StepSkipped = "skipped"
+)
+
+// LogFunc relays a verbose log line from within a step. The lines are only
+// shown when the runner runs in verbose mode.
+type LogFunc func(format string, args ...interface{})
+
+// StepFunc is the body of a single step.
+type StepFunc func(logf LogFunc) error
+
+// Result records the outcome of a single step.
+type Result struct {
+	// Name is the step name as shown in the progress output.
+	Name string `json:"name"`
+	// Status is one of succeeded, failed or skipped.
+	Status string `json:"status"`
+	// Duration is how long the step ran, in time.Duration string form.
+	Duration string `json:"duration,omitempty"`
+	// Error holds the failure message of a failed step.
+	Error string `json:"error,omitempty"`
+}
+
+type step struct {
+	name string
+	run  StepFunc
+}
+
+// Runner executes a sequence of named steps with progress output.
+type Runner struct {
+	out     io.Writer
+	verbose bool
+	steps   []step
+	results []Result
+}
+
+// NewRunner returns a runner writing progress to out.
+func NewRunner(out io.Writer) *Runner {
+	return &Runner{out: out}
+}
+
+// WithVerbose toggles relaying of per-step log lines.
+func (r *Runner) WithVerbose(verbose bool) *Runner {
+	r.verbose = verbose
+	return r
+}
+
+// AddStep appends a named step to the sequence.
+func (r *Runner) AddStep(name string, run StepFunc) {
+	r.steps = append(r.steps, step{name: name, run: run})
+}
+
+// Run executes the steps in order and stops at the first failure. Steps after
+// a failed one are recorded as skipped.
+func (r *Runner) Run() error {
+	r.results = make([]Result, 0, len(r.steps))
+
+	var failed error
+	for i, current := range r.steps {
+		if failed != nil {
+			r.results = append(r.results, Result{Name: current.name, Status: StepSkipped})
+			continue
+		}
+
+		fmt.Fprintf(r.out, "[%d/%d] %s", i+1, len(r.steps), current.name)
+		if r.verbose {
+			// verbose log lines follow, finish the progress line first.
+			fmt.Fprintln(r.out)
+		}
+
+		start := time.Now()
+		err := current.run(r.logf)
+		duration := time.Since(start).Round(time.Millisecond)
+
+		result := Result{Name: current.name, Duration: duration.String()}
+		if err != nil {
+			result.Status = StepFailed
+			result.Error = err.Error()
+			failed = fmt.Errorf("step %q failed: %v", current.name, err)
+			if r.verbose {
+				fmt.Fprintf(r.out, "  failed (%s): %v\n", duration, err)
+			} else {
+				fmt.Fprintf(r.out, " ... failed (%s): %v\n", duration, err)
+			}
+		} else {
+			result.Status = StepSucceeded
+			if r.verbose {
+				fmt.Fprintf(r.out, "  done (%s)\n", duration)
+			} else {
+				fmt.Fprintf(r.out, " ... done (%s)\n", duration)
+			}
+		}
+		r.results = append(r.results, result)
+	}
+	return failed
+}
+
+// Results returns the recorded outcome of the last Run.
+func (r *Runner) Results() []Result {
+	return r.results
+}
+
+// WriteResults emits the step results of the last Run as JSON.
+func (r *Runner) WriteResults(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r.results)
+}
+
+func (r *Runner) logf(format string, args ...interface{}) {
+	if !r.verbose {
+		return
+	}
+	fmt.Fprintf(r.out, "  "+format+"\n", args...)
+}
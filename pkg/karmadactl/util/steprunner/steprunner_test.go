@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package steprunner
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRunStopsAtFirstFailure(t *testing.T) {
+	out := &bytes.Buffer{}
+	runner := NewRunner(out)
+
+	var thirdRan bool
+	runner.AddStep("first", func(LogFunc) error { return nil })
+	runner.AddStep("second", func(LogFunc) error { return errors.New("boom") })
+	runner.AddStep("third", func(LogFunc) error { thirdRan = true; return nil })
+
+	err := runner.Run()
+	if err == nil || !strings.Contains(err.Error(), `step "second" failed`) {
+		t.Fatalf("Run() error = %v, want second step failure", err)
+	}
+	if thirdRan {
+		t.Errorf("step after a failed one must not run")
+	}
+
+	results := runner.Results()
+	if len(results) != 3 {
+		t.Fatalf("Results() returned %d entries, want 3", len(results))
+	}
+	wantStatus := []string{StepSucceeded, StepFailed, StepSkipped}
+	for i, want := range wantStatus {
+		if results[i].Status != want {
+			t.Errorf("step %d status = %q, want %q", i, results[i].Status, want)
+		}
+	}
+	if results[1].Error == "" {
+		t.Errorf("failed step must record its error")
+	}
+
+	progress := out.String()
+	if !strings.Contains(progress, "[1/3] first") || !strings.Contains(progress, "failed") {
+		t.Errorf("unexpected progress output:\n%s", progress)
+	}
+}
+
+func TestVerboseLogging(t *testing.T) {
+	out := &bytes.Buffer{}
+	runner := NewRunner(out).WithVerbose(true)
+	runner.AddStep("step", func(logf LogFunc) error {
+		logf("detail %d", 42)
+		return nil
+	})
+	if err := runner.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if !strings.Contains(out.String(), "detail 42") {
+		t.Errorf("verbose log line missing from output:\n%s", out.String())
+	}
+
+	quiet := &bytes.Buffer{}
+	runner = NewRunner(quiet)
+	runner.AddStep("step", func(logf LogFunc) error {
+		logf("detail %d", 42)
+		return nil
+	})
+	if err := runner.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if strings.Contains(quiet.String(), "detail 42") {
+		t.Errorf("log line leaked into non-verbose output:\n%s", quiet.String())
+	}
+}
+
+func TestWriteResults(t *testing.T) {
+	runner := NewRunner(&bytes.Buffer{})
+	runner.AddStep("step", func(LogFunc) error { return nil })
+	if err := runner.Run(); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+
+	out := &bytes.Buffer{}
+	if err := runner.WriteResults(out); err != nil {
+		t.Fatalf("WriteResults() unexpected error: %v", err)
+	}
+	var results []Result
+	if err := json.Unmarshal(out.Bytes(), &results); err != nil {
+		t.Fatalf("WriteResults() emitted invalid JSON: %v", err)
+	}
+	if len(results) != 1 || results[0].Status != StepSucceeded || results[0].Duration == "" {
+		t.Errorf("unexpected results: %+v", results)
+	}
+}
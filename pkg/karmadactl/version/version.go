@@ -0,0 +1,381 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util/apiclient"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	"github.com/karmada-io/karmada/pkg/version"
+)
+
+var (
+	versionShort = `Print the version information`
+	versionLong  = templates.LongDesc(`
+		Print the version information of karmadactl, optionally together with a
+		fleet-wide version matrix covering the Karmada API server, the member
+		clusters and, when the host cluster is reachable, the control-plane
+		components and scheduler estimators.`)
+	versionExample = templates.Examples(`
+		# Print %[1]s command version
+		%[1]s version
+
+		# Print the fleet-wide version matrix
+		%[1]s version --fleet
+
+		# Include the control-plane component versions from the host cluster
+		%[1]s version --fleet --host-kubeconfig=/etc/karmada/host.config
+
+		# Print the fleet-wide version matrix as JSON
+		%[1]s version --fleet -o json`)
+)
+
+// CommandVersionOptions contains the input to the version command.
+type CommandVersionOptions struct {
+	// Fleet indicates to query the versions across the whole fleet instead of
+	// only printing the client version.
+	Fleet bool
+
+	// Output is the output format, one of "table" or "json".
+	Output string
+
+	// HostKubeConfig is the path of the kubeconfig of the cluster hosting the
+	// Karmada control plane, enables the component version checks.
+	HostKubeConfig string
+
+	// HostContext is the context of HostKubeConfig to use.
+	HostContext string
+
+	genericiooptions.IOStreams
+}
+
+// NewCmdVersion prints out the release version info for this command binary,
+// and optionally the versions of the whole fleet.
+func NewCmdVersion(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandVersionOptions{IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:     "version",
+		Short:   versionShort,
+		Long:    versionLong,
+		Example: fmt.Sprintf(versionExample, parentCommand),
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(f, parentCommand)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(&o.Fleet, "fleet", false, "Query the versions of the Karmada API server, the member clusters and the control-plane components as well.")
+	flags.StringVarP(&o.Output, "output", "o", "table", "Output format. One of: table|json.")
+	flags.StringVar(&o.HostKubeConfig, "host-kubeconfig", "", "Path of the kubeconfig of the cluster hosting the Karmada control plane. Enables the control-plane component and scheduler-estimator versions.")
+	flags.StringVar(&o.HostContext, "host-context", "", "Context name of host-kubeconfig to use. Only works with --host-kubeconfig.")
+
+	return cmd
+}
+
+// Validate checks the options.
+func (o *CommandVersionOptions) Validate() error {
+	if o.Output != "table" && o.Output != "json" {
+		return fmt.Errorf("invalid output format %q, must be table or json", o.Output)
+	}
+	return nil
+}
+
+// fleetVersions is the version matrix of the whole fleet.
+type fleetVersions struct {
+	Karmadactl   version.Info          `json:"karmadactl"`
+	ControlPlane *controlPlaneVersions `json:"controlPlane,omitempty"`
+	Clusters     []clusterVersions     `json:"clusters,omitempty"`
+	Warnings     []string              `json:"warnings,omitempty"`
+}
+
+// controlPlaneVersions holds the versions of the Karmada control plane.
+type controlPlaneVersions struct {
+	// KubernetesVersion is the version of the kube-apiserver serving the
+	// Karmada control plane.
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
+	// Components are the versions of the control-plane components running in
+	// the host cluster, derived from their image tags. Includes the
+	// per-cluster scheduler estimators.
+	Components []componentVersion `json:"components,omitempty"`
+}
+
+type componentVersion struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// clusterVersions holds the versions reported for a member cluster.
+type clusterVersions struct {
+	Name              string `json:"name"`
+	SyncMode          string `json:"syncMode,omitempty"`
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	AgentVersion      string `json:"agentVersion,omitempty"`
+}
+
+// Run executes the version command.
+func (o *CommandVersionOptions) Run(f util.Factory, parentCommand string) error {
+	matrix := fleetVersions{Karmadactl: version.Get()}
+	if o.Fleet {
+		if err := o.collect(f, &matrix); err != nil {
+			return err
+		}
+		matrix.Warnings = skewWarnings(matrix)
+	}
+
+	if o.Output == "json" {
+		encoded, err := json.MarshalIndent(matrix, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(o.Out, string(encoded))
+		return nil
+	}
+
+	fmt.Fprintf(o.Out, "%s version: %s\n", parentCommand, matrix.Karmadactl)
+	if o.Fleet {
+		o.printMatrix(matrix)
+	}
+	return nil
+}
+
+// collect gathers the versions across the fleet.
+func (o *CommandVersionOptions) collect(f util.Factory, matrix *fleetVersions) error {
+	kubeClient, err := f.KubernetesClientSet()
+	if err != nil {
+		return err
+	}
+	serverVersion, err := kubeClient.Discovery().ServerVersion()
+	if err != nil {
+		return fmt.Errorf("failed to get the Karmada API server version: %v", err)
+	}
+	matrix.ControlPlane = &controlPlaneVersions{KubernetesVersion: serverVersion.GitVersion}
+
+	karmadaClient, err := f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+	clusters, err := karmadaClient.ClusterV1alpha1().Clusters().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list member clusters: %v", err)
+	}
+	for _, cluster := range clusters.Items {
+		matrix.Clusters = append(matrix.Clusters, clusterVersions{
+			Name:              cluster.Name,
+			SyncMode:          string(cluster.Spec.SyncMode),
+			KubernetesVersion: cluster.Status.KubernetesVersion,
+			AgentVersion:      cluster.Annotations[clusterv1alpha1.AgentVersionAnnotationKey],
+		})
+	}
+
+	if o.HostKubeConfig == "" {
+		return nil
+	}
+	hostConfig, err := apiclient.RestConfig(o.HostContext, o.HostKubeConfig)
+	if err != nil {
+		return err
+	}
+	hostClient, err := kubernetes.NewForConfig(hostConfig)
+	if err != nil {
+		return err
+	}
+	matrix.ControlPlane.Components, err = componentVersions(hostClient)
+	return err
+}
+
+// componentVersions derives the control-plane component versions from the
+// image tags of the deployments in the karmada system namespace of the host
+// cluster.
+func componentVersions(hostClient kubernetes.Interface) ([]componentVersion, error) {
+	deployments, err := hostClient.AppsV1().Deployments(names.NamespaceKarmadaSystem).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the control-plane deployments: %v", err)
+	}
+
+	var components []componentVersion
+	for _, deployment := range deployments.Items {
+		containers := deployment.Spec.Template.Spec.Containers
+		if len(containers) == 0 {
+			continue
+		}
+		components = append(components, componentVersion{
+			Name:    deployment.Name,
+			Version: imageTag(containers[0].Image),
+		})
+	}
+	sort.Slice(components, func(i, j int) bool { return components[i].Name < components[j].Name })
+	return components, nil
+}
+
+// imageTag returns the tag of the image reference, "unknown" if it has none.
+func imageTag(image string) string {
+	index := strings.LastIndex(image, ":")
+	// a colon before a slash belongs to a registry port, not a tag
+	if index < 0 || strings.Contains(image[index:], "/") {
+		return "unknown"
+	}
+	return image[index+1:]
+}
+
+// skewWarnings derives warnings for unsupported version combinations from the
+// version matrix.
+func skewWarnings(matrix fleetVersions) []string {
+	var warnings []string
+
+	if matrix.ControlPlane != nil {
+		warnings = append(warnings, componentSkewWarnings(matrix.ControlPlane.Components)...)
+		warnings = append(warnings, clusterSkewWarnings(matrix.ControlPlane.KubernetesVersion, matrix.Clusters)...)
+	}
+	warnings = append(warnings, agentSkewWarnings(matrix.Karmadactl.GitVersion, matrix.Clusters)...)
+	return warnings
+}
+
+// componentSkewWarnings warns when the karmada control-plane components do not
+// run the same version.
+func componentSkewWarnings(components []componentVersion) []string {
+	versions := map[string][]string{}
+	for _, component := range components {
+		if !strings.HasPrefix(component.Name, "karmada-") || component.Version == "unknown" {
+			continue
+		}
+		// estimators follow the scheduler release cycle but are rolled out per
+		// cluster, report them separately below
+		if strings.HasPrefix(component.Name, names.KarmadaSchedulerEstimatorComponentName) {
+			continue
+		}
+		versions[component.Version] = append(versions[component.Version], component.Name)
+	}
+	if len(versions) <= 1 {
+		return nil
+	}
+
+	groups := make([]string, 0, len(versions))
+	for componentsVersion, members := range versions {
+		groups = append(groups, fmt.Sprintf("%s(%s)", componentsVersion, strings.Join(members, ", ")))
+	}
+	sort.Strings(groups)
+	return []string{fmt.Sprintf("control-plane components run mixed versions: %s", strings.Join(groups, ", "))}
+}
+
+// clusterSkewWarnings warns when a member cluster runs a Kubernetes version
+// the control plane cannot be expected to support: newer than the control
+// plane, or more than three minor releases older.
+func clusterSkewWarnings(controlPlaneVersion string, clusters []clusterVersions) []string {
+	controlPlane, err := utilversion.ParseGeneric(controlPlaneVersion)
+	if err != nil {
+		return nil
+	}
+
+	var warnings []string
+	for _, cluster := range clusters {
+		member, err := utilversion.ParseGeneric(cluster.KubernetesVersion)
+		if err != nil {
+			continue
+		}
+		switch {
+		case member.Major() != controlPlane.Major() || member.Minor() > controlPlane.Minor():
+			warnings = append(warnings, fmt.Sprintf("cluster %s runs kubernetes %s, newer than the control plane %s",
+				cluster.Name, cluster.KubernetesVersion, controlPlaneVersion))
+		case controlPlane.Minor()-member.Minor() > 3:
+			warnings = append(warnings, fmt.Sprintf("cluster %s runs kubernetes %s, more than three minor releases behind the control plane %s",
+				cluster.Name, cluster.KubernetesVersion, controlPlaneVersion))
+		}
+	}
+	return warnings
+}
+
+// agentSkewWarnings warns when a pull-mode agent is more than one minor
+// release away from the karmadactl version.
+func agentSkewWarnings(clientVersion string, clusters []clusterVersions) []string {
+	client, err := utilversion.ParseGeneric(clientVersion)
+	if err != nil {
+		return nil
+	}
+
+	var warnings []string
+	for _, cluster := range clusters {
+		if cluster.AgentVersion == "" {
+			continue
+		}
+		agent, err := utilversion.ParseGeneric(cluster.AgentVersion)
+		if err != nil {
+			continue
+		}
+		skew := int64(agent.Minor()) - int64(client.Minor())
+		if agent.Major() != client.Major() || skew > 1 || skew < -1 {
+			warnings = append(warnings, fmt.Sprintf("cluster %s runs karmada-agent %s, more than one minor release away from karmadactl %s",
+				cluster.Name, cluster.AgentVersion, clientVersion))
+		}
+	}
+	return warnings
+}
+
+// printMatrix renders the fleet version matrix as tables.
+func (o *CommandVersionOptions) printMatrix(matrix fleetVersions) {
+	if matrix.ControlPlane != nil {
+		fmt.Fprintf(o.Out, "karmada apiserver: kubernetes %s\n", matrix.ControlPlane.KubernetesVersion)
+		if len(matrix.ControlPlane.Components) > 0 {
+			fmt.Fprintln(o.Out)
+			writer := tabwriter.NewWriter(o.Out, 0, 8, 2, ' ', 0)
+			fmt.Fprintln(writer, "COMPONENT\tVERSION")
+			for _, component := range matrix.ControlPlane.Components {
+				fmt.Fprintf(writer, "%s\t%s\n", component.Name, component.Version)
+			}
+			writer.Flush()
+		}
+	}
+
+	if len(matrix.Clusters) > 0 {
+		fmt.Fprintln(o.Out)
+		writer := tabwriter.NewWriter(o.Out, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(writer, "CLUSTER\tSYNC-MODE\tKUBERNETES\tAGENT")
+		for _, cluster := range matrix.Clusters {
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n",
+				cluster.Name, cluster.SyncMode, valueOrDash(cluster.KubernetesVersion), valueOrDash(cluster.AgentVersion))
+		}
+		writer.Flush()
+	}
+
+	for _, warning := range matrix.Warnings {
+		fmt.Fprintf(o.ErrOut, "Warning: %s\n", warning)
+	}
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/karmada-io/karmada/pkg/util/names"
+	"github.com/karmada-io/karmada/pkg/version"
+)
+
+func TestImageTag(t *testing.T) {
+	tests := []struct {
+		image string
+		want  string
+	}{
+		{image: "docker.io/karmada/karmada-scheduler:v1.10.0", want: "v1.10.0"},
+		{image: "registry.local:5000/karmada/karmada-webhook:v1.10.0", want: "v1.10.0"},
+		{image: "registry.local:5000/karmada/karmada-webhook", want: "unknown"},
+		{image: "karmada-scheduler", want: "unknown"},
+	}
+	for _, tt := range tests {
+		if got := imageTag(tt.image); got != tt.want {
+			t.Errorf("imageTag(%q) = %q, want %q", tt.image, got, tt.want)
+		}
+	}
+}
+
+func newDeployment(name, image string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: names.NamespaceKarmadaSystem, Name: name},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: name, Image: image}},
+				},
+			},
+		},
+	}
+}
+
+func TestComponentVersions(t *testing.T) {
+	hostClient := fakeclientset.NewClientset(
+		newDeployment("karmada-scheduler", "karmada/karmada-scheduler:v1.10.0"),
+		newDeployment("karmada-controller-manager", "karmada/karmada-controller-manager:v1.10.0"),
+	)
+
+	components, err := componentVersions(hostClient)
+	if err != nil {
+		t.Fatalf("componentVersions() returned error: %v", err)
+	}
+	want := []componentVersion{
+		{Name: "karmada-controller-manager", Version: "v1.10.0"},
+		{Name: "karmada-scheduler", Version: "v1.10.0"},
+	}
+	if len(components) != len(want) {
+		t.Fatalf("componentVersions() = %v, want %v", components, want)
+	}
+	for i := range want {
+		if components[i] != want[i] {
+			t.Errorf("componentVersions()[%d] = %v, want %v", i, components[i], want[i])
+		}
+	}
+}
+
+func TestSkewWarnings(t *testing.T) {
+	tests := []struct {
+		name        string
+		matrix      fleetVersions
+		wantWarning string
+	}{
+		{
+			name: "aligned versions produce no warnings",
+			matrix: fleetVersions{
+				Karmadactl: version.Info{GitVersion: "v1.10.0"},
+				ControlPlane: &controlPlaneVersions{
+					KubernetesVersion: "v1.30.2",
+					Components: []componentVersion{
+						{Name: "karmada-scheduler", Version: "v1.10.0"},
+						{Name: "karmada-webhook", Version: "v1.10.0"},
+					},
+				},
+				Clusters: []clusterVersions{
+					{Name: "member1", KubernetesVersion: "v1.29.0", AgentVersion: "v1.10.0"},
+				},
+			},
+		},
+		{
+			name: "mixed control-plane versions",
+			matrix: fleetVersions{
+				Karmadactl: version.Info{GitVersion: "v1.10.0"},
+				ControlPlane: &controlPlaneVersions{
+					KubernetesVersion: "v1.30.2",
+					Components: []componentVersion{
+						{Name: "karmada-scheduler", Version: "v1.10.0"},
+						{Name: "karmada-webhook", Version: "v1.9.1"},
+					},
+				},
+			},
+			wantWarning: "control-plane components run mixed versions",
+		},
+		{
+			name: "member cluster newer than the control plane",
+			matrix: fleetVersions{
+				Karmadactl:   version.Info{GitVersion: "v1.10.0"},
+				ControlPlane: &controlPlaneVersions{KubernetesVersion: "v1.30.2"},
+				Clusters: []clusterVersions{
+					{Name: "member1", KubernetesVersion: "v1.31.0"},
+				},
+			},
+			wantWarning: "newer than the control plane",
+		},
+		{
+			name: "member cluster far behind the control plane",
+			matrix: fleetVersions{
+				Karmadactl:   version.Info{GitVersion: "v1.10.0"},
+				ControlPlane: &controlPlaneVersions{KubernetesVersion: "v1.30.2"},
+				Clusters: []clusterVersions{
+					{Name: "member1", KubernetesVersion: "v1.25.0"},
+				},
+			},
+			wantWarning: "more than three minor releases behind",
+		},
+		{
+			name: "agent skew beyond one minor release",
+			matrix: fleetVersions{
+				Karmadactl: version.Info{GitVersion: "v1.10.0"},
+				Clusters: []clusterVersions{
+					{Name: "member1", AgentVersion: "v1.8.0"},
+				},
+			},
+			wantWarning: "more than one minor release away from karmadactl",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings := skewWarnings(tt.matrix)
+			if tt.wantWarning == "" {
+				if len(warnings) != 0 {
+					t.Errorf("skewWarnings() = %v, want none", warnings)
+				}
+				return
+			}
+			found := false
+			for _, warning := range warnings {
+				if strings.Contains(warning, tt.wantWarning) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("skewWarnings() = %v, want a warning containing %q", warnings, tt.wantWarning)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2025 The Karmada Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/resource"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	kubectlwait "k8s.io/kubectl/pkg/cmd/wait"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/karmada/pkg/clientfanout"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+var (
+	waitExample = templates.Examples(`
+		# Wait for the deployment "nginx" on the Karmada control plane to become Available
+		%[1]s wait deployment/nginx --for=condition=Available
+
+		# Wait for the deployment "nginx" to become Available in member clusters 'member1' and 'member2'
+		%[1]s wait deployment/nginx --for=condition=Available --operation-scope=members --clusters=member1,member2
+
+		# Wait for the deployment "nginx" to become Available in every cluster it is scheduled to
+		%[1]s wait deployment/nginx --for=condition=Available --all-scheduled-clusters --timeout=5m`)
+)
+
+// CommandWaitOptions declares the arguments specific to the wait command of karmadactl.
+type CommandWaitOptions struct {
+	// OperationScope is the operation scope of the command.
+	OperationScope options.OperationScope
+	// Clusters is the names of the target member clusters, and only takes
+	// effect when the operation scope is members.
+	Clusters []string
+	// AllScheduledClusters indicates waiting in every member cluster the
+	// resources are scheduled to, resolved from their bindings.
+	AllScheduledClusters bool
+}
+
+// NewCmdWait returns new initialized instance of wait sub command
+func NewCmdWait(f util.Factory, parentCommand string, ioStreams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandWaitOptions{OperationScope: options.KarmadaControlPlane}
+
+	cmd := kubectlwait.NewCmdWait(f, ioStreams)
+	cmd.Example = fmt.Sprintf(waitExample, parentCommand)
+	cmd.Annotations = map[string]string{
+		util.TagCommandGroup: util.GroupAdvancedCommands,
+	}
+	options.AddKubeConfigFlags(cmd.Flags())
+	options.AddNamespaceFlag(cmd.Flags())
+	cmd.Flags().VarP(&o.OperationScope, "operation-scope", "s", "Used to control the operation scope of the command. The optional values are karmada and members. Defaults to karmada.")
+	cmd.Flags().StringSliceVarP(&o.Clusters, "clusters", "C", []string{}, "Used to specify target member clusters and only takes effect when the command's operation scope is members, for example: --operation-scope=members --clusters=member1,member2")
+	cmd.Flags().BoolVar(&o.AllScheduledClusters, "all-scheduled-clusters", false, "Wait in every member cluster the resources are scheduled to, resolved from their ResourceBindings. Implies --operation-scope=members.")
+
+	// Keep the behavior of kubectl for the Karmada control plane, and fan the
+	// command out to the member clusters for the members scope.
+	controlPlaneRun := cmd.Run
+	cmd.Run = nil
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if err := o.Complete(f, cmd, args); err != nil {
+			return err
+		}
+		if err := o.Validate(); err != nil {
+			return err
+		}
+		if o.OperationScope == options.KarmadaControlPlane {
+			controlPlaneRun(cmd, args)
+			return nil
+		}
+		return o.RunAcrossClusters(f, cmd, args, ioStreams)
+	}
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	utilcomp.RegisterCompletionFuncForOperationScopeFlag(cmd, options.KarmadaControlPlane, options.Members)
+	utilcomp.RegisterCompletionFuncForClustersFlag(cmd)
+	return cmd
+}
+
+// Complete resolves the target member clusters from the bindings of the
+// selected resources when --all-scheduled-clusters is specified.
+func (o *CommandWaitOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
+	if !o.AllScheduledClusters {
+		return nil
+	}
+	if len(o.Clusters) > 0 {
+		return errors.New("--all-scheduled-clusters cannot be combined with --clusters")
+	}
+	o.OperationScope = options.Members
+	clusters, err := scheduledClusters(f, cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(clusters) == 0 {
+		return errors.New("the selected resources are not scheduled to any cluster")
+	}
+	o.Clusters = clusters
+	return nil
+}
+
+// Validate checks that the provided wait options are specified.
+func (o *CommandWaitOptions) Validate() error {
+	err := options.VerifyOperationScopeFlags(o.OperationScope, options.KarmadaControlPlane, options.Members)
+	if err != nil {
+		return err
+	}
+	if o.OperationScope == options.Members && len(o.Clusters) == 0 {
+		return errors.New("must specify member clusters by --clusters or --all-scheduled-clusters")
+	}
+	return nil
+}
+
+// RunAcrossClusters waits in every target member cluster in turn, so the
+// command only succeeds once the condition holds everywhere.
+func (o *CommandWaitOptions) RunAcrossClusters(f util.Factory, cmd *cobra.Command, args []string, ioStreams genericiooptions.IOStreams) error {
+	var errs []error
+	for _, cluster := range o.Clusters {
+		memberFactory, err := f.FactoryForMemberCluster(cluster)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cluster(%s): %v", cluster, err))
+			continue
+		}
+		if len(o.Clusters) > 1 {
+			fmt.Fprintf(ioStreams.Out, "# cluster: %s\n", cluster)
+		}
+
+		memberCmd := kubectlwait.NewCmdWait(memberFactory, ioStreams)
+		clientfanout.CopyFlags(cmd, memberCmd)
+		if err = clientfanout.RunMemberCommand(memberCmd, args); err != nil {
+			errs = append(errs, fmt.Errorf("cluster(%s): %v", cluster, err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// scheduledClusters resolves the clusters the selected resources are
+// scheduled to through their ResourceBindings or ClusterResourceBindings on
+// the Karmada control plane.
+func scheduledClusters(f util.Factory, cmd *cobra.Command, args []string) ([]string, error) {
+	karmadaClient, err := f.KarmadaClientSet()
+	if err != nil {
+		return nil, err
+	}
+	namespace, _, err := f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := sets.New[string]()
+	result := f.NewBuilder().
+		Unstructured().
+		NamespaceParam(namespace).DefaultNamespace().
+		LabelSelectorParam(cmdutil.GetFlagString(cmd, "selector")).
+		FieldSelectorParam(cmdutil.GetFlagString(cmd, "field-selector")).
+		SelectAllParam(cmdutil.GetFlagBool(cmd, "all")).
+		ResourceTypeOrNameArgs(false, args...).
+		Flatten().
+		Do()
+	err = result.Visit(func(info *resource.Info, err error) error {
+		if err != nil {
+			return err
+		}
+		kind := info.Mapping.GroupVersionKind.Kind
+		bindingName := names.GenerateBindingName(kind, info.Name)
+		if info.Namespace != "" {
+			binding, err := karmadaClient.WorkV1alpha2().ResourceBindings(info.Namespace).Get(context.TODO(), bindingName, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to get ResourceBinding for %s: %v", info.ObjectName(), err)
+			}
+			for _, cluster := range binding.Spec.Clusters {
+				clusters.Insert(cluster.Name)
+			}
+			return nil
+		}
+		binding, err := karmadaClient.WorkV1alpha2().ClusterResourceBindings().Get(context.TODO(), bindingName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get ClusterResourceBinding for %s: %v", info.ObjectName(), err)
+		}
+		for _, cluster := range binding.Spec.Clusters {
+			clusters.Insert(cluster.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sets.List(clusters), nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	registryCacheSizeMetricsName     = "registry_cache_bytes"
+	registryCacheEvictionMetricsName = "registry_cache_evictions_total"
+)
+
+var (
+	registryCacheSizeGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: registryCacheSizeMetricsName,
+		Help: "Approximate memory consumed by the in-memory caches of a ResourceRegistry, in bytes.",
+	}, []string{"registry"})
+
+	registryCacheEvictionCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: registryCacheEvictionMetricsName,
+		Help: "Number of resources evicted from a ResourceRegistry cache for exceeding the memory budget.",
+	}, []string{"registry", "resource"})
+)
+
+// RecordRegistryCacheSize records the approximate cache size of a ResourceRegistry.
+func RecordRegistryCacheSize(registry string, bytes int64) {
+	registryCacheSizeGauge.WithLabelValues(registry).Set(float64(bytes))
+}
+
+// RecordRegistryCacheEviction records a resource evicted from a ResourceRegistry cache.
+func RecordRegistryCacheEviction(registry, resource string) {
+	registryCacheEvictionCounter.WithLabelValues(registry, resource).Inc()
+}
+
+// RegistryCacheCollectors returns the collectors about ResourceRegistry caches.
+func RegistryCacheCollectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		registryCacheSizeGauge,
+		registryCacheEvictionCounter,
+	}
+}
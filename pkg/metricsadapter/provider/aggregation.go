@@ -0,0 +1,159 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// AggregationStrategy defines how metric values collected from multiple
+// member clusters are merged into a single value.
+type AggregationStrategy string
+
+const (
+	// AggregationSum adds the values of all member clusters, suitable for
+	// counter-like metrics such as request totals.
+	AggregationSum AggregationStrategy = "sum"
+	// AggregationAverage averages the values over the member clusters that
+	// reported the metric, suitable for utilization-like metrics.
+	AggregationAverage AggregationStrategy = "avg"
+	// AggregationMax takes the largest value among the member clusters,
+	// suitable for metrics like queue depth where the worst cluster should
+	// drive the scaling decision.
+	AggregationMax AggregationStrategy = "max"
+)
+
+// MissingClusterPolicy defines the behavior when a member cluster fails to
+// report a metric.
+type MissingClusterPolicy string
+
+const (
+	// MissingClusterIgnore aggregates over the clusters that did report the
+	// metric and ignores the rest.
+	MissingClusterIgnore MissingClusterPolicy = "ignore"
+	// MissingClusterFail rejects the query when any cluster fails to report
+	// the metric, preventing scaling decisions based on partial data.
+	MissingClusterFail MissingClusterPolicy = "fail"
+)
+
+// AggregationOptions holds the per-metric aggregation configuration of the
+// custom metrics provider.
+type AggregationOptions struct {
+	// DefaultStrategy is applied to metrics without an explicit rule.
+	DefaultStrategy AggregationStrategy
+	// Rules maps a metric name to its aggregation strategy.
+	Rules map[string]AggregationStrategy
+	// MissingClusterPolicy decides how queries behave when a member cluster
+	// fails to report a metric.
+	MissingClusterPolicy MissingClusterPolicy
+}
+
+// NewAggregationOptions returns the default aggregation options, keeping the
+// historical behavior: sum across clusters and ignore missing ones.
+func NewAggregationOptions() *AggregationOptions {
+	return &AggregationOptions{
+		DefaultStrategy:      AggregationSum,
+		Rules:                map[string]AggregationStrategy{},
+		MissingClusterPolicy: MissingClusterIgnore,
+	}
+}
+
+// Validate checks that the configured strategies and policy are known.
+func (o *AggregationOptions) Validate() error {
+	if err := validateStrategy(o.DefaultStrategy); err != nil {
+		return fmt.Errorf("invalid default aggregation strategy: %v", err)
+	}
+	for metric, strategy := range o.Rules {
+		if err := validateStrategy(strategy); err != nil {
+			return fmt.Errorf("invalid aggregation rule for metric %q: %v", metric, err)
+		}
+	}
+	switch o.MissingClusterPolicy {
+	case MissingClusterIgnore, MissingClusterFail:
+	default:
+		return fmt.Errorf("unsupported missing cluster policy %q, supported values are %q and %q",
+			o.MissingClusterPolicy, MissingClusterIgnore, MissingClusterFail)
+	}
+	return nil
+}
+
+// StrategyFor returns the aggregation strategy configured for the metric,
+// falling back to the default strategy.
+func (o *AggregationOptions) StrategyFor(metric string) AggregationStrategy {
+	if strategy, ok := o.Rules[metric]; ok {
+		return strategy
+	}
+	return o.DefaultStrategy
+}
+
+// ParseAggregationRules parses rule specifications of the form
+// "METRIC=STRATEGY", e.g. "http_requests=avg".
+func ParseAggregationRules(ruleSpecs []string) (map[string]AggregationStrategy, error) {
+	rules := make(map[string]AggregationStrategy, len(ruleSpecs))
+	for _, spec := range ruleSpecs {
+		parts := strings.SplitN(spec, "=", 2)
+		if len(parts) != 2 || len(parts[0]) == 0 {
+			return nil, fmt.Errorf("invalid aggregation rule %q, expect METRIC=STRATEGY", spec)
+		}
+		strategy := AggregationStrategy(parts[1])
+		if err := validateStrategy(strategy); err != nil {
+			return nil, fmt.Errorf("invalid aggregation rule %q: %v", spec, err)
+		}
+		rules[parts[0]] = strategy
+	}
+	return rules, nil
+}
+
+func validateStrategy(strategy AggregationStrategy) error {
+	switch strategy {
+	case AggregationSum, AggregationAverage, AggregationMax:
+		return nil
+	default:
+		return fmt.Errorf("unsupported strategy %q, supported values are %q, %q and %q",
+			strategy, AggregationSum, AggregationAverage, AggregationMax)
+	}
+}
+
+// aggregateQuantities merges the values reported by the member clusters
+// according to the given strategy. The caller guarantees at least one value.
+func aggregateQuantities(strategy AggregationStrategy, values []resource.Quantity) resource.Quantity {
+	switch strategy {
+	case AggregationMax:
+		result := values[0]
+		for _, value := range values[1:] {
+			if value.Cmp(result) > 0 {
+				result = value
+			}
+		}
+		return result
+	case AggregationAverage:
+		var sum int64
+		for _, value := range values {
+			sum += value.MilliValue()
+		}
+		return *resource.NewMilliQuantity(sum/int64(len(values)), values[0].Format)
+	default:
+		result := values[0].DeepCopy()
+		for _, value := range values[1:] {
+			result.Add(value)
+		}
+		return result
+	}
+}
@@ -22,7 +22,9 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"sync/atomic"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -50,13 +52,20 @@ type CustomMetricsProvider struct {
 	// multiClusterDiscovery returns a discovery client for member cluster apiserver
 	multiClusterDiscovery multiclient.MultiClusterDiscoveryInterface
 	clusterLister         clusterlister.ClusterLister
+	// aggregation configures how metric values from multiple clusters are
+	// merged and how missing clusters are handled.
+	aggregation *AggregationOptions
 }
 
 // MakeCustomMetricsProvider creates a new custom metrics provider
-func MakeCustomMetricsProvider(clusterLister clusterlister.ClusterLister, multiClusterDiscovery multiclient.MultiClusterDiscoveryInterface) *CustomMetricsProvider {
+func MakeCustomMetricsProvider(clusterLister clusterlister.ClusterLister, multiClusterDiscovery multiclient.MultiClusterDiscoveryInterface, aggregation *AggregationOptions) *CustomMetricsProvider {
+	if aggregation == nil {
+		aggregation = NewAggregationOptions()
+	}
 	return &CustomMetricsProvider{
 		clusterLister:         clusterLister,
 		multiClusterDiscovery: multiClusterDiscovery,
+		aggregation:           aggregation,
 	}
 }
 
@@ -69,6 +78,7 @@ func (c *CustomMetricsProvider) GetMetricByName(ctx context.Context, name types.
 	}
 	metricValueList := &custom_metrics.MetricValueList{}
 	metricsChannel := make(chan *custom_metrics.MetricValueList)
+	var failedClusters atomic.Int32
 	wg := sync.WaitGroup{}
 	for _, cluster := range clusters {
 		wg.Add(1)
@@ -76,6 +86,7 @@ func (c *CustomMetricsProvider) GetMetricByName(ctx context.Context, name types.
 			defer wg.Done()
 			metrics, err := c.getMetricByName(ctx, clusterName, name, info, metricSelector)
 			if err != nil {
+				failedClusters.Add(1)
 				klog.Warningf("query %s's %s metric from cluster %s failed, err: %+v", info.GroupResource.String(), info.Metric, clusterName, err)
 				return
 			}
@@ -93,20 +104,22 @@ func (c *CustomMetricsProvider) GetMetricByName(ctx context.Context, name types.
 		}
 		metricValueList.Items = append(metricValueList.Items, metrics.Items...)
 	}
-	var metrics *custom_metrics.MetricValue
-	// TODO(chaunceyjiang) The MetricValue items need to be sorted.
-	for i := range metricValueList.Items {
-		if metrics == nil {
-			metrics = &metricValueList.Items[i]
-			continue
-		}
-		// metrics is unique in one cluster, but it may exist in multiple clusters.
-		// for this situation, we need to add the value of all clusters.
-		metrics.Value.Add(metricValueList.Items[i].Value)
+	if failed := failedClusters.Load(); failed > 0 && c.aggregation.MissingClusterPolicy == MissingClusterFail {
+		return nil, fmt.Errorf("metric %s of %s is missing from %d member cluster(s)", info.Metric, info.GroupResource.String(), failed)
 	}
-	if metrics == nil {
+	if len(metricValueList.Items) == 0 {
 		return nil, provider.NewMetricNotFoundError(info.GroupResource, info.Metric)
 	}
+	// metrics is unique in one cluster, but it may exist in multiple clusters.
+	// for this situation, we need to aggregate the value of all clusters
+	// according to the strategy configured for the metric.
+	// TODO(chaunceyjiang) The MetricValue items need to be sorted.
+	values := make([]resource.Quantity, 0, len(metricValueList.Items))
+	for i := range metricValueList.Items {
+		values = append(values, metricValueList.Items[i].Value)
+	}
+	metrics := &metricValueList.Items[0]
+	metrics.Value = aggregateQuantities(c.aggregation.StrategyFor(info.Metric), values)
 	return metrics, nil
 }
 
@@ -120,12 +133,14 @@ func (c *CustomMetricsProvider) GetMetricBySelector(ctx context.Context, namespa
 	metricValueList := &custom_metrics.MetricValueList{}
 	wg := sync.WaitGroup{}
 	metricsChannel := make(chan *custom_metrics.MetricValueList)
+	var failedClusters atomic.Int32
 	for _, cluster := range clusters {
 		wg.Add(1)
 		go func(clusterName string) {
 			defer wg.Done()
 			metrics, err := c.getMetricBySelector(ctx, clusterName, namespace, selector, info, metricSelector)
 			if err != nil {
+				failedClusters.Add(1)
 				klog.Warningf("query %s's %s metric from cluster %s failed", info.GroupResource.String(), info.Metric, clusterName)
 				return
 			}
@@ -137,6 +152,7 @@ func (c *CustomMetricsProvider) GetMetricBySelector(ctx context.Context, namespa
 		close(metricsChannel)
 	}()
 	sameMetrics := make(map[string]custom_metrics.MetricValue)
+	clusterValues := make(map[string][]resource.Quantity)
 	for {
 		metrics, ok := <-metricsChannel
 		if !ok {
@@ -145,14 +161,18 @@ func (c *CustomMetricsProvider) GetMetricBySelector(ctx context.Context, namespa
 		// TODO(chaunceyjiang) The MetricValue items need to be sorted.
 		for _, metric := range metrics.Items {
 			// metrics is unique in one cluster, but it may exist in multiple clusters.
-			// for this situation, we need to add the value of all clusters.
-			if metricValue, same := sameMetrics[metric.DescribedObject.Name]; same {
-				metric.Value.Add(metricValue.Value)
-			}
+			// for this situation, we need to aggregate the value of all clusters
+			// according to the strategy configured for the metric.
+			clusterValues[metric.DescribedObject.Name] = append(clusterValues[metric.DescribedObject.Name], metric.Value)
 			sameMetrics[metric.DescribedObject.Name] = metric
 		}
 	}
-	for _, metric := range sameMetrics {
+	if failed := failedClusters.Load(); failed > 0 && c.aggregation.MissingClusterPolicy == MissingClusterFail {
+		return nil, fmt.Errorf("metric %s of %s is missing from %d member cluster(s)", info.Metric, info.GroupResource.String(), failed)
+	}
+	strategy := c.aggregation.StrategyFor(info.Metric)
+	for name, metric := range sameMetrics {
+		metric.Value = aggregateQuantities(strategy, clusterValues[name])
 		metricValueList.Items = append(metricValueList.Items, metric)
 	}
 	if len(metricValueList.Items) == 0 {
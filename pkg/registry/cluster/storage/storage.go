@@ -46,6 +46,7 @@ type ClusterStorage struct {
 	Cluster *REST
 	Status  *StatusREST
 	Proxy   *ProxyREST
+	WhoAmI  *WhoAmIREST
 }
 
 // NewStorage returns a ClusterStorage object that will work against clusters.
@@ -94,6 +95,7 @@ func NewStorage(scheme *runtime.Scheme, restConfig *restclient.Config, secretLis
 			karmadaLocation:  karmadaLocation,
 			karmadaTransPort: karmadaTransport,
 		},
+		WhoAmI: &WhoAmIREST{clusterLister: clusterRest.listClusters},
 	}, nil
 }
 
@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	clusterapis "github.com/karmada-io/karmada/pkg/apis/cluster"
+	"github.com/karmada-io/karmada/pkg/util/proxy"
+)
+
+// WhoAmIREST implements the virtual, create-only whoami resource which reports
+// the caller's identity and their proxy access to the member clusters.
+type WhoAmIREST struct {
+	clusterLister func(ctx context.Context) (*clusterapis.ClusterList, error)
+}
+
+// Implement Creater
+var _ = rest.Creater(&WhoAmIREST{})
+
+// New returns an empty WhoAmI object.
+func (r *WhoAmIREST) New() runtime.Object {
+	return &clusterapis.WhoAmI{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *WhoAmIREST) Destroy() {
+	// Given that the storage is stateless, we don't have anything to destroy.
+}
+
+// NamespaceScoped returns false as whoami is cluster scoped.
+func (r *WhoAmIREST) NamespaceScoped() bool {
+	return false
+}
+
+// GetSingularName returns the singular name of the resource.
+func (r *WhoAmIREST) GetSingularName() string {
+	return "whoami"
+}
+
+// Create resolves the caller's identity instead of persisting the object.
+func (r *WhoAmIREST) Create(ctx context.Context, obj runtime.Object, _ rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	whoAmI, ok := obj.(*clusterapis.WhoAmI)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type: %#v", obj)
+	}
+
+	requester, exist := request.UserFrom(ctx)
+	if !exist {
+		return nil, errors.New("no user found for request")
+	}
+
+	result := whoAmI.DeepCopy()
+	result.Status = clusterapis.WhoAmIStatus{
+		Username: requester.GetName(),
+		UID:      requester.GetUID(),
+		Groups:   requester.GetGroups(),
+	}
+
+	clusterList, err := r.clusterLister(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %v", err)
+	}
+
+	// The proxy impersonates the original user in the member cluster, except
+	// for the groups that must not be forwarded.
+	var impersonatedGroups []string
+	for _, group := range requester.GetGroups() {
+		if !proxy.SkipGroup(group) {
+			impersonatedGroups = append(impersonatedGroups, group)
+		}
+	}
+
+	for i := range clusterList.Items {
+		memberCluster := &clusterList.Items[i]
+		access := clusterapis.ClusterAccess{Name: memberCluster.Name}
+		if memberCluster.Spec.ImpersonatorSecretRef == nil {
+			access.Reason = "the cluster does not have an impersonator secret configured"
+		} else {
+			access.ProxyAvailable = true
+			access.ImpersonatedUser = requester.GetName()
+			access.ImpersonatedGroups = impersonatedGroups
+		}
+		result.Status.Clusters = append(result.Status.Clusters, access)
+	}
+	return result, nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	clusterapis "github.com/karmada-io/karmada/pkg/apis/cluster"
+)
+
+func TestWhoAmIRESTCreate(t *testing.T) {
+	clusterList := &clusterapis.ClusterList{
+		Items: []clusterapis.Cluster{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+				Spec: clusterapis.ClusterSpec{
+					ImpersonatorSecretRef: &clusterapis.LocalSecretReference{Namespace: "karmada-cluster", Name: "member1"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "member2"},
+			},
+		},
+	}
+	storage := &WhoAmIREST{
+		clusterLister: func(context.Context) (*clusterapis.ClusterList, error) {
+			return clusterList, nil
+		},
+	}
+
+	ctx := request.WithUser(context.TODO(), &user.DefaultInfo{
+		Name:   "alice",
+		UID:    "uid-1",
+		Groups: []string{"team-a", "system:authenticated"},
+	})
+	obj, err := storage.Create(ctx, &clusterapis.WhoAmI{}, nil, &metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("Create() unexpected error: %v", err)
+	}
+	whoAmI := obj.(*clusterapis.WhoAmI)
+
+	if whoAmI.Status.Username != "alice" || whoAmI.Status.UID != "uid-1" {
+		t.Errorf("unexpected identity: %+v", whoAmI.Status)
+	}
+	if len(whoAmI.Status.Clusters) != 2 {
+		t.Fatalf("expected access info for 2 clusters, got %d", len(whoAmI.Status.Clusters))
+	}
+
+	member1 := whoAmI.Status.Clusters[0]
+	if !member1.ProxyAvailable || member1.ImpersonatedUser != "alice" {
+		t.Errorf("member1 access = %+v, want proxy available for alice", member1)
+	}
+	if !reflect.DeepEqual(member1.ImpersonatedGroups, []string{"team-a"}) {
+		t.Errorf("member1 impersonated groups = %v, want the system groups filtered out", member1.ImpersonatedGroups)
+	}
+
+	member2 := whoAmI.Status.Clusters[1]
+	if member2.ProxyAvailable || member2.Reason == "" {
+		t.Errorf("member2 access = %+v, want proxy unavailable with reason", member2)
+	}
+}
+
+func TestWhoAmIRESTCreateWithoutUser(t *testing.T) {
+	storage := &WhoAmIREST{
+		clusterLister: func(context.Context) (*clusterapis.ClusterList, error) {
+			return &clusterapis.ClusterList{}, nil
+		},
+	}
+	if _, err := storage.Create(context.TODO(), &clusterapis.WhoAmI{}, nil, &metav1.CreateOptions{}); err == nil {
+		t.Errorf("Create() without an authenticated user must fail")
+	}
+}
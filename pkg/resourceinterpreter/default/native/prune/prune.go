@@ -17,15 +17,18 @@ limitations under the License.
 package prune
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	storagevolume "k8s.io/component-helpers/storage/volume"
 	utildeployment "k8s.io/kubectl/pkg/util/deployment"
 
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
 	"github.com/karmada-io/karmada/pkg/util"
 	"github.com/karmada-io/karmada/pkg/util/helper"
 )
@@ -74,6 +77,9 @@ func RemoveIrrelevantFields(workload *unstructured.Unstructured, extraHooks ...f
 	// populated by the kubernetes.
 	unstructured.RemoveNestedField(workload.Object, "metadata", "uid")
 
+	if err := preserveOwnerReferences(workload); err != nil {
+		return err
+	}
 	unstructured.RemoveNestedField(workload.Object, "metadata", "ownerReferences")
 
 	unstructured.RemoveNestedField(workload.Object, "metadata", "finalizers")
@@ -92,6 +98,42 @@ func RemoveIrrelevantFields(workload *unstructured.Unstructured, extraHooks ...f
 	return nil
 }
 
+// preserveOwnerReferences records the workload's ownerReferences in an annotation before they
+// are pruned, so that they can be translated and re-established in member clusters after apply.
+// It only takes effect when the workload declares the PreserveOwnerReferencesAnnotation.
+func preserveOwnerReferences(workload *unstructured.Unstructured) error {
+	if workload.GetAnnotations()[workv1alpha2.PreserveOwnerReferencesAnnotation] != "true" {
+		return nil
+	}
+
+	ownerReferences := workload.GetOwnerReferences()
+	if len(ownerReferences) == 0 {
+		return nil
+	}
+
+	// UIDs and blockOwnerDeletion only make sense in the source cluster, drop them so that
+	// they can be populated from the owner object in each member cluster.
+	preservedReferences := make([]metav1.OwnerReference, 0, len(ownerReferences))
+	for i := range ownerReferences {
+		preservedReferences = append(preservedReferences, metav1.OwnerReference{
+			APIVersion: ownerReferences[i].APIVersion,
+			Kind:       ownerReferences[i].Kind,
+			Name:       ownerReferences[i].Name,
+			Controller: ownerReferences[i].Controller,
+		})
+	}
+
+	referencesData, err := json.Marshal(preservedReferences)
+	if err != nil {
+		return fmt.Errorf("failed to marshal preserved ownerReferences: %v", err)
+	}
+
+	annotations := workload.GetAnnotations()
+	annotations[workv1alpha2.PreservedOwnerReferencesAnnotation] = string(referencesData)
+	workload.SetAnnotations(annotations)
+	return nil
+}
+
 func removeGenerateSelectorOfJob(workload *unstructured.Unstructured) error {
 	matchLabels, exist, err := unstructured.NestedStringMap(workload.Object, "spec", "selector", "matchLabels")
 	if err != nil {
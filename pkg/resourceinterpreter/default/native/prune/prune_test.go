@@ -17,15 +17,18 @@ limitations under the License.
 package prune
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	storagevolume "k8s.io/component-helpers/storage/volume"
 	utildeployment "k8s.io/kubectl/pkg/util/deployment"
 
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
 	"github.com/karmada-io/karmada/pkg/util"
 )
 
@@ -333,3 +336,60 @@ func getShouldNotRemoveFields(t *test) ([]field, error) {
 	}
 	return shouldNotRemoveFields, nil
 }
+
+func TestPreserveOwnerReferences(t *testing.T) {
+	makeWorkload := func(annotations map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": annotations,
+					"ownerReferences": []interface{}{
+						map[string]interface{}{
+							"apiVersion": "example.com/v1",
+							"kind":       "Foo",
+							"name":       "foo",
+							"uid":        "db56a4a6-0dff-465a-b046-2c1dea42a42b",
+							"controller": true,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("ownerReferences are not preserved without the opt-in annotation", func(t *testing.T) {
+		workload := makeWorkload(map[string]interface{}{})
+		if err := RemoveIrrelevantFields(workload); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, exist := workload.GetAnnotations()[workv1alpha2.PreservedOwnerReferencesAnnotation]; exist {
+			t.Errorf("expected ownerReferences not to be preserved without the opt-in annotation")
+		}
+	})
+
+	t.Run("ownerReferences are preserved with the opt-in annotation", func(t *testing.T) {
+		workload := makeWorkload(map[string]interface{}{
+			workv1alpha2.PreserveOwnerReferencesAnnotation: "true",
+		})
+		if err := RemoveIrrelevantFields(workload); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(workload.GetOwnerReferences()) != 0 {
+			t.Errorf("expected ownerReferences to be pruned from the workload")
+		}
+		preserved := workload.GetAnnotations()[workv1alpha2.PreservedOwnerReferencesAnnotation]
+		if preserved == "" {
+			t.Fatalf("expected ownerReferences to be recorded in annotation %s", workv1alpha2.PreservedOwnerReferencesAnnotation)
+		}
+		var references []metav1.OwnerReference
+		if err := json.Unmarshal([]byte(preserved), &references); err != nil {
+			t.Fatalf("failed to unmarshal preserved ownerReferences: %v", err)
+		}
+		if len(references) != 1 || references[0].Name != "foo" || references[0].Kind != "Foo" {
+			t.Errorf("unexpected preserved ownerReferences: %v", references)
+		}
+		if references[0].UID != "" {
+			t.Errorf("expected UID to be dropped from preserved ownerReferences, got %q", references[0].UID)
+		}
+	})
+}
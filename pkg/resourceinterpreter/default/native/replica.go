@@ -70,6 +70,16 @@ func statefulSetReplica(object *unstructured.Unstructured) (int32, *workv1alpha2
 		replica = *sts.Spec.Replicas
 	}
 	requirement := helper.GenerateReplicaRequirements(&sts.Spec.Template)
+	// Each replica claims its own volumes, carry the claim templates so the
+	// scheduler estimator can check whether the storage can be provisioned.
+	if len(sts.Spec.VolumeClaimTemplates) > 0 {
+		if requirement == nil {
+			requirement = &workv1alpha2.ReplicaRequirements{}
+		}
+		for i := range sts.Spec.VolumeClaimTemplates {
+			requirement.VolumeClaims = append(requirement.VolumeClaims, sts.Spec.VolumeClaimTemplates[i].Spec)
+		}
+	}
 
 	return replica, requirement, nil
 }
@@ -0,0 +1,95 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+)
+
+// LoadSchedulerConfiguration loads a SchedulerConfiguration from the given
+// file, applies the defaults and validates the result.
+func LoadSchedulerConfiguration(path string) (*SchedulerConfiguration, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scheduler configuration file %q: %w", path, err)
+	}
+
+	config := &SchedulerConfiguration{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("failed to decode scheduler configuration file %q: %w", path, err)
+	}
+	if gv, kind := GroupName+"/"+Version, SchedulerConfigurationKind; config.APIVersion != gv || config.Kind != kind {
+		return nil, fmt.Errorf("unsupported scheduler configuration %s/%s, expected %s/%s",
+			config.APIVersion, config.Kind, gv, kind)
+	}
+
+	SetDefaultsSchedulerConfiguration(config)
+	if err := ValidateSchedulerConfiguration(config); err != nil {
+		return nil, fmt.Errorf("invalid scheduler configuration file %q: %w", path, err)
+	}
+	return config, nil
+}
+
+// SetDefaultsSchedulerConfiguration fills the unset fields of the
+// configuration with their default values.
+func SetDefaultsSchedulerConfiguration(config *SchedulerConfiguration) {
+	for i := range config.Profiles {
+		profile := &config.Profiles[i]
+		if profile.SchedulerName == "" {
+			profile.SchedulerName = "default-scheduler"
+		}
+		if len(profile.Plugins) == 0 {
+			profile.Plugins = []string{"*"}
+		}
+	}
+}
+
+// ValidateSchedulerConfiguration tells if the configuration is valid.
+func ValidateSchedulerConfiguration(config *SchedulerConfiguration) error {
+	if len(config.Profiles) == 0 {
+		return fmt.Errorf("at least one profile is required")
+	}
+
+	schedulerNames := sets.New[string]()
+	for i := range config.Profiles {
+		profile := &config.Profiles[i]
+		if schedulerNames.Has(profile.SchedulerName) {
+			return fmt.Errorf("duplicate schedulerName %q", profile.SchedulerName)
+		}
+		schedulerNames.Insert(profile.SchedulerName)
+
+		pluginNames := sets.New[string]()
+		for j := range profile.PluginConfig {
+			pluginConfig := &profile.PluginConfig[j]
+			if pluginConfig.Name == "" {
+				return fmt.Errorf("profile %q: pluginConfig name must not be empty", profile.SchedulerName)
+			}
+			if pluginNames.Has(pluginConfig.Name) {
+				return fmt.Errorf("profile %q: duplicate pluginConfig for plugin %q", profile.SchedulerName, pluginConfig.Name)
+			}
+			pluginNames.Insert(pluginConfig.Name)
+			if pluginConfig.Weight != nil && *pluginConfig.Weight <= 0 {
+				return fmt.Errorf("profile %q: weight of plugin %q must be positive", profile.SchedulerName, pluginConfig.Name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/utils/ptr"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "scheduler-config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write scheduler configuration file: %v", err)
+	}
+	return path
+}
+
+func TestLoadSchedulerConfiguration(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		expectErr bool
+		verify    func(t *testing.T, config *SchedulerConfiguration)
+	}{
+		{
+			name: "valid configuration with two profiles",
+			content: `apiVersion: scheduler.config.karmada.io/v1alpha1
+kind: SchedulerConfiguration
+profiles:
+- schedulerName: default-scheduler
+- schedulerName: batch-scheduler
+  plugins: ["*", "-ClusterLocality"]
+  pluginConfig:
+  - name: TaintToleration
+    weight: 2
+    args:
+      foo: bar
+`,
+			verify: func(t *testing.T, config *SchedulerConfiguration) {
+				if len(config.Profiles) != 2 {
+					t.Fatalf("expected 2 profiles, got %d", len(config.Profiles))
+				}
+				if got := config.Profiles[0].Plugins; len(got) != 1 || got[0] != "*" {
+					t.Errorf("expected plugins to default to ['*'], got %v", got)
+				}
+				batch := config.Profiles[1]
+				if batch.SchedulerName != "batch-scheduler" {
+					t.Errorf("unexpected schedulerName %q", batch.SchedulerName)
+				}
+				if len(batch.PluginConfig) != 1 || *batch.PluginConfig[0].Weight != 2 {
+					t.Errorf("unexpected pluginConfig %+v", batch.PluginConfig)
+				}
+				if len(batch.PluginConfig[0].Args.Raw) == 0 {
+					t.Errorf("expected raw plugin args to be preserved")
+				}
+			},
+		},
+		{
+			name: "empty schedulerName defaults to default-scheduler",
+			content: `apiVersion: scheduler.config.karmada.io/v1alpha1
+kind: SchedulerConfiguration
+profiles:
+- plugins: ["*"]
+`,
+			verify: func(t *testing.T, config *SchedulerConfiguration) {
+				if config.Profiles[0].SchedulerName != "default-scheduler" {
+					t.Errorf("expected default schedulerName, got %q", config.Profiles[0].SchedulerName)
+				}
+			},
+		},
+		{
+			name: "unsupported apiVersion",
+			content: `apiVersion: scheduler.config.karmada.io/v1beta1
+kind: SchedulerConfiguration
+profiles:
+- schedulerName: default-scheduler
+`,
+			expectErr: true,
+		},
+		{
+			name: "unknown field",
+			content: `apiVersion: scheduler.config.karmada.io/v1alpha1
+kind: SchedulerConfiguration
+profiles:
+- schedulerName: default-scheduler
+  unknownField: true
+`,
+			expectErr: true,
+		},
+		{
+			name: "no profiles",
+			content: `apiVersion: scheduler.config.karmada.io/v1alpha1
+kind: SchedulerConfiguration
+profiles: []
+`,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := LoadSchedulerConfiguration(writeConfigFile(t, tt.content))
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.verify != nil {
+				tt.verify(t, config)
+			}
+		})
+	}
+}
+
+func TestValidateSchedulerConfiguration(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    *SchedulerConfiguration
+		expectErr bool
+	}{
+		{
+			name: "valid configuration",
+			config: &SchedulerConfiguration{Profiles: []SchedulerProfile{
+				{SchedulerName: "default-scheduler", PluginConfig: []PluginConfig{{Name: "TaintToleration", Weight: ptr.To[int32](2)}}},
+			}},
+		},
+		{
+			name: "duplicate schedulerName",
+			config: &SchedulerConfiguration{Profiles: []SchedulerProfile{
+				{SchedulerName: "default-scheduler"},
+				{SchedulerName: "default-scheduler"},
+			}},
+			expectErr: true,
+		},
+		{
+			name: "duplicate pluginConfig",
+			config: &SchedulerConfiguration{Profiles: []SchedulerProfile{
+				{SchedulerName: "default-scheduler", PluginConfig: []PluginConfig{{Name: "foo"}, {Name: "foo"}}},
+			}},
+			expectErr: true,
+		},
+		{
+			name: "empty pluginConfig name",
+			config: &SchedulerConfiguration{Profiles: []SchedulerProfile{
+				{SchedulerName: "default-scheduler", PluginConfig: []PluginConfig{{Name: ""}}},
+			}},
+			expectErr: true,
+		},
+		{
+			name: "non-positive weight",
+			config: &SchedulerConfiguration{Profiles: []SchedulerProfile{
+				{SchedulerName: "default-scheduler", PluginConfig: []PluginConfig{{Name: "foo", Weight: ptr.To[int32](0)}}},
+			}},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSchedulerConfiguration(tt.config)
+			if gotErr := err != nil; gotErr != tt.expectErr {
+				t.Errorf("ValidateSchedulerConfiguration() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
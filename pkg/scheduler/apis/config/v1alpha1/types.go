@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// GroupName is the group name used in this package.
+	GroupName = "scheduler.config.karmada.io"
+	// Version is the API version of this package.
+	Version = "v1alpha1"
+	// SchedulerConfigurationKind is the kind name of SchedulerConfiguration.
+	SchedulerConfigurationKind = "SchedulerConfiguration"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SchedulerConfiguration configures the karmada-scheduler. It is loaded from
+// the file referenced by the `--config` flag and replaces the flag-based
+// plugin enablement with named profiles, so a single scheduler instance can
+// serve different workload classes with different scheduling behavior.
+type SchedulerConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Profiles are the scheduling profiles the scheduler serves. Bindings
+	// choose a profile by setting their `.spec.schedulerName` to the profile's
+	// schedulerName, bindings that reference none of the profiles are ignored
+	// by this scheduler instance.
+	// +required
+	Profiles []SchedulerProfile `json:"profiles"`
+}
+
+// SchedulerProfile is a scheduling profile with its own set of enabled
+// plugins and per-plugin configuration.
+type SchedulerProfile struct {
+	// SchedulerName is the name bindings select this profile by. It must be
+	// unique across the profiles. Defaults to "default-scheduler".
+	// +optional
+	SchedulerName string `json:"schedulerName,omitempty"`
+
+	// Plugins is the list of plugins to enable for this profile, using the
+	// same syntax as the `--plugins` flag: '*' enables all in-tree and
+	// customized plugins, 'foo' enables the plugin named 'foo', '*,-foo'
+	// enables all plugins but 'foo'. Defaults to '*'.
+	// +optional
+	Plugins []string `json:"plugins,omitempty"`
+
+	// PluginConfig holds per-plugin arguments and score weights for the
+	// enabled plugins.
+	// +optional
+	PluginConfig []PluginConfig `json:"pluginConfig,omitempty"`
+}
+
+// PluginConfig configures a single plugin of a profile.
+type PluginConfig struct {
+	// Name is the name of the plugin being configured.
+	// +required
+	Name string `json:"name"`
+
+	// Weight multiplies the scores produced by the plugin when it implements
+	// the Score extension point. It must be positive and defaults to 1.
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+
+	// Args holds the arguments passed to the plugin at initialization time.
+	// The structure is defined by the plugin, plugins that do not accept
+	// arguments reject the configuration.
+	// +optional
+	Args *runtime.RawExtension `json:"args,omitempty"`
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package carbon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// clustersDataKey is the ConfigMap data key holding the per-cluster carbon
+// intensity signals, a YAML map from cluster name to gCO2e per kWh, e.g.
+// `member1: 120.5`.
+const clustersDataKey = "clusters"
+
+// ConfigMapSource periodically ingests carbon intensity signals from a
+// user-maintained ConfigMap, typically kept up to date by an adapter polling
+// a grid data service such as WattTime or Electricity Maps.
+type ConfigMapSource struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+	interval  time.Duration
+	store     *Store
+}
+
+// NewConfigMapSource creates a ConfigMapSource feeding the given store.
+func NewConfigMapSource(client kubernetes.Interface, namespace, name string, interval time.Duration, store *Store) *ConfigMapSource {
+	return &ConfigMapSource{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+		interval:  interval,
+		store:     store,
+	}
+}
+
+// Run ingests the ConfigMap periodically until the context is done.
+func (s *ConfigMapSource) Run(ctx context.Context) {
+	klog.Infof("Starting carbon intensity ConfigMap source with ConfigMap(%s/%s)", s.namespace, s.name)
+	wait.UntilWithContext(ctx, s.syncOnce, s.interval)
+}
+
+func (s *ConfigMapSource) syncOnce(ctx context.Context) {
+	configMap, err := s.client.CoreV1().ConfigMaps(s.namespace).Get(ctx, s.name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(4).Infof("Carbon intensity ConfigMap(%s/%s) does not exist, skip.", s.namespace, s.name)
+			return
+		}
+		klog.Errorf("Failed to get carbon intensity ConfigMap(%s/%s): %v", s.namespace, s.name, err)
+		return
+	}
+	if err := ingestConfigMapData(configMap.Data, s.store); err != nil {
+		klog.Errorf("Failed to ingest carbon intensity ConfigMap(%s/%s): %v", s.namespace, s.name, err)
+	}
+}
+
+// ingestConfigMapData parses the signals in the ConfigMap data and records
+// them in the store.
+func ingestConfigMapData(data map[string]string, store *Store) error {
+	raw, ok := data[clustersDataKey]
+	if !ok {
+		return nil
+	}
+	clusters := map[string]float64{}
+	if err := yaml.Unmarshal([]byte(raw), &clusters); err != nil {
+		return fmt.Errorf("failed to parse %q: %w", clustersDataKey, err)
+	}
+	for cluster, intensity := range clusters {
+		if intensity < 0 {
+			return fmt.Errorf("invalid carbon intensity %v of cluster %q: must not be negative", intensity, cluster)
+		}
+		store.SetClusterCarbonIntensity(cluster, intensity)
+	}
+	return nil
+}
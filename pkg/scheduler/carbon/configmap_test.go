@@ -0,0 +1,80 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package carbon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngestConfigMapData(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      map[string]string
+		expectErr bool
+		verify    func(t *testing.T, store *Store)
+	}{
+		{
+			name: "empty data",
+			data: map[string]string{},
+		},
+		{
+			name: "cluster intensities",
+			data: map[string]string{
+				clustersDataKey: "member1: 120.5\nmember2: 450\n",
+			},
+			verify: func(t *testing.T, store *Store) {
+				intensity, ok := store.ClusterCarbonIntensity("member1")
+				assert.True(t, ok)
+				assert.Equal(t, 120.5, intensity)
+				intensity, ok = store.ClusterCarbonIntensity("member2")
+				assert.True(t, ok)
+				assert.Equal(t, float64(450), intensity)
+			},
+		},
+		{
+			name: "negative intensity",
+			data: map[string]string{
+				clustersDataKey: "member1: -10\n",
+			},
+			expectErr: true,
+		},
+		{
+			name: "malformed yaml",
+			data: map[string]string{
+				clustersDataKey: "member1: low\n",
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewStore()
+			err := ingestConfigMapData(tt.data, store)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			if tt.verify != nil {
+				tt.verify(t, store)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package carbon holds the per-cluster carbon intensity signals the scheduler
+// uses for carbon-aware placement. The signals are ingested from a
+// user-maintained ConfigMap or written by external provider adapters, e.g.
+// for grid data services such as WattTime or Electricity Maps.
+package carbon
+
+import (
+	"sync"
+)
+
+// Provider exposes the carbon intensity signals to consumers such as the
+// CarbonAware scheduler plugin.
+type Provider interface {
+	// ClusterCarbonIntensity returns the carbon intensity of the grid powering
+	// the member cluster, in gCO2e per kWh, false when no signal is known.
+	ClusterCarbonIntensity(cluster string) (float64, bool)
+}
+
+// Store is an in-memory Provider the ConfigMap source and provider adapters
+// write their signals to.
+type Store struct {
+	mu sync.RWMutex
+	// cluster maps a member cluster name to the carbon intensity of its grid.
+	cluster map[string]float64
+}
+
+// NewStore creates an empty carbon intensity store.
+func NewStore() *Store {
+	return &Store{
+		cluster: make(map[string]float64),
+	}
+}
+
+// ClusterCarbonIntensity implements Provider.
+func (s *Store) ClusterCarbonIntensity(cluster string) (float64, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	intensity, ok := s.cluster[cluster]
+	return intensity, ok
+}
+
+// SetClusterCarbonIntensity records the carbon intensity of the grid powering
+// the member cluster, in gCO2e per kWh.
+func (s *Store) SetClusterCarbonIntensity(cluster string, intensity float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cluster[cluster] = intensity
+}
+
+// DeleteCluster drops the signal of the cluster, e.g. after the cluster has
+// been unregistered.
+func (s *Store) DeleteCluster(cluster string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.cluster, cluster)
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package carbon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoreClusterCarbonIntensity(t *testing.T) {
+	store := NewStore()
+
+	_, ok := store.ClusterCarbonIntensity("member1")
+	assert.False(t, ok)
+
+	store.SetClusterCarbonIntensity("member1", 120.5)
+	intensity, ok := store.ClusterCarbonIntensity("member1")
+	assert.True(t, ok)
+	assert.Equal(t, 120.5, intensity)
+
+	store.SetClusterCarbonIntensity("member1", 95)
+	intensity, ok = store.ClusterCarbonIntensity("member1")
+	assert.True(t, ok)
+	assert.Equal(t, float64(95), intensity)
+
+	store.DeleteCluster("member1")
+	_, ok = store.ClusterCarbonIntensity("member1")
+	assert.False(t, ok)
+}
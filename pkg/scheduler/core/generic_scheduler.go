@@ -56,8 +56,9 @@ type genericScheduler struct {
 func NewGenericScheduler(
 	schedCache cache.Cache,
 	registry runtime.Registry,
+	frameworkOpts ...runtime.Option,
 ) (ScheduleAlgorithm, error) {
-	f, err := runtime.NewFramework(registry)
+	f, err := runtime.NewFramework(registry, frameworkOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +107,15 @@ func (g *genericScheduler) Schedule(
 	}
 	klog.V(4).Infof("Assigned Replicas: %v", clustersWithReplicas)
 
+	clustersWithReplicas, err = applyClusterTopologySpread(spec.Placement, clusters, clustersWithReplicas)
+	if err != nil {
+		return result, fmt.Errorf("failed to apply cluster topology spread constraints: %w", err)
+	}
+
+	if err = validateProviderSpread(spec.Placement, clusters, clustersWithReplicas); err != nil {
+		return result, err
+	}
+
 	if scheduleAlgorithmOption.EnableEmptyWorkloadPropagation {
 		clustersWithReplicas = attachZeroReplicasCluster(clusters, clustersWithReplicas)
 	}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+// validateProviderSpread rejects placements that concentrate the workload in
+// fewer distinct providers or failure domains than the provider anti-affinity
+// of the placement requires. Only clusters that actually run the workload
+// count: with the divided replica scheduling type these are the clusters
+// assigned at least one replica, otherwise every selected cluster.
+func validateProviderSpread(
+	placement *policyv1alpha1.Placement,
+	clusters []*clusterv1alpha1.Cluster,
+	assignments []workv1alpha2.TargetCluster,
+) error {
+	if placement == nil || placement.ProviderAntiAffinity == nil {
+		return nil
+	}
+	antiAffinity := placement.ProviderAntiAffinity
+
+	clusterMap := make(map[string]*clusterv1alpha1.Cluster, len(clusters))
+	for _, cluster := range clusters {
+		clusterMap[cluster.Name] = cluster
+	}
+
+	divided := placement.ReplicaSchedulingType() == policyv1alpha1.ReplicaSchedulingTypeDivided
+	domains := sets.New[string]()
+	for _, assignment := range assignments {
+		if divided && assignment.Replicas == 0 {
+			continue
+		}
+		cluster, ok := clusterMap[assignment.Name]
+		if !ok {
+			continue
+		}
+		if domain := clusterDomain(cluster, antiAffinity.TopologyKey); domain != "" {
+			domains.Insert(domain)
+		}
+	}
+
+	if domains.Len() < int(antiAffinity.MinProviders) {
+		return fmt.Errorf("cannot satisfy the provider anti-affinity: selected clusters span %d distinct provider(s), less than the required %d",
+			domains.Len(), antiAffinity.MinProviders)
+	}
+	return nil
+}
+
+// clusterDomain returns the failure domain of the cluster: the value of the
+// topology key label when one is declared, the provider of the cluster spec
+// otherwise. An empty result means the domain of the cluster is unknown.
+func clusterDomain(cluster *clusterv1alpha1.Cluster, topologyKey string) string {
+	if topologyKey != "" {
+		return cluster.Labels[topologyKey]
+	}
+	return cluster.Spec.Provider
+}
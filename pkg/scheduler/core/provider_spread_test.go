@@ -0,0 +1,156 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+func newClusterWithProvider(name, provider string, labels map[string]string) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Spec: clusterv1alpha1.ClusterSpec{
+			Provider: provider,
+		},
+	}
+}
+
+func antiAffinityPlacement(minProviders int32, topologyKey string, divided bool) *policyv1alpha1.Placement {
+	placement := &policyv1alpha1.Placement{
+		ProviderAntiAffinity: &policyv1alpha1.ProviderAntiAffinity{
+			MinProviders: minProviders,
+			TopologyKey:  topologyKey,
+		},
+	}
+	if divided {
+		placement.ReplicaScheduling = &policyv1alpha1.ReplicaSchedulingStrategy{
+			ReplicaSchedulingType: policyv1alpha1.ReplicaSchedulingTypeDivided,
+		}
+	}
+	return placement
+}
+
+func Test_validateProviderSpread(t *testing.T) {
+	tests := []struct {
+		name        string
+		placement   *policyv1alpha1.Placement
+		clusters    []*clusterv1alpha1.Cluster
+		assignments []workv1alpha2.TargetCluster
+		wantErr     bool
+	}{
+		{
+			name:      "no provider anti-affinity",
+			placement: &policyv1alpha1.Placement{},
+			clusters: []*clusterv1alpha1.Cluster{
+				newClusterWithProvider(ClusterMember1, "aws", nil),
+			},
+			assignments: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1},
+			},
+		},
+		{
+			name:      "clusters span enough providers",
+			placement: antiAffinityPlacement(2, "", false),
+			clusters: []*clusterv1alpha1.Cluster{
+				newClusterWithProvider(ClusterMember1, "aws", nil),
+				newClusterWithProvider(ClusterMember2, "gcp", nil),
+			},
+			assignments: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1},
+				{Name: ClusterMember2},
+			},
+		},
+		{
+			name:      "clusters concentrate in a single provider",
+			placement: antiAffinityPlacement(2, "", false),
+			clusters: []*clusterv1alpha1.Cluster{
+				newClusterWithProvider(ClusterMember1, "aws", nil),
+				newClusterWithProvider(ClusterMember2, "aws", nil),
+			},
+			assignments: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1},
+				{Name: ClusterMember2},
+			},
+			wantErr: true,
+		},
+		{
+			name:      "clusters without a provider do not count",
+			placement: antiAffinityPlacement(2, "", false),
+			clusters: []*clusterv1alpha1.Cluster{
+				newClusterWithProvider(ClusterMember1, "aws", nil),
+				newClusterWithProvider(ClusterMember2, "", nil),
+			},
+			assignments: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1},
+				{Name: ClusterMember2},
+			},
+			wantErr: true,
+		},
+		{
+			name:      "domains derived from the topology key label",
+			placement: antiAffinityPlacement(2, "example.com/failure-domain", false),
+			clusters: []*clusterv1alpha1.Cluster{
+				newClusterWithProvider(ClusterMember1, "aws", map[string]string{"example.com/failure-domain": "dc-1"}),
+				newClusterWithProvider(ClusterMember2, "aws", map[string]string{"example.com/failure-domain": "dc-2"}),
+			},
+			assignments: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1},
+				{Name: ClusterMember2},
+			},
+		},
+		{
+			name:      "divided replicas concentrate after assignment",
+			placement: antiAffinityPlacement(2, "", true),
+			clusters: []*clusterv1alpha1.Cluster{
+				newClusterWithProvider(ClusterMember1, "aws", nil),
+				newClusterWithProvider(ClusterMember2, "gcp", nil),
+			},
+			assignments: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1, Replicas: 6},
+				{Name: ClusterMember2, Replicas: 0},
+			},
+			wantErr: true,
+		},
+		{
+			name:      "divided replicas span enough providers",
+			placement: antiAffinityPlacement(2, "", true),
+			clusters: []*clusterv1alpha1.Cluster{
+				newClusterWithProvider(ClusterMember1, "aws", nil),
+				newClusterWithProvider(ClusterMember2, "gcp", nil),
+			},
+			assignments: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1, Replicas: 4},
+				{Name: ClusterMember2, Replicas: 2},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateProviderSpread(tt.placement, tt.clusters, tt.assignments)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateProviderSpread() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
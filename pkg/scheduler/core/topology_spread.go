@@ -0,0 +1,242 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+// applyClusterTopologySpread rebalances the assigned replicas so that the
+// replica difference between any two topology domains stays within the MaxSkew
+// of each cluster topology spread constraint. Replicas are moved one by one
+// from the fullest domain to the emptiest one, deliberately trading the
+// division strategy's preference for topology balance. When a constraint with
+// DoNotSchedule still cannot be satisfied(e.g. constraints on different keys
+// conflict), an error is returned to fail the scheduling.
+// The constraints only apply to the divided replica scheduling type, with the
+// duplicated type every selected cluster runs the full replicas anyway.
+func applyClusterTopologySpread(
+	placement *policyv1alpha1.Placement,
+	clusters []*clusterv1alpha1.Cluster,
+	assignments []workv1alpha2.TargetCluster,
+) ([]workv1alpha2.TargetCluster, error) {
+	if placement == nil || len(placement.ClusterTopologySpreadConstraints) == 0 ||
+		placement.ReplicaSchedulingType() != policyv1alpha1.ReplicaSchedulingTypeDivided {
+		return assignments, nil
+	}
+
+	replicas := make(map[string]int32, len(clusters))
+	for _, cluster := range clusters {
+		replicas[cluster.Name] = 0
+	}
+	var total int32
+	for _, assignment := range assignments {
+		replicas[assignment.Name] = assignment.Replicas
+		total += assignment.Replicas
+	}
+
+	for _, constraint := range placement.ClusterTopologySpreadConstraints {
+		rebalanceTopologyDomains(constraint, clusters, replicas, total)
+	}
+
+	// A later constraint may have unbalanced an earlier one, or the domain
+	// layout may make the constraints impossible to satisfy together, so
+	// verify them all on the final assignment.
+	for _, constraint := range placement.ClusterTopologySpreadConstraints {
+		if skew := topologySkew(constraint.TopologyKey, clusters, replicas); skew > constraint.MaxSkew {
+			if constraint.WhenUnsatisfiable == policyv1alpha1.ScheduleAnyway {
+				continue
+			}
+			return nil, fmt.Errorf("cannot satisfy the topology spread constraint on %s: skew %d exceeds maxSkew %d",
+				constraint.TopologyKey, skew, constraint.MaxSkew)
+		}
+	}
+
+	return rebuildAssignments(assignments, clusters, replicas), nil
+}
+
+// rebalanceTopologyDomains moves replicas from the fullest domain of the
+// constraint's topology key to the emptiest one until the skew fits within
+// MaxSkew or no move can improve it further.
+func rebalanceTopologyDomains(
+	constraint policyv1alpha1.ClusterTopologySpreadConstraint,
+	clusters []*clusterv1alpha1.Cluster,
+	replicas map[string]int32,
+	total int32,
+) {
+	for moved := int32(0); moved < total; moved++ {
+		domains := groupTopologyDomains(constraint.TopologyKey, clusters)
+		if len(domains) < 2 {
+			return
+		}
+		totals := domainTotals(domains, replicas)
+		maxDomain, minDomain := extremeDomains(totals)
+		if totals[maxDomain]-totals[minDomain] <= constraint.MaxSkew {
+			return
+		}
+
+		// A cluster spanning both domains(possible with multi-zone clusters)
+		// contributes to both totals, moving its replicas cannot reduce the skew.
+		minMembers := sets.New[string](domains[minDomain]...)
+		maxMembers := sets.New[string](domains[maxDomain]...)
+		donor := pickDonor(domains[maxDomain], minMembers, replicas)
+		receiver := pickReceiver(domains[minDomain], maxMembers, replicas)
+		if donor == "" || receiver == "" {
+			return
+		}
+		replicas[donor]--
+		replicas[receiver]++
+	}
+}
+
+// groupTopologyDomains groups the cluster names by their value of the topology
+// key. A cluster reporting multiple zones belongs to each of them, a cluster
+// without a value for the key does not take part in any domain.
+func groupTopologyDomains(key policyv1alpha1.SpreadFieldValue, clusters []*clusterv1alpha1.Cluster) map[string][]string {
+	domains := make(map[string][]string)
+	for _, cluster := range clusters {
+		for _, value := range topologyValues(key, cluster) {
+			domains[value] = append(domains[value], cluster.Name)
+		}
+	}
+	return domains
+}
+
+// topologyValues returns the values the cluster reports for the topology key.
+func topologyValues(key policyv1alpha1.SpreadFieldValue, cluster *clusterv1alpha1.Cluster) []string {
+	var values []string
+	switch key {
+	case policyv1alpha1.SpreadByFieldProvider:
+		if cluster.Spec.Provider != "" {
+			values = []string{cluster.Spec.Provider}
+		}
+	case policyv1alpha1.SpreadByFieldRegion:
+		if cluster.Spec.Region != "" {
+			values = []string{cluster.Spec.Region}
+		}
+	case policyv1alpha1.SpreadByFieldZone:
+		values = cluster.Spec.Zones
+	}
+	return values
+}
+
+// domainTotals sums the replicas assigned to the clusters of each domain.
+func domainTotals(domains map[string][]string, replicas map[string]int32) map[string]int32 {
+	totals := make(map[string]int32, len(domains))
+	for domain, members := range domains {
+		for _, member := range members {
+			totals[domain] += replicas[member]
+		}
+	}
+	return totals
+}
+
+// extremeDomains returns the domains with the most and the fewest replicas,
+// breaking ties by domain name to keep the rebalancing deterministic.
+func extremeDomains(totals map[string]int32) (maxDomain, minDomain string) {
+	domains := make([]string, 0, len(totals))
+	for domain := range totals {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+	maxDomain, minDomain = domains[0], domains[0]
+	for _, domain := range domains[1:] {
+		if totals[domain] > totals[maxDomain] {
+			maxDomain = domain
+		}
+		if totals[domain] < totals[minDomain] {
+			minDomain = domain
+		}
+	}
+	return maxDomain, minDomain
+}
+
+// pickDonor returns the cluster of the fullest domain to take a replica from:
+// the one holding the most replicas that is not also part of the emptiest
+// domain, ties broken by name.
+func pickDonor(members []string, minMembers sets.Set[string], replicas map[string]int32) string {
+	donor := ""
+	for _, member := range sets.List(sets.New[string](members...)) {
+		if minMembers.Has(member) || replicas[member] == 0 {
+			continue
+		}
+		if donor == "" || replicas[member] > replicas[donor] {
+			donor = member
+		}
+	}
+	return donor
+}
+
+// pickReceiver returns the cluster of the emptiest domain to give a replica
+// to: the one holding the fewest replicas that is not also part of the fullest
+// domain, ties broken by name.
+func pickReceiver(members []string, maxMembers sets.Set[string], replicas map[string]int32) string {
+	receiver := ""
+	for _, member := range sets.List(sets.New[string](members...)) {
+		if maxMembers.Has(member) {
+			continue
+		}
+		if receiver == "" || replicas[member] < replicas[receiver] {
+			receiver = member
+		}
+	}
+	return receiver
+}
+
+// topologySkew computes the replica difference between the fullest and the
+// emptiest domain of the topology key. Returns 0 when there are less than two
+// domains as there is nothing to skew.
+func topologySkew(key policyv1alpha1.SpreadFieldValue, clusters []*clusterv1alpha1.Cluster, replicas map[string]int32) int32 {
+	domains := groupTopologyDomains(key, clusters)
+	if len(domains) < 2 {
+		return 0
+	}
+	totals := domainTotals(domains, replicas)
+	maxDomain, minDomain := extremeDomains(totals)
+	return totals[maxDomain] - totals[minDomain]
+}
+
+// rebuildAssignments renders the rebalanced replicas back to target clusters,
+// keeping the original assignment order, dropping clusters rebalanced down to
+// zero and appending clusters that only gained replicas by the rebalancing.
+func rebuildAssignments(
+	assignments []workv1alpha2.TargetCluster,
+	clusters []*clusterv1alpha1.Cluster,
+	replicas map[string]int32,
+) []workv1alpha2.TargetCluster {
+	assigned := sets.New[string]()
+	result := make([]workv1alpha2.TargetCluster, 0, len(assignments))
+	for _, assignment := range assignments {
+		assigned.Insert(assignment.Name)
+		if replicas[assignment.Name] > 0 {
+			result = append(result, workv1alpha2.TargetCluster{Name: assignment.Name, Replicas: replicas[assignment.Name]})
+		}
+	}
+	for _, cluster := range clusters {
+		if !assigned.Has(cluster.Name) && replicas[cluster.Name] > 0 {
+			result = append(result, workv1alpha2.TargetCluster{Name: cluster.Name, Replicas: replicas[cluster.Name]})
+		}
+	}
+	return result
+}
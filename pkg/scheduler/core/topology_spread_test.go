@@ -0,0 +1,196 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+func newClusterWithTopology(name, provider, region string, zones ...string) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: clusterv1alpha1.ClusterSpec{
+			Provider: provider,
+			Region:   region,
+			Zones:    zones,
+		},
+	}
+}
+
+func dividedPlacement(constraints ...policyv1alpha1.ClusterTopologySpreadConstraint) *policyv1alpha1.Placement {
+	return &policyv1alpha1.Placement{
+		ClusterTopologySpreadConstraints: constraints,
+		ReplicaScheduling: &policyv1alpha1.ReplicaSchedulingStrategy{
+			ReplicaSchedulingType: policyv1alpha1.ReplicaSchedulingTypeDivided,
+		},
+	}
+}
+
+func Test_applyClusterTopologySpread(t *testing.T) {
+	tests := []struct {
+		name        string
+		placement   *policyv1alpha1.Placement
+		clusters    []*clusterv1alpha1.Cluster
+		assignments []workv1alpha2.TargetCluster
+		want        []workv1alpha2.TargetCluster
+		wantErr     bool
+	}{
+		{
+			name:      "no constraints leaves the assignment unchanged",
+			placement: dividedPlacement(),
+			clusters: []*clusterv1alpha1.Cluster{
+				newClusterWithTopology(ClusterMember1, "", "r1"),
+				newClusterWithTopology(ClusterMember2, "", "r2"),
+			},
+			assignments: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1, Replicas: 5},
+				{Name: ClusterMember2, Replicas: 1},
+			},
+			want: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1, Replicas: 5},
+				{Name: ClusterMember2, Replicas: 1},
+			},
+		},
+		{
+			name: "duplicated scheduling type is not rebalanced",
+			placement: &policyv1alpha1.Placement{
+				ClusterTopologySpreadConstraints: []policyv1alpha1.ClusterTopologySpreadConstraint{
+					{TopologyKey: policyv1alpha1.SpreadByFieldRegion, MaxSkew: 1},
+				},
+			},
+			clusters: []*clusterv1alpha1.Cluster{
+				newClusterWithTopology(ClusterMember1, "", "r1"),
+				newClusterWithTopology(ClusterMember2, "", "r2"),
+			},
+			assignments: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1, Replicas: 5},
+				{Name: ClusterMember2, Replicas: 1},
+			},
+			want: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1, Replicas: 5},
+				{Name: ClusterMember2, Replicas: 1},
+			},
+		},
+		{
+			name: "skewed regions are rebalanced within maxSkew",
+			placement: dividedPlacement(
+				policyv1alpha1.ClusterTopologySpreadConstraint{TopologyKey: policyv1alpha1.SpreadByFieldRegion, MaxSkew: 1},
+			),
+			clusters: []*clusterv1alpha1.Cluster{
+				newClusterWithTopology(ClusterMember1, "", "r1"),
+				newClusterWithTopology(ClusterMember2, "", "r2"),
+			},
+			assignments: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1, Replicas: 5},
+				{Name: ClusterMember2, Replicas: 1},
+			},
+			want: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1, Replicas: 3},
+				{Name: ClusterMember2, Replicas: 3},
+			},
+		},
+		{
+			name: "cluster without replicas receives some to balance its region",
+			placement: dividedPlacement(
+				policyv1alpha1.ClusterTopologySpreadConstraint{TopologyKey: policyv1alpha1.SpreadByFieldRegion, MaxSkew: 1},
+			),
+			clusters: []*clusterv1alpha1.Cluster{
+				newClusterWithTopology(ClusterMember1, "", "r1"),
+				newClusterWithTopology(ClusterMember2, "", "r2"),
+			},
+			assignments: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1, Replicas: 4},
+			},
+			want: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1, Replicas: 2},
+				{Name: ClusterMember2, Replicas: 2},
+			},
+		},
+		{
+			name: "unsatisfiable DoNotSchedule constraint fails the scheduling",
+			placement: dividedPlacement(
+				policyv1alpha1.ClusterTopologySpreadConstraint{TopologyKey: policyv1alpha1.SpreadByFieldZone, MaxSkew: 1},
+			),
+			clusters: []*clusterv1alpha1.Cluster{
+				// member2 spans both zones, so no move can reduce the skew
+				// between zone-a and zone-b.
+				newClusterWithTopology(ClusterMember1, "", "r1", "zone-a"),
+				newClusterWithTopology(ClusterMember2, "", "r1", "zone-a", "zone-b"),
+			},
+			assignments: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1, Replicas: 5},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsatisfiable ScheduleAnyway constraint keeps the assignment",
+			placement: dividedPlacement(
+				policyv1alpha1.ClusterTopologySpreadConstraint{
+					TopologyKey:       policyv1alpha1.SpreadByFieldZone,
+					MaxSkew:           1,
+					WhenUnsatisfiable: policyv1alpha1.ScheduleAnyway,
+				},
+			),
+			clusters: []*clusterv1alpha1.Cluster{
+				newClusterWithTopology(ClusterMember1, "", "r1", "zone-a"),
+				newClusterWithTopology(ClusterMember2, "", "r1", "zone-a", "zone-b"),
+			},
+			assignments: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1, Replicas: 5},
+			},
+			want: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1, Replicas: 5},
+			},
+		},
+		{
+			name: "providers balanced within a larger maxSkew",
+			placement: dividedPlacement(
+				policyv1alpha1.ClusterTopologySpreadConstraint{TopologyKey: policyv1alpha1.SpreadByFieldProvider, MaxSkew: 2},
+			),
+			clusters: []*clusterv1alpha1.Cluster{
+				newClusterWithTopology(ClusterMember1, "aws", "r1"),
+				newClusterWithTopology(ClusterMember2, "gcp", "r2"),
+			},
+			assignments: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1, Replicas: 6},
+				{Name: ClusterMember2, Replicas: 2},
+			},
+			want: []workv1alpha2.TargetCluster{
+				{Name: ClusterMember1, Replicas: 5},
+				{Name: ClusterMember2, Replicas: 3},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := applyClusterTopologySpread(tt.placement, tt.clusters, tt.assignments)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyClusterTopologySpread() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("applyClusterTopologySpread() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -99,17 +99,17 @@ func (s *Scheduler) resourceBindingEventFilter(obj interface{}) bool {
 
 	switch t := obj.(type) {
 	case *workv1alpha2.ResourceBinding:
-		if !schedulerNameFilter(s.schedulerName, t.Spec.SchedulerName) {
+		if !s.schedulerNameMatches(t.Spec.SchedulerName) {
 			return false
 		}
-		if t.Spec.SchedulingSuspended() {
+		if t.Spec.SchedulingSuspended() || t.Spec.SchedulingGated() {
 			return false
 		}
 	case *workv1alpha2.ClusterResourceBinding:
-		if !schedulerNameFilter(s.schedulerName, t.Spec.SchedulerName) {
+		if !s.schedulerNameMatches(t.Spec.SchedulerName) {
 			return false
 		}
-		if t.Spec.SchedulingSuspended() {
+		if t.Spec.SchedulingSuspended() || t.Spec.SchedulingGated() {
 			return false
 		}
 	}
@@ -302,6 +302,19 @@ func (s *Scheduler) deleteCluster(obj interface{}) {
 	}
 }
 
+// schedulerNameMatches tells if a binding addressed to the given schedulerName
+// should be handled by this scheduler instance, either because it matches one
+// of the configured profiles or the flag-configured scheduler name.
+func (s *Scheduler) schedulerNameMatches(schedulerName string) bool {
+	if schedulerName == "" {
+		schedulerName = DefaultScheduler
+	}
+	if _, ok := s.algorithms[schedulerName]; ok {
+		return true
+	}
+	return schedulerNameFilter(s.schedulerName, schedulerName)
+}
+
 func schedulerNameFilter(schedulerNameFromOptions, schedulerName string) bool {
 	if schedulerName == "" {
 		schedulerName = DefaultScheduler
@@ -340,7 +353,7 @@ func (s *Scheduler) enqueueAffectedBindings(cluster *clusterv1alpha1.Cluster) er
 				// for scheduling or its status has not been synced to the
 				// cache. Just enqueue the binding to avoid missing the cluster
 				// update event.
-				if schedulerNameFilter(s.schedulerName, binding.Spec.SchedulerName) {
+				if s.schedulerNameMatches(binding.Spec.SchedulerName) {
 					s.onResourceBindingRequeue(binding, metrics.ClusterChanged)
 				}
 				continue
@@ -357,7 +370,7 @@ func (s *Scheduler) enqueueAffectedBindings(cluster *clusterv1alpha1.Cluster) er
 			fallthrough
 		case util.ClusterMatches(cluster, *affinity):
 			// If the cluster manifest match the affinity, add it to the queue, trigger rescheduling
-			if schedulerNameFilter(s.schedulerName, binding.Spec.SchedulerName) {
+			if s.schedulerNameMatches(binding.Spec.SchedulerName) {
 				s.onResourceBindingRequeue(binding, metrics.ClusterChanged)
 			}
 		}
@@ -385,7 +398,7 @@ func (s *Scheduler) enqueueAffectedCRBs(cluster *clusterv1alpha1.Cluster) error
 				// for scheduling or its status has not been synced to the
 				// cache. Just enqueue the binding to avoid missing the cluster
 				// update event.
-				if schedulerNameFilter(s.schedulerName, binding.Spec.SchedulerName) {
+				if s.schedulerNameMatches(binding.Spec.SchedulerName) {
 					s.onClusterResourceBindingRequeue(binding, metrics.ClusterChanged)
 				}
 				continue
@@ -402,7 +415,7 @@ func (s *Scheduler) enqueueAffectedCRBs(cluster *clusterv1alpha1.Cluster) error
 			fallthrough
 		case util.ClusterMatches(cluster, *affinity):
 			// If the cluster manifest match the affinity, add it to the queue, trigger rescheduling
-			if schedulerNameFilter(s.schedulerName, binding.Spec.SchedulerName) {
+			if s.schedulerNameMatches(binding.Spec.SchedulerName) {
 				s.onClusterResourceBindingRequeue(binding, metrics.ClusterChanged)
 			}
 		}
@@ -131,6 +131,30 @@ func TestResourceBindingEventFilter(t *testing.T) {
 			}, &workv1alpha2.Suspension{Scheduling: ptr.To(true)}),
 			expectedResult: false,
 		},
+		{
+			name:          "ResourceBinding gated",
+			schedulerName: "test-scheduler",
+			obj: func() *workv1alpha2.ResourceBinding {
+				rb := createResourceBinding("test-rb", "test-scheduler", map[string]string{
+					workv1alpha2.BindingManagedByLabel: "test-manager",
+				}, nil)
+				rb.Spec.SchedulingGates = []workv1alpha2.SchedulingGate{{Name: "example.com/capacity-approved"}}
+				return rb
+			}(),
+			expectedResult: false,
+		},
+		{
+			name:          "ClusterResourceBinding gated",
+			schedulerName: "test-scheduler",
+			obj: func() *workv1alpha2.ClusterResourceBinding {
+				crb := createClusterResourceBinding("test-crb", "test-scheduler", map[string]string{
+					policyv1alpha1.ClusterPropagationPolicyPermanentIDLabel: "test-id",
+				}, nil)
+				crb.Spec.SchedulingGates = []workv1alpha2.SchedulingGate{{Name: "example.com/capacity-approved"}}
+				return crb
+			}(),
+			expectedResult: false,
+		},
 	}
 
 	for _, tc := range testCases {
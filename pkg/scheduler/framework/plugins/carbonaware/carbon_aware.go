@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package carbonaware
+
+import (
+	"context"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/scheduler/carbon"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+)
+
+const (
+	// Name is the name of the plugin used in the plugin registry and configurations.
+	Name = "CarbonAware"
+
+	// referenceIntensity is the carbon intensity, in gCO2e per kWh, at and
+	// beyond which a cluster gets the lowest preference. It roughly matches
+	// the intensity of a fully fossil-fueled grid.
+	referenceIntensity = 1000
+)
+
+// CarbonAware is a score plugin that shifts flexible workloads toward member
+// clusters whose grid reports a lower carbon intensity. It only affects
+// bindings that opted in via the carbonAware placement field; clusters
+// without a signal are treated neutrally.
+type CarbonAware struct {
+	provider carbon.Provider
+}
+
+var _ framework.ScorePlugin = &CarbonAware{}
+
+// New instantiates the CarbonAware plugin with the given carbon intensity provider.
+func New(provider carbon.Provider) (framework.Plugin, error) {
+	return &CarbonAware{provider: provider}, nil
+}
+
+// Name returns the plugin name.
+func (p *CarbonAware) Name() string {
+	return Name
+}
+
+// Score calculates the score on the candidate cluster: the maximum score for
+// a carbon-free grid, decreasing linearly to the minimum score at the
+// reference intensity and beyond. Clusters without a signal score neutrally
+// in the middle of the range, so a missing signal neither disqualifies a
+// cluster nor makes it beat clusters with a clean measurement.
+func (p *CarbonAware) Score(_ context.Context,
+	spec *workv1alpha2.ResourceBindingSpec, cluster *clusterv1alpha1.Cluster) (int64, *framework.Result) {
+	if spec.Placement == nil || !spec.Placement.CarbonAware {
+		return framework.MinClusterScore, framework.NewResult(framework.Success)
+	}
+
+	intensity, ok := p.provider.ClusterCarbonIntensity(cluster.Name)
+	if !ok {
+		return framework.MaxClusterScore / 2, framework.NewResult(framework.Success)
+	}
+	if intensity >= referenceIntensity {
+		return framework.MinClusterScore, framework.NewResult(framework.Success)
+	}
+	return int64(float64(framework.MaxClusterScore) * (referenceIntensity - intensity) / referenceIntensity), framework.NewResult(framework.Success)
+}
+
+// ScoreExtensions of the Score plugin.
+func (p *CarbonAware) ScoreExtensions() framework.ScoreExtensions {
+	return nil
+}
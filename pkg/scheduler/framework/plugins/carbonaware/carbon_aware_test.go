@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package carbonaware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/scheduler/carbon"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+)
+
+func TestCarbonAware_Score(t *testing.T) {
+	store := carbon.NewStore()
+	store.SetClusterCarbonIntensity("cluster-clean", 100)
+	store.SetClusterCarbonIntensity("cluster-average", 500)
+	store.SetClusterCarbonIntensity("cluster-dirty", 1200)
+
+	optedIn := &workv1alpha2.ResourceBindingSpec{
+		Placement: &policyv1alpha1.Placement{CarbonAware: true},
+	}
+
+	tests := []struct {
+		name          string
+		bindingSpec   *workv1alpha2.ResourceBindingSpec
+		cluster       *clusterv1alpha1.Cluster
+		expectedScore int64
+	}{
+		{
+			name:          "no placement in spec",
+			bindingSpec:   &workv1alpha2.ResourceBindingSpec{},
+			cluster:       newCluster("cluster-clean"),
+			expectedScore: framework.MinClusterScore,
+		},
+		{
+			name: "not opted in",
+			bindingSpec: &workv1alpha2.ResourceBindingSpec{
+				Placement: &policyv1alpha1.Placement{},
+			},
+			cluster:       newCluster("cluster-clean"),
+			expectedScore: framework.MinClusterScore,
+		},
+		{
+			name:          "cluster without signal scores neutrally",
+			bindingSpec:   optedIn,
+			cluster:       newCluster("cluster-unknown"),
+			expectedScore: framework.MaxClusterScore / 2,
+		},
+		{
+			name:          "cluster with clean grid",
+			bindingSpec:   optedIn,
+			cluster:       newCluster("cluster-clean"),
+			expectedScore: framework.MaxClusterScore * 90 / 100,
+		},
+		{
+			name:          "cluster with average grid",
+			bindingSpec:   optedIn,
+			cluster:       newCluster("cluster-average"),
+			expectedScore: framework.MaxClusterScore / 2,
+		},
+		{
+			name:          "cluster beyond the reference intensity",
+			bindingSpec:   optedIn,
+			cluster:       newCluster("cluster-dirty"),
+			expectedScore: framework.MinClusterScore,
+		},
+	}
+
+	p := &CarbonAware{provider: store}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, result := p.Score(context.Background(), tt.bindingSpec, tt.cluster)
+			assert.Equal(t, tt.expectedScore, score)
+			assert.Equal(t, framework.Success, result.Code())
+		})
+	}
+}
+
+func newCluster(name string) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}
+}
+
+func TestNew(t *testing.T) {
+	plugin, err := New(carbon.NewStore())
+	assert.NoError(t, err)
+	assert.NotNil(t, plugin)
+	_, ok := plugin.(*CarbonAware)
+	assert.True(t, ok)
+}
+
+func TestCarbonAware_Name(t *testing.T) {
+	p := &CarbonAware{}
+	assert.Equal(t, Name, p.Name())
+}
+
+func TestCarbonAware_ScoreExtensions(t *testing.T) {
+	p := &CarbonAware{}
+	assert.Nil(t, p.ScoreExtensions())
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latencyaware
+
+import (
+	"context"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+	"github.com/karmada-io/karmada/pkg/scheduler/latency"
+)
+
+const (
+	// Name is the name of the plugin used in the plugin registry and configurations.
+	Name = "LatencyAware"
+)
+
+// LatencyAware is a score plugin that prefers clusters whose measured network
+// latency stays within the latency budget declared in the policy placement,
+// for latency-sensitive services. Clusters without a measurement are treated
+// neutrally and bindings without a latency budget are not affected.
+type LatencyAware struct {
+	provider latency.Provider
+}
+
+var _ framework.ScorePlugin = &LatencyAware{}
+
+// New instantiates the LatencyAware plugin with the given latency provider.
+func New(provider latency.Provider) (framework.Plugin, error) {
+	return &LatencyAware{provider: provider}, nil
+}
+
+// Name returns the plugin name.
+func (p *LatencyAware) Name() string {
+	return Name
+}
+
+// Score calculates the score on the candidate cluster: the maximum score for
+// a (near) zero latency, decreasing linearly to the minimum score at the
+// declared budget and beyond. Clusters without a measurement score neutrally
+// in the middle of the range, so a missing probe neither disqualifies a
+// cluster nor makes it beat clusters with a good measurement.
+func (p *LatencyAware) Score(_ context.Context,
+	spec *workv1alpha2.ResourceBindingSpec, cluster *clusterv1alpha1.Cluster) (int64, *framework.Result) {
+	if spec.Placement == nil || spec.Placement.LatencyBudget == nil {
+		return framework.MinClusterScore, framework.NewResult(framework.Success)
+	}
+	budget := time.Duration(spec.Placement.LatencyBudget.MaxMilliseconds) * time.Millisecond
+
+	measured, ok := p.provider.ClusterLatency(cluster.Name)
+	if !ok {
+		return framework.MaxClusterScore / 2, framework.NewResult(framework.Success)
+	}
+	if measured >= budget {
+		return framework.MinClusterScore, framework.NewResult(framework.Success)
+	}
+	return framework.MaxClusterScore * int64(budget-measured) / int64(budget), framework.NewResult(framework.Success)
+}
+
+// ScoreExtensions of the Score plugin.
+func (p *LatencyAware) ScoreExtensions() framework.ScoreExtensions {
+	return nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latencyaware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+	"github.com/karmada-io/karmada/pkg/scheduler/latency"
+)
+
+func TestLatencyAware_Score(t *testing.T) {
+	store := latency.NewStore()
+	store.SetClusterLatency("cluster-near", 10*time.Millisecond)
+	store.SetClusterLatency("cluster-half", 50*time.Millisecond)
+	store.SetClusterLatency("cluster-far", 150*time.Millisecond)
+
+	budget := &workv1alpha2.ResourceBindingSpec{
+		Placement: &policyv1alpha1.Placement{
+			LatencyBudget: &policyv1alpha1.LatencyBudget{MaxMilliseconds: 100},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		bindingSpec   *workv1alpha2.ResourceBindingSpec
+		cluster       *clusterv1alpha1.Cluster
+		expectedScore int64
+	}{
+		{
+			name:          "no placement in spec",
+			bindingSpec:   &workv1alpha2.ResourceBindingSpec{},
+			cluster:       newCluster("cluster-near"),
+			expectedScore: framework.MinClusterScore,
+		},
+		{
+			name: "no latency budget in placement",
+			bindingSpec: &workv1alpha2.ResourceBindingSpec{
+				Placement: &policyv1alpha1.Placement{},
+			},
+			cluster:       newCluster("cluster-near"),
+			expectedScore: framework.MinClusterScore,
+		},
+		{
+			name:          "cluster without measurement scores neutrally",
+			bindingSpec:   budget,
+			cluster:       newCluster("cluster-unknown"),
+			expectedScore: framework.MaxClusterScore / 2,
+		},
+		{
+			name:          "cluster well within budget",
+			bindingSpec:   budget,
+			cluster:       newCluster("cluster-near"),
+			expectedScore: framework.MaxClusterScore * 90 / 100,
+		},
+		{
+			name:          "cluster at half the budget",
+			bindingSpec:   budget,
+			cluster:       newCluster("cluster-half"),
+			expectedScore: framework.MaxClusterScore / 2,
+		},
+		{
+			name:          "cluster beyond budget",
+			bindingSpec:   budget,
+			cluster:       newCluster("cluster-far"),
+			expectedScore: framework.MinClusterScore,
+		},
+	}
+
+	p := &LatencyAware{provider: store}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, result := p.Score(context.Background(), tt.bindingSpec, tt.cluster)
+			assert.Equal(t, tt.expectedScore, score)
+			assert.Equal(t, framework.Success, result.Code())
+		})
+	}
+}
+
+func newCluster(name string) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}
+}
+
+func TestNew(t *testing.T) {
+	plugin, err := New(latency.NewStore())
+	assert.NoError(t, err)
+	assert.NotNil(t, plugin)
+	_, ok := plugin.(*LatencyAware)
+	assert.True(t, ok)
+}
+
+func TestLatencyAware_Name(t *testing.T) {
+	p := &LatencyAware{}
+	assert.Equal(t, Name, p.Name())
+}
+
+func TestLatencyAware_ScoreExtensions(t *testing.T) {
+	p := &LatencyAware{}
+	assert.Nil(t, p.ScoreExtensions())
+}
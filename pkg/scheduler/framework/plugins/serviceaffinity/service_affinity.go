@@ -0,0 +1,204 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaffinity
+
+import (
+	"context"
+	"fmt"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	worklisters "github.com/karmada-io/karmada/pkg/generated/listers/work/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+const (
+	// Name is the name of the plugin used in the plugin registry and configurations.
+	Name = "ServiceAffinity"
+)
+
+// EndpointClustersFunc resolves the names of the clusters holding healthy
+// endpoints of the given MultiClusterService.
+type EndpointClustersFunc func(namespace, name string) (sets.Set[string], error)
+
+// ServiceAffinity is a plugin that favors(or requires) the clusters holding
+// healthy endpoints of the MultiClusterServices the resource depends on.
+type ServiceAffinity struct {
+	endpointClusters EndpointClustersFunc
+}
+
+var _ framework.FilterPlugin = &ServiceAffinity{}
+var _ framework.ScorePlugin = &ServiceAffinity{}
+
+// New instantiates the ServiceAffinity plugin with the given resolver.
+// Unlike the dependency-free in-tree plugins it needs access to the collected
+// EndpointSlice works, so it is registered by the scheduler with a closure
+// instead of taking part in the in-tree registry.
+func New(endpointClusters EndpointClustersFunc) (framework.Plugin, error) {
+	if endpointClusters == nil {
+		return nil, fmt.Errorf("an endpoint clusters resolver is required")
+	}
+	return &ServiceAffinity{endpointClusters: endpointClusters}, nil
+}
+
+// Name returns the plugin name.
+func (p *ServiceAffinity) Name() string {
+	return Name
+}
+
+// Filter checks if the cluster holds healthy endpoints of every required
+// service affinity term. Terms that are not required do not filter, they only
+// influence the score.
+func (p *ServiceAffinity) Filter(
+	_ context.Context,
+	bindingSpec *workv1alpha2.ResourceBindingSpec,
+	_ *workv1alpha2.ResourceBindingStatus,
+	cluster *clusterv1alpha1.Cluster,
+) *framework.Result {
+	for _, term := range serviceAffinityTerms(bindingSpec) {
+		if !term.Required {
+			continue
+		}
+		clusters, err := p.endpointClusters(termNamespace(term, bindingSpec), term.Name)
+		if err != nil {
+			return framework.AsResult(fmt.Errorf("failed to resolve endpoint clusters of service %s/%s: %w",
+				termNamespace(term, bindingSpec), term.Name, err))
+		}
+		if !clusters.Has(cluster.Name) {
+			return framework.NewResult(framework.Unschedulable,
+				fmt.Sprintf("cluster(s) did not have healthy endpoints of service %s/%s", termNamespace(term, bindingSpec), term.Name))
+		}
+	}
+
+	return framework.NewResult(framework.Success)
+}
+
+// Score favors the clusters holding healthy endpoints of any of the services
+// the resource depends on.
+func (p *ServiceAffinity) Score(_ context.Context,
+	spec *workv1alpha2.ResourceBindingSpec, cluster *clusterv1alpha1.Cluster) (int64, *framework.Result) {
+	for _, term := range serviceAffinityTerms(spec) {
+		clusters, err := p.endpointClusters(termNamespace(term, spec), term.Name)
+		if err != nil {
+			return framework.MinClusterScore, framework.AsResult(fmt.Errorf("failed to resolve endpoint clusters of service %s/%s: %w",
+				termNamespace(term, spec), term.Name, err))
+		}
+		if clusters.Has(cluster.Name) {
+			return framework.MaxClusterScore, framework.NewResult(framework.Success)
+		}
+	}
+
+	return framework.MinClusterScore, framework.NewResult(framework.Success)
+}
+
+// ScoreExtensions of the Score plugin.
+func (p *ServiceAffinity) ScoreExtensions() framework.ScoreExtensions {
+	return nil
+}
+
+func serviceAffinityTerms(spec *workv1alpha2.ResourceBindingSpec) []policyv1alpha1.ServiceAffinityTerm {
+	if spec.Placement == nil {
+		return nil
+	}
+	return spec.Placement.ServiceAffinity
+}
+
+// termNamespace returns the namespace of the referenced service, defaulting to
+// the namespace of the resource being scheduled.
+func termNamespace(term policyv1alpha1.ServiceAffinityTerm, spec *workv1alpha2.ResourceBindingSpec) string {
+	if term.Namespace != "" {
+		return term.Namespace
+	}
+	return spec.Resource.Namespace
+}
+
+// EndpointClustersByWorkLister returns a resolver backed by the EndpointSlice
+// works the EndpointSliceCollectController reports into the cluster execution
+// namespaces. A cluster counts as holding healthy endpoints when at least one
+// collected EndpointSlice of the service has a ready endpoint there.
+func EndpointClustersByWorkLister(workLister worklisters.WorkLister) EndpointClustersFunc {
+	return func(namespace, name string) (sets.Set[string], error) {
+		selector := labels.SelectorFromSet(labels.Set{
+			util.MultiClusterServiceNamespaceLabel: namespace,
+			util.MultiClusterServiceNameLabel:      name,
+		})
+		works, err := workLister.List(selector)
+		if err != nil {
+			return nil, err
+		}
+
+		clusters := sets.New[string]()
+		for _, work := range works {
+			// Works dispatched to consumer clusters carry the provision cluster
+			// annotation, only the works collected from the provider clusters
+			// tell where the endpoints actually are.
+			if _, dispatched := work.Annotations[util.EndpointSliceProvisionClusterAnnotation]; dispatched {
+				continue
+			}
+			clusterName, err := names.GetClusterName(work.Namespace)
+			if err != nil {
+				klog.Errorf("Failed to get cluster name from work namespace %s: %v", work.Namespace, err)
+				continue
+			}
+			if clusters.Has(clusterName) {
+				continue
+			}
+			if workContainsReadyEndpoints(work) {
+				clusters.Insert(clusterName)
+			}
+		}
+		return clusters, nil
+	}
+}
+
+// workContainsReadyEndpoints reports whether any EndpointSlice manifest of the
+// work has at least one ready endpoint.
+func workContainsReadyEndpoints(work *workv1alpha1.Work) bool {
+	for _, manifest := range work.Spec.Workload.Manifests {
+		unstructuredObj := &unstructured.Unstructured{}
+		if err := unstructuredObj.UnmarshalJSON(manifest.Raw); err != nil {
+			klog.Errorf("Failed to unmarshal work(%s/%s) manifest: %v", work.Namespace, work.Name, err)
+			continue
+		}
+		if unstructuredObj.GetKind() != util.EndpointSliceKind {
+			continue
+		}
+		endpointSlice := &discoveryv1.EndpointSlice{}
+		if err := helper.ConvertToTypedObject(unstructuredObj, endpointSlice); err != nil {
+			klog.Errorf("Failed to convert work(%s/%s) manifest to EndpointSlice: %v", work.Namespace, work.Name, err)
+			continue
+		}
+		for _, endpoint := range endpointSlice.Endpoints {
+			if len(endpoint.Addresses) > 0 && ptr.Deref[bool](endpoint.Conditions.Ready, true) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaffinity
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	worklisters "github.com/karmada-io/karmada/pkg/generated/listers/work/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+func fixedEndpointClusters(clusters ...string) EndpointClustersFunc {
+	return func(_, _ string) (sets.Set[string], error) {
+		return sets.New[string](clusters...), nil
+	}
+}
+
+func newBindingSpec(terms ...policyv1alpha1.ServiceAffinityTerm) *workv1alpha2.ResourceBindingSpec {
+	return &workv1alpha2.ResourceBindingSpec{
+		Resource:  workv1alpha2.ObjectReference{Namespace: "default", Name: "demo"},
+		Placement: &policyv1alpha1.Placement{ServiceAffinity: terms},
+	}
+}
+
+func newCluster(name string) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: name}}
+}
+
+func TestServiceAffinity_Filter(t *testing.T) {
+	tests := []struct {
+		name             string
+		bindingSpec      *workv1alpha2.ResourceBindingSpec
+		endpointClusters EndpointClustersFunc
+		cluster          *clusterv1alpha1.Cluster
+		expectedCode     framework.Code
+	}{
+		{
+			name:             "no service affinity terms",
+			bindingSpec:      newBindingSpec(),
+			endpointClusters: fixedEndpointClusters(),
+			cluster:          newCluster("member1"),
+			expectedCode:     framework.Success,
+		},
+		{
+			name:             "required term - cluster has endpoints",
+			bindingSpec:      newBindingSpec(policyv1alpha1.ServiceAffinityTerm{Name: "backend", Required: true}),
+			endpointClusters: fixedEndpointClusters("member1"),
+			cluster:          newCluster("member1"),
+			expectedCode:     framework.Success,
+		},
+		{
+			name:             "required term - cluster without endpoints",
+			bindingSpec:      newBindingSpec(policyv1alpha1.ServiceAffinityTerm{Name: "backend", Required: true}),
+			endpointClusters: fixedEndpointClusters("member1"),
+			cluster:          newCluster("member2"),
+			expectedCode:     framework.Unschedulable,
+		},
+		{
+			name:             "preferred term does not filter",
+			bindingSpec:      newBindingSpec(policyv1alpha1.ServiceAffinityTerm{Name: "backend"}),
+			endpointClusters: fixedEndpointClusters("member1"),
+			cluster:          newCluster("member2"),
+			expectedCode:     framework.Success,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &ServiceAffinity{endpointClusters: tt.endpointClusters}
+			result := p.Filter(context.Background(), tt.bindingSpec, nil, tt.cluster)
+			assert.Equal(t, tt.expectedCode, result.Code())
+		})
+	}
+}
+
+func TestServiceAffinity_Score(t *testing.T) {
+	p := &ServiceAffinity{endpointClusters: fixedEndpointClusters("member1")}
+	spec := newBindingSpec(policyv1alpha1.ServiceAffinityTerm{Name: "backend"})
+
+	score, result := p.Score(context.Background(), spec, newCluster("member1"))
+	assert.True(t, result.IsSuccess())
+	assert.Equal(t, framework.MaxClusterScore, score)
+
+	score, result = p.Score(context.Background(), spec, newCluster("member2"))
+	assert.True(t, result.IsSuccess())
+	assert.Equal(t, framework.MinClusterScore, score)
+}
+
+func newEndpointSliceWork(t *testing.T, namespace string, ready bool, annotations map[string]string) *workv1alpha1.Work {
+	endpointSlice := &discoveryv1.EndpointSlice{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "discovery.k8s.io/v1", Kind: util.EndpointSliceKind},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "backend-eps"},
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses:  []string{"10.0.0.1"},
+				Conditions: discoveryv1.EndpointConditions{Ready: ptr.To[bool](ready)},
+			},
+		},
+	}
+	raw, err := json.Marshal(endpointSlice)
+	if err != nil {
+		t.Fatalf("failed to marshal endpointslice: %v", err)
+	}
+	return &workv1alpha1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        "backend-eps-work",
+			Annotations: annotations,
+		},
+		Spec: workv1alpha1.WorkSpec{
+			Workload: workv1alpha1.WorkloadTemplate{
+				Manifests: []workv1alpha1.Manifest{{RawExtension: runtime.RawExtension{Raw: raw}}},
+			},
+		},
+	}
+}
+
+func TestWorkContainsReadyEndpoints(t *testing.T) {
+	assert.True(t, workContainsReadyEndpoints(newEndpointSliceWork(t, "karmada-es-member1", true, nil)))
+	assert.False(t, workContainsReadyEndpoints(newEndpointSliceWork(t, "karmada-es-member1", false, nil)))
+}
+
+func TestEndpointClustersByWorkLister(t *testing.T) {
+	serviceLabels := map[string]string{
+		util.MultiClusterServiceNamespaceLabel: "default",
+		util.MultiClusterServiceNameLabel:      "backend",
+	}
+	collected := newEndpointSliceWork(t, "karmada-es-member1", true, nil)
+	collected.Labels = serviceLabels
+	// dispatched to a consumer cluster, must not count as an endpoint location
+	dispatched := newEndpointSliceWork(t, "karmada-es-member2", true,
+		map[string]string{util.EndpointSliceProvisionClusterAnnotation: "member1"})
+	dispatched.Labels = serviceLabels
+	notReady := newEndpointSliceWork(t, "karmada-es-member3", false, nil)
+	notReady.Labels = serviceLabels
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, work := range []*workv1alpha1.Work{collected, dispatched, notReady} {
+		if err := indexer.Add(work); err != nil {
+			t.Fatalf("failed to add work to indexer: %v", err)
+		}
+	}
+
+	resolver := EndpointClustersByWorkLister(worklisters.NewWorkLister(indexer))
+	clusters, err := resolver("default", "backend")
+	assert.NoError(t, err)
+	assert.Equal(t, sets.New[string]("member1"), clusters)
+}
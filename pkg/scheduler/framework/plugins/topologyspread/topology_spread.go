@@ -0,0 +1,80 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topologyspread
+
+import (
+	"context"
+	"fmt"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+)
+
+const (
+	// Name is the name of the plugin used in the plugin registry and configurations.
+	Name = "ClusterTopologySpread"
+)
+
+// ClusterTopologySpread is a plugin that checks if a cluster can take part in
+// the topology domains demanded by the cluster topology spread constraints.
+type ClusterTopologySpread struct{}
+
+var _ framework.FilterPlugin = &ClusterTopologySpread{}
+
+// New instantiates the ClusterTopologySpread plugin.
+func New() (framework.Plugin, error) {
+	return &ClusterTopologySpread{}, nil
+}
+
+// Name returns the plugin name.
+func (p *ClusterTopologySpread) Name() string {
+	return Name
+}
+
+// Filter checks if the cluster reports a value for every topology key demanded
+// by a DoNotSchedule constraint. A cluster without a value would form an
+// anonymous domain the skew cannot be computed for, so it is filtered out.
+// Constraints with ScheduleAnyway are best-effort and do not filter.
+func (p *ClusterTopologySpread) Filter(
+	_ context.Context,
+	bindingSpec *workv1alpha2.ResourceBindingSpec,
+	_ *workv1alpha2.ResourceBindingStatus,
+	cluster *clusterv1alpha1.Cluster,
+) *framework.Result {
+	for _, constraint := range bindingSpec.Placement.ClusterTopologySpreadConstraints {
+		if constraint.WhenUnsatisfiable == policyv1alpha1.ScheduleAnyway {
+			continue
+		}
+		var missing bool
+		switch constraint.TopologyKey {
+		case policyv1alpha1.SpreadByFieldProvider:
+			missing = cluster.Spec.Provider == ""
+		case policyv1alpha1.SpreadByFieldRegion:
+			missing = cluster.Spec.Region == ""
+		case policyv1alpha1.SpreadByFieldZone:
+			missing = len(cluster.Spec.Zones) == 0
+		}
+		if missing {
+			return framework.NewResult(framework.Unschedulable,
+				fmt.Sprintf("cluster(s) did not have %s property demanded by the topology spread constraint", constraint.TopologyKey))
+		}
+	}
+
+	return framework.NewResult(framework.Success)
+}
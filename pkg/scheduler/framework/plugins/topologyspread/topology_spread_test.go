@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topologyspread
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+)
+
+func TestClusterTopologySpread_Filter(t *testing.T) {
+	tests := []struct {
+		name         string
+		bindingSpec  *workv1alpha2.ResourceBindingSpec
+		cluster      *clusterv1alpha1.Cluster
+		expectedCode framework.Code
+	}{
+		{
+			name: "no topology spread constraints",
+			bindingSpec: &workv1alpha2.ResourceBindingSpec{
+				Placement: &policyv1alpha1.Placement{},
+			},
+			cluster:      &clusterv1alpha1.Cluster{},
+			expectedCode: framework.Success,
+		},
+		{
+			name: "region constraint - region present",
+			bindingSpec: &workv1alpha2.ResourceBindingSpec{
+				Placement: &policyv1alpha1.Placement{
+					ClusterTopologySpreadConstraints: []policyv1alpha1.ClusterTopologySpreadConstraint{
+						{TopologyKey: policyv1alpha1.SpreadByFieldRegion, MaxSkew: 1},
+					},
+				},
+			},
+			cluster: &clusterv1alpha1.Cluster{
+				Spec: clusterv1alpha1.ClusterSpec{
+					Region: "us-west-2",
+				},
+			},
+			expectedCode: framework.Success,
+		},
+		{
+			name: "region constraint - region missing",
+			bindingSpec: &workv1alpha2.ResourceBindingSpec{
+				Placement: &policyv1alpha1.Placement{
+					ClusterTopologySpreadConstraints: []policyv1alpha1.ClusterTopologySpreadConstraint{
+						{TopologyKey: policyv1alpha1.SpreadByFieldRegion, MaxSkew: 1},
+					},
+				},
+			},
+			cluster:      &clusterv1alpha1.Cluster{},
+			expectedCode: framework.Unschedulable,
+		},
+		{
+			name: "provider constraint - provider missing",
+			bindingSpec: &workv1alpha2.ResourceBindingSpec{
+				Placement: &policyv1alpha1.Placement{
+					ClusterTopologySpreadConstraints: []policyv1alpha1.ClusterTopologySpreadConstraint{
+						{TopologyKey: policyv1alpha1.SpreadByFieldProvider, MaxSkew: 1},
+					},
+				},
+			},
+			cluster:      &clusterv1alpha1.Cluster{},
+			expectedCode: framework.Unschedulable,
+		},
+		{
+			name: "zone constraint - zones missing",
+			bindingSpec: &workv1alpha2.ResourceBindingSpec{
+				Placement: &policyv1alpha1.Placement{
+					ClusterTopologySpreadConstraints: []policyv1alpha1.ClusterTopologySpreadConstraint{
+						{TopologyKey: policyv1alpha1.SpreadByFieldZone, MaxSkew: 1},
+					},
+				},
+			},
+			cluster:      &clusterv1alpha1.Cluster{},
+			expectedCode: framework.Unschedulable,
+		},
+		{
+			name: "zone constraint - zones present",
+			bindingSpec: &workv1alpha2.ResourceBindingSpec{
+				Placement: &policyv1alpha1.Placement{
+					ClusterTopologySpreadConstraints: []policyv1alpha1.ClusterTopologySpreadConstraint{
+						{TopologyKey: policyv1alpha1.SpreadByFieldZone, MaxSkew: 1},
+					},
+				},
+			},
+			cluster: &clusterv1alpha1.Cluster{
+				Spec: clusterv1alpha1.ClusterSpec{
+					Zones: []string{"us-west-2a"},
+				},
+			},
+			expectedCode: framework.Success,
+		},
+		{
+			name: "ScheduleAnyway constraint does not filter",
+			bindingSpec: &workv1alpha2.ResourceBindingSpec{
+				Placement: &policyv1alpha1.Placement{
+					ClusterTopologySpreadConstraints: []policyv1alpha1.ClusterTopologySpreadConstraint{
+						{
+							TopologyKey:       policyv1alpha1.SpreadByFieldRegion,
+							MaxSkew:           1,
+							WhenUnsatisfiable: policyv1alpha1.ScheduleAnyway,
+						},
+					},
+				},
+			},
+			cluster:      &clusterv1alpha1.Cluster{},
+			expectedCode: framework.Success,
+		},
+	}
+
+	p := &ClusterTopologySpread{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := p.Filter(context.Background(), tt.bindingSpec, nil, tt.cluster)
+			assert.Equal(t, tt.expectedCode, result.Code())
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	plugin, err := New()
+	assert.NoError(t, err)
+	assert.Equal(t, Name, plugin.Name())
+}
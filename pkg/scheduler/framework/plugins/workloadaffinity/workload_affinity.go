@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadaffinity
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	worklister "github.com/karmada-io/karmada/pkg/generated/listers/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+const (
+	// Name is the name of the plugin used in the plugin registry and configurations.
+	Name = "WorkloadAffinity"
+)
+
+// WorkloadAffinity is a filter plugin that co-schedules dependent workloads:
+// when the placement declares coScheduleWith terms, only member clusters
+// every referenced workload has been scheduled to pass the filter. The
+// binding stays pending until all referenced workloads have been scheduled.
+type WorkloadAffinity struct {
+	bindingLister worklister.ResourceBindingLister
+}
+
+var _ framework.FilterPlugin = &WorkloadAffinity{}
+
+// New instantiates the WorkloadAffinity plugin with the given binding lister.
+func New(bindingLister worklister.ResourceBindingLister) (framework.Plugin, error) {
+	return &WorkloadAffinity{bindingLister: bindingLister}, nil
+}
+
+// Name returns the plugin name.
+func (p *WorkloadAffinity) Name() string {
+	return Name
+}
+
+// Filter checks if the target cluster hosts every workload referenced by the
+// coScheduleWith terms of the placement.
+func (p *WorkloadAffinity) Filter(_ context.Context,
+	bindingSpec *workv1alpha2.ResourceBindingSpec, _ *workv1alpha2.ResourceBindingStatus, cluster *clusterv1alpha1.Cluster) *framework.Result {
+	if bindingSpec.Placement == nil || len(bindingSpec.Placement.CoScheduleWith) == 0 {
+		return framework.NewResult(framework.Success)
+	}
+
+	for _, term := range bindingSpec.Placement.CoScheduleWith {
+		clusters, err := p.scheduledClusters(term, bindingSpec.Resource.Namespace)
+		if err != nil {
+			return framework.AsResult(err)
+		}
+		if clusters.Len() == 0 {
+			klog.V(2).Infof("Referenced workload of %s %s/%s is not scheduled yet.",
+				bindingSpec.Resource.Kind, bindingSpec.Resource.Namespace, bindingSpec.Resource.Name)
+			return framework.NewResult(framework.Unschedulable, "referenced workload(s) not scheduled yet")
+		}
+		if !clusters.Has(cluster.Name) {
+			klog.V(2).Infof("Cluster(%s) does not host the workload(s) referenced by %s %s/%s.",
+				cluster.Name, bindingSpec.Resource.Kind, bindingSpec.Resource.Namespace, bindingSpec.Resource.Name)
+			return framework.NewResult(framework.Unschedulable, "cluster(s) did not host the referenced workload(s)")
+		}
+	}
+
+	return framework.NewResult(framework.Success)
+}
+
+// scheduledClusters resolves the term to the set of clusters every referenced
+// workload has been scheduled to.
+func (p *WorkloadAffinity) scheduledClusters(term policyv1alpha1.WorkloadAffinityTerm, defaultNamespace string) (sets.Set[string], error) {
+	namespace := term.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	var bindings []*workv1alpha2.ResourceBinding
+	switch {
+	case term.Name != "":
+		binding, err := p.bindingLister.ResourceBindings(namespace).Get(names.GenerateBindingName(term.Kind, term.Name))
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return sets.New[string](), nil
+			}
+			return nil, fmt.Errorf("failed to get binding of referenced workload %s %s/%s: %w", term.Kind, namespace, term.Name, err)
+		}
+		bindings = append(bindings, binding)
+	case term.LabelSelector != nil:
+		selector, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector of workload affinity term: %w", err)
+		}
+		bindings, err = p.bindingLister.ResourceBindings(namespace).List(selector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list bindings of referenced workloads: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("invalid workload affinity term: one of name and labelSelector must be specified")
+	}
+
+	var clusters sets.Set[string]
+	for _, binding := range bindings {
+		bindingClusters := sets.New[string]()
+		for _, targetCluster := range binding.Spec.Clusters {
+			bindingClusters.Insert(targetCluster.Name)
+		}
+		if clusters == nil {
+			clusters = bindingClusters
+		} else {
+			clusters = clusters.Intersection(bindingClusters)
+		}
+	}
+	if clusters == nil {
+		clusters = sets.New[string]()
+	}
+	return clusters, nil
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadaffinity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	worklisters "github.com/karmada-io/karmada/pkg/generated/listers/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+func newBinding(namespace, kind, name string, labels map[string]string, clusters ...string) *workv1alpha2.ResourceBinding {
+	targetClusters := make([]workv1alpha2.TargetCluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		targetClusters = append(targetClusters, workv1alpha2.TargetCluster{Name: cluster})
+	}
+	return &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      names.GenerateBindingName(kind, name),
+			Labels:    labels,
+		},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Resource: workv1alpha2.ObjectReference{Kind: kind, Namespace: namespace, Name: name},
+			Clusters: targetClusters,
+		},
+	}
+}
+
+func newBindingSpec(terms ...policyv1alpha1.WorkloadAffinityTerm) *workv1alpha2.ResourceBindingSpec {
+	return &workv1alpha2.ResourceBindingSpec{
+		Resource:  workv1alpha2.ObjectReference{Kind: "Deployment", Namespace: "default", Name: "frontend"},
+		Placement: &policyv1alpha1.Placement{CoScheduleWith: terms},
+	}
+}
+
+func newCluster(name string) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: name}}
+}
+
+func nameTerm(name string) policyv1alpha1.WorkloadAffinityTerm {
+	return policyv1alpha1.WorkloadAffinityTerm{APIVersion: "apps/v1", Kind: "Deployment", Name: name}
+}
+
+func TestWorkloadAffinity_Filter(t *testing.T) {
+	tests := []struct {
+		name         string
+		bindingSpec  *workv1alpha2.ResourceBindingSpec
+		bindings     []*workv1alpha2.ResourceBinding
+		cluster      *clusterv1alpha1.Cluster
+		expectedCode framework.Code
+	}{
+		{
+			name:         "no workload affinity terms",
+			bindingSpec:  newBindingSpec(),
+			cluster:      newCluster("member1"),
+			expectedCode: framework.Success,
+		},
+		{
+			name:        "cluster hosts the referenced workload",
+			bindingSpec: newBindingSpec(nameTerm("backend")),
+			bindings: []*workv1alpha2.ResourceBinding{
+				newBinding("default", "Deployment", "backend", nil, "member1", "member2"),
+			},
+			cluster:      newCluster("member1"),
+			expectedCode: framework.Success,
+		},
+		{
+			name:        "cluster does not host the referenced workload",
+			bindingSpec: newBindingSpec(nameTerm("backend")),
+			bindings: []*workv1alpha2.ResourceBinding{
+				newBinding("default", "Deployment", "backend", nil, "member2"),
+			},
+			cluster:      newCluster("member1"),
+			expectedCode: framework.Unschedulable,
+		},
+		{
+			name:         "referenced workload does not exist",
+			bindingSpec:  newBindingSpec(nameTerm("backend")),
+			cluster:      newCluster("member1"),
+			expectedCode: framework.Unschedulable,
+		},
+		{
+			name:        "referenced workload is not scheduled yet",
+			bindingSpec: newBindingSpec(nameTerm("backend")),
+			bindings: []*workv1alpha2.ResourceBinding{
+				newBinding("default", "Deployment", "backend", nil),
+			},
+			cluster:      newCluster("member1"),
+			expectedCode: framework.Unschedulable,
+		},
+		{
+			name: "term in another namespace",
+			bindingSpec: newBindingSpec(policyv1alpha1.WorkloadAffinityTerm{
+				APIVersion: "apps/v1", Kind: "Deployment", Namespace: "backend-system", Name: "backend",
+			}),
+			bindings: []*workv1alpha2.ResourceBinding{
+				newBinding("backend-system", "Deployment", "backend", nil, "member1"),
+			},
+			cluster:      newCluster("member1"),
+			expectedCode: framework.Success,
+		},
+		{
+			name: "label selector intersects the referenced workloads",
+			bindingSpec: newBindingSpec(policyv1alpha1.WorkloadAffinityTerm{
+				APIVersion: "apps/v1", Kind: "Deployment",
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "backend"}},
+			}),
+			bindings: []*workv1alpha2.ResourceBinding{
+				newBinding("default", "Deployment", "backend", map[string]string{"tier": "backend"}, "member1", "member2"),
+				newBinding("default", "Deployment", "cache", map[string]string{"tier": "backend"}, "member1"),
+			},
+			cluster:      newCluster("member1"),
+			expectedCode: framework.Success,
+		},
+		{
+			name: "label selector without common cluster",
+			bindingSpec: newBindingSpec(policyv1alpha1.WorkloadAffinityTerm{
+				APIVersion: "apps/v1", Kind: "Deployment",
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "backend"}},
+			}),
+			bindings: []*workv1alpha2.ResourceBinding{
+				newBinding("default", "Deployment", "backend", map[string]string{"tier": "backend"}, "member1"),
+				newBinding("default", "Deployment", "cache", map[string]string{"tier": "backend"}, "member2"),
+			},
+			cluster:      newCluster("member1"),
+			expectedCode: framework.Unschedulable,
+		},
+		{
+			name: "invalid term without name and label selector",
+			bindingSpec: newBindingSpec(policyv1alpha1.WorkloadAffinityTerm{
+				APIVersion: "apps/v1", Kind: "Deployment",
+			}),
+			cluster:      newCluster("member1"),
+			expectedCode: framework.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, binding := range tt.bindings {
+				assert.NoError(t, indexer.Add(binding))
+			}
+			p := &WorkloadAffinity{bindingLister: worklisters.NewResourceBindingLister(indexer)}
+
+			result := p.Filter(context.Background(), tt.bindingSpec, nil, tt.cluster)
+			assert.Equal(t, tt.expectedCode, result.Code())
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	plugin, err := New(nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, plugin)
+	_, ok := plugin.(*WorkloadAffinity)
+	assert.True(t, ok)
+}
+
+func TestWorkloadAffinity_Name(t *testing.T) {
+	p := &WorkloadAffinity{}
+	assert.Equal(t, Name, p.Name())
+}
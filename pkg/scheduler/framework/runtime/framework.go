@@ -22,6 +22,8 @@ import (
 	"reflect"
 	"time"
 
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
 	"github.com/karmada-io/karmada/pkg/scheduler/framework"
@@ -47,13 +49,40 @@ type frameworkImpl struct {
 
 var _ framework.Framework = &frameworkImpl{}
 
+// ConfigurablePlugin is an optional interface for plugins that accept
+// arguments from the scheduler configuration file. The framework delivers the
+// configured arguments right after the plugin is built.
+type ConfigurablePlugin interface {
+	framework.Plugin
+	// SetArgs parses and applies the plugin arguments.
+	SetArgs(args *apiruntime.RawExtension) error
+}
+
 type frameworkOptions struct {
-	metricsRecorder *metricsRecorder
+	metricsRecorder       *metricsRecorder
+	scorePluginsWeightMap map[string]int
+	pluginArgs            map[string]*apiruntime.RawExtension
 }
 
 // Option for the frameworkImpl.
 type Option func(*frameworkOptions)
 
+// WithScorePluginsWeightMap sets the weights the scores of the named plugins
+// are multiplied by, plugins not in the map keep their raw scores.
+func WithScorePluginsWeightMap(weightMap map[string]int) Option {
+	return func(o *frameworkOptions) {
+		o.scorePluginsWeightMap = weightMap
+	}
+}
+
+// WithPluginArgs sets the per-plugin arguments delivered to the plugins at
+// initialization time.
+func WithPluginArgs(pluginArgs map[string]*apiruntime.RawExtension) Option {
+	return func(o *frameworkOptions) {
+		o.pluginArgs = pluginArgs
+	}
+}
+
 func defaultFrameworkOptions() frameworkOptions {
 	return frameworkOptions{
 		metricsRecorder: newMetricsRecorder(1000, time.Second),
@@ -68,7 +97,8 @@ func NewFramework(r Registry, opts ...Option) (framework.Framework, error) {
 	}
 
 	f := &frameworkImpl{
-		metricsRecorder: options.metricsRecorder,
+		scorePluginsWeightMap: options.scorePluginsWeightMap,
+		metricsRecorder:       options.metricsRecorder,
 	}
 	filterPluginsList := reflect.ValueOf(&f.filterPlugins).Elem()
 	scorePluginsList := reflect.ValueOf(&f.scorePlugins).Elem()
@@ -80,6 +110,15 @@ func NewFramework(r Registry, opts ...Option) (framework.Framework, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize plugin %q: %w", name, err)
 		}
+		if args, ok := options.pluginArgs[name]; ok && args != nil {
+			configurable, ok := p.(ConfigurablePlugin)
+			if !ok {
+				return nil, fmt.Errorf("plugin %q does not accept arguments", name)
+			}
+			if err := configurable.SetArgs(args); err != nil {
+				return nil, fmt.Errorf("failed to apply arguments of plugin %q: %w", name, err)
+			}
+		}
 
 		addPluginToList(p, filterType, &filterPluginsList)
 		addPluginToList(p, scoreType, &scorePluginsList)
@@ -23,6 +23,7 @@ import (
 
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
 
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	"github.com/karmada-io/karmada/pkg/scheduler/framework"
@@ -183,3 +184,77 @@ func Test_frameworkImpl_RunScorePlugins(t *testing.T) {
 		})
 	}
 }
+
+func Test_frameworkImpl_ScorePluginWeights(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	clusters := []*clusterv1alpha1.Cluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: "c1"}},
+	}
+
+	mockScorePlugin := frameworktesting.NewMockScorePlugin(mockCtrl)
+	mockScorePlugin.EXPECT().Score(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(int64(60), framework.NewResult(framework.Success))
+	mockScorePlugin.EXPECT().ScoreExtensions().Return(nil)
+	mockScorePlugin.EXPECT().Name().AnyTimes().Return("foo")
+
+	registry, err := createAndRegisterFactory([]framework.Plugin{mockScorePlugin})
+	if err != nil {
+		t.Errorf("create plugin factory error:%v", err)
+	}
+
+	frameWork, err := NewFramework(registry, WithScorePluginsWeightMap(map[string]int{"foo": 2}))
+	if err != nil {
+		t.Errorf("create frame work error:%v", err)
+	}
+
+	scores, result := frameWork.RunScorePlugins(ctx, nil, clusters)
+	if !result.IsSuccess() {
+		t.Fatalf("want success, but get:%v", result.AsError())
+	}
+	if got := scores["foo"][0].Score; got != 120 {
+		t.Errorf("want weighted score 120, but get:%d", got)
+	}
+}
+
+type configurableTestPlugin struct {
+	args *apiruntime.RawExtension
+}
+
+func (p *configurableTestPlugin) Name() string { return "configurable" }
+
+func (p *configurableTestPlugin) SetArgs(args *apiruntime.RawExtension) error {
+	p.args = args
+	return nil
+}
+
+func Test_NewFramework_PluginArgs(t *testing.T) {
+	plugin := &configurableTestPlugin{}
+	registry := Registry{}
+	if err := registry.Register(plugin.Name(), func() (framework.Plugin, error) { return plugin, nil }); err != nil {
+		t.Fatalf("register plugin error:%v", err)
+	}
+
+	args := &apiruntime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}
+	if _, err := NewFramework(registry, WithPluginArgs(map[string]*apiruntime.RawExtension{plugin.Name(): args})); err != nil {
+		t.Fatalf("create frame work error:%v", err)
+	}
+	if plugin.args != args {
+		t.Errorf("plugin args were not delivered")
+	}
+
+	// A plugin that does not accept arguments must reject the configuration.
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockFilterPlugin := frameworktesting.NewMockFilterPlugin(mockCtrl)
+	mockFilterPlugin.EXPECT().Name().AnyTimes().Return("foo")
+	registry, err := createAndRegisterFactory([]framework.Plugin{mockFilterPlugin})
+	if err != nil {
+		t.Fatalf("create plugin factory error:%v", err)
+	}
+	if _, err := NewFramework(registry, WithPluginArgs(map[string]*apiruntime.RawExtension{"foo0": args})); err == nil {
+		t.Errorf("expected an error for a plugin that does not accept arguments")
+	}
+}
@@ -20,9 +20,11 @@ import (
 	"encoding/json"
 	"errors"
 	"reflect"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
 	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
@@ -94,6 +96,74 @@ func clusterAffinitiesChanged(
 	return false
 }
 
+// excludedClustersFromAnnotation parses the binding-scope cluster denylist
+// declared by the excluded-clusters annotation. Empty entries are ignored.
+func excludedClustersFromAnnotation(annotations map[string]string) []string {
+	value := annotations[workv1alpha2.BindingExcludedClustersAnnotation]
+	if value == "" {
+		return nil
+	}
+
+	var clusters []string
+	for _, cluster := range strings.Split(value, ",") {
+		if cluster = strings.TrimSpace(cluster); cluster != "" {
+			clusters = append(clusters, cluster)
+		}
+	}
+	return clusters
+}
+
+// applyExcludedClusters merges the binding-scope excluded clusters into every
+// cluster affinity of the placement so the scheduler filters them out.
+func applyExcludedClusters(placement *policyv1alpha1.Placement, excluded []string) {
+	if placement == nil || len(excluded) == 0 {
+		return
+	}
+
+	if len(placement.ClusterAffinities) > 0 {
+		for index := range placement.ClusterAffinities {
+			placement.ClusterAffinities[index].ExcludeClusters = mergeClusterNames(placement.ClusterAffinities[index].ExcludeClusters, excluded)
+		}
+		return
+	}
+
+	if placement.ClusterAffinity == nil {
+		placement.ClusterAffinity = &policyv1alpha1.ClusterAffinity{}
+	}
+	placement.ClusterAffinity.ExcludeClusters = mergeClusterNames(placement.ClusterAffinity.ExcludeClusters, excluded)
+}
+
+func mergeClusterNames(existing, additional []string) []string {
+	known := sets.New(existing...)
+	merged := existing
+	for _, name := range additional {
+		if !known.Has(name) {
+			known.Insert(name)
+			merged = append(merged, name)
+		}
+	}
+	return merged
+}
+
+// targetClustersEqual reports whether the two schedule results assign the same
+// replicas to the same clusters, regardless of order.
+func targetClustersEqual(a, b []workv1alpha2.TargetCluster) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	replicas := make(map[string]int32, len(a))
+	for _, cluster := range a {
+		replicas[cluster.Name] = cluster.Replicas
+	}
+	for _, cluster := range b {
+		assigned, ok := replicas[cluster.Name]
+		if !ok || assigned != cluster.Replicas {
+			return false
+		}
+	}
+	return true
+}
+
 func getAffinityIndex(affinities []policyv1alpha1.ClusterAffinityTerm, observedName string) int {
 	if observedName == "" {
 		return 0
@@ -19,6 +19,7 @@ package scheduler
 import (
 	"encoding/json"
 	"errors"
+	"reflect"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -504,3 +505,152 @@ func Test_getConditionByError(t *testing.T) {
 		})
 	}
 }
+
+func Test_excludedClustersFromAnnotation(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        []string
+	}{
+		{
+			name:        "no annotation",
+			annotations: nil,
+			want:        nil,
+		},
+		{
+			name:        "single cluster",
+			annotations: map[string]string{workv1alpha2.BindingExcludedClustersAnnotation: "member1"},
+			want:        []string{"member1"},
+		},
+		{
+			name:        "multiple clusters with spaces and empty entries",
+			annotations: map[string]string{workv1alpha2.BindingExcludedClustersAnnotation: "member1, member2,,"},
+			want:        []string{"member1", "member2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := excludedClustersFromAnnotation(tt.annotations); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("excludedClustersFromAnnotation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_applyExcludedClusters(t *testing.T) {
+	tests := []struct {
+		name      string
+		placement *policyv1alpha1.Placement
+		excluded  []string
+		want      *policyv1alpha1.Placement
+	}{
+		{
+			name:      "nothing excluded",
+			placement: &policyv1alpha1.Placement{},
+			excluded:  nil,
+			want:      &policyv1alpha1.Placement{},
+		},
+		{
+			name:      "no cluster affinity declared",
+			placement: &policyv1alpha1.Placement{},
+			excluded:  []string{"member1"},
+			want: &policyv1alpha1.Placement{
+				ClusterAffinity: &policyv1alpha1.ClusterAffinity{ExcludeClusters: []string{"member1"}},
+			},
+		},
+		{
+			name: "merged into cluster affinity without duplicates",
+			placement: &policyv1alpha1.Placement{
+				ClusterAffinity: &policyv1alpha1.ClusterAffinity{ExcludeClusters: []string{"member1"}},
+			},
+			excluded: []string{"member1", "member2"},
+			want: &policyv1alpha1.Placement{
+				ClusterAffinity: &policyv1alpha1.ClusterAffinity{ExcludeClusters: []string{"member1", "member2"}},
+			},
+		},
+		{
+			name: "merged into every cluster affinity term",
+			placement: &policyv1alpha1.Placement{
+				ClusterAffinities: []policyv1alpha1.ClusterAffinityTerm{
+					{AffinityName: "primary"},
+					{AffinityName: "backup", ClusterAffinity: policyv1alpha1.ClusterAffinity{ExcludeClusters: []string{"member3"}}},
+				},
+			},
+			excluded: []string{"member1"},
+			want: &policyv1alpha1.Placement{
+				ClusterAffinities: []policyv1alpha1.ClusterAffinityTerm{
+					{AffinityName: "primary", ClusterAffinity: policyv1alpha1.ClusterAffinity{ExcludeClusters: []string{"member1"}}},
+					{AffinityName: "backup", ClusterAffinity: policyv1alpha1.ClusterAffinity{ExcludeClusters: []string{"member3", "member1"}}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applyExcludedClusters(tt.placement, tt.excluded)
+			if !reflect.DeepEqual(tt.placement, tt.want) {
+				t.Errorf("applyExcludedClusters() = %+v, want %+v", tt.placement, tt.want)
+			}
+		})
+	}
+}
+
+func Test_targetClustersEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []workv1alpha2.TargetCluster
+		b    []workv1alpha2.TargetCluster
+		want bool
+	}{
+		{
+			name: "both empty",
+			want: true,
+		},
+		{
+			name: "same clusters in different order",
+			a: []workv1alpha2.TargetCluster{
+				{Name: "member1", Replicas: 1},
+				{Name: "member2", Replicas: 2},
+			},
+			b: []workv1alpha2.TargetCluster{
+				{Name: "member2", Replicas: 2},
+				{Name: "member1", Replicas: 1},
+			},
+			want: true,
+		},
+		{
+			name: "different replicas",
+			a: []workv1alpha2.TargetCluster{
+				{Name: "member1", Replicas: 1},
+			},
+			b: []workv1alpha2.TargetCluster{
+				{Name: "member1", Replicas: 2},
+			},
+			want: false,
+		},
+		{
+			name: "different clusters",
+			a: []workv1alpha2.TargetCluster{
+				{Name: "member1", Replicas: 1},
+			},
+			b: []workv1alpha2.TargetCluster{
+				{Name: "member2", Replicas: 1},
+			},
+			want: false,
+		},
+		{
+			name: "different length",
+			a: []workv1alpha2.TargetCluster{
+				{Name: "member1", Replicas: 1},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := targetClustersEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("targetClustersEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
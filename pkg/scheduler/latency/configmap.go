@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// clustersDataKey is the ConfigMap data key holding the
+	// control-plane-to-cluster latency measurements, a YAML map from cluster
+	// name to duration, e.g. `member1: 15ms`.
+	clustersDataKey = "clusters"
+	// interClusterDataKey is the ConfigMap data key holding the inter-cluster
+	// latency measurements, a nested YAML map from cluster name to cluster
+	// name to duration.
+	interClusterDataKey = "interCluster"
+)
+
+// ConfigMapSource periodically ingests latency measurements from a
+// user-maintained ConfigMap, for environments where the built-in probes can
+// not reach the member clusters or better measurements are available from an
+// external monitoring system.
+type ConfigMapSource struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+	interval  time.Duration
+	store     *Store
+}
+
+// NewConfigMapSource creates a ConfigMapSource feeding the given store.
+func NewConfigMapSource(client kubernetes.Interface, namespace, name string, interval time.Duration, store *Store) *ConfigMapSource {
+	return &ConfigMapSource{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+		interval:  interval,
+		store:     store,
+	}
+}
+
+// Run ingests the ConfigMap periodically until the context is done.
+func (s *ConfigMapSource) Run(ctx context.Context) {
+	klog.Infof("Starting latency ConfigMap source with ConfigMap(%s/%s)", s.namespace, s.name)
+	wait.UntilWithContext(ctx, s.syncOnce, s.interval)
+}
+
+func (s *ConfigMapSource) syncOnce(ctx context.Context) {
+	configMap, err := s.client.CoreV1().ConfigMaps(s.namespace).Get(ctx, s.name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(4).Infof("Latency ConfigMap(%s/%s) does not exist, skip.", s.namespace, s.name)
+			return
+		}
+		klog.Errorf("Failed to get latency ConfigMap(%s/%s): %v", s.namespace, s.name, err)
+		return
+	}
+	if err := ingestConfigMapData(configMap.Data, s.store); err != nil {
+		klog.Errorf("Failed to ingest latency ConfigMap(%s/%s): %v", s.namespace, s.name, err)
+	}
+}
+
+// ingestConfigMapData parses the measurements in the ConfigMap data and
+// records them in the store.
+func ingestConfigMapData(data map[string]string, store *Store) error {
+	if raw, ok := data[clustersDataKey]; ok {
+		clusters := map[string]string{}
+		if err := yaml.Unmarshal([]byte(raw), &clusters); err != nil {
+			return fmt.Errorf("failed to parse %q: %w", clustersDataKey, err)
+		}
+		for cluster, value := range clusters {
+			latency, err := time.ParseDuration(value)
+			if err != nil {
+				return fmt.Errorf("invalid latency %q of cluster %q: %w", value, cluster, err)
+			}
+			store.SetClusterLatency(cluster, latency)
+		}
+	}
+
+	if raw, ok := data[interClusterDataKey]; ok {
+		interCluster := map[string]map[string]string{}
+		if err := yaml.Unmarshal([]byte(raw), &interCluster); err != nil {
+			return fmt.Errorf("failed to parse %q: %w", interClusterDataKey, err)
+		}
+		for from, targets := range interCluster {
+			for to, value := range targets {
+				latency, err := time.ParseDuration(value)
+				if err != nil {
+					return fmt.Errorf("invalid latency %q between clusters %q and %q: %w", value, from, to, err)
+				}
+				store.SetInterClusterLatency(from, to, latency)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latency
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	clusterlister "github.com/karmada-io/karmada/pkg/generated/listers/cluster/v1alpha1"
+)
+
+const probeTimeout = 5 * time.Second
+
+// APIEndpointProber measures the control-plane-to-cluster latency by timing a
+// TCP connection establishment to the API endpoint of each member cluster.
+// It is intentionally lightweight: the round-trip of the TCP handshake tracks
+// the network latency closely enough for placement preferences.
+type APIEndpointProber struct {
+	clusterLister clusterlister.ClusterLister
+	interval      time.Duration
+	store         *Store
+
+	// dial is swappable for testing.
+	dial func(ctx context.Context, address string) error
+}
+
+// NewAPIEndpointProber creates a prober feeding the given store.
+func NewAPIEndpointProber(clusterLister clusterlister.ClusterLister, interval time.Duration, store *Store) *APIEndpointProber {
+	return &APIEndpointProber{
+		clusterLister: clusterLister,
+		interval:      interval,
+		store:         store,
+		dial: func(ctx context.Context, address string) error {
+			var dialer net.Dialer
+			conn, err := dialer.DialContext(ctx, "tcp", address)
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		},
+	}
+}
+
+// Run probes the member clusters periodically until the context is done.
+func (p *APIEndpointProber) Run(ctx context.Context) {
+	klog.Infof("Starting cluster latency prober with interval %s", p.interval)
+	wait.UntilWithContext(ctx, p.probeOnce, p.interval)
+}
+
+func (p *APIEndpointProber) probeOnce(ctx context.Context) {
+	clusters, err := p.clusterLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list clusters for latency probing: %v", err)
+		return
+	}
+	for _, cluster := range clusters {
+		address, err := probeAddress(cluster.Spec.APIEndpoint)
+		if err != nil {
+			klog.V(4).Infof("Skip latency probe of cluster %s: %v", cluster.Name, err)
+			continue
+		}
+
+		probeCtx, cancel := context.WithTimeout(ctx, probeTimeout)
+		start := time.Now()
+		err = p.dial(probeCtx, address)
+		cancel()
+		if err != nil {
+			klog.V(4).Infof("Latency probe of cluster %s at %s failed: %v", cluster.Name, address, err)
+			continue
+		}
+		p.store.SetClusterLatency(cluster.Name, time.Since(start))
+	}
+}
+
+// probeAddress derives the host:port to probe from the cluster API endpoint.
+func probeAddress(apiEndpoint string) (string, error) {
+	endpoint := apiEndpoint
+	if !strings.Contains(endpoint, "://") {
+		// The endpoint may be a bare host or host:port without a scheme.
+		endpoint = "https://" + endpoint
+	}
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	if parsed.Hostname() == "" {
+		return "", fmt.Errorf("no host in API endpoint %q", apiEndpoint)
+	}
+	if parsed.Port() != "" {
+		return parsed.Host, nil
+	}
+	port := "443"
+	if parsed.Scheme == "http" {
+		port = "80"
+	}
+	return net.JoinHostPort(parsed.Hostname(), port), nil
+}
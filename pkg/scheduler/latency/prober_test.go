@@ -0,0 +1,70 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbeAddress(t *testing.T) {
+	tests := []struct {
+		name        string
+		apiEndpoint string
+		expected    string
+		expectErr   bool
+	}{
+		{
+			name:        "https endpoint without port",
+			apiEndpoint: "https://cluster.example.com",
+			expected:    "cluster.example.com:443",
+		},
+		{
+			name:        "https endpoint with port",
+			apiEndpoint: "https://cluster.example.com:6443",
+			expected:    "cluster.example.com:6443",
+		},
+		{
+			name:        "http endpoint without port",
+			apiEndpoint: "http://cluster.example.com",
+			expected:    "cluster.example.com:80",
+		},
+		{
+			name:        "bare host and port",
+			apiEndpoint: "10.0.0.1:6443",
+			expected:    "10.0.0.1:6443",
+		},
+		{
+			name:        "invalid endpoint",
+			apiEndpoint: "https://cluster example com",
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address, err := probeAddress(tt.apiEndpoint)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, address)
+		})
+	}
+}
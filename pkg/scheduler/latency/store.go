@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package latency holds the network latency measurements the scheduler uses
+// for latency-aware placement. The measurements are produced by the probe
+// subsystem or ingested from a user-maintained ConfigMap.
+package latency
+
+import (
+	"sync"
+	"time"
+)
+
+// Provider exposes the latency measurements to consumers such as the
+// LatencyAware scheduler plugin.
+type Provider interface {
+	// ClusterLatency returns the measured latency between the Karmada control
+	// plane and the member cluster, false when no measurement is known.
+	ClusterLatency(cluster string) (time.Duration, bool)
+	// InterClusterLatency returns the measured latency between two member
+	// clusters, false when no measurement is known.
+	InterClusterLatency(from, to string) (time.Duration, bool)
+}
+
+// Store is an in-memory Provider the probe subsystem and the ConfigMap
+// source write their measurements to.
+type Store struct {
+	mu sync.RWMutex
+	// cluster maps a member cluster name to the control-plane-to-cluster latency.
+	cluster map[string]time.Duration
+	// interCluster maps a pair of member cluster names to their latency.
+	interCluster map[string]map[string]time.Duration
+}
+
+// NewStore creates an empty latency store.
+func NewStore() *Store {
+	return &Store{
+		cluster:      make(map[string]time.Duration),
+		interCluster: make(map[string]map[string]time.Duration),
+	}
+}
+
+// ClusterLatency implements Provider.
+func (s *Store) ClusterLatency(cluster string) (time.Duration, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	latency, ok := s.cluster[cluster]
+	return latency, ok
+}
+
+// InterClusterLatency implements Provider. The measurement is treated as
+// symmetric: when no measurement from->to is known, the reverse direction is
+// consulted.
+func (s *Store) InterClusterLatency(from, to string) (time.Duration, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if latency, ok := s.interCluster[from][to]; ok {
+		return latency, true
+	}
+	latency, ok := s.interCluster[to][from]
+	return latency, ok
+}
+
+// SetClusterLatency records the control-plane-to-cluster latency.
+func (s *Store) SetClusterLatency(cluster string, latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cluster[cluster] = latency
+}
+
+// SetInterClusterLatency records the latency between two member clusters.
+func (s *Store) SetInterClusterLatency(from, to string, latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.interCluster[from] == nil {
+		s.interCluster[from] = make(map[string]time.Duration)
+	}
+	s.interCluster[from][to] = latency
+}
+
+// DeleteCluster drops every measurement involving the cluster, e.g. after the
+// cluster has been unregistered.
+func (s *Store) DeleteCluster(cluster string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.cluster, cluster)
+	delete(s.interCluster, cluster)
+	for from := range s.interCluster {
+		delete(s.interCluster[from], cluster)
+	}
+}
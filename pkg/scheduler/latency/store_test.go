@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoreClusterLatency(t *testing.T) {
+	store := NewStore()
+
+	_, ok := store.ClusterLatency("member1")
+	assert.False(t, ok)
+
+	store.SetClusterLatency("member1", 15*time.Millisecond)
+	latency, ok := store.ClusterLatency("member1")
+	assert.True(t, ok)
+	assert.Equal(t, 15*time.Millisecond, latency)
+
+	store.SetClusterLatency("member1", 20*time.Millisecond)
+	latency, ok = store.ClusterLatency("member1")
+	assert.True(t, ok)
+	assert.Equal(t, 20*time.Millisecond, latency)
+}
+
+func TestStoreInterClusterLatencyIsSymmetric(t *testing.T) {
+	store := NewStore()
+
+	_, ok := store.InterClusterLatency("member1", "member2")
+	assert.False(t, ok)
+
+	store.SetInterClusterLatency("member1", "member2", 30*time.Millisecond)
+
+	latency, ok := store.InterClusterLatency("member1", "member2")
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Millisecond, latency)
+
+	latency, ok = store.InterClusterLatency("member2", "member1")
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Millisecond, latency)
+}
+
+func TestStoreDeleteCluster(t *testing.T) {
+	store := NewStore()
+	store.SetClusterLatency("member1", 15*time.Millisecond)
+	store.SetInterClusterLatency("member1", "member2", 30*time.Millisecond)
+	store.SetInterClusterLatency("member3", "member1", 40*time.Millisecond)
+	store.SetInterClusterLatency("member2", "member3", 50*time.Millisecond)
+
+	store.DeleteCluster("member1")
+
+	_, ok := store.ClusterLatency("member1")
+	assert.False(t, ok)
+	_, ok = store.InterClusterLatency("member1", "member2")
+	assert.False(t, ok)
+	_, ok = store.InterClusterLatency("member3", "member1")
+	assert.False(t, ok)
+
+	latency, ok := store.InterClusterLatency("member2", "member3")
+	assert.True(t, ok)
+	assert.Equal(t, 50*time.Millisecond, latency)
+}
@@ -33,6 +33,11 @@ const (
 	errorResult     = "error"
 )
 
+const (
+	matchedResult  = "matched"
+	divergedResult = "diverged"
+)
+
 const (
 	// BindingAdd is the event when a new binding is added to API server.
 	BindingAdd = "BindingAdd"
@@ -112,6 +117,15 @@ var (
 		},
 		[]string{"plugin", "extension_point", "result"})
 
+	// shadowScheduleDecisions counts schedule decisions computed in shadow
+	// mode, partitioned by whether they match the live schedule result.
+	shadowScheduleDecisions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: SchedulerSubsystem,
+			Name:      "shadow_schedule_decisions_total",
+			Help:      "Number of schedule decisions computed in shadow mode, partitioned by whether they match the live schedule result.",
+		}, []string{"result"})
+
 	metrics = []prometheus.Collector{
 		scheduleAttempts,
 		e2eSchedulingLatency,
@@ -119,6 +133,7 @@ var (
 		SchedulerQueueIncomingBindings,
 		FrameworkExtensionPointDuration,
 		PluginExecutionDuration,
+		shadowScheduleDecisions,
 	}
 )
 
@@ -138,6 +153,15 @@ func BindingSchedule(scheduleType string, duration float64, err error) {
 	}
 }
 
+// ShadowScheduleDecision records a schedule decision computed in shadow mode.
+func ShadowScheduleDecision(diverged bool) {
+	if diverged {
+		shadowScheduleDecisions.WithLabelValues(divergedResult).Inc()
+	} else {
+		shadowScheduleDecisions.WithLabelValues(matchedResult).Inc()
+	}
+}
+
 func observeScheduleAttemptAndLatency(result, scheduleType string, duration float64) {
 	e2eSchedulingLatency.WithLabelValues(result, scheduleType).Observe(duration)
 	scheduleAttempts.WithLabelValues(result, scheduleType).Inc()
@@ -0,0 +1,254 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/events"
+	"github.com/karmada-io/karmada/pkg/features"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+// preemptionVictim is a lower priority ResourceBinding whose rescheduling can
+// free replicas in a candidate cluster for a pending high-priority binding.
+// Cluster-scoped bindings are never selected as victims since they usually
+// carry cluster-wide infrastructure.
+type preemptionVictim struct {
+	binding *workv1alpha2.ResourceBinding
+	// replicas is the number of replicas the victim holds in the candidate cluster.
+	replicas int32
+}
+
+// attemptPreemptionForResourceBinding tries to make room for an unschedulable
+// high-priority ResourceBinding by triggering the rescheduling of lower
+// priority bindings in one candidate cluster. It is a best-effort phase: the
+// preemptor is requeued by the regular error handling and picks up the freed
+// capacity on a later scheduling attempt.
+func (s *Scheduler) attemptPreemptionForResourceBinding(rb *workv1alpha2.ResourceBinding, schedulerErr error) {
+	preemptor := fmt.Sprintf("ResourceBinding(%s/%s)", rb.Namespace, rb.Name)
+	self := types.NamespacedName{Namespace: rb.Namespace, Name: rb.Name}
+	s.attemptPreemption(rb, preemptor, self, &rb.Spec, schedulerErr)
+}
+
+// attemptPreemptionForClusterResourceBinding is the ClusterResourceBinding
+// counterpart of attemptPreemptionForResourceBinding.
+func (s *Scheduler) attemptPreemptionForClusterResourceBinding(crb *workv1alpha2.ClusterResourceBinding, schedulerErr error) {
+	preemptor := fmt.Sprintf("ClusterResourceBinding(%s)", crb.Name)
+	s.attemptPreemption(crb, preemptor, types.NamespacedName{}, &crb.Spec, schedulerErr)
+}
+
+func (s *Scheduler) attemptPreemption(preemptorObject runtime.Object, preemptor string, self types.NamespacedName, spec *workv1alpha2.ResourceBindingSpec, schedulerErr error) {
+	if !features.FeatureGate.Enabled(features.PriorityBasedPreemptiveScheduling) || s.shadowMode {
+		return
+	}
+	if !isCapacityError(schedulerErr) {
+		return
+	}
+	priority := spec.SchedulePriorityValue()
+	if priority <= 0 || spec.Replicas <= 0 {
+		return
+	}
+
+	candidates, err := s.candidatePreemptionClusters(spec.Placement)
+	if err != nil {
+		klog.Errorf("Failed to list candidate clusters for preemption by %s: %v", preemptor, err)
+		return
+	}
+	if candidates.Len() == 0 {
+		return
+	}
+
+	cluster, victims := s.selectPreemptionVictims(candidates, self, priority, spec.Replicas)
+	if len(victims) == 0 {
+		klog.V(4).Infof("No lower priority bindings can be preempted for %s", preemptor)
+		return
+	}
+
+	var freedReplicas int32
+	preempted := make([]string, 0, len(victims))
+	for _, victim := range victims {
+		if err := s.triggerVictimReschedule(victim.binding); err != nil {
+			klog.Errorf("Failed to trigger rescheduling of ResourceBinding(%s/%s) preempted by %s: %v",
+				victim.binding.Namespace, victim.binding.Name, preemptor, err)
+			s.eventRecorder.Event(preemptorObject, corev1.EventTypeWarning, events.EventReasonPreemptBindingFailed,
+				fmt.Sprintf("Failed to trigger rescheduling of lower priority ResourceBinding(%s/%s): %v",
+					victim.binding.Namespace, victim.binding.Name, err))
+			continue
+		}
+		freedReplicas += victim.replicas
+		preempted = append(preempted, fmt.Sprintf("%s/%s", victim.binding.Namespace, victim.binding.Name))
+		s.eventRecorder.Event(victim.binding, corev1.EventTypeNormal, events.EventReasonBindingPreempted,
+			fmt.Sprintf("Rescheduling triggered by higher priority binding %s(priority %d) to free %d replica(s) in cluster %s.",
+				preemptor, priority, victim.replicas, cluster))
+	}
+	if len(preempted) == 0 {
+		return
+	}
+
+	klog.V(2).Infof("%s preempted lower priority bindings %v in cluster %s, up to %d replica(s) will be freed",
+		preemptor, preempted, cluster, freedReplicas)
+	s.eventRecorder.Event(preemptorObject, corev1.EventTypeNormal, events.EventReasonPreemptBindingSucceed,
+		fmt.Sprintf("Triggered rescheduling of %d lower priority binding(s) in cluster %s to free up to %d replica(s).",
+			len(preempted), cluster, freedReplicas))
+}
+
+// isCapacityError tells if the scheduling failure was caused by insufficient
+// cluster capacity, the only failure preemption can help with.
+func isCapacityError(schedulerErr error) bool {
+	var unschedulableErr *framework.UnschedulableError
+	return errors.As(schedulerErr, &unschedulableErr)
+}
+
+// candidatePreemptionClusters returns the names of the ready clusters the
+// preemptor may be scheduled to according to its placement.
+func (s *Scheduler) candidatePreemptionClusters(placement *policyv1alpha1.Placement) (sets.Set[string], error) {
+	clusters, err := s.clusterLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := sets.New[string]()
+	for _, cluster := range clusters {
+		if !util.IsClusterReady(&cluster.Status) {
+			continue
+		}
+		if placement != nil && !placementMatches(placement, cluster) {
+			continue
+		}
+		candidates.Insert(cluster.Name)
+	}
+	return candidates, nil
+}
+
+func placementMatches(placement *policyv1alpha1.Placement, cluster *clusterv1alpha1.Cluster) bool {
+	if placement.ClusterAffinity != nil {
+		return util.ClusterMatches(cluster, *placement.ClusterAffinity)
+	}
+	for index := range placement.ClusterAffinities {
+		if util.ClusterMatches(cluster, placement.ClusterAffinities[index].ClusterAffinity) {
+			return true
+		}
+	}
+	// No affinity constraints, all clusters are candidates.
+	return placement.ClusterAffinities == nil
+}
+
+// selectPreemptionVictims picks the candidate cluster in which preemption can
+// free the most replicas and returns the smallest set of its lowest priority
+// bindings whose rescheduling frees at least the wanted replicas, or all of
+// them when even that is not enough.
+func (s *Scheduler) selectPreemptionVictims(candidates sets.Set[string], self types.NamespacedName, priority, wantedReplicas int32) (string, []preemptionVictim) {
+	bindings, err := s.bindingLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list resource bindings for preemption: %v", err)
+		return "", nil
+	}
+
+	victimsByCluster := make(map[string][]preemptionVictim)
+	for _, binding := range bindings {
+		if binding.Namespace == self.Namespace && binding.Name == self.Name {
+			continue
+		}
+		if binding.Spec.SchedulePriorityValue() >= priority {
+			continue
+		}
+		if binding.Spec.SchedulingSuspended() {
+			continue
+		}
+		// Skip victims that are already about to be rescheduled.
+		if util.RescheduleRequired(binding.Spec.RescheduleTriggeredAt, binding.Status.LastScheduledTime) {
+			continue
+		}
+		for _, target := range binding.Spec.Clusters {
+			if target.Replicas <= 0 || !candidates.Has(target.Name) {
+				continue
+			}
+			victimsByCluster[target.Name] = append(victimsByCluster[target.Name], preemptionVictim{
+				binding:  binding,
+				replicas: target.Replicas,
+			})
+		}
+	}
+	if len(victimsByCluster) == 0 {
+		return "", nil
+	}
+
+	// Prefer the cluster in which preemption can free the most replicas, ties
+	// are broken by cluster name to keep the choice deterministic.
+	var bestCluster string
+	var bestFreeable int32
+	for cluster, victims := range victimsByCluster {
+		var freeable int32
+		for _, victim := range victims {
+			freeable += victim.replicas
+		}
+		if freeable > bestFreeable || (freeable == bestFreeable && (bestCluster == "" || cluster < bestCluster)) {
+			bestCluster, bestFreeable = cluster, freeable
+		}
+	}
+
+	// Evict the lowest priority bindings first and stop as soon as enough
+	// replicas are freed.
+	victims := victimsByCluster[bestCluster]
+	sort.Slice(victims, func(i, j int) bool {
+		victimI, victimJ := victims[i], victims[j]
+		if pi, pj := victimI.binding.Spec.SchedulePriorityValue(), victimJ.binding.Spec.SchedulePriorityValue(); pi != pj {
+			return pi < pj
+		}
+		if victimI.binding.Namespace != victimJ.binding.Namespace {
+			return victimI.binding.Namespace < victimJ.binding.Namespace
+		}
+		return victimI.binding.Name < victimJ.binding.Name
+	})
+
+	var freedReplicas int32
+	selected := make([]preemptionVictim, 0, len(victims))
+	for _, victim := range victims {
+		selected = append(selected, victim)
+		freedReplicas += victim.replicas
+		if freedReplicas >= wantedReplicas {
+			break
+		}
+	}
+	return bestCluster, selected
+}
+
+// triggerVictimReschedule marks the victim binding for rescheduling, the same
+// mechanism the `karmadactl reschedule` command uses.
+func (s *Scheduler) triggerVictimReschedule(binding *workv1alpha2.ResourceBinding) error {
+	patchBytes := []byte(fmt.Sprintf(`{"spec":{"rescheduleTriggeredAt":%q}}`, metav1.Now().UTC().Format(time.RFC3339)))
+	_, err := s.KarmadaClient.WorkV1alpha2().ResourceBindings(binding.Namespace).Patch(
+		context.TODO(), binding.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
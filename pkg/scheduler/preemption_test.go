@@ -0,0 +1,281 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/features"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	clusterlister "github.com/karmada-io/karmada/pkg/generated/listers/cluster/v1alpha1"
+	workv1alpha2lister "github.com/karmada-io/karmada/pkg/generated/listers/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+)
+
+type fakePreemptionBindingLister struct {
+	bindings []*workv1alpha2.ResourceBinding
+}
+
+func (f *fakePreemptionBindingLister) List(_ labels.Selector) ([]*workv1alpha2.ResourceBinding, error) {
+	return f.bindings, nil
+}
+
+func (f *fakePreemptionBindingLister) ResourceBindings(_ string) workv1alpha2lister.ResourceBindingNamespaceLister {
+	return nil
+}
+
+func newVictimBinding(name string, priority int32, clusters ...workv1alpha2.TargetCluster) *workv1alpha2.ResourceBinding {
+	return &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			SchedulePriority: &workv1alpha2.SchedulePriority{Priority: priority},
+			Clusters:         clusters,
+		},
+	}
+}
+
+func newReadyCluster(name string) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: clusterv1alpha1.ClusterStatus{
+			Conditions: []metav1.Condition{
+				{Type: clusterv1alpha1.ClusterConditionReady, Status: metav1.ConditionTrue},
+			},
+		},
+	}
+}
+
+func newClusterListerWithClusters(clusters ...*clusterv1alpha1.Cluster) clusterlister.ClusterLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, cluster := range clusters {
+		_ = indexer.Add(cluster)
+	}
+	return clusterlister.NewClusterLister(indexer)
+}
+
+func TestIsCapacityError(t *testing.T) {
+	if isCapacityError(fmt.Errorf("connection refused")) {
+		t.Errorf("isCapacityError() = true for a generic error, want false")
+	}
+	wrapped := fmt.Errorf("failed to assign replicas: %w", &framework.UnschedulableError{Message: "not enough"})
+	if !isCapacityError(wrapped) {
+		t.Errorf("isCapacityError() = false for a wrapped UnschedulableError, want true")
+	}
+}
+
+func TestPlacementMatches(t *testing.T) {
+	member1 := newReadyCluster("member1")
+	tests := []struct {
+		name      string
+		placement *policyv1alpha1.Placement
+		want      bool
+	}{
+		{
+			name:      "no affinity matches all clusters",
+			placement: &policyv1alpha1.Placement{},
+			want:      true,
+		},
+		{
+			name: "cluster affinity matches",
+			placement: &policyv1alpha1.Placement{
+				ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}},
+			},
+			want: true,
+		},
+		{
+			name: "cluster affinity does not match",
+			placement: &policyv1alpha1.Placement{
+				ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member2"}},
+			},
+			want: false,
+		},
+		{
+			name: "one of the ordered affinities matches",
+			placement: &policyv1alpha1.Placement{
+				ClusterAffinities: []policyv1alpha1.ClusterAffinityTerm{
+					{ClusterAffinity: policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member2"}}},
+					{ClusterAffinity: policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}}},
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := placementMatches(tt.placement, member1); got != tt.want {
+				t.Errorf("placementMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectPreemptionVictims(t *testing.T) {
+	tests := []struct {
+		name           string
+		bindings       []*workv1alpha2.ResourceBinding
+		candidates     sets.Set[string]
+		priority       int32
+		wantedReplicas int32
+		wantCluster    string
+		wantVictims    []string
+	}{
+		{
+			name: "lowest priority binding is preempted first",
+			bindings: []*workv1alpha2.ResourceBinding{
+				newVictimBinding("low", 1, workv1alpha2.TargetCluster{Name: "member1", Replicas: 2}),
+				newVictimBinding("lower", 0, workv1alpha2.TargetCluster{Name: "member1", Replicas: 2}),
+			},
+			candidates:     sets.New("member1"),
+			priority:       10,
+			wantedReplicas: 2,
+			wantCluster:    "member1",
+			wantVictims:    []string{"lower"},
+		},
+		{
+			name: "multiple victims until enough replicas are freed",
+			bindings: []*workv1alpha2.ResourceBinding{
+				newVictimBinding("victim1", 0, workv1alpha2.TargetCluster{Name: "member1", Replicas: 1}),
+				newVictimBinding("victim2", 1, workv1alpha2.TargetCluster{Name: "member1", Replicas: 2}),
+			},
+			candidates:     sets.New("member1"),
+			priority:       10,
+			wantedReplicas: 3,
+			wantCluster:    "member1",
+			wantVictims:    []string{"victim1", "victim2"},
+		},
+		{
+			name: "cluster able to free the most replicas is preferred",
+			bindings: []*workv1alpha2.ResourceBinding{
+				newVictimBinding("small", 0, workv1alpha2.TargetCluster{Name: "member1", Replicas: 1}),
+				newVictimBinding("big", 0, workv1alpha2.TargetCluster{Name: "member2", Replicas: 5}),
+			},
+			candidates:     sets.New("member1", "member2"),
+			priority:       10,
+			wantedReplicas: 4,
+			wantCluster:    "member2",
+			wantVictims:    []string{"big"},
+		},
+		{
+			name: "equal or higher priority bindings are not victims",
+			bindings: []*workv1alpha2.ResourceBinding{
+				newVictimBinding("equal", 10, workv1alpha2.TargetCluster{Name: "member1", Replicas: 2}),
+				newVictimBinding("higher", 20, workv1alpha2.TargetCluster{Name: "member1", Replicas: 2}),
+			},
+			candidates:     sets.New("member1"),
+			priority:       10,
+			wantedReplicas: 1,
+		},
+		{
+			name: "bindings outside the candidate clusters are not victims",
+			bindings: []*workv1alpha2.ResourceBinding{
+				newVictimBinding("elsewhere", 0, workv1alpha2.TargetCluster{Name: "member2", Replicas: 2}),
+			},
+			candidates:     sets.New("member1"),
+			priority:       10,
+			wantedReplicas: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheduler := &Scheduler{bindingLister: &fakePreemptionBindingLister{bindings: tt.bindings}}
+			cluster, victims := scheduler.selectPreemptionVictims(tt.candidates, types.NamespacedName{}, tt.priority, tt.wantedReplicas)
+			if cluster != tt.wantCluster {
+				t.Errorf("selectPreemptionVictims() cluster = %q, want %q", cluster, tt.wantCluster)
+			}
+			victimNames := make([]string, 0, len(victims))
+			for _, victim := range victims {
+				victimNames = append(victimNames, victim.binding.Name)
+			}
+			if len(victimNames) != len(tt.wantVictims) {
+				t.Fatalf("selectPreemptionVictims() victims = %v, want %v", victimNames, tt.wantVictims)
+			}
+			for i := range tt.wantVictims {
+				if victimNames[i] != tt.wantVictims[i] {
+					t.Errorf("selectPreemptionVictims() victims = %v, want %v", victimNames, tt.wantVictims)
+				}
+			}
+		})
+	}
+}
+
+func TestAttemptPreemptionForResourceBinding(t *testing.T) {
+	if err := features.FeatureGate.Set(fmt.Sprintf("%s=%t", features.PriorityBasedPreemptiveScheduling, true)); err != nil {
+		t.Fatalf("failed to enable feature gate: %v", err)
+	}
+	defer func() {
+		_ = features.FeatureGate.Set(fmt.Sprintf("%s=%t", features.PriorityBasedPreemptiveScheduling, false))
+	}()
+
+	victim := newVictimBinding("victim", 0, workv1alpha2.TargetCluster{Name: "member1", Replicas: 2})
+	preemptor := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "preemptor"},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Replicas:         2,
+			SchedulePriority: &workv1alpha2.SchedulePriority{Priority: 10},
+			Placement:        &policyv1alpha1.Placement{},
+		},
+	}
+
+	fakeClient := karmadafake.NewSimpleClientset(victim)
+	fakeRecorder := record.NewFakeRecorder(10)
+	scheduler := &Scheduler{
+		KarmadaClient: fakeClient,
+		eventRecorder: fakeRecorder,
+		bindingLister: &fakePreemptionBindingLister{bindings: []*workv1alpha2.ResourceBinding{victim}},
+		clusterLister: newClusterListerWithClusters(newReadyCluster("member1")),
+	}
+
+	schedulerErr := fmt.Errorf("failed to assign replicas: %w", &framework.UnschedulableError{Message: "not enough"})
+	scheduler.attemptPreemptionForResourceBinding(preemptor, schedulerErr)
+
+	updated, err := fakeClient.WorkV1alpha2().ResourceBindings("default").Get(context.TODO(), "victim", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get victim binding: %v", err)
+	}
+	if updated.Spec.RescheduleTriggeredAt == nil {
+		t.Errorf("victim binding was not marked for rescheduling")
+	}
+	if len(fakeRecorder.Events) != 2 {
+		t.Errorf("expected 2 preemption events, got %d", len(fakeRecorder.Events))
+	}
+
+	// A non-capacity error must not trigger preemption.
+	victim2 := newVictimBinding("victim2", 0, workv1alpha2.TargetCluster{Name: "member1", Replicas: 2})
+	fakeClient = karmadafake.NewSimpleClientset(victim2)
+	scheduler.KarmadaClient = fakeClient
+	scheduler.bindingLister = &fakePreemptionBindingLister{bindings: []*workv1alpha2.ResourceBinding{victim2}}
+	scheduler.attemptPreemptionForResourceBinding(preemptor, fmt.Errorf("connection refused"))
+	updated, err = fakeClient.WorkV1alpha2().ResourceBindings("default").Get(context.TODO(), "victim2", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get victim binding: %v", err)
+	}
+	if updated.Spec.RescheduleTriggeredAt != nil {
+		t.Errorf("preemption was triggered for a non-capacity error")
+	}
+}
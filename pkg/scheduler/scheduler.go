@@ -29,6 +29,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -49,12 +50,19 @@ import (
 	informerfactory "github.com/karmada-io/karmada/pkg/generated/informers/externalversions"
 	clusterlister "github.com/karmada-io/karmada/pkg/generated/listers/cluster/v1alpha1"
 	worklister "github.com/karmada-io/karmada/pkg/generated/listers/work/v1alpha2"
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/scheduler/apis/config/v1alpha1"
 	schedulercache "github.com/karmada-io/karmada/pkg/scheduler/cache"
+	"github.com/karmada-io/karmada/pkg/scheduler/carbon"
 	"github.com/karmada-io/karmada/pkg/scheduler/core"
 	"github.com/karmada-io/karmada/pkg/scheduler/framework"
 	frameworkplugins "github.com/karmada-io/karmada/pkg/scheduler/framework/plugins"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins/carbonaware"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins/latencyaware"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins/serviceaffinity"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins/workloadaffinity"
 	"github.com/karmada-io/karmada/pkg/scheduler/framework/runtime"
 	internalqueue "github.com/karmada-io/karmada/pkg/scheduler/internal/queue"
+	"github.com/karmada-io/karmada/pkg/scheduler/latency"
 	"github.com/karmada-io/karmada/pkg/scheduler/metrics"
 	"github.com/karmada-io/karmada/pkg/sharedcli/ratelimiterflag"
 	"github.com/karmada-io/karmada/pkg/util"
@@ -84,6 +92,15 @@ const (
 	successfulSchedulingMessage = "Binding has been scheduled successfully."
 )
 
+// defaultLatencyConfigMapSyncInterval is the interval the latency ConfigMap is
+// re-read at when the latency probes are disabled.
+const defaultLatencyConfigMapSyncInterval = 30 * time.Second
+
+// carbonIntensityConfigMapSyncInterval is the interval the carbon intensity
+// ConfigMap is re-read at. Grid signals update on the order of minutes, so a
+// tighter interval would only add load on the API server.
+const carbonIntensityConfigMapSyncInterval = 5 * time.Minute
+
 // Scheduler is the scheduler schema, which is used to schedule a specific resource to specific clusters
 type Scheduler struct {
 	DynamicClient        dynamic.Interface
@@ -106,6 +123,12 @@ type Scheduler struct {
 	Algorithm      core.ScheduleAlgorithm
 	schedulerCache schedulercache.Cache
 
+	// algorithms maps the schedulerName of each configured profile to its
+	// schedule algorithm, bindings selecting none of the profiles fall back
+	// to Algorithm. It is empty unless a scheduler configuration file with
+	// profiles is provided.
+	algorithms map[string]core.ScheduleAlgorithm
+
 	eventRecorder record.EventRecorder
 
 	enableSchedulerEstimator            bool
@@ -118,6 +141,24 @@ type Scheduler struct {
 	schedulerName                       string
 
 	enableEmptyWorkloadPropagation bool
+
+	// shadowMode indicates the scheduler runs as a canary alongside the active
+	// one: it computes schedule decisions and records divergences from the
+	// live results, but never writes them back.
+	shadowMode bool
+
+	// latencyStore holds the network latency measurements consumed by the
+	// LatencyAware plugin. The prober and the ConfigMap source feeding it are
+	// nil unless enabled by the corresponding options.
+	latencyStore           *latency.Store
+	latencyProber          *latency.APIEndpointProber
+	latencyConfigMapSource *latency.ConfigMapSource
+
+	// carbonStore holds the carbon intensity signals consumed by the
+	// CarbonAware plugin. The ConfigMap source feeding it is nil unless
+	// enabled by the corresponding option.
+	carbonStore           *carbon.Store
+	carbonConfigMapSource *carbon.ConfigMapSource
 }
 
 type schedulerOptions struct {
@@ -135,10 +176,24 @@ type schedulerOptions struct {
 	schedulerName string
 	// enableEmptyWorkloadPropagation represents whether allow workload with replicas 0 propagated to member clusters should be enabled
 	enableEmptyWorkloadPropagation bool
+	// shadowMode represents whether the scheduler runs in shadow mode without acting.
+	shadowMode bool
 	// outOfTreeRegistry represents the registry of out-of-tree plugins
 	outOfTreeRegistry runtime.Registry
 	// plugins is the list of plugins to enable or disable
 	plugins []string
+	// profiles is the list of scheduling profiles loaded from the scheduler
+	// configuration file.
+	profiles []configv1alpha1.SchedulerProfile
+	// latencyProbeInterval is the interval between two cluster latency probe
+	// rounds, 0 disables the probes.
+	latencyProbeInterval time.Duration
+	// latencyConfigMap references the ConfigMap(namespace/name) latency
+	// measurements are ingested from, empty disables the ingestion.
+	latencyConfigMap string
+	// carbonIntensityConfigMap references the ConfigMap(namespace/name) carbon
+	// intensity signals are ingested from, empty disables the ingestion.
+	carbonIntensityConfigMap string
 	// contains the options for rate limiter.
 	RateLimiterOptions ratelimiterflag.Options
 	// schedulerEstimatorClientConfig contains the configuration of GRPC.
@@ -210,6 +265,13 @@ func WithEnableEmptyWorkloadPropagation(enableEmptyWorkloadPropagation bool) Opt
 	}
 }
 
+// WithShadowMode sets the shadowMode for scheduler
+func WithShadowMode(shadowMode bool) Option {
+	return func(o *schedulerOptions) {
+		o.shadowMode = shadowMode
+	}
+}
+
 // WithEnableSchedulerPlugin sets the scheduler-plugin for scheduler
 func WithEnableSchedulerPlugin(plugins []string) Option {
 	return func(o *schedulerOptions) {
@@ -217,6 +279,38 @@ func WithEnableSchedulerPlugin(plugins []string) Option {
 	}
 }
 
+// WithLatencyProbeInterval sets the interval between two cluster latency
+// probe rounds, 0 disables the probes.
+func WithLatencyProbeInterval(interval time.Duration) Option {
+	return func(o *schedulerOptions) {
+		o.latencyProbeInterval = interval
+	}
+}
+
+// WithLatencyConfigMap sets the ConfigMap(namespace/name) latency
+// measurements are ingested from, empty disables the ingestion.
+func WithLatencyConfigMap(configMap string) Option {
+	return func(o *schedulerOptions) {
+		o.latencyConfigMap = configMap
+	}
+}
+
+// WithCarbonIntensityConfigMap sets the ConfigMap(namespace/name) carbon
+// intensity signals are ingested from, empty disables the ingestion.
+func WithCarbonIntensityConfigMap(configMap string) Option {
+	return func(o *schedulerOptions) {
+		o.carbonIntensityConfigMap = configMap
+	}
+}
+
+// WithSchedulerProfiles sets the scheduling profiles loaded from the
+// scheduler configuration file.
+func WithSchedulerProfiles(profiles []configv1alpha1.SchedulerProfile) Option {
+	return func(o *schedulerOptions) {
+		o.profiles = profiles
+	}
+}
+
 // WithOutOfTreeRegistry sets the registry for out-of-tree plugins. Those plugins
 // will be appended to the default in-tree registry.
 func WithOutOfTreeRegistry(registry runtime.Registry) Option {
@@ -252,9 +346,51 @@ func NewScheduler(dynamicClient dynamic.Interface, karmadaClient karmadaclientse
 		legacyQueue = workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiterflag.DefaultControllerRateLimiter[any](options.RateLimiterOptions), workqueue.TypedRateLimitingQueueConfig[any]{Name: "scheduler-queue"})
 	}
 	registry := frameworkplugins.NewInTreeRegistry()
+	// The ServiceAffinity plugin needs access to the collected EndpointSlice
+	// works, so it is registered here with a lister-backed resolver instead of
+	// taking part in the dependency-free in-tree registry.
+	endpointSliceWorkLister := factory.Work().V1alpha1().Works().Lister()
+	if err := registry.Register(serviceaffinity.Name, func() (framework.Plugin, error) {
+		return serviceaffinity.New(serviceaffinity.EndpointClustersByWorkLister(endpointSliceWorkLister))
+	}); err != nil {
+		return nil, err
+	}
+	// The LatencyAware plugin consumes the measurements collected by the
+	// latency probe subsystem, so it is registered here with the shared store
+	// instead of taking part in the dependency-free in-tree registry.
+	latencyStore := latency.NewStore()
+	if err := registry.Register(latencyaware.Name, func() (framework.Plugin, error) {
+		return latencyaware.New(latencyStore)
+	}); err != nil {
+		return nil, err
+	}
+	// The CarbonAware plugin consumes the signals collected in the shared
+	// carbon intensity store, so it is registered here as well.
+	carbonStore := carbon.NewStore()
+	if err := registry.Register(carbonaware.Name, func() (framework.Plugin, error) {
+		return carbonaware.New(carbonStore)
+	}); err != nil {
+		return nil, err
+	}
+	// The WorkloadAffinity plugin resolves the bindings of the referenced
+	// workloads, so it is registered here with the binding lister.
+	if err := registry.Register(workloadaffinity.Name, func() (framework.Plugin, error) {
+		return workloadaffinity.New(bindingLister)
+	}); err != nil {
+		return nil, err
+	}
 	if err := registry.Merge(options.outOfTreeRegistry); err != nil {
 		return nil, err
 	}
+	algorithms := make(map[string]core.ScheduleAlgorithm, len(options.profiles))
+	for i := range options.profiles {
+		profile := &options.profiles[i]
+		profileAlgorithm, err := newProfileAlgorithm(schedulerCache, registry, profile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build scheduling profile %q: %w", profile.SchedulerName, err)
+		}
+		algorithms[profile.SchedulerName] = profileAlgorithm
+	}
 	registry = registry.Filter(options.plugins)
 	algorithm, err := core.NewGenericScheduler(schedulerCache, registry)
 	if err != nil {
@@ -272,6 +408,7 @@ func NewScheduler(dynamicClient dynamic.Interface, karmadaClient karmadaclientse
 		queue:                legacyQueue,
 		priorityQueue:        priorityQueue,
 		Algorithm:            algorithm,
+		algorithms:           algorithms,
 		schedulerCache:       schedulerCache,
 	}
 
@@ -297,12 +434,70 @@ func NewScheduler(dynamicClient dynamic.Interface, karmadaClient karmadaclientse
 		estimatorclient.RegisterSchedulerEstimator(schedulerEstimator)
 	}
 	sched.enableEmptyWorkloadPropagation = options.enableEmptyWorkloadPropagation
+	sched.shadowMode = options.shadowMode
 	sched.schedulerName = options.schedulerName
 
+	sched.latencyStore = latencyStore
+	if options.latencyProbeInterval > 0 {
+		sched.latencyProber = latency.NewAPIEndpointProber(clusterLister, options.latencyProbeInterval, latencyStore)
+	}
+	if options.latencyConfigMap != "" {
+		namespace, name, err := cache.SplitMetaNamespaceKey(options.latencyConfigMap)
+		if err != nil || namespace == "" || name == "" {
+			return nil, fmt.Errorf("invalid latency ConfigMap reference %q, expected format namespace/name", options.latencyConfigMap)
+		}
+		syncInterval := options.latencyProbeInterval
+		if syncInterval <= 0 {
+			syncInterval = defaultLatencyConfigMapSyncInterval
+		}
+		sched.latencyConfigMapSource = latency.NewConfigMapSource(kubeClient, namespace, name, syncInterval, latencyStore)
+	}
+
+	sched.carbonStore = carbonStore
+	if options.carbonIntensityConfigMap != "" {
+		namespace, name, err := cache.SplitMetaNamespaceKey(options.carbonIntensityConfigMap)
+		if err != nil || namespace == "" || name == "" {
+			return nil, fmt.Errorf("invalid carbon intensity ConfigMap reference %q, expected format namespace/name", options.carbonIntensityConfigMap)
+		}
+		sched.carbonConfigMapSource = carbon.NewConfigMapSource(kubeClient, namespace, name, carbonIntensityConfigMapSyncInterval, carbonStore)
+	}
+
 	sched.addAllEventHandlers()
 	return sched, nil
 }
 
+// newProfileAlgorithm builds the schedule algorithm of a scheduling profile:
+// the profile's plugin enablement list filters the registry and its plugin
+// configurations supply the score weights and plugin arguments.
+func newProfileAlgorithm(schedulerCache schedulercache.Cache, registry runtime.Registry, profile *configv1alpha1.SchedulerProfile) (core.ScheduleAlgorithm, error) {
+	weights := make(map[string]int)
+	args := make(map[string]*apiruntime.RawExtension)
+	for i := range profile.PluginConfig {
+		pluginConfig := &profile.PluginConfig[i]
+		if pluginConfig.Weight != nil {
+			weights[pluginConfig.Name] = int(*pluginConfig.Weight)
+		}
+		if pluginConfig.Args != nil {
+			args[pluginConfig.Name] = pluginConfig.Args
+		}
+	}
+	return core.NewGenericScheduler(schedulerCache, registry.Filter(profile.Plugins),
+		runtime.WithScorePluginsWeightMap(weights), runtime.WithPluginArgs(args))
+}
+
+// algorithmFor returns the schedule algorithm of the profile the binding
+// selects by its schedulerName, falling back to the flag-configured default
+// algorithm when no profile matches.
+func (s *Scheduler) algorithmFor(schedulerName string) core.ScheduleAlgorithm {
+	if schedulerName == "" {
+		schedulerName = DefaultScheduler
+	}
+	if algorithm, ok := s.algorithms[schedulerName]; ok {
+		return algorithm
+	}
+	return s.Algorithm
+}
+
 // Run runs the scheduler
 func (s *Scheduler) Run(ctx context.Context) {
 	klog.Infof("Starting karmada scheduler")
@@ -319,6 +514,16 @@ func (s *Scheduler) Run(ctx context.Context) {
 
 	s.clusterReconcileWorker.Run(ctx, 1)
 
+	if s.latencyProber != nil {
+		go s.latencyProber.Run(ctx)
+	}
+	if s.latencyConfigMapSource != nil {
+		go s.latencyConfigMapSource.Run(ctx)
+	}
+	if s.carbonConfigMapSource != nil {
+		go s.carbonConfigMapSource.Run(ctx)
+	}
+
 	go wait.Until(s.worker, time.Second, ctx.Done())
 
 	if features.FeatureGate.Enabled(features.PriorityBasedScheduling) {
@@ -386,6 +591,13 @@ func (s *Scheduler) doScheduleBinding(namespace, name string) (err error) {
 		klog.V(4).InfoS("Skip schedule deleting ResourceBinding", "ResourceBinding", klog.KObj(rb))
 		return nil
 	}
+	if rb.Spec.SchedulingGated() {
+		// Gated bindings are filtered out before enqueueing, this guards against
+		// a gate added after the binding was queued. Removing the last gate bumps
+		// the generation and requeues the binding through the event handler.
+		klog.V(4).InfoS("Skip schedule gated ResourceBinding", "ResourceBinding", klog.KObj(rb))
+		return nil
+	}
 
 	rb = rb.DeepCopy()
 
@@ -396,6 +608,11 @@ func (s *Scheduler) doScheduleBinding(namespace, name string) (err error) {
 		return err
 	}
 
+	// Merge the binding-scope cluster denylist into the placement before any
+	// comparison, so that adding or removing the annotation is observed as a
+	// placement change and triggers rescheduling.
+	applyExcludedClusters(rb.Spec.Placement, excludedClustersFromAnnotation(rb.Annotations))
+
 	start := time.Now()
 	appliedPlacementStr := util.GetLabelValue(rb.Annotations, util.PolicyPlacementAnnotation)
 	if placementChanged(*rb.Spec.Placement, appliedPlacementStr, rb.Status.SchedulerObservedAffinityName) {
@@ -434,7 +651,7 @@ func (s *Scheduler) doScheduleBinding(namespace, name string) (err error) {
 	// If no scheduling is required, we need to ensure that binding.Generation is equal to
 	// binding.Status.SchedulerObservedGeneration which means the current status of binding
 	// is the latest status of successful scheduling.
-	if rb.Generation != rb.Status.SchedulerObservedGeneration {
+	if rb.Generation != rb.Status.SchedulerObservedGeneration && !s.shadowMode {
 		updateRB := rb.DeepCopy()
 		updateRB.Status.SchedulerObservedGeneration = updateRB.Generation
 		return patchBindingStatus(s.KarmadaClient, rb, updateRB)
@@ -456,6 +673,11 @@ func (s *Scheduler) doScheduleClusterBinding(name string) (err error) {
 		klog.V(4).InfoS("Skip schedule deleting ClusterResourceBinding", "ClusterResourceBinding", klog.KObj(crb))
 		return nil
 	}
+	if crb.Spec.SchedulingGated() {
+		// See the comment on the ResourceBinding counterpart above.
+		klog.V(4).InfoS("Skip schedule gated ClusterResourceBinding", "ClusterResourceBinding", klog.KObj(crb))
+		return nil
+	}
 
 	crb = crb.DeepCopy()
 
@@ -466,6 +688,11 @@ func (s *Scheduler) doScheduleClusterBinding(name string) (err error) {
 		return err
 	}
 
+	// Merge the binding-scope cluster denylist into the placement before any
+	// comparison, so that adding or removing the annotation is observed as a
+	// placement change and triggers rescheduling.
+	applyExcludedClusters(crb.Spec.Placement, excludedClustersFromAnnotation(crb.Annotations))
+
 	start := time.Now()
 	appliedPlacementStr := util.GetLabelValue(crb.Annotations, util.PolicyPlacementAnnotation)
 	if placementChanged(*crb.Spec.Placement, appliedPlacementStr, crb.Status.SchedulerObservedAffinityName) {
@@ -504,7 +731,7 @@ func (s *Scheduler) doScheduleClusterBinding(name string) (err error) {
 	// If no scheduling is required, we need to ensure that binding.Generation is equal to
 	// binding.Status.SchedulerObservedGeneration which means the current status of binding
 	// is the latest status of successful scheduling.
-	if crb.Generation != crb.Status.SchedulerObservedGeneration {
+	if crb.Generation != crb.Status.SchedulerObservedGeneration && !s.shadowMode {
 		updateCRB := crb.DeepCopy()
 		updateCRB.Status.SchedulerObservedGeneration = updateCRB.Generation
 		return patchClusterResourceBindingStatus(s.KarmadaClient, crb, updateCRB)
@@ -515,6 +742,13 @@ func (s *Scheduler) doScheduleClusterBinding(name string) (err error) {
 func (s *Scheduler) scheduleResourceBinding(rb *workv1alpha2.ResourceBinding) (err error) {
 	defer func() {
 		condition, ignoreErr := getConditionByError(err)
+		if s.shadowMode {
+			// in shadow mode the condition is computed but never written back.
+			if ignoreErr && err != nil {
+				err = nil
+			}
+			return
+		}
 		if updateErr := patchBindingStatusCondition(s.KarmadaClient, rb, condition); updateErr != nil {
 			// if patch error occurs, just return patch error to reconcile again.
 			err = updateErr
@@ -526,9 +760,14 @@ func (s *Scheduler) scheduleResourceBinding(rb *workv1alpha2.ResourceBinding) (e
 	}()
 
 	if rb.Spec.Placement.ClusterAffinities != nil {
-		return s.scheduleResourceBindingWithClusterAffinities(rb)
+		err = s.scheduleResourceBindingWithClusterAffinities(rb)
+	} else {
+		err = s.scheduleResourceBindingWithClusterAffinity(rb)
+	}
+	if err != nil {
+		s.attemptPreemptionForResourceBinding(rb, err)
 	}
-	return s.scheduleResourceBindingWithClusterAffinity(rb)
+	return err
 }
 
 func (s *Scheduler) scheduleResourceBindingWithClusterAffinity(rb *workv1alpha2.ResourceBinding) error {
@@ -541,7 +780,7 @@ func (s *Scheduler) scheduleResourceBindingWithClusterAffinity(rb *workv1alpha2.
 		return err
 	}
 
-	scheduleResult, err := s.Algorithm.Schedule(context.TODO(), &rb.Spec, &rb.Status, &core.ScheduleAlgorithmOption{EnableEmptyWorkloadPropagation: s.enableEmptyWorkloadPropagation})
+	scheduleResult, err := s.algorithmFor(rb.Spec.SchedulerName).Schedule(context.TODO(), &rb.Spec, &rb.Status, &core.ScheduleAlgorithmOption{EnableEmptyWorkloadPropagation: s.enableEmptyWorkloadPropagation})
 	var fitErr *framework.FitError
 	// in case of no cluster error, can not return but continue to patch(cleanup) the result.
 	if err != nil && !errors.As(err, &fitErr) {
@@ -579,7 +818,7 @@ func (s *Scheduler) scheduleResourceBindingWithClusterAffinities(rb *workv1alpha
 	for affinityIndex < len(rb.Spec.Placement.ClusterAffinities) {
 		klog.V(4).Infof("Schedule ResourceBinding(%s/%s) with clusterAffiliates index(%d)", rb.Namespace, rb.Name, affinityIndex)
 		updatedStatus.SchedulerObservedAffinityName = rb.Spec.Placement.ClusterAffinities[affinityIndex].AffinityName
-		scheduleResult, err = s.Algorithm.Schedule(context.TODO(), &rb.Spec, updatedStatus, &core.ScheduleAlgorithmOption{EnableEmptyWorkloadPropagation: s.enableEmptyWorkloadPropagation})
+		scheduleResult, err = s.algorithmFor(rb.Spec.SchedulerName).Schedule(context.TODO(), &rb.Spec, updatedStatus, &core.ScheduleAlgorithmOption{EnableEmptyWorkloadPropagation: s.enableEmptyWorkloadPropagation})
 		if err == nil {
 			break
 		}
@@ -618,13 +857,36 @@ func (s *Scheduler) scheduleResourceBindingWithClusterAffinities(rb *workv1alpha
 
 	klog.V(4).Infof("ResourceBinding(%s/%s) scheduled to clusters %v", rb.Namespace, rb.Name, scheduleResult.SuggestedClusters)
 	patchErr := s.patchScheduleResultForResourceBinding(rb, string(placementBytes), scheduleResult.SuggestedClusters)
-	patchStatusErr := patchBindingStatusWithAffinityName(s.KarmadaClient, rb, updatedStatus.SchedulerObservedAffinityName)
+	var patchStatusErr error
+	if !s.shadowMode {
+		patchStatusErr = patchBindingStatusWithAffinityName(s.KarmadaClient, rb, updatedStatus.SchedulerObservedAffinityName)
+	}
 	scheduleErr := utilerrors.NewAggregate([]error{patchErr, patchStatusErr})
 	s.recordScheduleResultEventForResourceBinding(rb, nil, scheduleErr)
 	return scheduleErr
 }
 
 func (s *Scheduler) patchScheduleResultForResourceBinding(oldBinding *workv1alpha2.ResourceBinding, placement string, scheduleResult []workv1alpha2.TargetCluster) error {
+	singleton := oldBinding.Spec.Placement != nil && oldBinding.Spec.Placement.Singleton
+	if singleton {
+		var err error
+		scheduleResult, err = s.enforceSingletonPlacement(oldBinding.Spec.Resource, oldBinding.Spec.Clusters, scheduleResult)
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.shadowMode {
+		s.recordShadowDecision("ResourceBinding", oldBinding.Namespace+"/"+oldBinding.Name, oldBinding.Spec.Clusters, scheduleResult)
+		return nil
+	}
+
+	if singleton {
+		if err := s.patchSingletonHolderForResourceBinding(oldBinding, scheduleResult); err != nil {
+			return err
+		}
+	}
+
 	newBinding := oldBinding.DeepCopy()
 	if newBinding.Annotations == nil {
 		newBinding.Annotations = make(map[string]string)
@@ -647,12 +909,23 @@ func (s *Scheduler) patchScheduleResultForResourceBinding(oldBinding *workv1alph
 	}
 
 	klog.V(4).Infof("Patch schedule to ResourceBinding(%s/%s) succeed", oldBinding.Namespace, oldBinding.Name)
+	if err = recordScheduleHistoryForResourceBinding(s.KarmadaClient, oldBinding, scheduleResult); err != nil {
+		// the history is auxiliary, losing a record must not fail the scheduling
+		klog.Warningf("Failed to record scheduling history for ResourceBinding(%s/%s): %v", oldBinding.Namespace, oldBinding.Name, err)
+	}
 	return nil
 }
 
 func (s *Scheduler) scheduleClusterResourceBinding(crb *workv1alpha2.ClusterResourceBinding) (err error) {
 	defer func() {
 		condition, ignoreErr := getConditionByError(err)
+		if s.shadowMode {
+			// in shadow mode the condition is computed but never written back.
+			if ignoreErr && err != nil {
+				err = nil
+			}
+			return
+		}
 		if updateErr := patchClusterBindingStatusCondition(s.KarmadaClient, crb, condition); updateErr != nil {
 			// if patch error occurs, just return patch error to reconcile again.
 			err = updateErr
@@ -664,9 +937,14 @@ func (s *Scheduler) scheduleClusterResourceBinding(crb *workv1alpha2.ClusterReso
 	}()
 
 	if crb.Spec.Placement.ClusterAffinities != nil {
-		return s.scheduleClusterResourceBindingWithClusterAffinities(crb)
+		err = s.scheduleClusterResourceBindingWithClusterAffinities(crb)
+	} else {
+		err = s.scheduleClusterResourceBindingWithClusterAffinity(crb)
+	}
+	if err != nil {
+		s.attemptPreemptionForClusterResourceBinding(crb, err)
 	}
-	return s.scheduleClusterResourceBindingWithClusterAffinity(crb)
+	return err
 }
 
 func (s *Scheduler) scheduleClusterResourceBindingWithClusterAffinity(crb *workv1alpha2.ClusterResourceBinding) error {
@@ -679,7 +957,7 @@ func (s *Scheduler) scheduleClusterResourceBindingWithClusterAffinity(crb *workv
 		return err
 	}
 
-	scheduleResult, err := s.Algorithm.Schedule(context.TODO(), &crb.Spec, &crb.Status, &core.ScheduleAlgorithmOption{EnableEmptyWorkloadPropagation: s.enableEmptyWorkloadPropagation})
+	scheduleResult, err := s.algorithmFor(crb.Spec.SchedulerName).Schedule(context.TODO(), &crb.Spec, &crb.Status, &core.ScheduleAlgorithmOption{EnableEmptyWorkloadPropagation: s.enableEmptyWorkloadPropagation})
 	var fitErr *framework.FitError
 	// in case of no cluster error, can not return but continue to patch(cleanup) the result.
 	if err != nil && !errors.As(err, &fitErr) {
@@ -717,7 +995,7 @@ func (s *Scheduler) scheduleClusterResourceBindingWithClusterAffinities(crb *wor
 	for affinityIndex < len(crb.Spec.Placement.ClusterAffinities) {
 		klog.V(4).Infof("Schedule ClusterResourceBinding(%s) with clusterAffiliates index(%d)", crb.Name, affinityIndex)
 		updatedStatus.SchedulerObservedAffinityName = crb.Spec.Placement.ClusterAffinities[affinityIndex].AffinityName
-		scheduleResult, err = s.Algorithm.Schedule(context.TODO(), &crb.Spec, updatedStatus, &core.ScheduleAlgorithmOption{EnableEmptyWorkloadPropagation: s.enableEmptyWorkloadPropagation})
+		scheduleResult, err = s.algorithmFor(crb.Spec.SchedulerName).Schedule(context.TODO(), &crb.Spec, updatedStatus, &core.ScheduleAlgorithmOption{EnableEmptyWorkloadPropagation: s.enableEmptyWorkloadPropagation})
 		if err == nil {
 			break
 		}
@@ -756,13 +1034,36 @@ func (s *Scheduler) scheduleClusterResourceBindingWithClusterAffinities(crb *wor
 
 	klog.V(4).Infof("ClusterResourceBinding(%s) scheduled to clusters %v", crb.Name, scheduleResult.SuggestedClusters)
 	patchErr := s.patchScheduleResultForClusterResourceBinding(crb, string(placementBytes), scheduleResult.SuggestedClusters)
-	patchStatusErr := patchClusterBindingStatusWithAffinityName(s.KarmadaClient, crb, updatedStatus.SchedulerObservedAffinityName)
+	var patchStatusErr error
+	if !s.shadowMode {
+		patchStatusErr = patchClusterBindingStatusWithAffinityName(s.KarmadaClient, crb, updatedStatus.SchedulerObservedAffinityName)
+	}
 	scheduleErr := utilerrors.NewAggregate([]error{patchErr, patchStatusErr})
 	s.recordScheduleResultEventForClusterResourceBinding(crb, nil, scheduleErr)
 	return scheduleErr
 }
 
 func (s *Scheduler) patchScheduleResultForClusterResourceBinding(oldBinding *workv1alpha2.ClusterResourceBinding, placement string, scheduleResult []workv1alpha2.TargetCluster) error {
+	singleton := oldBinding.Spec.Placement != nil && oldBinding.Spec.Placement.Singleton
+	if singleton {
+		var err error
+		scheduleResult, err = s.enforceSingletonPlacement(oldBinding.Spec.Resource, oldBinding.Spec.Clusters, scheduleResult)
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.shadowMode {
+		s.recordShadowDecision("ClusterResourceBinding", oldBinding.Name, oldBinding.Spec.Clusters, scheduleResult)
+		return nil
+	}
+
+	if singleton {
+		if err := s.patchSingletonHolderForClusterResourceBinding(oldBinding, scheduleResult); err != nil {
+			return err
+		}
+	}
+
 	newBinding := oldBinding.DeepCopy()
 	if newBinding.Annotations == nil {
 		newBinding.Annotations = make(map[string]string)
@@ -785,6 +1086,10 @@ func (s *Scheduler) patchScheduleResultForClusterResourceBinding(oldBinding *wor
 	}
 
 	klog.V(4).Infof("Patch schedule to ClusterResourceBinding(%s) succeed", oldBinding.Name)
+	if err = recordScheduleHistoryForClusterResourceBinding(s.KarmadaClient, oldBinding, scheduleResult); err != nil {
+		// the history is auxiliary, losing a record must not fail the scheduling
+		klog.Warningf("Failed to record scheduling history for ClusterResourceBinding(%s): %v", oldBinding.Name, err)
+	}
 	return nil
 }
 
@@ -859,6 +1164,49 @@ func (s *Scheduler) establishEstimatorConnections() {
 	}
 }
 
+// maxScheduleRecords bounds the scheduling history kept on the binding status.
+const maxScheduleRecords = 10
+
+// recordScheduleHistoryForResourceBinding appends the new replica assignment to
+// the bounded scheduling history in the binding status. Unchanged assignments
+// are not recorded.
+func recordScheduleHistoryForResourceBinding(karmadaClient karmadaclientset.Interface, rb *workv1alpha2.ResourceBinding, scheduleResult []workv1alpha2.TargetCluster) error {
+	if reflect.DeepEqual(rb.Spec.Clusters, scheduleResult) {
+		return nil
+	}
+	updateRB := rb.DeepCopy()
+	updateRB.Status.SchedulingHistory = appendScheduleRecord(updateRB.Status.SchedulingHistory, scheduleResult)
+	return patchBindingStatus(karmadaClient, rb, updateRB)
+}
+
+// recordScheduleHistoryForClusterResourceBinding appends the new replica
+// assignment to the bounded scheduling history in the binding status.
+// Unchanged assignments are not recorded.
+func recordScheduleHistoryForClusterResourceBinding(karmadaClient karmadaclientset.Interface, crb *workv1alpha2.ClusterResourceBinding, scheduleResult []workv1alpha2.TargetCluster) error {
+	if reflect.DeepEqual(crb.Spec.Clusters, scheduleResult) {
+		return nil
+	}
+	updateCRB := crb.DeepCopy()
+	updateCRB.Status.SchedulingHistory = appendScheduleRecord(updateCRB.Status.SchedulingHistory, scheduleResult)
+	return patchClusterResourceBindingStatus(karmadaClient, crb, updateCRB)
+}
+
+func appendScheduleRecord(history []workv1alpha2.ScheduleRecord, scheduleResult []workv1alpha2.TargetCluster) []workv1alpha2.ScheduleRecord {
+	reason := "Scheduled"
+	if len(scheduleResult) == 0 {
+		reason = "Descheduled"
+	}
+	history = append(history, workv1alpha2.ScheduleRecord{
+		Clusters:  scheduleResult,
+		Reason:    reason,
+		Timestamp: metav1.Now(),
+	})
+	if len(history) > maxScheduleRecords {
+		history = history[len(history)-maxScheduleRecords:]
+	}
+	return history
+}
+
 // patchBindingStatusCondition patches schedule status condition of ResourceBinding when necessary.
 func patchBindingStatusCondition(karmadaClient karmadaclientset.Interface, rb *workv1alpha2.ResourceBinding, newScheduledCondition metav1.Condition) error {
 	klog.V(4).Infof("Begin to patch status condition to ResourceBinding(%s/%s)", rb.Namespace, rb.Name)
@@ -961,9 +1309,23 @@ func patchClusterResourceBindingStatus(karmadaClient karmadaclientset.Interface,
 	return nil
 }
 
+// recordShadowDecision records the schedule decision computed in shadow mode
+// and any divergence from the live schedule result, without acting on the
+// binding.
+func (s *Scheduler) recordShadowDecision(kind, name string, liveResult, shadowResult []workv1alpha2.TargetCluster) {
+	if targetClustersEqual(liveResult, shadowResult) {
+		metrics.ShadowScheduleDecision(false)
+		klog.V(4).Infof("ShadowMode: schedule decision for %s(%s) matches the live result: %s", kind, name, targetClustersToString(shadowResult))
+		return
+	}
+	metrics.ShadowScheduleDecision(true)
+	klog.Infof("ShadowMode: schedule decision for %s(%s) diverges from the live result, live: {%s}, shadow: {%s}",
+		kind, name, targetClustersToString(liveResult), targetClustersToString(shadowResult))
+}
+
 func (s *Scheduler) recordScheduleResultEventForResourceBinding(rb *workv1alpha2.ResourceBinding,
 	scheduleResult []workv1alpha2.TargetCluster, schedulerErr error) {
-	if rb == nil {
+	if rb == nil || s.shadowMode {
 		return
 	}
 
@@ -987,7 +1349,7 @@ func (s *Scheduler) recordScheduleResultEventForResourceBinding(rb *workv1alpha2
 
 func (s *Scheduler) recordScheduleResultEventForClusterResourceBinding(crb *workv1alpha2.ClusterResourceBinding,
 	scheduleResult []workv1alpha2.TargetCluster, schedulerErr error) {
-	if crb == nil {
+	if crb == nil || s.shadowMode {
 		return
 	}
 
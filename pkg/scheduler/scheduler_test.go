@@ -501,9 +501,11 @@ func TestScheduleResourceBindingWithClusterAffinity(t *testing.T) {
 			if tt.expectError {
 				assert.Empty(t, patchActions, "Expected no patch actions for error case")
 			} else {
-				assert.Len(t, patchActions, 1, "Expected one patch action")
-				if len(patchActions) > 0 {
-					actualPatch := string(patchActions[0].GetPatch())
+				historyPatches, otherPatches := splitScheduleHistoryPatches(patchActions)
+				assert.Len(t, historyPatches, 1, "Expected the new assignment to be recorded in the scheduling history")
+				assert.Len(t, otherPatches, 1, "Expected one patch action")
+				if len(otherPatches) > 0 {
+					actualPatch := string(otherPatches[0].GetPatch())
 					assert.JSONEq(t, tt.expectedPatch, actualPatch, "Patch does not match expected")
 				}
 			}
@@ -677,9 +679,11 @@ func TestScheduleResourceBindingWithClusterAffinities(t *testing.T) {
 			if tt.expectError {
 				assert.Empty(t, patchActions, "Expected no patch actions for error case")
 			} else {
-				assert.Len(t, patchActions, len(tt.expectedPatches), "Expected %d patch actions", len(tt.expectedPatches))
+				historyPatches, otherPatches := splitScheduleHistoryPatches(patchActions)
+				assert.Len(t, historyPatches, 1, "Expected the new assignment to be recorded in the scheduling history")
+				assert.Len(t, otherPatches, len(tt.expectedPatches), "Expected %d patch actions", len(tt.expectedPatches))
 				for i, expectedPatch := range tt.expectedPatches {
-					actualPatch := string(patchActions[i].GetPatch())
+					actualPatch := string(otherPatches[i].GetPatch())
 					assert.JSONEq(t, expectedPatch, actualPatch, "Patch %d does not match expected", i+1)
 				}
 			}
@@ -699,6 +703,19 @@ func TestScheduleResourceBindingWithClusterAffinities(t *testing.T) {
 	}
 }
 
+func TestAppendScheduleRecord(t *testing.T) {
+	var history []workv1alpha2.ScheduleRecord
+	for i := 0; i < maxScheduleRecords+5; i++ {
+		history = appendScheduleRecord(history, []workv1alpha2.TargetCluster{{Name: fmt.Sprintf("cluster%d", i), Replicas: 1}})
+	}
+	assert.Len(t, history, maxScheduleRecords, "Expected the history to stay bounded")
+	assert.Equal(t, "cluster14", history[maxScheduleRecords-1].Clusters[0].Name, "Expected the most recent record last")
+	assert.Equal(t, "Scheduled", history[0].Reason)
+
+	history = appendScheduleRecord(history, nil)
+	assert.Equal(t, "Descheduled", history[len(history)-1].Reason)
+}
+
 func TestPatchScheduleResultForResourceBinding(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -2038,6 +2055,19 @@ func filterPatchActions(actions []clienttesting.Action) []clienttesting.PatchAct
 	return patchActions
 }
 
+// Helper function to separate the scheduling history status patches, which
+// carry a non-deterministic timestamp, from the remaining patch actions.
+func splitScheduleHistoryPatches(patchActions []clienttesting.PatchAction) (history, others []clienttesting.PatchAction) {
+	for _, action := range patchActions {
+		if strings.Contains(string(action.GetPatch()), `"schedulingHistory"`) {
+			history = append(history, action)
+			continue
+		}
+		others = append(others, action)
+	}
+	return history, others
+}
+
 // Mock Implementations
 
 type mockAlgorithm struct {
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// Reasons of the SingletonHolder condition.
+const (
+	// SingletonReasonHolderElected means the singleton has a holder cluster.
+	SingletonReasonHolderElected = "HolderElected"
+	// SingletonReasonNoHolder means the singleton is not placed in any cluster.
+	SingletonReasonNoHolder = "NoHolder"
+)
+
+// enforceSingletonPlacement narrows the schedule result of a singleton
+// placement down to exactly one cluster. The current holder is kept as long
+// as it stays feasible, moving to another cluster requires the replica in the
+// previous holder to be confirmed absent first.
+func (s *Scheduler) enforceSingletonPlacement(resource workv1alpha2.ObjectReference, current, suggested []workv1alpha2.TargetCluster) ([]workv1alpha2.TargetCluster, error) {
+	holder := singletonHolder(current)
+	targets, needsConfirmation := singletonTargets(holder, suggested)
+	if !needsConfirmation {
+		return targets, nil
+	}
+
+	confirmed, err := s.singletonAbsenceConfirmed(resource, holder)
+	if err != nil {
+		return nil, err
+	}
+	if !confirmed {
+		klog.V(2).Infof("Singleton %s(%s/%s) stays in cluster %s until the previous replica is confirmed absent",
+			resource.Kind, resource.Namespace, resource.Name, holder)
+		return singletonTargetsOf(current, holder), nil
+	}
+	return targets, nil
+}
+
+// singletonHolder returns the cluster currently holding the singleton, empty
+// if it is not placed anywhere.
+func singletonHolder(clusters []workv1alpha2.TargetCluster) string {
+	if len(clusters) == 0 {
+		return ""
+	}
+	return clusters[0].Name
+}
+
+// singletonTargets picks the single target cluster from the suggested
+// clusters, preferring the current holder. It reports whether moving away
+// from the holder requires the absence of the previous replica to be
+// confirmed first.
+func singletonTargets(holder string, suggested []workv1alpha2.TargetCluster) ([]workv1alpha2.TargetCluster, bool) {
+	if holder != "" {
+		if targets := singletonTargetsOf(suggested, holder); targets != nil {
+			return targets, false
+		}
+	}
+	if len(suggested) == 0 {
+		return nil, false
+	}
+	return suggested[:1:1], holder != ""
+}
+
+// singletonTargetsOf returns the entry of the named cluster as a single
+// element target list, nil if the cluster is not among the targets.
+func singletonTargetsOf(clusters []workv1alpha2.TargetCluster, name string) []workv1alpha2.TargetCluster {
+	for _, cluster := range clusters {
+		if cluster.Name == name {
+			return []workv1alpha2.TargetCluster{cluster}
+		}
+	}
+	return nil
+}
+
+// singletonAbsenceConfirmed reports whether the replica in the previous
+// holder cluster is confirmed absent, which is the case when the cluster has
+// been removed from the control plane or the Work delivering the resource to
+// it is gone.
+func (s *Scheduler) singletonAbsenceConfirmed(resource workv1alpha2.ObjectReference, holder string) (bool, error) {
+	_, err := s.KarmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), holder, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	workName := names.GenerateWorkName(resource.Kind, resource.Name, resource.Namespace)
+	_, err = s.KarmadaClient.WorkV1alpha1().Works(names.GenerateExecutionSpaceName(holder)).Get(context.TODO(), workName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+// singletonHolderCondition builds the condition exposing the current holder
+// of the singleton.
+func singletonHolderCondition(holder string) metav1.Condition {
+	if holder == "" {
+		return util.NewCondition(workv1alpha2.SingletonHolder, SingletonReasonNoHolder,
+			"singleton is not placed in any cluster", metav1.ConditionFalse)
+	}
+	return util.NewCondition(workv1alpha2.SingletonHolder, SingletonReasonHolderElected,
+		fmt.Sprintf("singleton is held by cluster %q", holder), metav1.ConditionTrue)
+}
+
+// patchSingletonHolderForResourceBinding exposes the current holder through
+// the status conditions of the ResourceBinding.
+func (s *Scheduler) patchSingletonHolderForResourceBinding(rb *workv1alpha2.ResourceBinding, scheduleResult []workv1alpha2.TargetCluster) error {
+	updateRB := rb.DeepCopy()
+	meta.SetStatusCondition(&updateRB.Status.Conditions, singletonHolderCondition(singletonHolder(scheduleResult)))
+	if reflect.DeepEqual(rb.Status, updateRB.Status) {
+		return nil
+	}
+	return patchBindingStatus(s.KarmadaClient, rb, updateRB)
+}
+
+// patchSingletonHolderForClusterResourceBinding exposes the current holder
+// through the status conditions of the ClusterResourceBinding.
+func (s *Scheduler) patchSingletonHolderForClusterResourceBinding(crb *workv1alpha2.ClusterResourceBinding, scheduleResult []workv1alpha2.TargetCluster) error {
+	updateCRB := crb.DeepCopy()
+	meta.SetStatusCondition(&updateCRB.Status.Conditions, singletonHolderCondition(singletonHolder(scheduleResult)))
+	if reflect.DeepEqual(crb.Status, updateCRB.Status) {
+		return nil
+	}
+	return patchClusterResourceBindingStatus(s.KarmadaClient, crb, updateCRB)
+}
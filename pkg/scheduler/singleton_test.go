@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+func TestSingletonTargets(t *testing.T) {
+	tests := []struct {
+		name                  string
+		holder                string
+		suggested             []workv1alpha2.TargetCluster
+		wantTargets           []workv1alpha2.TargetCluster
+		wantNeedsConfirmation bool
+	}{
+		{
+			name:   "holder still feasible",
+			holder: "member2",
+			suggested: []workv1alpha2.TargetCluster{
+				{Name: "member1", Replicas: 1},
+				{Name: "member2", Replicas: 1},
+			},
+			wantTargets: []workv1alpha2.TargetCluster{{Name: "member2", Replicas: 1}},
+		},
+		{
+			name:   "first placement",
+			holder: "",
+			suggested: []workv1alpha2.TargetCluster{
+				{Name: "member1", Replicas: 1},
+				{Name: "member2", Replicas: 1},
+			},
+			wantTargets: []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 1}},
+		},
+		{
+			name:                  "holder infeasible",
+			holder:                "member3",
+			suggested:             []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 1}},
+			wantTargets:           []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 1}},
+			wantNeedsConfirmation: true,
+		},
+		{
+			name:        "no feasible cluster",
+			holder:      "member3",
+			suggested:   nil,
+			wantTargets: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets, needsConfirmation := singletonTargets(tt.holder, tt.suggested)
+			if !reflect.DeepEqual(targets, tt.wantTargets) {
+				t.Errorf("singletonTargets() targets = %v, want %v", targets, tt.wantTargets)
+			}
+			if needsConfirmation != tt.wantNeedsConfirmation {
+				t.Errorf("singletonTargets() needsConfirmation = %v, want %v", needsConfirmation, tt.wantNeedsConfirmation)
+			}
+		})
+	}
+}
+
+func TestSingletonHolder(t *testing.T) {
+	if got := singletonHolder(nil); got != "" {
+		t.Errorf("singletonHolder(nil) = %q, want empty", got)
+	}
+	clusters := []workv1alpha2.TargetCluster{{Name: "member1"}, {Name: "member2"}}
+	if got := singletonHolder(clusters); got != "member1" {
+		t.Errorf("singletonHolder() = %q, want %q", got, "member1")
+	}
+}
+
+func TestSingletonHolderCondition(t *testing.T) {
+	condition := singletonHolderCondition("member1")
+	if condition.Type != workv1alpha2.SingletonHolder || condition.Status != metav1.ConditionTrue {
+		t.Errorf("condition = %+v, want type %s with status True", condition, workv1alpha2.SingletonHolder)
+	}
+	if condition.Reason != SingletonReasonHolderElected {
+		t.Errorf("reason = %q, want %q", condition.Reason, SingletonReasonHolderElected)
+	}
+
+	condition = singletonHolderCondition("")
+	if condition.Status != metav1.ConditionFalse || condition.Reason != SingletonReasonNoHolder {
+		t.Errorf("condition = %+v, want status False with reason %s", condition, SingletonReasonNoHolder)
+	}
+}
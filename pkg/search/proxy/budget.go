@@ -0,0 +1,113 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+
+	searchv1alpha1 "github.com/karmada-io/karmada/pkg/apis/search/v1alpha1"
+	karmadametrics "github.com/karmada-io/karmada/pkg/metrics"
+	"github.com/karmada-io/karmada/pkg/search/proxy/store"
+)
+
+// resourceUsage is the cache footprint of one resource of a registry.
+type resourceUsage struct {
+	gvr         schema.GroupVersionResource
+	size        int64
+	lastQueried time.Time
+}
+
+// enforceMemoryBudget enforces the memory budget of the registry by removing
+// the least-recently-queried resources from matchedResources until the
+// remaining cache size fits the budget. Removed resources are dropped from
+// the cache on the subsequent UpdateCache call. Evicted resources are
+// re-admitted on a later reconcile, giving them another chance once the
+// budget is raised or other caches shrink.
+func (ctl *Controller) enforceMemoryBudget(registry *searchv1alpha1.ResourceRegistry, matchedResources map[schema.GroupVersionResource]*store.MultiNamespace) {
+	if registry.Spec.MemoryBudget == nil {
+		return
+	}
+	budget := registry.Spec.MemoryBudget.Value()
+
+	usages := make([]resourceUsage, 0, len(matchedResources))
+	var total int64
+	for gvr := range matchedResources {
+		size, lastQueried := ctl.store.ResourceUsage(gvr)
+		usages = append(usages, resourceUsage{gvr: gvr, size: size, lastQueried: lastQueried})
+		total += size
+	}
+	karmadametrics.RecordRegistryCacheSize(registry.Name, total)
+
+	var evicted []string
+	if total > budget {
+		sort.Slice(usages, func(i, j int) bool {
+			return usages[i].lastQueried.Before(usages[j].lastQueried)
+		})
+		remaining := total
+		for _, usage := range usages {
+			if remaining <= budget {
+				break
+			}
+			delete(matchedResources, usage.gvr)
+			remaining -= usage.size
+			evicted = append(evicted, usage.gvr.String())
+			karmadametrics.RecordRegistryCacheEviction(registry.Name, usage.gvr.String())
+			klog.Warningf("Evicting %s from cache of ResourceRegistry(%s): cache size %d bytes exceeds budget %s",
+				usage.gvr.String(), registry.Name, total, registry.Spec.MemoryBudget.String())
+		}
+	}
+
+	ctl.syncMemoryBudgetCondition(registry, total, evicted)
+}
+
+// syncMemoryBudgetCondition reports via status condition whether the registry
+// exceeded its memory budget during this reconcile.
+func (ctl *Controller) syncMemoryBudgetCondition(registry *searchv1alpha1.ResourceRegistry, total int64, evicted []string) {
+	if ctl.karmadaClient == nil {
+		return
+	}
+
+	condition := metav1.Condition{
+		Type:    searchv1alpha1.MemoryBudgetExceeded,
+		Status:  metav1.ConditionFalse,
+		Reason:  "WithinBudget",
+		Message: fmt.Sprintf("cache size %d bytes is within the budget %s", total, registry.Spec.MemoryBudget.String()),
+	}
+	if len(evicted) > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "BudgetExceeded"
+		condition.Message = fmt.Sprintf("cache size %d bytes exceeded the budget %s, evicted: %s",
+			total, registry.Spec.MemoryBudget.String(), strings.Join(evicted, ", "))
+	}
+
+	updated := registry.DeepCopy()
+	if !meta.SetStatusCondition(&updated.Status.Conditions, condition) {
+		return
+	}
+	if _, err := ctl.karmadaClient.SearchV1alpha1().ResourceRegistries().UpdateStatus(context.TODO(), updated, metav1.UpdateOptions{}); err != nil {
+		klog.Errorf("Failed to update %s condition of ResourceRegistry(%s): %v", condition.Type, registry.Name, err)
+	}
+}
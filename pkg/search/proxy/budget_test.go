@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	searchv1alpha1 "github.com/karmada-io/karmada/pkg/apis/search/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/search/proxy/store"
+	proxytesting "github.com/karmada-io/karmada/pkg/search/proxy/testing"
+)
+
+func TestEnforceMemoryBudget(t *testing.T) {
+	podGVR := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	nodeGVR := schema.GroupVersionResource{Version: "v1", Resource: "nodes"}
+	now := time.Now()
+	usages := map[schema.GroupVersionResource]resourceUsage{
+		podGVR:  {size: 80, lastQueried: now.Add(-time.Hour)},
+		nodeGVR: {size: 50, lastQueried: now},
+	}
+	mockStore := &proxytesting.MockStore{
+		ResourceUsageFunc: func(gvr schema.GroupVersionResource) (int64, time.Time) {
+			usage := usages[gvr]
+			return usage.size, usage.lastQueried
+		},
+	}
+
+	newRegistry := func(budget string) *searchv1alpha1.ResourceRegistry {
+		registry := &searchv1alpha1.ResourceRegistry{ObjectMeta: metav1.ObjectMeta{Name: "registry"}}
+		if budget != "" {
+			quantity := resource.MustParse(budget)
+			registry.Spec.MemoryBudget = &quantity
+		}
+		return registry
+	}
+
+	tests := []struct {
+		name     string
+		registry *searchv1alpha1.ResourceRegistry
+		want     []schema.GroupVersionResource
+	}{
+		{
+			name:     "no budget keeps all resources",
+			registry: newRegistry(""),
+			want:     []schema.GroupVersionResource{podGVR, nodeGVR},
+		},
+		{
+			name:     "within budget keeps all resources",
+			registry: newRegistry("200"),
+			want:     []schema.GroupVersionResource{podGVR, nodeGVR},
+		},
+		{
+			name:     "over budget evicts least-recently-queried resource",
+			registry: newRegistry("100"),
+			want:     []schema.GroupVersionResource{nodeGVR},
+		},
+		{
+			name:     "tight budget evicts until it fits",
+			registry: newRegistry("10"),
+			want:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := &Controller{store: mockStore}
+			matchedResources := map[schema.GroupVersionResource]*store.MultiNamespace{
+				podGVR:  store.NewMultiNamespace(),
+				nodeGVR: store.NewMultiNamespace(),
+			}
+			ctl.enforceMemoryBudget(tt.registry, matchedResources)
+			if len(matchedResources) != len(tt.want) {
+				t.Fatalf("expected %d resources to survive, got %v", len(tt.want), matchedResources)
+			}
+			for _, gvr := range tt.want {
+				if _, exist := matchedResources[gvr]; !exist {
+					t.Errorf("expected %s to survive eviction, got %v", gvr.String(), matchedResources)
+				}
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"sync"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -39,12 +40,15 @@ import (
 	listcorev1 "k8s.io/client-go/listers/core/v1"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/component-base/metrics/legacyregistry"
 	"k8s.io/klog/v2"
 
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
 	informerfactory "github.com/karmada-io/karmada/pkg/generated/informers/externalversions"
 	clusterlisters "github.com/karmada-io/karmada/pkg/generated/listers/cluster/v1alpha1"
 	searchlisters "github.com/karmada-io/karmada/pkg/generated/listers/search/v1alpha1"
+	karmadametrics "github.com/karmada-io/karmada/pkg/metrics"
 	"github.com/karmada-io/karmada/pkg/search/proxy/framework"
 	"github.com/karmada-io/karmada/pkg/search/proxy/framework/plugins"
 	pluginruntime "github.com/karmada-io/karmada/pkg/search/proxy/framework/runtime"
@@ -58,6 +62,10 @@ import (
 
 const workKey = "key"
 
+// registerRegistryCacheMetricsOnce guards metrics registration, NewController
+// may be called multiple times in tests.
+var registerRegistryCacheMetricsOnce sync.Once
+
 // Controller syncs Cluster and GlobalResource.
 type Controller struct {
 	restMapper           meta.RESTMapper
@@ -68,6 +76,9 @@ type Controller struct {
 	registryLister searchlisters.ResourceRegistryLister
 	worker         util.AsyncWorker
 	store          store.Store
+	// karmadaClient reports memory budget conditions on ResourceRegistries,
+	// it is nil when no rest config is provided.
+	karmadaClient karmadaclientset.Interface
 
 	proxy framework.Proxy
 
@@ -98,6 +109,21 @@ func NewController(option NewControllerOption) (*Controller, error) {
 	clientFactory := dynamicClientForClusterFunc(clusterLister, secretLister)
 	multiClusterStore := store.NewMultiClusterCache(clientFactory, option.RestMapper)
 
+	var karmadaClient karmadaclientset.Interface
+	if option.RestConfig != nil {
+		client, err := karmadaclientset.NewForConfig(option.RestConfig)
+		if err != nil {
+			// Reporting budget conditions is best-effort, it is skipped
+			// without a client.
+			klog.Errorf("Failed to create karmada client for ResourceRegistry status updates: %v", err)
+		} else {
+			karmadaClient = client
+		}
+	}
+	registerRegistryCacheMetricsOnce.Do(func() {
+		legacyregistry.RawMustRegister(karmadametrics.RegistryCacheCollectors()...)
+	})
+
 	allPlugins, err := newPlugins(option, multiClusterStore)
 	if err != nil {
 		return nil, err
@@ -112,6 +138,7 @@ func NewController(option NewControllerOption) (*Controller, error) {
 		clusterLister:                clusterLister,
 		registryLister:               option.KarmadaFactory.Search().V1alpha1().ResourceRegistries().Lister(),
 		store:                        multiClusterStore,
+		karmadaClient:                karmadaClient,
 		storageInitializationTimeout: option.StorageInitializationTimeout,
 		proxy:                        proxy,
 	}
@@ -213,12 +240,19 @@ func (ctl *Controller) reconcile(util.QueueKey) error {
 				matchedResources[gvr] = nsSelector
 			}
 			nsSelector.Add(selector.Namespace)
-			registeredResources[gvr] = struct{}{}
 		}
 		if len(matchedResources) == 0 {
 			continue
 		}
 
+		// Enforce the registry's memory budget before registering its resources,
+		// so evicted resources are dropped from the cache by exclusion.
+		ctl.enforceMemoryBudget(registry, matchedResources)
+
+		for gvr := range matchedResources {
+			registeredResources[gvr] = struct{}{}
+		}
+
 		for _, cluster := range clusters {
 			if !util.ClusterMatches(cluster, registry.Spec.TargetCluster) {
 				continue
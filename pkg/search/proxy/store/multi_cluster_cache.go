@@ -51,6 +51,10 @@ type Store interface {
 
 	// ReadinessCheck checks if the storage is ready for accepting requests.
 	ReadinessCheck() error
+
+	// ResourceUsage reports the approximate memory footprint in bytes of a
+	// cached resource across all clusters, and the time it was last queried.
+	ResourceUsage(gvr schema.GroupVersionResource) (int64, time.Time)
 }
 
 // MultiClusterCache caches resource from multi member clusters
@@ -61,6 +65,11 @@ type MultiClusterCache struct {
 	restMapper          meta.RESTMapper
 	// newClientFunc returns a dynamic client for member cluster apiserver
 	newClientFunc func(string) (dynamic.Interface, error)
+
+	// lastQueried records when each resource was last queried, guiding the
+	// eviction order when a registry memory budget is exceeded.
+	queryLock   sync.RWMutex
+	lastQueried map[schema.GroupVersionResource]time.Time
 }
 
 var _ Store = &MultiClusterCache{}
@@ -72,6 +81,7 @@ func NewMultiClusterCache(newClientFunc func(string) (dynamic.Interface, error),
 		newClientFunc:       newClientFunc,
 		cache:               map[string]*clusterCache{},
 		registeredResources: map[schema.GroupVersionResource]struct{}{},
+		lastQueried:         map[schema.GroupVersionResource]time.Time{},
 	}
 }
 
@@ -158,6 +168,7 @@ func (c *MultiClusterCache) GetResourceFromCache(ctx context.Context, gvr schema
 
 // Get returns the target object
 func (c *MultiClusterCache) Get(ctx context.Context, gvr schema.GroupVersionResource, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	c.recordQuery(gvr)
 	_, clusterName, cache, err := c.getResourceFromCache(ctx, gvr, request.NamespaceValue(ctx), name)
 	if err != nil {
 		return nil, err
@@ -185,6 +196,7 @@ func (c *MultiClusterCache) Get(ctx context.Context, gvr schema.GroupVersionReso
 // List returns the object list
 // nolint:gocyclo
 func (c *MultiClusterCache) List(ctx context.Context, gvr schema.GroupVersionResource, o *metainternalversion.ListOptions) (runtime.Object, error) {
+	c.recordQuery(gvr)
 	klog.V(5).Infof("Request list %v with rv=%#v, continue=%#v, limit=%v", gvr.String(),
 		newMultiClusterResourceVersionFromString(o.ResourceVersion), newMultiClusterContinueFromString(o.Continue), o.Limit)
 	requestCluster, options, requestResourceVersion := prepareBeforeList(o)
@@ -329,6 +341,7 @@ func (c *MultiClusterCache) List(ctx context.Context, gvr schema.GroupVersionRes
 
 // Watch watches the resource
 func (c *MultiClusterCache) Watch(ctx context.Context, gvr schema.GroupVersionResource, options *metainternalversion.ListOptions) (watch.Interface, error) {
+	c.recordQuery(gvr)
 	klog.V(5).Infof("Request watch %v with rv=%v", gvr.String(), options.ResourceVersion)
 	resourceVersion := newMultiClusterResourceVersionFromString(options.ResourceVersion)
 
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/klog/v2"
+)
+
+// recordQuery records the time a resource is queried, so the least-recently-queried
+// resources can be evicted first when a memory budget is exceeded.
+func (c *MultiClusterCache) recordQuery(gvr schema.GroupVersionResource) {
+	c.queryLock.Lock()
+	defer c.queryLock.Unlock()
+	c.lastQueried[gvr] = time.Now()
+}
+
+// ResourceUsage reports the approximate memory footprint in bytes of a cached
+// resource across all clusters, and the time the resource was last queried.
+// A zero time means the resource has never been queried.
+func (c *MultiClusterCache) ResourceUsage(gvr schema.GroupVersionResource) (int64, time.Time) {
+	c.lock.RLock()
+	caches := make([]*resourceCache, 0, len(c.cache))
+	for _, cc := range c.cache {
+		if rc := cc.cacheForResource(gvr); rc != nil {
+			caches = append(caches, rc)
+		}
+	}
+	c.lock.RUnlock()
+
+	var size int64
+	for _, rc := range caches {
+		size += rc.approximateSize()
+	}
+
+	c.queryLock.RLock()
+	lastQueried := c.lastQueried[gvr]
+	c.queryLock.RUnlock()
+	return size, lastQueried
+}
+
+// approximateSize estimates the memory consumed by the cached objects of the
+// resource. Caches that are not ready yet are counted as empty.
+func (c *resourceCache) approximateSize() int64 {
+	if c.ReadinessCheck() != nil {
+		return 0
+	}
+	ctx := request.WithNamespace(request.NewContext(), metav1.NamespaceAll)
+	// ResourceVersion "0" serves the list from the cache without quorum read.
+	objs, err := c.List(ctx, &metainternalversion.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		klog.Errorf("Failed to list %s of cluster(%s) for size estimation: %v", c.resource.String(), c.clusterName, err)
+		return 0
+	}
+	items, err := meta.ExtractList(objs)
+	if err != nil {
+		klog.Errorf("Failed to extract %s list of cluster(%s) for size estimation: %v", c.resource.String(), c.clusterName, err)
+		return 0
+	}
+
+	var size int64
+	for _, item := range items {
+		if obj, ok := item.(*unstructured.Unstructured); ok {
+			size += approximateObjectSize(obj.Object)
+		}
+	}
+	return size
+}
+
+// approximateObjectSize walks the unstructured content and estimates the
+// memory it occupies. The estimation does not need to be exact, it only has
+// to be proportional so resources can be compared against a budget.
+func approximateObjectSize(value interface{}) int64 {
+	// every value carries interface and allocation overhead
+	const overhead = 16
+	switch v := value.(type) {
+	case map[string]interface{}:
+		size := int64(overhead)
+		for key, item := range v {
+			size += int64(len(key)) + overhead + approximateObjectSize(item)
+		}
+		return size
+	case []interface{}:
+		size := int64(overhead)
+		for _, item := range v {
+			size += approximateObjectSize(item)
+		}
+		return size
+	case string:
+		return int64(len(v)) + overhead
+	default:
+		return overhead
+	}
+}
@@ -18,6 +18,7 @@ package testing
 
 import (
 	"context"
+	"time"
 
 	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -37,6 +38,7 @@ type MockStore struct {
 	GetFunc                  func(ctx context.Context, gvr schema.GroupVersionResource, name string, options *metav1.GetOptions) (runtime.Object, error)
 	ListFunc                 func(ctx context.Context, gvr schema.GroupVersionResource, options *metainternalversion.ListOptions) (runtime.Object, error)
 	WatchFunc                func(ctx context.Context, gvr schema.GroupVersionResource, options *metainternalversion.ListOptions) (watch.Interface, error)
+	ResourceUsageFunc        func(gvr schema.GroupVersionResource) (int64, time.Time)
 }
 
 var _ store.Store = &MockStore{}
@@ -103,3 +105,11 @@ func (c *MockStore) Watch(ctx context.Context, gvr schema.GroupVersionResource,
 func (c *MockStore) ReadinessCheck() error {
 	return nil
 }
+
+// ResourceUsage implements store.Store interface
+func (c *MockStore) ResourceUsage(gvr schema.GroupVersionResource) (int64, time.Time) {
+	if c.ResourceUsageFunc == nil {
+		return 0, time.Time{}
+	}
+	return c.ResourceUsageFunc(gvr)
+}
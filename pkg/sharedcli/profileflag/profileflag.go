@@ -83,6 +83,7 @@ func ListenAndServe(opts Options) {
 	if opts.EnableProfile {
 		mux := http.NewServeMux()
 		installHandlerForPProf(mux)
+		installHandlerForRuntimeFlags(mux)
 		klog.Infof("Starting profiling on port %s", opts.ProfilingBindAddress)
 		go func() {
 			httpServer := http.Server{
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profileflag
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"k8s.io/apiserver/pkg/server/routes"
+	"k8s.io/component-base/logs"
+
+	"github.com/karmada-io/karmada/pkg/features"
+)
+
+// installHandlerForRuntimeFlags registers handlers that allow changing the log
+// verbosity and feature gates of a running component without restarting it:
+//
+//   - PUT /debug/flags/v with the desired verbosity(e.g. "4") in the body
+//     changes the klog log level.
+//   - GET /debug/flags/features lists the current feature gate states.
+//   - PUT /debug/flags/features with a gate expression(e.g. "Failover=true,PropagateDeps=false")
+//     in the body changes the shared feature gates.
+//
+// Note: feature gate changes only affect code paths that query the gate at
+// runtime, controllers that are enabled or disabled at startup are not
+// restarted.
+func installHandlerForRuntimeFlags(mux *http.ServeMux) {
+	mux.HandleFunc("/debug/flags/v", routes.StringFlagPutHandler(logs.GlogSetter))
+	mux.HandleFunc("/debug/flags/features", featureGateHandler)
+}
+
+func featureGateHandler(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		known := features.FeatureGate.KnownFeatures()
+		sort.Strings(known)
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		for _, feature := range known {
+			fmt.Fprintln(w, feature)
+		}
+	case http.MethodPut:
+		body, err := io.ReadAll(io.LimitReader(req.Body, 4096))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to read request body: %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := features.FeatureGate.Set(string(body)); err != nil {
+			http.Error(w, fmt.Sprintf("failed to set feature gates %q: %v", string(body), err), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprintf(w, "successfully set feature gates to %q\n", string(body))
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
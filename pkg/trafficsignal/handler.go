@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trafficsignal
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EndpointPath is the HTTP path the traffic signal endpoint is served on.
+const EndpointPath = "/traffic-signals"
+
+// Response is the body of a traffic signal response.
+type Response struct {
+	// Services holds one entry per exported Service, sorted by namespace
+	// and name.
+	Services []ServiceSignal `json:"services"`
+}
+
+// handler serves the readiness signals of exported Services.
+type handler struct {
+	client client.Client
+}
+
+// NewHandler returns an HTTP handler serving the readiness signals of
+// exported Services. The optional 'namespace' and 'name' query parameters
+// restrict the response to a single Service.
+func NewHandler(c client.Client) http.Handler {
+	return &handler{client: c}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		http.Error(writer, "only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	signals, err := Collect(request.Context(), h.client,
+		request.URL.Query().Get("namespace"), request.URL.Query().Get("name"))
+	if err != nil {
+		klog.Errorf("Failed to collect traffic signals: %v", err)
+		http.Error(writer, "failed to collect traffic signals", http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(writer).Encode(Response{Services: signals}); err != nil {
+		klog.Errorf("Failed to encode traffic signals: %v", err)
+	}
+}
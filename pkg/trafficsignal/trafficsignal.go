@@ -0,0 +1,206 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package trafficsignal computes per-cluster readiness fractions of exported
+// Services from the EndpointSlices which member clusters report to the control
+// plane, and serves them over HTTP so external GSLB or traffic managers can
+// shift weights as Karmada detects degraded clusters.
+package trafficsignal
+
+import (
+	"context"
+	"sort"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// ServiceSignal reports the per-cluster readiness of one exported Service.
+type ServiceSignal struct {
+	// Namespace is the namespace of the Service in the member clusters.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Service in the member clusters.
+	Name string `json:"name"`
+	// Clusters holds one entry per member cluster serving the Service,
+	// sorted by cluster name.
+	Clusters []ClusterSignal `json:"clusters"`
+}
+
+// ClusterSignal reports the readiness of one Service in one member cluster.
+type ClusterSignal struct {
+	// Cluster is the name of the member cluster.
+	Cluster string `json:"cluster"`
+	// ReadyEndpoints is the number of endpoints whose ready condition is
+	// true or unset.
+	ReadyEndpoints int `json:"readyEndpoints"`
+	// TotalEndpoints is the number of endpoints reported by the cluster.
+	TotalEndpoints int `json:"totalEndpoints"`
+	// ReadinessFraction is ReadyEndpoints divided by TotalEndpoints, or
+	// zero when the cluster reports no endpoints. External traffic
+	// managers can use it directly as a relative weight.
+	ReadinessFraction float64 `json:"readinessFraction"`
+}
+
+// Collect computes the readiness signals of exported Services from the
+// EndpointSlice works which member clusters reported to the control plane.
+// An empty namespace or name matches every Service.
+func Collect(ctx context.Context, c client.Client, namespace, name string) ([]ServiceSignal, error) {
+	works, err := listEndpointSliceWorks(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	type counts struct{ ready, total int }
+	type serviceKey struct{ namespace, name string }
+	countsByService := map[serviceKey]map[string]*counts{}
+	for _, work := range works {
+		svcNamespace, svcName := serviceOfWork(work)
+		if svcName == "" {
+			continue
+		}
+		if namespace != "" && namespace != svcNamespace {
+			continue
+		}
+		if name != "" && name != svcName {
+			continue
+		}
+		cluster, err := names.GetClusterName(work.Namespace)
+		if err != nil {
+			klog.Errorf("Failed to get cluster name for work %s/%s: %v", work.Namespace, work.Name, err)
+			continue
+		}
+
+		key := serviceKey{namespace: svcNamespace, name: svcName}
+		if countsByService[key] == nil {
+			countsByService[key] = map[string]*counts{}
+		}
+		if countsByService[key][cluster] == nil {
+			countsByService[key][cluster] = &counts{}
+		}
+		for _, endpointSlice := range endpointSlicesOfWork(work) {
+			ready, total := countEndpoints(endpointSlice)
+			countsByService[key][cluster].ready += ready
+			countsByService[key][cluster].total += total
+		}
+	}
+
+	signals := make([]ServiceSignal, 0, len(countsByService))
+	for key, clusters := range countsByService {
+		signal := ServiceSignal{Namespace: key.namespace, Name: key.name}
+		for cluster, c := range clusters {
+			clusterSignal := ClusterSignal{Cluster: cluster, ReadyEndpoints: c.ready, TotalEndpoints: c.total}
+			if c.total > 0 {
+				clusterSignal.ReadinessFraction = float64(c.ready) / float64(c.total)
+			}
+			signal.Clusters = append(signal.Clusters, clusterSignal)
+		}
+		sort.Slice(signal.Clusters, func(i, j int) bool {
+			return signal.Clusters[i].Cluster < signal.Clusters[j].Cluster
+		})
+		signals = append(signals, signal)
+	}
+	sort.Slice(signals, func(i, j int) bool {
+		if signals[i].Namespace != signals[j].Namespace {
+			return signals[i].Namespace < signals[j].Namespace
+		}
+		return signals[i].Name < signals[j].Name
+	})
+	return signals, nil
+}
+
+// listEndpointSliceWorks lists the works carrying EndpointSlices reported by
+// member clusters, deduplicated across the service-export and
+// multiclusterservice collection paths.
+func listEndpointSliceWorks(ctx context.Context, c client.Client) ([]*workv1alpha1.Work, error) {
+	var works []*workv1alpha1.Work
+	seen := map[client.ObjectKey]struct{}{}
+	for _, labelKey := range []string{util.ServiceNameLabel, util.MultiClusterServiceNameLabel} {
+		requirement, err := labels.NewRequirement(labelKey, selection.Exists, nil)
+		if err != nil {
+			return nil, err
+		}
+		workList := &workv1alpha1.WorkList{}
+		if err := c.List(ctx, workList, &client.ListOptions{
+			LabelSelector: labels.NewSelector().Add(*requirement),
+		}); err != nil {
+			return nil, err
+		}
+		for i := range workList.Items {
+			work := &workList.Items[i]
+			key := client.ObjectKeyFromObject(work)
+			if _, exist := seen[key]; exist {
+				continue
+			}
+			seen[key] = struct{}{}
+			works = append(works, work)
+		}
+	}
+	return works, nil
+}
+
+// serviceOfWork returns the namespace and name of the Service whose
+// EndpointSlices the work carries.
+func serviceOfWork(work *workv1alpha1.Work) (namespace, name string) {
+	if name = util.GetLabelValue(work.Labels, util.ServiceNameLabel); name != "" {
+		return util.GetLabelValue(work.Labels, util.ServiceNamespaceLabel), name
+	}
+	return util.GetLabelValue(work.Labels, util.MultiClusterServiceNamespaceLabel),
+		util.GetLabelValue(work.Labels, util.MultiClusterServiceNameLabel)
+}
+
+// endpointSlicesOfWork extracts the EndpointSlice manifests of the work.
+func endpointSlicesOfWork(work *workv1alpha1.Work) []*discoveryv1.EndpointSlice {
+	var endpointSlices []*discoveryv1.EndpointSlice
+	for _, manifest := range work.Spec.Workload.Manifests {
+		unstructObj := &unstructured.Unstructured{}
+		if err := unstructObj.UnmarshalJSON(manifest.Raw); err != nil {
+			klog.Errorf("Failed to unmarshal manifest of work %s/%s: %v", work.Namespace, work.Name, err)
+			continue
+		}
+		if unstructObj.GetKind() != util.EndpointSliceKind {
+			continue
+		}
+		endpointSlice := &discoveryv1.EndpointSlice{}
+		if err := helper.ConvertToTypedObject(unstructObj, endpointSlice); err != nil {
+			klog.Errorf("Failed to convert manifest of work %s/%s to EndpointSlice: %v", work.Namespace, work.Name, err)
+			continue
+		}
+		endpointSlices = append(endpointSlices, endpointSlice)
+	}
+	return endpointSlices
+}
+
+// countEndpoints counts the ready and total endpoints of the EndpointSlice.
+// Consumers should interpret an unset ready condition as true, see the
+// EndpointConditions API documentation.
+func countEndpoints(endpointSlice *discoveryv1.EndpointSlice) (ready, total int) {
+	for _, endpoint := range endpointSlice.Endpoints {
+		total++
+		if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+			ready++
+		}
+	}
+	return ready, total
+}
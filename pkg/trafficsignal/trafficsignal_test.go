@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trafficsignal
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+func newEndpointSliceWork(t *testing.T, cluster, svcNamespace, svcName, workName string, ready ...bool) *workv1alpha1.Work {
+	endpointSlice := &discoveryv1.EndpointSlice{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: discoveryv1.SchemeGroupVersion.String(),
+			Kind:       util.EndpointSliceKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Namespace: svcNamespace, Name: workName},
+	}
+	for _, r := range ready {
+		endpointSlice.Endpoints = append(endpointSlice.Endpoints, discoveryv1.Endpoint{
+			Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(r)},
+		})
+	}
+	raw, err := json.Marshal(endpointSlice)
+	if err != nil {
+		t.Fatalf("failed to marshal EndpointSlice: %v", err)
+	}
+	return &workv1alpha1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: names.GenerateExecutionSpaceName(cluster),
+			Name:      workName,
+			Labels: map[string]string{
+				util.ServiceNamespaceLabel: svcNamespace,
+				util.ServiceNameLabel:      svcName,
+			},
+		},
+		Spec: workv1alpha1.WorkSpec{
+			Workload: workv1alpha1.WorkloadTemplate{
+				Manifests: []workv1alpha1.Manifest{{RawExtension: runtime.RawExtension{Raw: raw}}},
+			},
+		},
+	}
+}
+
+func setupScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := workv1alpha1.Install(scheme); err != nil {
+		t.Fatalf("failed to install work scheme: %v", err)
+	}
+	return scheme
+}
+
+func TestCollect(t *testing.T) {
+	works := []*workv1alpha1.Work{
+		newEndpointSliceWork(t, "member1", "default", "frontend", "eps-a", true, true, false),
+		newEndpointSliceWork(t, "member2", "default", "frontend", "eps-b", false, false),
+		newEndpointSliceWork(t, "member1", "default", "backend", "eps-c", true),
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(setupScheme(t)).
+		WithObjects(works[0], works[1], works[2]).Build()
+
+	signals, err := Collect(context.TODO(), fakeClient, "", "")
+	if err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	want := []ServiceSignal{
+		{
+			Namespace: "default", Name: "backend",
+			Clusters: []ClusterSignal{
+				{Cluster: "member1", ReadyEndpoints: 1, TotalEndpoints: 1, ReadinessFraction: 1},
+			},
+		},
+		{
+			Namespace: "default", Name: "frontend",
+			Clusters: []ClusterSignal{
+				{Cluster: "member1", ReadyEndpoints: 2, TotalEndpoints: 3, ReadinessFraction: 2.0 / 3},
+				{Cluster: "member2", ReadyEndpoints: 0, TotalEndpoints: 2, ReadinessFraction: 0},
+			},
+		},
+	}
+	if !reflect.DeepEqual(signals, want) {
+		t.Errorf("Collect() = %+v, want %+v", signals, want)
+	}
+
+	signals, err = Collect(context.TODO(), fakeClient, "default", "backend")
+	if err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if len(signals) != 1 || signals[0].Name != "backend" {
+		t.Errorf("Collect() with name filter = %+v, want only backend", signals)
+	}
+}
+
+func TestCountEndpoints(t *testing.T) {
+	endpointSlice := &discoveryv1.EndpointSlice{
+		Endpoints: []discoveryv1.Endpoint{
+			{Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)}},
+			{Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false)}},
+			// An unset ready condition should be interpreted as true.
+			{Conditions: discoveryv1.EndpointConditions{}},
+		},
+	}
+	ready, total := countEndpoints(endpointSlice)
+	if ready != 2 || total != 3 {
+		t.Errorf("countEndpoints() = (%d, %d), want (2, 3)", ready, total)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().WithScheme(setupScheme(t)).
+		WithObjects(newEndpointSliceWork(t, "member1", "default", "frontend", "eps-a", true)).Build()
+	handler := NewHandler(fakeClient)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", EndpointPath, nil))
+	if recorder.Code != 200 {
+		t.Fatalf("status = %d, want 200", recorder.Code)
+	}
+	response := &Response{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response.Services) != 1 || response.Services[0].Clusters[0].ReadinessFraction != 1 {
+		t.Errorf("unexpected response: %s", recorder.Body.String())
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("POST", EndpointPath, nil))
+	if recorder.Code != 405 {
+		t.Errorf("status for POST = %d, want 405", recorder.Code)
+	}
+}
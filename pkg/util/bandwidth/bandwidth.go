@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bandwidth accounts the control plane traffic towards member
+// clusters and optionally throttles it to a per-cluster budget, which matters
+// on metered WAN links at edge sites.
+package bandwidth
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/transport"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+)
+
+// limiters shares one byte rate limiter per cluster, clients towards the same
+// cluster are rebuilt frequently and must drain a common budget.
+var limiters sync.Map
+
+type clusterLimiter struct {
+	budget  int64
+	limiter *rate.Limiter
+}
+
+// BudgetFromCluster parses the bandwidth budget declared by the cluster
+// annotation, zero means no budget.
+func BudgetFromCluster(cluster *clusterv1alpha1.Cluster) (int64, error) {
+	value, exist := cluster.Annotations[clusterv1alpha1.BandwidthBudgetAnnotationKey]
+	if !exist {
+		return 0, nil
+	}
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q of annotation %s: %v", value, clusterv1alpha1.BandwidthBudgetAnnotationKey, err)
+	}
+	budget := quantity.Value()
+	if budget < 0 {
+		return 0, fmt.Errorf("invalid value %q of annotation %s: must not be negative", value, clusterv1alpha1.BandwidthBudgetAnnotationKey)
+	}
+	return budget, nil
+}
+
+// NewTransportWrapper returns a transport wrapper accounting the traffic of
+// the given cluster and, when the budget in bytes per second is positive,
+// throttling it.
+func NewTransportWrapper(clusterName string, budget int64) transport.WrapperFunc {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &accountingRoundTripper{
+			cluster:  clusterName,
+			limiter:  limiterForCluster(clusterName, budget),
+			delegate: rt,
+		}
+	}
+}
+
+// limiterForCluster returns the shared limiter of the cluster, replacing it
+// when the declared budget changed.
+func limiterForCluster(clusterName string, budget int64) *rate.Limiter {
+	if budget <= 0 {
+		limiters.Delete(clusterName)
+		return nil
+	}
+	if value, exist := limiters.Load(clusterName); exist {
+		if existing := value.(*clusterLimiter); existing.budget == budget {
+			return existing.limiter
+		}
+	}
+	limiter := rate.NewLimiter(rate.Limit(budget), int(budget))
+	limiters.Store(clusterName, &clusterLimiter{budget: budget, limiter: limiter})
+	return limiter
+}
+
+type accountingRoundTripper struct {
+	cluster  string
+	limiter  *rate.Limiter
+	delegate http.RoundTripper
+}
+
+// RoundTrip accounts the request body as egress and the response body as
+// ingress, and draws both from the cluster budget.
+func (rt *accountingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body = &countingReadCloser{
+			delegate: req.Body,
+			record: func(n int) {
+				RecordClusterEgressBytes(rt.cluster, n)
+				rt.throttle(req, n)
+			},
+		}
+	}
+
+	resp, err := rt.delegate.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	if resp.Body != nil {
+		resp.Body = &countingReadCloser{
+			delegate: resp.Body,
+			record: func(n int) {
+				RecordClusterIngressBytes(rt.cluster, n)
+				rt.throttle(req, n)
+			},
+		}
+	}
+	return resp, err
+}
+
+// throttle blocks until the budget covers n more bytes and accounts the time
+// spent waiting.
+func (rt *accountingRoundTripper) throttle(req *http.Request, n int) {
+	if rt.limiter == nil || n <= 0 {
+		return
+	}
+	// Chunks larger than the burst can never be admitted at once.
+	if burst := rt.limiter.Burst(); n > burst {
+		n = burst
+	}
+	start := time.Now()
+	if err := rt.limiter.WaitN(req.Context(), n); err != nil {
+		return
+	}
+	if waited := time.Since(start); waited > 0 {
+		RecordClusterNetworkThrottle(rt.cluster, waited)
+	}
+}
+
+// countingReadCloser reports the number of bytes read to the record callback.
+type countingReadCloser struct {
+	delegate io.ReadCloser
+	record   func(n int)
+}
+
+func (c *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := c.delegate.Read(p)
+	if n > 0 {
+		c.record(n)
+	}
+	return n, err
+}
+
+func (c *countingReadCloser) Close() error {
+	return c.delegate.Close()
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bandwidth
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+)
+
+func TestBudgetFromCluster(t *testing.T) {
+	tests := []struct {
+		name       string
+		annotation string
+		want       int64
+		wantErr    bool
+	}{
+		{
+			name: "no annotation",
+			want: 0,
+		},
+		{
+			name:       "quantity value",
+			annotation: "10Mi",
+			want:       10 * 1024 * 1024,
+		},
+		{
+			name:       "plain number",
+			annotation: "1024",
+			want:       1024,
+		},
+		{
+			name:       "invalid value",
+			annotation: "fast",
+			wantErr:    true,
+		},
+		{
+			name:       "negative value",
+			annotation: "-1",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}}
+			if tt.annotation != "" {
+				cluster.Annotations = map[string]string{clusterv1alpha1.BandwidthBudgetAnnotationKey: tt.annotation}
+			}
+			got, err := BudgetFromCluster(cluster)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BudgetFromCluster() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("BudgetFromCluster() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+type stubRoundTripper struct {
+	requestBytes int
+	response     string
+}
+
+func (s *stubRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		s.requestBytes = len(body)
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(s.response)),
+	}, nil
+}
+
+func TestAccountingRoundTripper(t *testing.T) {
+	stub := &stubRoundTripper{response: "response-body"}
+	rt := NewTransportWrapper("member1", 0)(stub)
+
+	egressBefore := counterValue(t, clusterEgressBytesCounter.WithLabelValues("member1"))
+	ingressBefore := counterValue(t, clusterIngressBytesCounter.WithLabelValues("member1"))
+
+	req, err := http.NewRequest(http.MethodPost, "https://example.com", bytes.NewBufferString("request"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() unexpected error: %v", err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if stub.requestBytes != len("request") {
+		t.Errorf("request body forwarded %d bytes, want %d", stub.requestBytes, len("request"))
+	}
+	if string(body) != "response-body" {
+		t.Errorf("response body = %q, want %q", string(body), "response-body")
+	}
+
+	egress := counterValue(t, clusterEgressBytesCounter.WithLabelValues("member1")) - egressBefore
+	if egress != float64(len("request")) {
+		t.Errorf("accounted egress = %v bytes, want %d", egress, len("request"))
+	}
+	ingress := counterValue(t, clusterIngressBytesCounter.WithLabelValues("member1")) - ingressBefore
+	if ingress != float64(len("response-body")) {
+		t.Errorf("accounted ingress = %v bytes, want %d", ingress, len("response-body"))
+	}
+}
+
+func counterValue(t *testing.T, counter prometheus.Counter) float64 {
+	t.Helper()
+	metric := &dto.Metric{}
+	if err := counter.Write(metric); err != nil {
+		t.Fatal(err)
+	}
+	return metric.GetCounter().GetValue()
+}
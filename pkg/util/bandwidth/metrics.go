@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bandwidth
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	clusterEgressBytesMetricsName      = "cluster_network_egress_bytes_total"
+	clusterIngressBytesMetricsName     = "cluster_network_ingress_bytes_total"
+	clusterThrottleDurationMetricsName = "cluster_network_throttle_duration_seconds_total"
+)
+
+var (
+	// clusterEgressBytesCounter reports the bytes sent to the given cluster.
+	clusterEgressBytesCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: clusterEgressBytesMetricsName,
+		Help: "Number of bytes sent from the control plane to the cluster.",
+	}, []string{"cluster_name"})
+
+	// clusterIngressBytesCounter reports the bytes received from the given cluster.
+	clusterIngressBytesCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: clusterIngressBytesMetricsName,
+		Help: "Number of bytes received by the control plane from the cluster.",
+	}, []string{"cluster_name"})
+
+	// clusterThrottleDurationCounter reports the time the traffic of the given
+	// cluster spent waiting for its bandwidth budget.
+	clusterThrottleDurationCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: clusterThrottleDurationMetricsName,
+		Help: "Duration in seconds the traffic of the cluster was throttled to its bandwidth budget.",
+	}, []string{"cluster_name"})
+)
+
+// RecordClusterEgressBytes records bytes sent to the given cluster.
+func RecordClusterEgressBytes(clusterName string, n int) {
+	clusterEgressBytesCounter.WithLabelValues(clusterName).Add(float64(n))
+}
+
+// RecordClusterIngressBytes records bytes received from the given cluster.
+func RecordClusterIngressBytes(clusterName string, n int) {
+	clusterIngressBytesCounter.WithLabelValues(clusterName).Add(float64(n))
+}
+
+// RecordClusterNetworkThrottle records the time the traffic of the given
+// cluster spent waiting for its bandwidth budget.
+func RecordClusterNetworkThrottle(clusterName string, waited time.Duration) {
+	clusterThrottleDurationCounter.WithLabelValues(clusterName).Add(waited.Seconds())
+}
+
+// Collectors returns the collectors about the cluster network traffic.
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		clusterEgressBytesCounter,
+		clusterIngressBytesCounter,
+		clusterThrottleDurationCounter,
+	}
+}
@@ -59,6 +59,8 @@ type ClusterRegisterOption struct {
 	ClusterProvider    string
 	ClusterRegion      string
 	ClusterZones       []string
+	ClusterLabels      map[string]string
+	ClusterTaints      []corev1.Taint
 	DryRun             bool
 
 	ControlPlaneConfig *rest.Config
@@ -247,6 +249,41 @@ func ObtainClusterID(clusterKubeClient kubernetes.Interface) (string, error) {
 	return string(ns.UID), nil
 }
 
+// DiscoverRegionAndZones inspects the topology labels of the member cluster nodes
+// and derives the region and zones of the cluster from them. The region is only
+// reported when all labeled nodes agree on it, the zones are the sorted union of
+// the zones all nodes spread across.
+func DiscoverRegionAndZones(clusterKubeClient kubernetes.Interface) (string, []string, error) {
+	nodes, err := clusterKubeClient.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return "", nil, err
+	}
+
+	regions := sets.New[string]()
+	zones := sets.New[string]()
+	for i := range nodes.Items {
+		nodeLabels := nodes.Items[i].Labels
+		if region, exist := nodeLabels[corev1.LabelTopologyRegion]; exist {
+			regions.Insert(region)
+		} else if region, exist = nodeLabels[corev1.LabelFailureDomainBetaRegion]; exist {
+			regions.Insert(region)
+		}
+		if zone, exist := nodeLabels[corev1.LabelTopologyZone]; exist {
+			zones.Insert(zone)
+		} else if zone, exist = nodeLabels[corev1.LabelFailureDomainBetaZone]; exist {
+			zones.Insert(zone)
+		}
+	}
+
+	var region string
+	if regions.Len() == 1 {
+		region, _ = regions.PopAny()
+	} else if regions.Len() > 1 {
+		klog.Warningf("Nodes report multiple regions(%v), unable to derive the cluster region.", sets.List(regions))
+	}
+	return region, sets.List(zones), nil
+}
+
 // ClusterAccessCredentialChanged checks whether the cluster access credential changed
 func ClusterAccessCredentialChanged(newSpec, oldSpec clusterv1alpha1.ClusterSpec) bool {
 	if oldSpec.APIEndpoint == newSpec.APIEndpoint &&
@@ -22,6 +22,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -534,3 +535,69 @@ func TestObtainClusterID(t *testing.T) {
 		})
 	}
 }
+
+func TestDiscoverRegionAndZones(t *testing.T) {
+	newNode := func(name string, labels map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	tests := []struct {
+		name       string
+		nodes      []runtime.Object
+		wantRegion string
+		wantZones  []string
+	}{
+		{
+			name:       "no nodes",
+			wantRegion: "",
+			wantZones:  []string{},
+		},
+		{
+			name: "nodes without topology labels",
+			nodes: []runtime.Object{
+				newNode("node1", nil),
+			},
+			wantRegion: "",
+			wantZones:  []string{},
+		},
+		{
+			name: "nodes agree on region and spread across zones",
+			nodes: []runtime.Object{
+				newNode("node1", map[string]string{corev1.LabelTopologyRegion: "us-west", corev1.LabelTopologyZone: "us-west-b"}),
+				newNode("node2", map[string]string{corev1.LabelTopologyRegion: "us-west", corev1.LabelTopologyZone: "us-west-a"}),
+			},
+			wantRegion: "us-west",
+			wantZones:  []string{"us-west-a", "us-west-b"},
+		},
+		{
+			name: "nodes report conflicting regions",
+			nodes: []runtime.Object{
+				newNode("node1", map[string]string{corev1.LabelTopologyRegion: "us-west", corev1.LabelTopologyZone: "us-west-a"}),
+				newNode("node2", map[string]string{corev1.LabelTopologyRegion: "us-east", corev1.LabelTopologyZone: "us-east-a"}),
+			},
+			wantRegion: "",
+			wantZones:  []string{"us-east-a", "us-west-a"},
+		},
+		{
+			name: "beta topology labels as fallback",
+			nodes: []runtime.Object{
+				newNode("node1", map[string]string{corev1.LabelFailureDomainBetaRegion: "us-west", corev1.LabelFailureDomainBetaZone: "us-west-a"}),
+			},
+			wantRegion: "us-west",
+			wantZones:  []string{"us-west-a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			region, zones, err := DiscoverRegionAndZones(fake.NewSimpleClientset(tt.nodes...))
+			if err != nil {
+				t.Fatalf("DiscoverRegionAndZones() unexpected error: %v", err)
+			}
+			if region != tt.wantRegion {
+				t.Errorf("DiscoverRegionAndZones() region = %q, want %q", region, tt.wantRegion)
+			}
+			if !reflect.DeepEqual(zones, tt.wantZones) {
+				t.Errorf("DiscoverRegionAndZones() zones = %v, want %v", zones, tt.wantZones)
+			}
+		})
+	}
+}
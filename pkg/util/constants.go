@@ -207,6 +207,10 @@ const (
 	CRDKind = "CustomResourceDefinition"
 	// SecretKind indicates the target resource is a Secret
 	SecretKind = "Secret"
+	// ResourceQuotaKind indicates the target resource is a resourcequota
+	ResourceQuotaKind = "ResourceQuota"
+	// LimitRangeKind indicates the target resource is a limitrange
+	LimitRangeKind = "LimitRange"
 
 	// ServiceExportKind indicates the target resource is a serviceexport crd
 	ServiceExportKind = "ServiceExport"
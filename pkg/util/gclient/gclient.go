@@ -17,6 +17,7 @@ limitations under the License.
 package gclient
 
 import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -42,6 +43,7 @@ var aggregatedScheme = runtime.NewScheme()
 
 func init() {
 	utilruntime.Must(scheme.AddToScheme(aggregatedScheme))            // add Kubernetes schemes
+	utilruntime.Must(apiextensionsv1.AddToScheme(aggregatedScheme))   // add apiextensions v1 schemes
 	utilruntime.Must(clusterv1alpha1.Install(aggregatedScheme))       // add cluster schemes
 	utilruntime.Must(configv1alpha1.Install(aggregatedScheme))        // add config v1alpha1 schemes
 	utilruntime.Must(networkingv1alpha1.Install(aggregatedScheme))    // add network v1alpha1 schemes
@@ -35,6 +35,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/bandwidth"
 )
 
 // ClusterClient stands for a cluster Clientset for the given member cluster
@@ -242,6 +243,14 @@ func BuildClusterConfig(clusterName string,
 		}
 	}
 
+	// Account the traffic towards the cluster and throttle it when the
+	// cluster declares a bandwidth budget.
+	budget, err := bandwidth.BudgetFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	clusterConfig.Wrap(bandwidth.NewTransportWrapper(clusterName, budget))
+
 	return clusterConfig, nil
 }
 
@@ -110,6 +110,8 @@ func (o *objectWatcherImpl) Create(ctx context.Context, clusterName string, desi
 			return err
 		}
 
+		o.reestablishOwnerReferences(ctx, dynamicClusterClient, desireObj)
+
 		clusterObj, err := dynamicClusterClient.DynamicClientSet.Resource(gvr).Namespace(desireObj.GetNamespace()).Create(ctx, desireObj, metav1.CreateOptions{})
 		if err != nil {
 			klog.Errorf("Failed to create resource(kind=%s, %s/%s) in cluster %s, err is %v.", desireObj.GetKind(), desireObj.GetNamespace(), desireObj.GetName(), clusterName, err)
@@ -176,6 +178,8 @@ func (o *objectWatcherImpl) Update(ctx context.Context, clusterName string, desi
 		}
 	}
 
+	o.reestablishOwnerReferences(ctx, dynamicClusterClient, desireObj)
+
 	// If there's no actual content changes, skip the update and record the current version.
 	// Whether the DeepEqual check returns true will depend on the implementation of the member cluster's mutating webhook.
 	// In theory, if the member cluster's mutating webhook modifies the content on every update,
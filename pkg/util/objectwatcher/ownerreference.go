@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectwatcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+// reestablishOwnerReferences translates the ownerReferences preserved in the manifest
+// annotation to member cluster owner objects and sets them on the object about to be
+// applied. Owners that do not exist(yet) in the member cluster are skipped without
+// blocking the apply, they will be picked up by a following reconciliation.
+func (o *objectWatcherImpl) reestablishOwnerReferences(ctx context.Context, dynamicClusterClient *util.DynamicClusterClient, desireObj *unstructured.Unstructured) {
+	preserved, exist := desireObj.GetAnnotations()[workv1alpha2.PreservedOwnerReferencesAnnotation]
+	if !exist {
+		return
+	}
+
+	var preservedReferences []metav1.OwnerReference
+	if err := json.Unmarshal([]byte(preserved), &preservedReferences); err != nil {
+		klog.Errorf("Failed to unmarshal preserved ownerReferences of resource(kind=%s, %s/%s): %v",
+			desireObj.GetKind(), desireObj.GetNamespace(), desireObj.GetName(), err)
+		return
+	}
+
+	ownerReferences := desireObj.GetOwnerReferences()
+	for i := range preservedReferences {
+		if containsOwnerReference(ownerReferences, preservedReferences[i]) {
+			continue
+		}
+
+		owner, err := o.resolveOwner(ctx, dynamicClusterClient, desireObj.GetNamespace(), preservedReferences[i])
+		if err != nil {
+			klog.Warningf("Failed to resolve owner(%s/%s, kind=%s) of resource(kind=%s, %s/%s) in cluster %s: %v",
+				desireObj.GetNamespace(), preservedReferences[i].Name, preservedReferences[i].Kind,
+				desireObj.GetKind(), desireObj.GetNamespace(), desireObj.GetName(), dynamicClusterClient.ClusterName, err)
+			continue
+		}
+
+		reference := preservedReferences[i]
+		reference.UID = owner.GetUID()
+		ownerReferences = append(ownerReferences, reference)
+	}
+
+	if len(ownerReferences) != 0 {
+		desireObj.SetOwnerReferences(ownerReferences)
+	}
+}
+
+// resolveOwner fetches the owner object from the member cluster to obtain its UID.
+func (o *objectWatcherImpl) resolveOwner(ctx context.Context, dynamicClusterClient *util.DynamicClusterClient, namespace string, reference metav1.OwnerReference) (*unstructured.Unstructured, error) {
+	groupVersion, err := schema.ParseGroupVersion(reference.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	ownerGVK := groupVersion.WithKind(reference.Kind)
+
+	mapping, err := o.RESTMapper.RESTMapping(ownerGVK.GroupKind(), ownerGVK.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map GVK to GVR: %v", err)
+	}
+
+	if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		namespace = ""
+	}
+	return dynamicClusterClient.DynamicClientSet.Resource(mapping.Resource).Namespace(namespace).Get(ctx, reference.Name, metav1.GetOptions{})
+}
+
+func containsOwnerReference(references []metav1.OwnerReference, target metav1.OwnerReference) bool {
+	for i := range references {
+		if references[i].APIVersion == target.APIVersion && references[i].Kind == target.Kind && references[i].Name == target.Name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridemanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+)
+
+// TraceStep records the application of a single matching override rule during
+// a dry-run trace.
+type TraceStep struct {
+	// PolicyName is the name of the policy the rule came from.
+	PolicyName string
+	// PolicyNamespace is the namespace of the policy, empty for a
+	// ClusterOverridePolicy.
+	PolicyNamespace string
+	// Overriders is the overrider rule that was applied at this step.
+	Overriders policyv1alpha1.Overriders
+	// Before is the JSON encoding of the object before this step.
+	Before []byte
+	// After is the JSON encoding of the object after this step.
+	After []byte
+}
+
+// TraceOverridePolicies applies the override policies matching rawObj and the
+// given cluster to a deep copy of rawObj, in the same order
+// ApplyOverridePolicies would, and records every step. The given object is
+// never mutated and no events are emitted, making it suitable for dry-run
+// debugging tools.
+func TraceOverridePolicies(ctx context.Context, c client.Client, rawObj *unstructured.Unstructured, clusterName string) ([]TraceStep, error) {
+	clusterObj := &clusterv1alpha1.Cluster{}
+	if err := c.Get(ctx, client.ObjectKey{Name: clusterName}, clusterObj); err != nil {
+		return nil, fmt.Errorf("failed to get member cluster %s: %v", clusterName, err)
+	}
+
+	o := &overrideManagerImpl{Client: c}
+	obj := rawObj.DeepCopy()
+	var steps []TraceStep
+
+	// Cluster scoped override policies apply first, matching the order used
+	// by ApplyOverridePolicies.
+	clusterPolicyList := &policyv1alpha1.ClusterOverridePolicyList{}
+	if err := c.List(ctx, clusterPolicyList, &client.ListOptions{UnsafeDisableDeepCopy: ptr.To(true)}); err != nil {
+		return nil, fmt.Errorf("failed to list cluster override policies: %v", err)
+	}
+	clusterItems := make([]GeneralOverridePolicy, 0, len(clusterPolicyList.Items))
+	for i := range clusterPolicyList.Items {
+		clusterItems = append(clusterItems, &clusterPolicyList.Items[i])
+	}
+	steps, err := traceMatchingOverriders(o, clusterItems, obj, clusterObj, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rawObj.GetNamespace()) > 0 {
+		policyList := &policyv1alpha1.OverridePolicyList{}
+		if err := c.List(ctx, policyList, &client.ListOptions{Namespace: rawObj.GetNamespace(), UnsafeDisableDeepCopy: ptr.To(true)}); err != nil {
+			return nil, fmt.Errorf("failed to list override policies from namespace %s: %v", rawObj.GetNamespace(), err)
+		}
+		items := make([]GeneralOverridePolicy, 0, len(policyList.Items))
+		for i := range policyList.Items {
+			items = append(items, &policyList.Items[i])
+		}
+		steps, err = traceMatchingOverriders(o, items, obj, clusterObj, steps)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return steps, nil
+}
+
+// traceMatchingOverriders applies the rules of the given policies that match
+// obj and cluster, appending one TraceStep per applied rule.
+func traceMatchingOverriders(o *overrideManagerImpl, policies []GeneralOverridePolicy, obj *unstructured.Unstructured, cluster *clusterv1alpha1.Cluster, steps []TraceStep) ([]TraceStep, error) {
+	for _, p := range o.getOverridersFromOverridePolicies(policies, obj, cluster) {
+		before, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		if err := applyPolicyOverriders(obj, p.overriders); err != nil {
+			return nil, fmt.Errorf("failed to apply overriders of policy %s: %v", p.name, err)
+		}
+		after, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, TraceStep{
+			PolicyName:      p.name,
+			PolicyNamespace: p.namespace,
+			Overriders:      p.overriders,
+			Before:          before,
+			After:           after,
+		})
+	}
+	return steps, nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridemanager
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+	utilhelper "github.com/karmada-io/karmada/pkg/util/helper"
+	"github.com/karmada-io/karmada/test/helper"
+)
+
+func TestTraceOverridePolicies(t *testing.T) {
+	deployment := helper.NewDeployment(metav1.NamespaceDefault, "test1")
+	deploymentObj, _ := utilhelper.ToUnstructured(deployment)
+
+	clusterPolicy := &policyv1alpha1.ClusterOverridePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "cop1"},
+		Spec: policyv1alpha1.OverrideSpec{
+			OverrideRules: []policyv1alpha1.RuleWithCluster{
+				{
+					TargetCluster: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"test1"}},
+					Overriders: policyv1alpha1.Overriders{
+						LabelsOverrider: []policyv1alpha1.LabelAnnotationOverrider{
+							{
+								Operator: policyv1alpha1.OverriderOpAdd,
+								Value:    map[string]string{"fromClusterPolicy": "true"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	namespacedPolicy := &policyv1alpha1.OverridePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "op1", Namespace: metav1.NamespaceDefault},
+		Spec: policyv1alpha1.OverrideSpec{
+			OverrideRules: []policyv1alpha1.RuleWithCluster{
+				{
+					TargetCluster: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"test1"}},
+					Overriders: policyv1alpha1.Overriders{
+						AnnotationsOverrider: []policyv1alpha1.LabelAnnotationOverrider{
+							{
+								Operator: policyv1alpha1.OverriderOpAdd,
+								Value:    map[string]string{"fromNamespacedPolicy": "true"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	unmatchedPolicy := &policyv1alpha1.OverridePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "op2", Namespace: metav1.NamespaceDefault},
+		Spec: policyv1alpha1.OverrideSpec{
+			OverrideRules: []policyv1alpha1.RuleWithCluster{
+				{
+					TargetCluster: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"other"}},
+					Overriders: policyv1alpha1.Overriders{
+						LabelsOverrider: []policyv1alpha1.LabelAnnotationOverrider{
+							{
+								Operator: policyv1alpha1.OverriderOpAdd,
+								Value:    map[string]string{"never": "applied"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).
+		WithObjects(helper.NewCluster("test1"), clusterPolicy, namespacedPolicy, unmatchedPolicy).Build()
+
+	steps, err := TraceOverridePolicies(context.TODO(), fakeClient, deploymentObj, "test1")
+	if err != nil {
+		t.Fatalf("TraceOverridePolicies() returned error: %v", err)
+	}
+	if len(steps) != 2 {
+		t.Fatalf("TraceOverridePolicies() returned %d steps, want 2", len(steps))
+	}
+	if steps[0].PolicyName != "cop1" || len(steps[0].PolicyNamespace) != 0 {
+		t.Errorf("first step = %s/%s, want cluster policy cop1", steps[0].PolicyNamespace, steps[0].PolicyName)
+	}
+	if steps[1].PolicyName != "op1" || steps[1].PolicyNamespace != metav1.NamespaceDefault {
+		t.Errorf("second step = %s/%s, want default/op1", steps[1].PolicyNamespace, steps[1].PolicyName)
+	}
+	for i, step := range steps {
+		if bytes.Equal(step.Before, step.After) {
+			t.Errorf("step %d recorded no change, want the overrider applied", i)
+		}
+	}
+	// The next step must start where the previous one ended.
+	if !bytes.Equal(steps[0].After, steps[1].Before) {
+		t.Errorf("step 2 does not continue from the result of step 1")
+	}
+	if labels := deploymentObj.GetLabels(); labels["fromClusterPolicy"] == "true" {
+		t.Errorf("TraceOverridePolicies() mutated the input object")
+	}
+}
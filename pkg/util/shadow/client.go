@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package shadow supports running a canary controller-manager alongside the
+// active one: every write is executed as a server-side dry run and the
+// intended change is recorded in the log, so operators can compare the
+// decisions of a new version against the live components without acting.
+package shadow
+
+import (
+	"context"
+
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewClient wraps c so that all writes are executed as server-side dry run
+// and the intended changes are recorded in the log.
+func NewClient(c client.Client) client.Client {
+	return &shadowClient{Client: client.NewDryRunClient(c)}
+}
+
+type shadowClient struct {
+	client.Client
+}
+
+// Create records the intended creation and executes it as dry run.
+func (c *shadowClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	recordIntent("create", obj)
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+// Update records the intended update and executes it as dry run.
+func (c *shadowClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	recordIntent("update", obj)
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+// Delete records the intended deletion and executes it as dry run.
+func (c *shadowClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	recordIntent("delete", obj)
+	return c.Client.Delete(ctx, obj, opts...)
+}
+
+// DeleteAllOf records the intended deletion and executes it as dry run.
+func (c *shadowClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	recordIntent("delete collection of", obj)
+	return c.Client.DeleteAllOf(ctx, obj, opts...)
+}
+
+// Patch records the intended patch and executes it as dry run.
+func (c *shadowClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	recordIntent("patch", obj)
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+// Status returns a status writer recording the intended status changes.
+func (c *shadowClient) Status() client.SubResourceWriter {
+	return &shadowSubResourceWriter{writer: c.Client.Status(), subResource: "status"}
+}
+
+// SubResource returns a client recording the intended sub resource changes.
+func (c *shadowClient) SubResource(subResource string) client.SubResourceClient {
+	inner := c.Client.SubResource(subResource)
+	return &shadowSubResourceClient{
+		shadowSubResourceWriter: shadowSubResourceWriter{writer: inner, subResource: subResource},
+		inner:                   inner,
+	}
+}
+
+type shadowSubResourceWriter struct {
+	writer      client.SubResourceWriter
+	subResource string
+}
+
+// Create records the intended sub resource creation and executes it as dry run.
+func (w *shadowSubResourceWriter) Create(ctx context.Context, obj, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	recordIntent("create "+w.subResource+" of", obj)
+	return w.writer.Create(ctx, obj, subResource, opts...)
+}
+
+// Update records the intended sub resource update and executes it as dry run.
+func (w *shadowSubResourceWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	recordIntent("update "+w.subResource+" of", obj)
+	return w.writer.Update(ctx, obj, opts...)
+}
+
+// Patch records the intended sub resource patch and executes it as dry run.
+func (w *shadowSubResourceWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	recordIntent("patch "+w.subResource+" of", obj)
+	return w.writer.Patch(ctx, obj, patch, opts...)
+}
+
+type shadowSubResourceClient struct {
+	shadowSubResourceWriter
+	inner client.SubResourceClient
+}
+
+// Get retrieves the sub resource as usual, reads are not affected.
+func (c *shadowSubResourceClient) Get(ctx context.Context, obj, subResource client.Object, opts ...client.SubResourceGetOption) error {
+	return c.inner.Get(ctx, obj, subResource, opts...)
+}
+
+func recordIntent(verb string, obj client.Object) {
+	name := obj.GetName()
+	if namespace := obj.GetNamespace(); namespace != "" {
+		name = namespace + "/" + name
+	}
+	klog.Infof("ShadowMode: would %s %T %s", verb, obj, name)
+}
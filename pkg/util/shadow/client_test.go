@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shadow
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// recordingClient captures the dry run setting of every write it receives.
+type recordingClient struct {
+	client.Client
+	calls map[string][]string
+}
+
+func (r *recordingClient) record(verb string, dryRun []string) {
+	if r.calls == nil {
+		r.calls = map[string][]string{}
+	}
+	r.calls[verb] = dryRun
+}
+
+func (r *recordingClient) Create(_ context.Context, _ client.Object, opts ...client.CreateOption) error {
+	options := &client.CreateOptions{}
+	options.ApplyOptions(opts)
+	r.record("create", options.DryRun)
+	return nil
+}
+
+func (r *recordingClient) Update(_ context.Context, _ client.Object, opts ...client.UpdateOption) error {
+	options := &client.UpdateOptions{}
+	options.ApplyOptions(opts)
+	r.record("update", options.DryRun)
+	return nil
+}
+
+func (r *recordingClient) Delete(_ context.Context, _ client.Object, opts ...client.DeleteOption) error {
+	options := &client.DeleteOptions{}
+	options.ApplyOptions(opts)
+	r.record("delete", options.DryRun)
+	return nil
+}
+
+func (r *recordingClient) Patch(_ context.Context, _ client.Object, _ client.Patch, opts ...client.PatchOption) error {
+	options := &client.PatchOptions{}
+	options.ApplyOptions(opts)
+	r.record("patch", options.DryRun)
+	return nil
+}
+
+func (r *recordingClient) SubResource(subResource string) client.SubResourceClient {
+	return &recordingSubResourceClient{parent: r, subResource: subResource}
+}
+
+type recordingSubResourceClient struct {
+	client.SubResourceClient
+	parent      *recordingClient
+	subResource string
+}
+
+func (c *recordingSubResourceClient) Update(_ context.Context, _ client.Object, opts ...client.SubResourceUpdateOption) error {
+	options := &client.SubResourceUpdateOptions{}
+	options.ApplyOptions(opts)
+	c.parent.record("update "+c.subResource, options.DryRun)
+	return nil
+}
+
+func TestShadowClientWritesAreDryRun(t *testing.T) {
+	inner := &recordingClient{}
+	shadowed := NewClient(inner)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"}}
+	ctx := context.TODO()
+	if err := shadowed.Create(ctx, pod); err != nil {
+		t.Fatalf("Create() unexpected error: %v", err)
+	}
+	if err := shadowed.Update(ctx, pod); err != nil {
+		t.Fatalf("Update() unexpected error: %v", err)
+	}
+	if err := shadowed.Delete(ctx, pod); err != nil {
+		t.Fatalf("Delete() unexpected error: %v", err)
+	}
+	if err := shadowed.Patch(ctx, pod, client.Merge); err != nil {
+		t.Fatalf("Patch() unexpected error: %v", err)
+	}
+	if err := shadowed.Status().Update(ctx, pod); err != nil {
+		t.Fatalf("Status().Update() unexpected error: %v", err)
+	}
+
+	wantDryRun := []string{metav1.DryRunAll}
+	for _, verb := range []string{"create", "update", "delete", "patch", "update status"} {
+		dryRun, ok := inner.calls[verb]
+		if !ok {
+			t.Errorf("%s was not forwarded to the inner client", verb)
+			continue
+		}
+		if !reflect.DeepEqual(dryRun, wantDryRun) {
+			t.Errorf("%s was executed with dry run %v, want %v", verb, dryRun, wantDryRun)
+		}
+	}
+}
@@ -92,6 +92,7 @@ func ValidatePlacement(placement policyv1alpha1.Placement, fldPath *field.Path)
 	allErrs = append(allErrs, ValidateClusterAffinity(placement.ClusterAffinity, fldPath.Child("clusterAffinity"))...)
 	allErrs = append(allErrs, ValidateClusterAffinities(placement.ClusterAffinities, fldPath.Child("clusterAffinities"))...)
 	allErrs = append(allErrs, ValidateSpreadConstraint(placement.SpreadConstraints, fldPath.Child("spreadConstraints"))...)
+	allErrs = append(allErrs, ValidateClusterTopologySpreadConstraints(placement.ClusterTopologySpreadConstraints, fldPath.Child("clusterTopologySpreadConstraints"))...)
 	return allErrs
 }
 
@@ -202,6 +203,32 @@ func ValidateSpreadConstraint(spreadConstraints []policyv1alpha1.SpreadConstrain
 	return allErrs
 }
 
+// ValidateClusterTopologySpreadConstraints tests if the cluster topology spread constraints are valid.
+func ValidateClusterTopologySpreadConstraints(constraints []policyv1alpha1.ClusterTopologySpreadConstraint, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	seenTopologyKeys := make(map[policyv1alpha1.SpreadFieldValue]bool)
+	for index, constraint := range constraints {
+		switch constraint.TopologyKey {
+		case policyv1alpha1.SpreadByFieldProvider, policyv1alpha1.SpreadByFieldRegion, policyv1alpha1.SpreadByFieldZone:
+		default:
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(index), constraint, "topologyKey must be provider, region, or zone"))
+		}
+
+		// MaxSkew is defaulted to 1 and should not be lower than that.
+		if constraint.MaxSkew < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(index), constraint, "maxSkew lower than 1 is not allowed"))
+		}
+
+		if seenTopologyKeys[constraint.TopologyKey] {
+			allErrs = append(allErrs, field.Invalid(fldPath, constraints, fmt.Sprintf("multiple constraints on topology key %s are not allowed", constraint.TopologyKey)))
+		}
+		seenTopologyKeys[constraint.TopologyKey] = true
+	}
+
+	return allErrs
+}
+
 // ValidateFailover validates that the failoverBehavior is correctly defined.
 func ValidateFailover(failoverBehavior *policyv1alpha1.FailoverBehavior, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
@@ -646,6 +646,60 @@ func TestValidatePropagationSpec(t *testing.T) {
 	}
 }
 
+func TestValidateClusterTopologySpreadConstraints(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraints []policyv1alpha1.ClusterTopologySpreadConstraint
+		expectedErr string
+	}{
+		{
+			name: "valid constraints",
+			constraints: []policyv1alpha1.ClusterTopologySpreadConstraint{
+				{TopologyKey: policyv1alpha1.SpreadByFieldRegion, MaxSkew: 1},
+				{TopologyKey: policyv1alpha1.SpreadByFieldZone, MaxSkew: 2, WhenUnsatisfiable: policyv1alpha1.ScheduleAnyway},
+			},
+			expectedErr: "",
+		},
+		{
+			name: "unsupported topology key",
+			constraints: []policyv1alpha1.ClusterTopologySpreadConstraint{
+				{TopologyKey: policyv1alpha1.SpreadByFieldCluster, MaxSkew: 1},
+			},
+			expectedErr: "topologyKey must be provider, region, or zone",
+		},
+		{
+			name: "maxSkew lower than 1",
+			constraints: []policyv1alpha1.ClusterTopologySpreadConstraint{
+				{TopologyKey: policyv1alpha1.SpreadByFieldRegion, MaxSkew: 0},
+			},
+			expectedErr: "maxSkew lower than 1 is not allowed",
+		},
+		{
+			name: "duplicated topology key",
+			constraints: []policyv1alpha1.ClusterTopologySpreadConstraint{
+				{TopologyKey: policyv1alpha1.SpreadByFieldRegion, MaxSkew: 1},
+				{TopologyKey: policyv1alpha1.SpreadByFieldRegion, MaxSkew: 2},
+			},
+			expectedErr: "multiple constraints on topology key region are not allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateClusterTopologySpreadConstraints(tt.constraints, field.NewPath("spec").Child("placement").Child("clusterTopologySpreadConstraints")).ToAggregate()
+			if err != nil {
+				if tt.expectedErr == "" {
+					t.Errorf("expected no error:\n  but got:\n  %s", err.Error())
+				} else if !strings.Contains(err.Error(), tt.expectedErr) {
+					t.Errorf("expected to contain:\n  %s\ngot:\n  %s", tt.expectedErr, err.Error())
+				}
+			} else if tt.expectedErr != "" {
+				t.Errorf("unexpected no error, expected to contain:\n  %s", tt.expectedErr)
+			}
+		})
+	}
+}
+
 func TestValidateApplicationFailover(t *testing.T) {
 	tests := []struct {
 		name                        string
@@ -181,3 +181,35 @@ func TestMutatingAdmission_Handle_FullCoverage(t *testing.T) {
 		t.Errorf("Handle() got.Allowed = false, want true")
 	}
 }
+
+func TestValidateExcludedClustersAnnotation(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantErr     bool
+	}{
+		{
+			name:        "no annotation",
+			annotations: nil,
+			wantErr:     false,
+		},
+		{
+			name:        "valid cluster names",
+			annotations: map[string]string{workv1alpha2.BindingExcludedClustersAnnotation: "member1,member2"},
+			wantErr:     false,
+		},
+		{
+			name:        "invalid cluster name",
+			annotations: map[string]string{workv1alpha2.BindingExcludedClustersAnnotation: "member1,Invalid_Cluster"},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateExcludedClustersAnnotation(tt.annotations)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateExcludedClustersAnnotation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propagationpolicy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+// capacityImpactWarnings estimates the scheduling impact of a policy update
+// that shrinks the candidate cluster set. It reports how many replicas are
+// currently scheduled to the clusters leaving the set and whether the
+// remaining candidates report enough free pod capacity in their resource
+// summaries, the same signal the general scheduler estimator consumes. The
+// estimation is best-effort and never blocks the update, any lookup failure
+// just suppresses the warnings.
+func capacityImpactWarnings(ctx context.Context, c client.Client, oldPolicy, policy *policyv1alpha1.PropagationPolicy) []string {
+	clusterList := &clusterv1alpha1.ClusterList{}
+	if err := c.List(ctx, clusterList); err != nil {
+		klog.Errorf("Failed to list clusters when estimating capacity impact of PropagationPolicy(%s/%s): %v",
+			policy.Namespace, policy.Name, err)
+		return nil
+	}
+
+	oldCandidates := candidateClusters(clusterList.Items, oldPolicy.Spec.Placement)
+	newCandidates := candidateClusters(clusterList.Items, policy.Spec.Placement)
+	removed := sets.List(oldCandidates.Difference(newCandidates))
+	if len(removed) == 0 {
+		return nil
+	}
+
+	warnings := []string{fmt.Sprintf("this update removes %d candidate cluster(s) from the policy: %s",
+		len(removed), strings.Join(removed, ", "))}
+
+	displaced, ok := displacedReplicas(ctx, c, policy, sets.New(removed...))
+	if !ok {
+		return warnings
+	}
+	if displaced == 0 {
+		warnings = append(warnings, "no replicas are currently scheduled to the removed cluster(s)")
+		return warnings
+	}
+	warnings = append(warnings, fmt.Sprintf("%d replica(s) currently scheduled to the removed cluster(s) will be rescheduled", displaced))
+
+	freePods, summarized := freePodCapacity(clusterList.Items, newCandidates)
+	switch {
+	case !summarized:
+		warnings = append(warnings, "the remaining candidate clusters have not reported resource summaries, capacity cannot be estimated")
+	case freePods < int64(displaced):
+		warnings = append(warnings, fmt.Sprintf("the remaining candidate clusters report free capacity for only %d pod(s), which may not fit the displaced replicas", freePods))
+	default:
+		warnings = append(warnings, fmt.Sprintf("the remaining candidate clusters report free capacity for %d pod(s)", freePods))
+	}
+	return warnings
+}
+
+// candidateClusters returns the names of clusters matching the placement's
+// cluster affinity. A placement without affinity constraints matches all
+// clusters.
+func candidateClusters(clusters []clusterv1alpha1.Cluster, placement policyv1alpha1.Placement) sets.Set[string] {
+	candidates := sets.New[string]()
+	for i := range clusters {
+		cluster := &clusters[i]
+		switch {
+		case len(placement.ClusterAffinities) != 0:
+			for index := range placement.ClusterAffinities {
+				if util.ClusterMatches(cluster, placement.ClusterAffinities[index].ClusterAffinity) {
+					candidates.Insert(cluster.Name)
+					break
+				}
+			}
+		case placement.ClusterAffinity != nil:
+			if util.ClusterMatches(cluster, *placement.ClusterAffinity) {
+				candidates.Insert(cluster.Name)
+			}
+		default:
+			candidates.Insert(cluster.Name)
+		}
+	}
+	return candidates
+}
+
+// displacedReplicas sums the replicas that the bindings claimed by the policy
+// have currently scheduled to the removed clusters.
+func displacedReplicas(ctx context.Context, c client.Client, policy *policyv1alpha1.PropagationPolicy, removed sets.Set[string]) (int32, bool) {
+	permanentID := policy.Labels[policyv1alpha1.PropagationPolicyPermanentIDLabel]
+	if len(permanentID) == 0 {
+		return 0, false
+	}
+
+	bindingList := &workv1alpha2.ResourceBindingList{}
+	if err := c.List(ctx, bindingList, client.InNamespace(policy.Namespace),
+		client.MatchingLabels{policyv1alpha1.PropagationPolicyPermanentIDLabel: permanentID}); err != nil {
+		klog.Errorf("Failed to list bindings of PropagationPolicy(%s/%s): %v", policy.Namespace, policy.Name, err)
+		return 0, false
+	}
+
+	var displaced int32
+	for i := range bindingList.Items {
+		for _, targetCluster := range bindingList.Items[i].Spec.Clusters {
+			if removed.Has(targetCluster.Name) {
+				displaced += targetCluster.Replicas
+			}
+		}
+	}
+	return displaced, true
+}
+
+// freePodCapacity sums the free pod capacity the candidate clusters report in
+// their resource summaries. The second return value reports whether at least
+// one candidate has a resource summary to base the estimation on.
+func freePodCapacity(clusters []clusterv1alpha1.Cluster, candidates sets.Set[string]) (int64, bool) {
+	var freePods int64
+	summarized := false
+	for i := range clusters {
+		cluster := &clusters[i]
+		if !candidates.Has(cluster.Name) || cluster.Status.ResourceSummary == nil {
+			continue
+		}
+		summarized = true
+		allocatable := cluster.Status.ResourceSummary.Allocatable[corev1.ResourcePods]
+		allocated := cluster.Status.ResourceSummary.Allocated[corev1.ResourcePods]
+		allocating := cluster.Status.ResourceSummary.Allocating[corev1.ResourcePods]
+		if free := allocatable.Value() - allocated.Value() - allocating.Value(); free > 0 {
+			freePods += free
+		}
+	}
+	return freePods, summarized
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propagationpolicy
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+)
+
+func newTestCluster(name string, freePods int64) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: clusterv1alpha1.ClusterStatus{
+			ResourceSummary: &clusterv1alpha1.ResourceSummary{
+				Allocatable: corev1.ResourceList{corev1.ResourcePods: *resource.NewQuantity(freePods, resource.DecimalSI)},
+				Allocated:   corev1.ResourceList{corev1.ResourcePods: *resource.NewQuantity(0, resource.DecimalSI)},
+			},
+		},
+	}
+}
+
+func newTestPolicy(clusterNames ...string) *policyv1alpha1.PropagationPolicy {
+	return &policyv1alpha1.PropagationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "nginx",
+			Labels:    map[string]string{policyv1alpha1.PropagationPolicyPermanentIDLabel: "test-id"},
+		},
+		Spec: policyv1alpha1.PropagationSpec{
+			Placement: policyv1alpha1.Placement{
+				ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: clusterNames},
+			},
+		},
+	}
+}
+
+func TestCapacityImpactWarnings(t *testing.T) {
+	binding := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "nginx-deployment",
+			Labels:    map[string]string{policyv1alpha1.PropagationPolicyPermanentIDLabel: "test-id"},
+		},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Clusters: []workv1alpha2.TargetCluster{
+				{Name: "member1", Replicas: 2},
+				{Name: "member2", Replicas: 3},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		oldPolicy    *policyv1alpha1.PropagationPolicy
+		newPolicy    *policyv1alpha1.PropagationPolicy
+		member2Free  int64
+		wantNone     bool
+		wantContains []string
+	}{
+		{
+			name:      "unchanged candidate set yields no warnings",
+			oldPolicy: newTestPolicy("member1", "member2"),
+			newPolicy: newTestPolicy("member1", "member2"),
+			wantNone:  true,
+		},
+		{
+			name:      "grown candidate set yields no warnings",
+			oldPolicy: newTestPolicy("member1"),
+			newPolicy: newTestPolicy("member1", "member2"),
+			wantNone:  true,
+		},
+		{
+			name:        "shrunk set with enough remaining capacity",
+			oldPolicy:   newTestPolicy("member1", "member2"),
+			newPolicy:   newTestPolicy("member2"),
+			member2Free: 100,
+			wantContains: []string{
+				"removes 1 candidate cluster(s)",
+				"member1",
+				"2 replica(s) currently scheduled to the removed cluster(s) will be rescheduled",
+				"free capacity for 100 pod(s)",
+			},
+		},
+		{
+			name:        "shrunk set without enough remaining capacity",
+			oldPolicy:   newTestPolicy("member1", "member2"),
+			newPolicy:   newTestPolicy("member2"),
+			member2Free: 1,
+			wantContains: []string{
+				"free capacity for only 1 pod(s)",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).
+				WithObjects(newTestCluster("member1", 100), newTestCluster("member2", tt.member2Free), binding).
+				Build()
+			warnings := capacityImpactWarnings(context.TODO(), fakeClient, tt.oldPolicy, tt.newPolicy)
+			if tt.wantNone {
+				if len(warnings) != 0 {
+					t.Errorf("capacityImpactWarnings() = %v, want none", warnings)
+				}
+				return
+			}
+			joined := strings.Join(warnings, "\n")
+			for _, want := range tt.wantContains {
+				if !strings.Contains(joined, want) {
+					t.Errorf("capacityImpactWarnings() = %q, want it to contain %q", joined, want)
+				}
+			}
+		})
+	}
+}
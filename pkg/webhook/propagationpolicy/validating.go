@@ -23,6 +23,7 @@ import (
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
@@ -31,6 +32,9 @@ import (
 
 // ValidatingAdmission validates PropagationPolicy object when creating/updating/deleting.
 type ValidatingAdmission struct {
+	// Client is used to estimate the capacity impact of policy updates, the
+	// estimation is skipped when it is not configured.
+	Client  client.Client
 	Decoder admission.Decoder
 }
 
@@ -39,7 +43,7 @@ var _ admission.Handler = &ValidatingAdmission{}
 
 // Handle implements admission.Handler interface.
 // It yields a response to an AdmissionRequest.
-func (v *ValidatingAdmission) Handle(_ context.Context, req admission.Request) admission.Response {
+func (v *ValidatingAdmission) Handle(ctx context.Context, req admission.Request) admission.Response {
 	policy := &policyv1alpha1.PropagationPolicy{}
 
 	err := v.Decoder.Decode(req, policy)
@@ -48,6 +52,7 @@ func (v *ValidatingAdmission) Handle(_ context.Context, req admission.Request) a
 	}
 	klog.V(2).Infof("Validating PropagationPolicy(%s/%s) for request: %s", policy.Namespace, policy.Name, req.Operation)
 
+	var warnings []string
 	if req.Operation == admissionv1.Update {
 		oldPolicy := &policyv1alpha1.PropagationPolicy{}
 		err = v.Decoder.DecodeRaw(req.OldObject, oldPolicy)
@@ -66,6 +71,10 @@ func (v *ValidatingAdmission) Handle(_ context.Context, req admission.Request) a
 			return admission.Denied(fmt.Sprintf("label %s is immutable, it can only be set by the system during creation",
 				policyv1alpha1.PropagationPolicyPermanentIDLabel))
 		}
+
+		if v.Client != nil {
+			warnings = capacityImpactWarnings(ctx, v.Client, oldPolicy, policy)
+		}
 	}
 	if _, exist := policy.Labels[policyv1alpha1.PropagationPolicyPermanentIDLabel]; !exist {
 		return admission.Denied(fmt.Sprintf("label %s is required, it should be set by the mutating admission webhook during creation",
@@ -77,5 +86,5 @@ func (v *ValidatingAdmission) Handle(_ context.Context, req admission.Request) a
 		klog.Error(errs)
 		return admission.Denied(errs.ToAggregate().Error())
 	}
-	return admission.Allowed("")
+	return admission.Allowed("").WithWarnings(warnings...)
 }
@@ -19,12 +19,15 @@ package resourcebinding
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/google/uuid"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	clustervalidation "github.com/karmada-io/karmada/pkg/apis/cluster/validation"
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
 	"github.com/karmada-io/karmada/pkg/util"
 )
@@ -47,6 +50,11 @@ func (a *MutatingAdmission) Handle(_ context.Context, req admission.Request) adm
 	}
 	klog.V(2).Infof("Mutating resourceBinding(%s/%s) for request: %s", rb.Namespace, rb.Name, req.Operation)
 
+	if err = validateExcludedClustersAnnotation(rb.Annotations); err != nil {
+		klog.Infof("Denied resourceBinding(%s/%s): %v", rb.Namespace, rb.Name, err)
+		return admission.Denied(err.Error())
+	}
+
 	if util.GetLabelValue(rb.Labels, workv1alpha2.ResourceBindingPermanentIDLabel) == "" {
 		util.MergeLabel(rb, workv1alpha2.ResourceBindingPermanentIDLabel, uuid.New().String())
 	}
@@ -58,3 +66,24 @@ func (a *MutatingAdmission) Handle(_ context.Context, req admission.Request) adm
 
 	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledBytes)
 }
+
+// validateExcludedClustersAnnotation rejects malformed cluster names in the
+// binding-scope excluded-clusters annotation.
+func validateExcludedClustersAnnotation(annotations map[string]string) error {
+	value := annotations[workv1alpha2.BindingExcludedClustersAnnotation]
+	if value == "" {
+		return nil
+	}
+
+	for _, cluster := range strings.Split(value, ",") {
+		cluster = strings.TrimSpace(cluster)
+		if cluster == "" {
+			continue
+		}
+		if errs := clustervalidation.ValidateClusterName(cluster); len(errs) > 0 {
+			return fmt.Errorf("invalid cluster name %q in annotation %s: %s",
+				cluster, workv1alpha2.BindingExcludedClustersAnnotation, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
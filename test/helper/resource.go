@@ -556,6 +556,26 @@ func NewPodWithRequest(pod, node string, milliCPU, memory, ephemeralStorage int6
 	}
 }
 
+// NewResourceListWithExtended will build a ResourceList that additionally
+// requests the given extended resources, e.g. nvidia.com/gpu.
+func NewResourceListWithExtended(milliCPU, memory, ephemeralStorage int64, extendedResources map[corev1.ResourceName]int64) corev1.ResourceList {
+	resourceList := NewResourceList(milliCPU, memory, ephemeralStorage)
+	for name, quantity := range extendedResources {
+		resourceList[name] = *resource.NewQuantity(quantity, resource.DecimalSI)
+	}
+	return resourceList
+}
+
+// NewPodWithExtendedRequest will build a Pod that additionally requests the
+// given extended resources, e.g. nvidia.com/gpu.
+func NewPodWithExtendedRequest(pod, node string, milliCPU, memory int64, extendedResources map[corev1.ResourceName]int64) *corev1.Pod {
+	newPod := NewPodWithRequest(pod, node, milliCPU, memory, ResourceUnitZero)
+	for name, quantity := range extendedResources {
+		newPod.Spec.Containers[0].Resources.Requests[name] = *resource.NewQuantity(quantity, resource.DecimalSI)
+	}
+	return newPod
+}
+
 // NewNode will build a ready node with resource.
 func NewNode(node string, milliCPU, memory, pods, ephemeralStorage int64) *corev1.Node {
 	return &corev1.Node{
@@ -586,6 +606,17 @@ func NewNode(node string, milliCPU, memory, pods, ephemeralStorage int64) *corev
 	}
 }
 
+// MakeNodeWithExtendedResource will build a ready node that additionally
+// reports the given extended resources, e.g. nvidia.com/gpu, as allocatable.
+func MakeNodeWithExtendedResource(node string, milliCPU, memory, pods, ephemeralStorage int64, extendedResources map[corev1.ResourceName]int64) *corev1.Node {
+	newNode := NewNode(node, milliCPU, memory, pods, ephemeralStorage)
+	for name, quantity := range extendedResources {
+		newNode.Status.Capacity[name] = *resource.NewQuantity(quantity, resource.DecimalSI)
+		newNode.Status.Allocatable[name] = *resource.NewQuantity(quantity, resource.DecimalSI)
+	}
+	return newNode
+}
+
 // MakeNodesAndPods will make batch of nodes and pods based on template.
 func MakeNodesAndPods(allNodesNum, allPodsNum int, nodeTemplate *corev1.Node, podTemplate *corev1.Pod) ([]*corev1.Node, []*corev1.Pod) {
 	nodes := make([]*corev1.Node, 0, allNodesNum)